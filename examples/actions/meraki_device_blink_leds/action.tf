@@ -0,0 +1,22 @@
+action "meraki_device_blink_leds" "example" {
+  config {
+    serial   = "Q234-ABCD-5678"
+    duration = 30
+  }
+}
+
+# Blink the LEDs of a switch once it is renamed, to find it in the rack.
+resource "meraki_devices" "example" {
+  serial = "Q234-ABCD-5678"
+  name   = "access-switch-01"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.meraki_device_blink_leds.example]
+    }
+  }
+}
+
+# Or invoke it on its own:
+# terraform apply -invoke=action.meraki_device_blink_leds.example
@@ -0,0 +1,8 @@
+action "meraki_device_switch_ports_cycle" "example" {
+  config {
+    serial = "Q234-ABCD-5678"
+    ports  = ["1", "2-5"]
+  }
+}
+
+# terraform apply -invoke=action.meraki_device_switch_ports_cycle.example
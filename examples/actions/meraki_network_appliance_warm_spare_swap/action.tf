@@ -0,0 +1,7 @@
+action "meraki_network_appliance_warm_spare_swap" "example" {
+  config {
+    network_id = "string"
+  }
+}
+
+# terraform apply -invoke=action.meraki_network_appliance_warm_spare_swap.example
@@ -0,0 +1,12 @@
+action "meraki_network_firmware_rollback" "example" {
+  config {
+    network_id = "string"
+    product    = "switch"
+    reasons = [{
+      category = "performance"
+      comment  = "Throughput dropped after the upgrade"
+    }]
+  }
+}
+
+# terraform apply -invoke=action.meraki_network_firmware_rollback.example
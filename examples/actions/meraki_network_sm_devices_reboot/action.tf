@@ -0,0 +1,9 @@
+action "meraki_network_sm_devices_reboot" "example" {
+  config {
+    network_id  = "string"
+    serials     = ["XY0XX0Y0X0"]
+    notify_user = true
+  }
+}
+
+# terraform apply -invoke=action.meraki_network_sm_devices_reboot.example
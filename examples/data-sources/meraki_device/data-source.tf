@@ -0,0 +1,9 @@
+data "meraki_device" "lobby_ap" {
+  organization_id = "string"
+  name            = "AP"
+  tag             = "lobby"
+}
+
+output "meraki_device_lobby_ap" {
+  value = data.meraki_device.lobby_ap.item.serial
+}
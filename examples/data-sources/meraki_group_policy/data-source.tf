@@ -0,0 +1,8 @@
+data "meraki_group_policy" "guests" {
+  network_id = "string"
+  name       = "Guests"
+}
+
+output "meraki_group_policy_guests" {
+  value = data.meraki_group_policy.guests.item.group_policy_id
+}
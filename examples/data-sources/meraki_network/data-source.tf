@@ -0,0 +1,8 @@
+data "meraki_network" "branch" {
+  organization_id = "string"
+  name            = "Branch 2"
+}
+
+output "meraki_network_branch" {
+  value = data.meraki_network.branch.item.id
+}
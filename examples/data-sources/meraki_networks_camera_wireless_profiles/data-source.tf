@@ -5,7 +5,8 @@ data "meraki_networks_camera_wireless_profiles" "example" {
 }
 
 output "meraki_networks_camera_wireless_profiles_example" {
-  value = data.meraki_networks_camera_wireless_profiles.example.items
+  value     = data.meraki_networks_camera_wireless_profiles.example.items
+  sensitive = true
 }
 
 data "meraki_networks_camera_wireless_profiles" "example" {
@@ -14,5 +15,6 @@ data "meraki_networks_camera_wireless_profiles" "example" {
 }
 
 output "meraki_networks_camera_wireless_profiles_example" {
-  value = data.meraki_networks_camera_wireless_profiles.example.item
+  value     = data.meraki_networks_camera_wireless_profiles.example.item
+  sensitive = true
 }
@@ -5,5 +5,6 @@ data "meraki_networks_snmp" "example" {
 }
 
 output "meraki_networks_snmp_example" {
-  value = data.meraki_networks_snmp.example.item
+  value     = data.meraki_networks_snmp.example.item
+  sensitive = true
 }
@@ -5,5 +5,6 @@ data "meraki_networks_switch_routing_ospf" "example" {
 }
 
 output "meraki_networks_switch_routing_ospf_example" {
-  value = data.meraki_networks_switch_routing_ospf.example.item
+  value     = data.meraki_networks_switch_routing_ospf.example.item
+  sensitive = true
 }
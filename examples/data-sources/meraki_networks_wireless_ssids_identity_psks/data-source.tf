@@ -6,7 +6,8 @@ data "meraki_networks_wireless_ssids_identity_psks" "example" {
 }
 
 output "meraki_networks_wireless_ssids_identity_psks_example" {
-  value = data.meraki_networks_wireless_ssids_identity_psks.example.items
+  value     = data.meraki_networks_wireless_ssids_identity_psks.example.items
+  sensitive = true
 }
 
 data "meraki_networks_wireless_ssids_identity_psks" "example" {
@@ -16,5 +17,6 @@ data "meraki_networks_wireless_ssids_identity_psks" "example" {
 }
 
 output "meraki_networks_wireless_ssids_identity_psks_example" {
-  value = data.meraki_networks_wireless_ssids_identity_psks.example.item
+  value     = data.meraki_networks_wireless_ssids_identity_psks.example.item
+  sensitive = true
 }
@@ -5,5 +5,6 @@ data "meraki_organizations_appliance_vpn_third_party_vpnpeers" "example" {
 }
 
 output "meraki_organizations_appliance_vpn_third_party_vpnpeers_example" {
-  value = data.meraki_organizations_appliance_vpn_third_party_vpnpeers.example.item
+  value     = data.meraki_organizations_appliance_vpn_third_party_vpnpeers.example.item
+  sensitive = true
 }
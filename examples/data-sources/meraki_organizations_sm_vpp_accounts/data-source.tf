@@ -5,7 +5,8 @@ data "meraki_organizations_sm_vpp_accounts" "example" {
 }
 
 output "meraki_organizations_sm_vpp_accounts_example" {
-  value = data.meraki_organizations_sm_vpp_accounts.example.items
+  value     = data.meraki_organizations_sm_vpp_accounts.example.items
+  sensitive = true
 }
 
 data "meraki_organizations_sm_vpp_accounts" "example" {
@@ -14,5 +15,6 @@ data "meraki_organizations_sm_vpp_accounts" "example" {
 }
 
 output "meraki_organizations_sm_vpp_accounts_example" {
-  value = data.meraki_organizations_sm_vpp_accounts.example.item
+  value     = data.meraki_organizations_sm_vpp_accounts.example.item
+  sensitive = true
 }
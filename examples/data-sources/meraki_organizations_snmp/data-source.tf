@@ -5,5 +5,6 @@ data "meraki_organizations_snmp" "example" {
 }
 
 output "meraki_organizations_snmp_example" {
-  value = data.meraki_organizations_snmp.example.item
+  value     = data.meraki_organizations_snmp.example.item
+  sensitive = true
 }
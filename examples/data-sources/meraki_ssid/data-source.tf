@@ -0,0 +1,8 @@
+data "meraki_ssid" "guest" {
+  network_id = "string"
+  name       = "Guest"
+}
+
+output "meraki_ssid_guest" {
+  value = data.meraki_ssid.guest.item.number
+}
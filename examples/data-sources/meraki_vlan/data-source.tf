@@ -0,0 +1,8 @@
+data "meraki_vlan" "voice" {
+  network_id = "string"
+  name       = "Voice"
+}
+
+output "meraki_vlan_voice" {
+  value = data.meraki_vlan.voice.item.id
+}
@@ -0,0 +1,8 @@
+ephemeral "meraki_api_key" "example" {
+}
+
+# The key is revoked when Terraform closes the ephemeral resource at the end of the run.
+provider "meraki" {
+  alias                    = "short_lived"
+  meraki_dashboard_api_key = ephemeral.meraki_api_key.example.key
+}
@@ -0,0 +1,8 @@
+ephemeral "meraki_vmx_authentication_token" "example" {
+
+  serial = "string"
+}
+
+# The token can only be referenced from ephemeral contexts, such as write-only
+# arguments, provider blocks or other ephemeral resources, for example to pass it
+# to the user data of a vMX cloud instance.
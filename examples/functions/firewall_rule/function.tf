@@ -0,0 +1,8 @@
+resource "meraki_networks_appliance_firewall_l3_firewall_rules" "example" {
+
+  network_id = "string"
+  rules = [
+    provider::meraki::firewall_rule("Allow web", "allow", "tcp", ["10.0.0.0/24", "10.0.1.0/24"], "Any", [], "80,443"),
+    provider::meraki::firewall_rule("Block guest", "deny", "any", ["VLAN(20).*"], "Any", ["10.0.0.0/8"], "Any"),
+  ]
+}
@@ -0,0 +1,3 @@
+output "web_ports" {
+  value = provider::meraki::normalize_port_range("80, 443, 8000 - 8080")
+}
@@ -0,0 +1,5 @@
+resource "meraki_devices" "example" {
+
+  serial = provider::meraki::parse_serial("q2xxxxxxxxxx")
+  name   = "Main office MX"
+}
@@ -0,0 +1,12 @@
+locals {
+  users = provider::meraki::vlan_subnet("192.168.10.0/24", 1)
+}
+
+resource "meraki_networks_appliance_vlans" "example" {
+
+  network_id   = "string"
+  id           = "10"
+  name         = "Users"
+  subnet       = local.users.subnet
+  appliance_ip = local.users.appliance_ip
+}
@@ -4,5 +4,6 @@ resource "meraki_administered_identities_me_api_keys_generate" "example" {
 }
 
 output "meraki_administered_identities_me_api_keys_generate_example" {
-  value = meraki_administered_identities_me_api_keys_generate.example
+  value     = meraki_administered_identities_me_api_keys_generate.example
+  sensitive = true
 }
\ No newline at end of file
@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices.example
+  identity = {
+    serial = "string"
+  }
+}
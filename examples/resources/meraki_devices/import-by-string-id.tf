@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices.example
+  id = "serial"
+}
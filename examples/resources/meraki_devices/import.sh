@@ -1 +1 @@
-terraform import meraki_devices.example "organization_id,serial"
\ No newline at end of file
+terraform import meraki_devices.example "serial"
\ No newline at end of file
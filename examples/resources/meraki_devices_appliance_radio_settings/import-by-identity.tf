@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_appliance_radio_settings.example
+  identity = {
+    serial = "string"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_appliance_uplinks_settings.example
+  identity = {
+    serial = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_appliance_uplinks_settings.example
+  id = "serial"
+}
@@ -8,5 +8,6 @@ resource "meraki_devices_appliance_vmx_authentication_token" "example" {
 }
 
 output "meraki_devices_appliance_vmx_authentication_token_example" {
-  value = meraki_devices_appliance_vmx_authentication_token.example
+  value     = meraki_devices_appliance_vmx_authentication_token.example
+  sensitive = true
 }
\ No newline at end of file
@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_camera_custom_analytics.example
+  identity = {
+    serial = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_camera_custom_analytics.example
+  id = "serial"
+}
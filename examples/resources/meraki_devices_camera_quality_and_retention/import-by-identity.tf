@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_camera_quality_and_retention.example
+  identity = {
+    serial = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_camera_quality_and_retention.example
+  id = "serial"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_camera_sense.example
+  identity = {
+    serial = "string"
+  }
+}
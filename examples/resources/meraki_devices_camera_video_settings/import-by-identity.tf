@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_camera_video_settings.example
+  identity = {
+    serial = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_camera_video_settings.example
+  id = "serial"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_camera_wireless_profiles.example
+  identity = {
+    serial = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_camera_wireless_profiles.example
+  id = "serial"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_cellular_gateway_lan.example
+  identity = {
+    serial = "string"
+  }
+}
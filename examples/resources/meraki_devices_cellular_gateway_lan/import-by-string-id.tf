@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_cellular_gateway_lan.example
+  id = "serial"
+}
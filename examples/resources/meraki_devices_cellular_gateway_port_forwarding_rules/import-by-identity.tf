@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_cellular_gateway_port_forwarding_rules.example
+  identity = {
+    serial = "string"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_cellular_sims.example
+  identity = {
+    serial = "string"
+  }
+}
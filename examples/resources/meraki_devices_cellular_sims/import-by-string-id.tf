@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_cellular_sims.example
+  id = "serial"
+}
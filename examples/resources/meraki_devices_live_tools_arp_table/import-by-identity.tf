@@ -0,0 +1,7 @@
+import {
+  to = meraki_devices_live_tools_arp_table.example
+  identity = {
+    serial       = "string"
+    arp_table_id = "string"
+  }
+}
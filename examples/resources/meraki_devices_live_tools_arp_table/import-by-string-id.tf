@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_live_tools_arp_table.example
+  id = "serial,arp_table_id"
+}
@@ -1 +1 @@
-terraform import meraki_devices_live_tools_arp_table.example "arp_table_id,serial"
\ No newline at end of file
+terraform import meraki_devices_live_tools_arp_table.example "serial,arp_table_id"
\ No newline at end of file
@@ -18,5 +18,6 @@ resource "meraki_devices_live_tools_arp_table" "example" {
 }
 
 output "meraki_devices_live_tools_arp_table_example" {
-  value = meraki_devices_live_tools_arp_table.example
+  value     = meraki_devices_live_tools_arp_table.example
+  sensitive = true
 }
\ No newline at end of file
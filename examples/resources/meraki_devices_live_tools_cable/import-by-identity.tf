@@ -0,0 +1,7 @@
+import {
+  to = meraki_devices_live_tools_cable.example
+  identity = {
+    serial = "string"
+    id     = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_live_tools_cable.example
+  id = "serial,id"
+}
@@ -1 +1 @@
-terraform import meraki_devices_live_tools_cable.example "id,serial"
\ No newline at end of file
+terraform import meraki_devices_live_tools_cable.example "serial,id"
\ No newline at end of file
@@ -19,5 +19,6 @@ resource "meraki_devices_live_tools_cable" "example" {
 }
 
 output "meraki_devices_live_tools_cable_example" {
-  value = meraki_devices_live_tools_cable.example
+  value     = meraki_devices_live_tools_cable.example
+  sensitive = true
 }
\ No newline at end of file
@@ -22,5 +22,6 @@ resource "meraki_devices_live_tools_leds_blink" "example" {
 }
 
 output "meraki_devices_live_tools_leds_blink_example" {
-  value = meraki_devices_live_tools_leds_blink.example
+  value     = meraki_devices_live_tools_leds_blink.example
+  sensitive = true
 }
\ No newline at end of file
@@ -23,5 +23,6 @@ resource "meraki_devices_live_tools_ping" "example" {
 }
 
 output "meraki_devices_live_tools_ping_example" {
-  value = meraki_devices_live_tools_ping.example
+  value     = meraki_devices_live_tools_ping.example
+  sensitive = true
 }
\ No newline at end of file
@@ -22,5 +22,6 @@ resource "meraki_devices_live_tools_ping_device" "example" {
 }
 
 output "meraki_devices_live_tools_ping_device_example" {
-  value = meraki_devices_live_tools_ping_device.example
+  value     = meraki_devices_live_tools_ping_device.example
+  sensitive = true
 }
\ No newline at end of file
@@ -18,5 +18,6 @@ resource "meraki_devices_live_tools_throughput_test" "example" {
 }
 
 output "meraki_devices_live_tools_throughput_test_example" {
-  value = meraki_devices_live_tools_throughput_test.example
+  value     = meraki_devices_live_tools_throughput_test.example
+  sensitive = true
 }
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = meraki_devices_live_tools_wake_on_lan.example
+  identity = {
+    serial         = "string"
+    wake_on_lan_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_live_tools_wake_on_lan.example
+  id = "serial,wake_on_lan_id"
+}
@@ -20,5 +20,6 @@ resource "meraki_devices_live_tools_wake_on_lan" "example" {
 }
 
 output "meraki_devices_live_tools_wake_on_lan_example" {
-  value = meraki_devices_live_tools_wake_on_lan.example
+  value     = meraki_devices_live_tools_wake_on_lan.example
+  sensitive = true
 }
\ No newline at end of file
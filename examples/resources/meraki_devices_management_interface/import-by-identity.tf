@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_management_interface.example
+  identity = {
+    serial = "string"
+  }
+}
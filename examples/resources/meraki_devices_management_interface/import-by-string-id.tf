@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_management_interface.example
+  id = "serial"
+}
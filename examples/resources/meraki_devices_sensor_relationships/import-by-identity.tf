@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_sensor_relationships.example
+  identity = {
+    serial = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_sensor_relationships.example
+  id = "serial"
+}
@@ -0,0 +1,7 @@
+import {
+  to = meraki_devices_switch_port_ranges.example
+  identity = {
+    serial   = "string"
+    port_ids = "string"
+  }
+}
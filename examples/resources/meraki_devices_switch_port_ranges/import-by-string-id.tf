@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_switch_port_ranges.example
+  id = "serial,port_ids"
+}
@@ -0,0 +1 @@
+terraform import meraki_devices_switch_port_ranges.example "serial,port_ids"
\ No newline at end of file
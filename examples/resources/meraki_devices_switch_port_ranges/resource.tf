@@ -0,0 +1,19 @@
+
+resource "meraki_devices_switch_port_ranges" "example" {
+  organization_id = "string"
+  serial          = "string"
+  port_ids        = "1-24,49"
+
+  access_policy_number = 2
+  access_policy_type   = "Custom access policy"
+  poe_enabled          = true
+  stp_guard            = "bpdu guard"
+  tags                 = ["desk"]
+  type                 = "access"
+  vlan                 = 10
+  voice_vlan           = 20
+}
+
+output "meraki_devices_switch_port_ranges_example" {
+  value = meraki_devices_switch_port_ranges.example
+}
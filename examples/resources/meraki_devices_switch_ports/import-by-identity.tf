@@ -0,0 +1,7 @@
+import {
+  to = meraki_devices_switch_ports.example
+  identity = {
+    serial  = "string"
+    port_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_switch_ports.example
+  id = "serial,port_id"
+}
@@ -1 +1 @@
-terraform import meraki_devices_switch_ports.example "port_id,serial"
\ No newline at end of file
+terraform import meraki_devices_switch_ports.example "serial,port_id"
\ No newline at end of file
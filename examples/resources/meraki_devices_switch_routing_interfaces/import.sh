@@ -1 +1 @@
-terraform import meraki_devices_switch_routing_interfaces.example "interface_id,serial"
\ No newline at end of file
+terraform import meraki_devices_switch_routing_interfaces.example "serial,interface_id"
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = meraki_devices_switch_routing_interfaces_dhcp.example
+  identity = {
+    serial       = "string"
+    interface_id = "string"
+  }
+}
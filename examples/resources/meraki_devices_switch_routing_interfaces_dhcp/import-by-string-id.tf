@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_switch_routing_interfaces_dhcp.example
+  id = "serial,interface_id"
+}
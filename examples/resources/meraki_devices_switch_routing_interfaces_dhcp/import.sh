@@ -1 +1 @@
-terraform import meraki_devices_switch_routing_interfaces_dhcp.example "interface_id,serial"
\ No newline at end of file
+terraform import meraki_devices_switch_routing_interfaces_dhcp.example "serial,interface_id"
\ No newline at end of file
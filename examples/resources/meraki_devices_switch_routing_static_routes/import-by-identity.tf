@@ -0,0 +1,7 @@
+import {
+  to = meraki_devices_switch_routing_static_routes.example
+  identity = {
+    serial          = "string"
+    static_route_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_switch_routing_static_routes.example
+  id = "serial,static_route_id"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_switch_warm_spare.example
+  identity = {
+    serial = "string"
+  }
+}
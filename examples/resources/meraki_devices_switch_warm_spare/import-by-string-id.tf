@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_switch_warm_spare.example
+  id = "serial"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_wireless_bluetooth_settings.example
+  identity = {
+    serial = "string"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_devices_wireless_electronic_shelf_label.example
+  identity = {
+    serial = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_wireless_electronic_shelf_label.example
+  id = "serial"
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_devices_wireless_radio_settings.example
+  id = "serial"
+}
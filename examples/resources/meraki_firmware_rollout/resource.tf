@@ -0,0 +1,23 @@
+
+resource "meraki_firmware_rollout" "example" {
+  organization_id = "string"
+  network_id      = "string"
+  to_version_id   = "1234"
+
+  stages = [{
+    group_id = "1234"
+    }, {
+    group_id = "5678"
+  }]
+
+  offline_threshold = "20m"
+  on_failure        = "rollback"
+
+  timeouts {
+    create = "4h"
+  }
+}
+
+output "meraki_firmware_rollout_example" {
+  value = meraki_firmware_rollout.example
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks.example
+  identity = {
+    id = "string"
+  }
+}
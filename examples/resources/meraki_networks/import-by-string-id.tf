@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks.example
+  id = "id"
+}
@@ -1 +1 @@
-terraform import meraki_networks.example "network_id"
\ No newline at end of file
+terraform import meraki_networks.example "id"
\ No newline at end of file
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_appliance_connectivity_monitoring_destinations.example
+  identity = {
+    network_id = "string"
+  }
+}
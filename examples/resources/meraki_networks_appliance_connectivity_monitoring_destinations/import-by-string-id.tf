@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_connectivity_monitoring_destinations.example
+  id = "network_id"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_appliance_content_filtering.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_content_filtering.example
+  id = "network_id"
+}
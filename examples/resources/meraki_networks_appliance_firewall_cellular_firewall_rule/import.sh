@@ -0,0 +1 @@
+terraform import meraki_networks_appliance_firewall_cellular_firewall_rule.example "network_id,priority"
\ No newline at end of file
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_appliance_firewall_cellular_firewall_rules.example
+  identity = {
+    network_id = "string"
+  }
+}
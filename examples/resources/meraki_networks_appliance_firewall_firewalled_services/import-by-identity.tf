@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_appliance_firewall_firewalled_services.example
+  identity = {
+    network_id = "string"
+    service    = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_firewall_firewalled_services.example
+  id = "network_id,service"
+}
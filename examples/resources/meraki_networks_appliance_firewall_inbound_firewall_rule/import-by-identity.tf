@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_appliance_firewall_inbound_firewall_rule.example
+  identity = {
+    network_id = "string"
+    priority   = "string"
+  }
+}
@@ -0,0 +1,13 @@
+resource "meraki_networks_appliance_firewall_inbound_firewall_rule" "example" {
+  network_id = "string"
+  priority   = 1
+
+  comment        = "Allow TCP traffic to subnet with HTTP servers."
+  dest_cidr      = "192.168.1.0/24"
+  dest_port      = "443"
+  policy         = "allow"
+  protocol       = "tcp"
+  src_cidr       = "Any"
+  src_port       = "Any"
+  syslog_enabled = false
+}
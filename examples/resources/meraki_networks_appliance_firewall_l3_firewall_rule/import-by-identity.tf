@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_appliance_firewall_l3_firewall_rule.example
+  identity = {
+    network_id = "string"
+    priority   = "string"
+  }
+}
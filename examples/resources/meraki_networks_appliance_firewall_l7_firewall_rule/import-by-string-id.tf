@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_firewall_l7_firewall_rule.example
+  id = "network_id,priority"
+}
@@ -0,0 +1,8 @@
+resource "meraki_networks_appliance_firewall_l7_firewall_rule" "example" {
+  network_id = "string"
+  priority   = 1
+
+  policy = "deny"
+  type   = "host"
+  value  = "google.com"
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_firewall_one_to_many_nat_rules.example
+  id = "network_id"
+}
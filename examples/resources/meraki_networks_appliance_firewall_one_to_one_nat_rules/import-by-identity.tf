@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_appliance_firewall_one_to_one_nat_rules.example
+  identity = {
+    network_id = "string"
+  }
+}
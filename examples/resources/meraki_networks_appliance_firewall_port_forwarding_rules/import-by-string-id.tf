@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_firewall_port_forwarding_rules.example
+  id = "network_id"
+}
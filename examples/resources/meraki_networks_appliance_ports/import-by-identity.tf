@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_appliance_ports.example
+  identity = {
+    network_id = "string"
+    port_id    = "string"
+  }
+}
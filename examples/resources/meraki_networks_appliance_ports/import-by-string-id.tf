@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_ports.example
+  id = "network_id,port_id"
+}
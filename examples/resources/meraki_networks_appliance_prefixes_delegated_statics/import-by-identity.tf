@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_appliance_prefixes_delegated_statics.example
+  identity = {
+    network_id                 = "string"
+    static_delegated_prefix_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_prefixes_delegated_statics.example
+  id = "network_id,static_delegated_prefix_id"
+}
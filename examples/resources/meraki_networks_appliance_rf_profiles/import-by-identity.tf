@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_appliance_rf_profiles.example
+  identity = {
+    network_id = "string"
+    id         = "string"
+  }
+}
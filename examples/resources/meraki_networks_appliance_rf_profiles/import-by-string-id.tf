@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_rf_profiles.example
+  id = "network_id,id"
+}
@@ -1 +1 @@
-terraform import meraki_networks_appliance_rf_profiles.example "network_id,rf_profile_id"
\ No newline at end of file
+terraform import meraki_networks_appliance_rf_profiles.example "network_id,id"
\ No newline at end of file
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_appliance_security_intrusion.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_security_intrusion.example
+  id = "network_id"
+}
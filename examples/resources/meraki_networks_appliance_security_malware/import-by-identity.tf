@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_appliance_security_malware.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_security_malware.example
+  id = "network_id"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_appliance_single_lan.example
+  identity = {
+    network_id = "string"
+  }
+}
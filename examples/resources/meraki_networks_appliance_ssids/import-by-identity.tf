@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_appliance_ssids.example
+  identity = {
+    network_id = "string"
+    number     = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_ssids.example
+  id = "network_id,number"
+}
@@ -29,5 +29,6 @@ resource "meraki_networks_appliance_ssids" "example" {
 }
 
 output "meraki_networks_appliance_ssids_example" {
-  value = meraki_networks_appliance_ssids.example
+  value     = meraki_networks_appliance_ssids.example
+  sensitive = true
 }
\ No newline at end of file
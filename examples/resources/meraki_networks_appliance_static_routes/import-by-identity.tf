@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_appliance_static_routes.example
+  identity = {
+    network_id      = "string"
+    static_route_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_static_routes.example
+  id = "network_id,static_route_id"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_appliance_traffic_shaping.example
+  identity = {
+    network_id = "string"
+  }
+}
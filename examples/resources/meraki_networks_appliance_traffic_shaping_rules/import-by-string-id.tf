@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_traffic_shaping_rules.example
+  id = "network_id"
+}
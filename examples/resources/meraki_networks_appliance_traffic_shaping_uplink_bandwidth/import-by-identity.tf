@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_appliance_traffic_shaping_uplink_bandwidth.example
+  identity = {
+    network_id = "string"
+  }
+}
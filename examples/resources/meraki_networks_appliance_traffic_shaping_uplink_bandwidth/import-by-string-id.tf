@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_traffic_shaping_uplink_bandwidth.example
+  id = "network_id"
+}
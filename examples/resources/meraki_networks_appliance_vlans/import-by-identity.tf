@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_appliance_vlans.example
+  identity = {
+    network_id = "string"
+    id         = "string"
+  }
+}
@@ -1 +1 @@
-terraform import meraki_networks_appliance_vlans.example "network_id,vlan_id"
\ No newline at end of file
+terraform import meraki_networks_appliance_vlans.example "network_id,id"
\ No newline at end of file
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_vlans_settings.example
+  id = "network_id"
+}
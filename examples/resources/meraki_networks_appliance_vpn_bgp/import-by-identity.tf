@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_appliance_vpn_bgp.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_vpn_bgp.example
+  id = "network_id"
+}
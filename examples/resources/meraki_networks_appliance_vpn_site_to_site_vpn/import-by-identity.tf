@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_appliance_vpn_site_to_site_vpn.example
+  identity = {
+    network_id = "string"
+  }
+}
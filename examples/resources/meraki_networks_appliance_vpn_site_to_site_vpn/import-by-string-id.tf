@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_vpn_site_to_site_vpn.example
+  id = "network_id"
+}
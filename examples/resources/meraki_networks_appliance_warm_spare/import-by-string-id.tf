@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_appliance_warm_spare.example
+  id = "network_id"
+}
@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_camera_quality_retention_profiles.example
+  identity = {
+    network_id                   = "string"
+    quality_retention_profile_id = "string"
+  }
+}
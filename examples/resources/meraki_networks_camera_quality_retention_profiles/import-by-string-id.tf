@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_camera_quality_retention_profiles.example
+  id = "network_id,quality_retention_profile_id"
+}
@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_camera_wireless_profiles.example
+  identity = {
+    network_id          = "string"
+    wireless_profile_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_camera_wireless_profiles.example
+  id = "network_id,wireless_profile_id"
+}
@@ -18,5 +18,6 @@ resource "meraki_networks_camera_wireless_profiles" "example" {
 }
 
 output "meraki_networks_camera_wireless_profiles_example" {
-  value = meraki_networks_camera_wireless_profiles.example
+  value     = meraki_networks_camera_wireless_profiles.example
+  sensitive = true
 }
\ No newline at end of file
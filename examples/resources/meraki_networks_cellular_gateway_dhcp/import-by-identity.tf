@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_cellular_gateway_dhcp.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_cellular_gateway_subnet_pool.example
+  id = "network_id"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_cellular_gateway_uplink.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_cellular_gateway_uplink.example
+  id = "network_id"
+}
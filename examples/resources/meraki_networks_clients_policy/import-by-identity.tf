@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_clients_policy.example
+  identity = {
+    network_id = "string"
+    client_id  = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_clients_policy.example
+  id = "network_id,client_id"
+}
@@ -1 +1 @@
-terraform import meraki_networks_clients_policy.example "client_id,network_id"
\ No newline at end of file
+terraform import meraki_networks_clients_policy.example "network_id,client_id"
\ No newline at end of file
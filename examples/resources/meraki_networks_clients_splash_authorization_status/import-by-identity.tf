@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_clients_splash_authorization_status.example
+  identity = {
+    network_id = "string"
+    client_id  = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_clients_splash_authorization_status.example
+  id = "network_id,client_id"
+}
@@ -1 +1 @@
-terraform import meraki_networks_clients_splash_authorization_status.example "client_id,network_id"
\ No newline at end of file
+terraform import meraki_networks_clients_splash_authorization_status.example "network_id,client_id"
\ No newline at end of file
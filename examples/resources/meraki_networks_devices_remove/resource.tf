@@ -1,8 +1,9 @@
 
 resource "meraki_networks_devices_remove" "example" {
 
-  network_id = "string"
-  parameters = {
+  confirm_target = "Q234-ABCD-5678"
+  network_id     = "string"
+  parameters     = {
 
     serial = "Q234-ABCD-5678"
   }
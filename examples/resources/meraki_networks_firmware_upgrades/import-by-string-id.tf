@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_firmware_upgrades.example
+  id = "network_id"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_firmware_upgrades_staged_events.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_firmware_upgrades_staged_groups.example
+  identity = {
+    network_id = "string"
+    group_id   = "string"
+  }
+}
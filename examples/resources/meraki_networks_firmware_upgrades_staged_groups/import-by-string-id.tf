@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_firmware_upgrades_staged_groups.example
+  id = "network_id,group_id"
+}
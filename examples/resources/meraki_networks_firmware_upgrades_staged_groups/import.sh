@@ -1 +1 @@
-terraform import meraki_networks_firmware_upgrades_staged_groups.example "group_id,network_id"
\ No newline at end of file
+terraform import meraki_networks_firmware_upgrades_staged_groups.example "network_id,group_id"
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_floor_plans.example
+  identity = {
+    network_id    = "string"
+    floor_plan_id = "string"
+  }
+}
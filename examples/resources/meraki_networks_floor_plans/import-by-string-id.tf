@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_floor_plans.example
+  id = "network_id,floor_plan_id"
+}
@@ -1 +1 @@
-terraform import meraki_networks_floor_plans.example "floor_plan_id,network_id"
\ No newline at end of file
+terraform import meraki_networks_floor_plans.example "network_id,floor_plan_id"
\ No newline at end of file
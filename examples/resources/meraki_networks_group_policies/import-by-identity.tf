@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_group_policies.example
+  identity = {
+    network_id      = "string"
+    group_policy_id = "string"
+  }
+}
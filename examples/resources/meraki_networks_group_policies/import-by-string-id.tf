@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_group_policies.example
+  id = "network_id,group_policy_id"
+}
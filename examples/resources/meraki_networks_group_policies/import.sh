@@ -1 +1 @@
-terraform import meraki_networks_group_policies.example "group_policy_id,network_id"
\ No newline at end of file
+terraform import meraki_networks_group_policies.example "network_id,group_policy_id"
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_meraki_auth_users.example
+  identity = {
+    network_id          = "string"
+    meraki_auth_user_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_meraki_auth_users.example
+  id = "network_id,meraki_auth_user_id"
+}
@@ -1 +1 @@
-terraform import meraki_networks_meraki_auth_users.example "meraki_auth_user_id,network_id"
\ No newline at end of file
+terraform import meraki_networks_meraki_auth_users.example "network_id,meraki_auth_user_id"
\ No newline at end of file
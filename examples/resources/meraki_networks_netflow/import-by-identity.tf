@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_netflow.example
+  identity = {
+    network_id = "string"
+  }
+}
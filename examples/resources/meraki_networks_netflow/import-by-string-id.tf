@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_netflow.example
+  id = "network_id"
+}
@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_sensor_alerts_profiles.example
+  identity = {
+    network_id = "string"
+    id         = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_sensor_alerts_profiles.example
+  id = "network_id,id"
+}
@@ -1 +1 @@
-terraform import meraki_networks_sensor_alerts_profiles.example "id,network_id"
\ No newline at end of file
+terraform import meraki_networks_sensor_alerts_profiles.example "network_id,id"
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_sensor_mqtt_brokers.example
+  identity = {
+    network_id     = "string"
+    mqtt_broker_id = "string"
+  }
+}
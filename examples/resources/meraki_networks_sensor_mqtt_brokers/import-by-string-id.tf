@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_sensor_mqtt_brokers.example
+  id = "network_id,mqtt_broker_id"
+}
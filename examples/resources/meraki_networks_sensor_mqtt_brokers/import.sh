@@ -1 +1 @@
-terraform import meraki_networks_sensor_mqtt_brokers.example "mqtt_broker_id,network_id"
\ No newline at end of file
+terraform import meraki_networks_sensor_mqtt_brokers.example "network_id,mqtt_broker_id"
\ No newline at end of file
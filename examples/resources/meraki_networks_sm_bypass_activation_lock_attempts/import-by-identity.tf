@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_sm_bypass_activation_lock_attempts.example
+  identity = {
+    network_id = "string"
+    attempt_id = "string"
+  }
+}
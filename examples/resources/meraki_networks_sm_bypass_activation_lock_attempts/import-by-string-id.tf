@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_sm_bypass_activation_lock_attempts.example
+  id = "network_id,attempt_id"
+}
@@ -1 +1 @@
-terraform import meraki_networks_sm_bypass_activation_lock_attempts.example "attempt_id,network_id"
\ No newline at end of file
+terraform import meraki_networks_sm_bypass_activation_lock_attempts.example "network_id,attempt_id"
\ No newline at end of file
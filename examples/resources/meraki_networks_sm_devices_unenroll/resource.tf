@@ -1,9 +1,10 @@
 
 resource "meraki_networks_sm_devices_unenroll" "example" {
 
-  device_id  = "string"
-  network_id = "string"
-  parameters = {
+  confirm_target = "string"
+  device_id      = "string"
+  network_id     = "string"
+  parameters     = {
 
   }
 }
@@ -1,8 +1,9 @@
 
 resource "meraki_networks_sm_devices_wipe" "example" {
 
-  network_id = "string"
-  parameters = {
+  confirm_target = "XY0XX0Y0X0"
+  network_id     = "string"
+  parameters     = {
 
     id       = "1284392014819"
     pin      = 123456
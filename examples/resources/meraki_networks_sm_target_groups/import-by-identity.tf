@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_sm_target_groups.example
+  identity = {
+    network_id      = "string"
+    target_group_id = "string"
+  }
+}
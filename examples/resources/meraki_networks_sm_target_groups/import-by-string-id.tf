@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_sm_target_groups.example
+  id = "network_id,target_group_id"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_snmp.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_snmp.example
+  id = "network_id"
+}
@@ -12,5 +12,6 @@ resource "meraki_networks_snmp" "example" {
 }
 
 output "meraki_networks_snmp_example" {
-  value = meraki_networks_snmp.example
+  value     = meraki_networks_snmp.example
+  sensitive = true
 }
\ No newline at end of file
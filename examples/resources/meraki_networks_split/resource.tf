@@ -1,8 +1,9 @@
 
 resource "meraki_networks_split" "example" {
 
-  network_id = "string"
-  parameters = {
+  confirm_target = "string"
+  network_id     = "string"
+  parameters     = {
 
   }
 }
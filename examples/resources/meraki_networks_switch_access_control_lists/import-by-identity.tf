@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_switch_access_control_lists.example
+  identity = {
+    network_id = "string"
+  }
+}
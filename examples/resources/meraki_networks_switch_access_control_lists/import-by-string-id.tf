@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_switch_access_control_lists.example
+  id = "network_id"
+}
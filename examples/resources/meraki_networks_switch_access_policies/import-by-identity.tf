@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_switch_access_policies.example
+  identity = {
+    network_id           = "string"
+    access_policy_number = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_switch_access_policies.example
+  id = "network_id,access_policy_number"
+}
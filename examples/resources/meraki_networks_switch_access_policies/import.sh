@@ -1 +1 @@
-terraform import meraki_networks_switch_access_policies.example "access_policy_number,network_id"
\ No newline at end of file
+terraform import meraki_networks_switch_access_policies.example "network_id,access_policy_number"
\ No newline at end of file
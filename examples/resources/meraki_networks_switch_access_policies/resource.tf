@@ -52,5 +52,6 @@ resource "meraki_networks_switch_access_policies" "example" {
 }
 
 output "meraki_networks_switch_access_policies_example" {
-  value = meraki_networks_switch_access_policies.example
+  value     = meraki_networks_switch_access_policies.example
+  sensitive = true
 }
\ No newline at end of file
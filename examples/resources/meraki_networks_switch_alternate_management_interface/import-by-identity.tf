@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_switch_alternate_management_interface.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_switch_alternate_management_interface.example
+  id = "network_id"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_switch_dhcp_server_policy.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_switch_dhcp_server_policy.example
+  id = "network_id"
+}
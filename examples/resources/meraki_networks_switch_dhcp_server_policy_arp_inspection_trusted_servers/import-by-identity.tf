@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_switch_dhcp_server_policy_arp_inspection_trusted_servers.example
+  identity = {
+    network_id = "string"
+  }
+}
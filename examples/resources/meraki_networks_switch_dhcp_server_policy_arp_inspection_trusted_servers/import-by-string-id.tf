@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_switch_dhcp_server_policy_arp_inspection_trusted_servers.example
+  id = "network_id"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_switch_dscp_to_cos_mappings.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_switch_dscp_to_cos_mappings.example
+  id = "network_id"
+}
@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_switch_link_aggregations.example
+  identity = {
+    network_id = "string"
+    id         = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_switch_link_aggregations.example
+  id = "network_id,id"
+}
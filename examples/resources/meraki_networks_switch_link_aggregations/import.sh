@@ -1 +1 @@
-terraform import meraki_networks_switch_link_aggregations.example "network_id"
\ No newline at end of file
+terraform import meraki_networks_switch_link_aggregations.example "network_id,id"
\ No newline at end of file
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_switch_port_schedules.example
+  identity = {
+    network_id = "string"
+  }
+}
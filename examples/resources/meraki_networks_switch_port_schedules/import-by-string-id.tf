@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_switch_port_schedules.example
+  id = "network_id"
+}
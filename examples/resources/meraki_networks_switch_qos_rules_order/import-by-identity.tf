@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_switch_qos_rules_order.example
+  identity = {
+    network_id  = "string"
+    qos_rule_id = "string"
+  }
+}
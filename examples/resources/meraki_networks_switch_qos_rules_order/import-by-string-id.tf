@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_switch_qos_rules_order.example
+  id = "network_id,qos_rule_id"
+}
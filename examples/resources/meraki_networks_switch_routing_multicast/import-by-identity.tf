@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_switch_routing_multicast.example
+  identity = {
+    network_id = "string"
+  }
+}
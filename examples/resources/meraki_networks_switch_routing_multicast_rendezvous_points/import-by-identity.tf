@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_switch_routing_multicast_rendezvous_points.example
+  identity = {
+    network_id          = "string"
+    rendezvous_point_id = "string"
+  }
+}
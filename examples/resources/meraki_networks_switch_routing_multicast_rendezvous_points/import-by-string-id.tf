@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_switch_routing_multicast_rendezvous_points.example
+  id = "network_id,rendezvous_point_id"
+}
@@ -32,5 +32,6 @@ resource "meraki_networks_switch_routing_ospf" "example" {
 }
 
 output "meraki_networks_switch_routing_ospf_example" {
-  value = meraki_networks_switch_routing_ospf.example
+  value     = meraki_networks_switch_routing_ospf.example
+  sensitive = true
 }
\ No newline at end of file
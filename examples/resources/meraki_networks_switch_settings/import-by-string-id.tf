@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_switch_settings.example
+  id = "network_id"
+}
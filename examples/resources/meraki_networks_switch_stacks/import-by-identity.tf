@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_switch_stacks.example
+  identity = {
+    network_id      = "string"
+    switch_stack_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_switch_stacks.example
+  id = "network_id,switch_stack_id"
+}
@@ -0,0 +1,8 @@
+import {
+  to = meraki_networks_switch_stacks_routing_interfaces.example
+  identity = {
+    network_id      = "string"
+    switch_stack_id = "string"
+    interface_id    = "string"
+  }
+}
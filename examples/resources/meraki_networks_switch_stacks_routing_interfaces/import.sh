@@ -1 +1 @@
-terraform import meraki_networks_switch_stacks_routing_interfaces.example "interface_id,network_id,switch_stack_id"
\ No newline at end of file
+terraform import meraki_networks_switch_stacks_routing_interfaces.example "network_id,switch_stack_id,interface_id"
\ No newline at end of file
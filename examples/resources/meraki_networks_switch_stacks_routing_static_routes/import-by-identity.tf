@@ -0,0 +1,8 @@
+import {
+  to = meraki_networks_switch_stacks_routing_static_routes.example
+  identity = {
+    network_id      = "string"
+    switch_stack_id = "string"
+    static_route_id = "string"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_switch_storm_control.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_syslog_servers.example
+  identity = {
+    network_id = "string"
+  }
+}
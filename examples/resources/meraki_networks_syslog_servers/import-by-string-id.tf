@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_syslog_servers.example
+  id = "network_id"
+}
@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_traffic_analysis.example
+  identity = {
+    network_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_traffic_analysis.example
+  id = "network_id"
+}
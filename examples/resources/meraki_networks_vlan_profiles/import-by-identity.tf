@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_vlan_profiles.example
+  identity = {
+    network_id = "string"
+    iname      = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_vlan_profiles.example
+  id = "network_id,iname"
+}
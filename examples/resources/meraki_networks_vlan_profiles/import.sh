@@ -1 +1 @@
-terraform import meraki_networks_vlan_profiles.example "iname,network_id"
\ No newline at end of file
+terraform import meraki_networks_vlan_profiles.example "network_id,iname"
\ No newline at end of file
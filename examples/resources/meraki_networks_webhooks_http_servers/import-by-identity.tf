@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_webhooks_http_servers.example
+  identity = {
+    network_id     = "string"
+    http_server_id = "string"
+  }
+}
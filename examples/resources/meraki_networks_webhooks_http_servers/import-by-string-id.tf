@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_webhooks_http_servers.example
+  id = "network_id,http_server_id"
+}
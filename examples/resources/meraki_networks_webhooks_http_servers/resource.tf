@@ -13,5 +13,6 @@ resource "meraki_networks_webhooks_http_servers" "example" {
 }
 
 output "meraki_networks_webhooks_http_servers_example" {
-  value = meraki_networks_webhooks_http_servers.example
+  value     = meraki_networks_webhooks_http_servers.example
+  sensitive = true
 }
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_webhooks_payload_templates.example
+  identity = {
+    network_id          = "string"
+    payload_template_id = "string"
+  }
+}
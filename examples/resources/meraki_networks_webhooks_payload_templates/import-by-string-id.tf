@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_webhooks_payload_templates.example
+  id = "network_id,payload_template_id"
+}
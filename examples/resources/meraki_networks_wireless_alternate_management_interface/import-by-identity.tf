@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_wireless_alternate_management_interface.example
+  identity = {
+    network_id = "string"
+  }
+}
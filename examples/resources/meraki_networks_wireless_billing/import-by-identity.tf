@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_wireless_billing.example
+  identity = {
+    network_id = "string"
+  }
+}
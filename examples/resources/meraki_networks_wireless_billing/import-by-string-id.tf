@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_wireless_billing.example
+  id = "network_id"
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_wireless_bluetooth_settings.example
+  id = "network_id"
+}
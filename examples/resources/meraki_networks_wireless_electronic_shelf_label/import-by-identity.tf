@@ -0,0 +1,6 @@
+import {
+  to = meraki_networks_wireless_electronic_shelf_label.example
+  identity = {
+    network_id = "string"
+  }
+}
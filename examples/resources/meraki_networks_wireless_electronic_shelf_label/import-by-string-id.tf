@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_wireless_electronic_shelf_label.example
+  id = "network_id"
+}
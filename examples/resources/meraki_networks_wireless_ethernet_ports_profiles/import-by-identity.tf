@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_wireless_ethernet_ports_profiles.example
+  identity = {
+    network_id = "string"
+    profile_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_wireless_ethernet_ports_profiles.example
+  id = "network_id,profile_id"
+}
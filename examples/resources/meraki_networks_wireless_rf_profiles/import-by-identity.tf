@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_wireless_rf_profiles.example
+  identity = {
+    network_id    = "string"
+    rf_profile_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_wireless_rf_profiles.example
+  id = "network_id,rf_profile_id"
+}
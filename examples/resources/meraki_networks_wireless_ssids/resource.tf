@@ -283,5 +283,6 @@ resource "meraki_networks_wireless_ssids" "example" {
 }
 
 output "meraki_networks_wireless_ssids_example" {
-  value = meraki_networks_wireless_ssids.example
+  value     = meraki_networks_wireless_ssids.example
+  sensitive = true
 }
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_wireless_ssids_device_type_group_policies.example
+  identity = {
+    network_id = "string"
+    number     = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_wireless_ssids_device_type_group_policies.example
+  id = "network_id,number"
+}
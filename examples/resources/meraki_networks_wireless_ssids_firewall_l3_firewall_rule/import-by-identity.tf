@@ -0,0 +1,8 @@
+import {
+  to = meraki_networks_wireless_ssids_firewall_l3_firewall_rule.example
+  identity = {
+    network_id = "string"
+    number     = "string"
+    priority   = "string"
+  }
+}
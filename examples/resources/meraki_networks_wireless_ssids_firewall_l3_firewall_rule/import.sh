@@ -0,0 +1 @@
+terraform import meraki_networks_wireless_ssids_firewall_l3_firewall_rule.example "network_id,number,priority"
\ No newline at end of file
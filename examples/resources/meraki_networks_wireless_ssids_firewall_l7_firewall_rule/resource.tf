@@ -0,0 +1,9 @@
+resource "meraki_networks_wireless_ssids_firewall_l7_firewall_rule" "example" {
+  network_id = "string"
+  number     = "string"
+  priority   = 1
+
+  policy = "deny"
+  type   = "host"
+  value  = "google.com"
+}
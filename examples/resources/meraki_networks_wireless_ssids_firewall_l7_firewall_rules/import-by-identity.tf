@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_wireless_ssids_firewall_l7_firewall_rules.example
+  identity = {
+    network_id = "string"
+    number     = "string"
+  }
+}
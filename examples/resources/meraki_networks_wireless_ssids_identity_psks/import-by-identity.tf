@@ -0,0 +1,8 @@
+import {
+  to = meraki_networks_wireless_ssids_identity_psks.example
+  identity = {
+    network_id      = "string"
+    number          = "string"
+    identity_psk_id = "string"
+  }
+}
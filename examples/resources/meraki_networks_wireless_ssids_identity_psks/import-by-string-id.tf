@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_wireless_ssids_identity_psks.example
+  id = "network_id,number,identity_psk_id"
+}
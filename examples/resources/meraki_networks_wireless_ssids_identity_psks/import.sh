@@ -1 +1 @@
-terraform import meraki_networks_wireless_ssids_identity_psks.example "identity_psk_id,network_id,number"
\ No newline at end of file
+terraform import meraki_networks_wireless_ssids_identity_psks.example "network_id,number,identity_psk_id"
\ No newline at end of file
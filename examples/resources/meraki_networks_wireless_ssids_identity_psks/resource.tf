@@ -10,5 +10,6 @@ resource "meraki_networks_wireless_ssids_identity_psks" "example" {
 }
 
 output "meraki_networks_wireless_ssids_identity_psks_example" {
-  value = meraki_networks_wireless_ssids_identity_psks.example
+  value     = meraki_networks_wireless_ssids_identity_psks.example
+  sensitive = true
 }
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_wireless_ssids_schedules.example
+  identity = {
+    network_id = "string"
+    number     = "string"
+  }
+}
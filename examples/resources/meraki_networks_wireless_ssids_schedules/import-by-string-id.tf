@@ -0,0 +1,4 @@
+import {
+  to = meraki_networks_wireless_ssids_schedules.example
+  id = "network_id,number"
+}
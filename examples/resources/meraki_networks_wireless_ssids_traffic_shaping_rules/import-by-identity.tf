@@ -0,0 +1,7 @@
+import {
+  to = meraki_networks_wireless_ssids_traffic_shaping_rules.example
+  identity = {
+    network_id = "string"
+    number     = "string"
+  }
+}
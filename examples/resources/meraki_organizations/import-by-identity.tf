@@ -0,0 +1,6 @@
+import {
+  to = meraki_organizations.example
+  identity = {
+    organization_id = "string"
+  }
+}
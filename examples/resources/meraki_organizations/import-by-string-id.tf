@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations.example
+  id = "organization_id"
+}
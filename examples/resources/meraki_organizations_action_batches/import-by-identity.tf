@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_action_batches.example
+  identity = {
+    organization_id = "string"
+    action_batch_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_action_batches.example
+  id = "action_batch_id,organization_id"
+}
@@ -19,11 +19,17 @@ resource "meraki_organizations_action_batches" "example" {
     shared_secret = "secret"
     url           = "https://webhook.site/28efa24e-f830-4d9f-a12b-fbb9e5035031"
   }
-  confirmed       = true
-  organization_id = "string"
-  synchronous     = true
+  confirmed           = true
+  organization_id     = "string"
+  synchronous         = false
+  wait_for_completion = true
+
+  timeouts {
+    create = "15m"
+  }
 }
 
 output "meraki_organizations_action_batches_example" {
-  value = meraki_organizations_action_batches.example
+  value     = meraki_organizations_action_batches.example
+  sensitive = true
 }
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_adaptive_policy_acls.example
+  identity = {
+    organization_id = "string"
+    acl_id          = "string"
+  }
+}
@@ -1 +1 @@
-terraform import meraki_organizations_adaptive_policy_acls.example "acl_id,organization_id"
\ No newline at end of file
+terraform import meraki_organizations_adaptive_policy_acls.example "organization_id,acl_id"
\ No newline at end of file
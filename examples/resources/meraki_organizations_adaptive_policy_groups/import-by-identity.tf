@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_adaptive_policy_groups.example
+  identity = {
+    organization_id = "string"
+    id              = "string"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_adaptive_policy_policies.example
+  identity = {
+    organization_id = "string"
+    id              = "string"
+  }
+}
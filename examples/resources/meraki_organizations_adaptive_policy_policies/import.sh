@@ -1 +1 @@
-terraform import meraki_organizations_adaptive_policy_policies.example "id,organization_id"
\ No newline at end of file
+terraform import meraki_organizations_adaptive_policy_policies.example "organization_id,id"
\ No newline at end of file
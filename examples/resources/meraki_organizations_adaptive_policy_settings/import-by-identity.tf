@@ -0,0 +1,6 @@
+import {
+  to = meraki_organizations_adaptive_policy_settings.example
+  identity = {
+    organization_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_adaptive_policy_settings.example
+  id = "organization_id"
+}
@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_admins.example
+  identity = {
+    organization_id = "string"
+    id              = "string"
+  }
+}
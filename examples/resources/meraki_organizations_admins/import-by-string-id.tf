@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_admins.example
+  id = "organization_id,id"
+}
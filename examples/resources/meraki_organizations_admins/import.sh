@@ -1 +1 @@
-terraform import meraki_organizations_admins.example "organization_id"
\ No newline at end of file
+terraform import meraki_organizations_admins.example "organization_id,id"
\ No newline at end of file
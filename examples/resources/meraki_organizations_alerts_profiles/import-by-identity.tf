@@ -0,0 +1,6 @@
+import {
+  to = meraki_organizations_alerts_profiles.example
+  identity = {
+    organization_id = "string"
+  }
+}
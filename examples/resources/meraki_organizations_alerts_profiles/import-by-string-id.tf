@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_alerts_profiles.example
+  id = "organization_id"
+}
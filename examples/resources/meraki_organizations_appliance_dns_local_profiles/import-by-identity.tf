@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_appliance_dns_local_profiles.example
+  identity = {
+    organization_id = "string"
+    name            = "string"
+  }
+}
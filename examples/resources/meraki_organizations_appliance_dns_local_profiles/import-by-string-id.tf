@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_appliance_dns_local_profiles.example
+  id = "organization_id,name"
+}
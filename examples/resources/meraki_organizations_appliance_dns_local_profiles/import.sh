@@ -1 +1 @@
-terraform import meraki_organizations_appliance_dns_local_profiles.example "organization_id"
\ No newline at end of file
+terraform import meraki_organizations_appliance_dns_local_profiles.example "organization_id,name"
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_appliance_dns_local_records.example
+  identity = {
+    organization_id = "string"
+    hostname        = "string"
+  }
+}
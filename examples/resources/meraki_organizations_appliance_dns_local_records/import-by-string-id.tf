@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_appliance_dns_local_records.example
+  id = "organization_id,hostname"
+}
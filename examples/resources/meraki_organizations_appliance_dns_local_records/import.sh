@@ -1 +1 @@
-terraform import meraki_organizations_appliance_dns_local_records.example "organization_id"
\ No newline at end of file
+terraform import meraki_organizations_appliance_dns_local_records.example "organization_id,hostname"
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_appliance_dns_split_profiles.example
+  identity = {
+    organization_id = "string"
+    name            = "string"
+  }
+}
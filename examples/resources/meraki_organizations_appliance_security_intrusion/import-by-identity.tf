@@ -0,0 +1,6 @@
+import {
+  to = meraki_organizations_appliance_security_intrusion.example
+  identity = {
+    organization_id = "string"
+  }
+}
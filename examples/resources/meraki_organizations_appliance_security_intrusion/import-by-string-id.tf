@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_appliance_security_intrusion.example
+  id = "organization_id"
+}
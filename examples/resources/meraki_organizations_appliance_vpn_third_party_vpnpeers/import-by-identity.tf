@@ -0,0 +1,6 @@
+import {
+  to = meraki_organizations_appliance_vpn_third_party_vpnpeers.example
+  identity = {
+    organization_id = "string"
+  }
+}
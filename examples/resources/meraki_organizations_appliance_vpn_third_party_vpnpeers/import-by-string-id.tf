@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_appliance_vpn_third_party_vpnpeers.example
+  id = "organization_id"
+}
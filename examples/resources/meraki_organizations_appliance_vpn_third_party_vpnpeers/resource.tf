@@ -30,5 +30,6 @@ resource "meraki_organizations_appliance_vpn_third_party_vpnpeers" "example" {
 }
 
 output "meraki_organizations_appliance_vpn_third_party_vpnpeers_example" {
-  value = meraki_organizations_appliance_vpn_third_party_vpnpeers.example
+  value     = meraki_organizations_appliance_vpn_third_party_vpnpeers.example
+  sensitive = true
 }
\ No newline at end of file
@@ -0,0 +1,6 @@
+import {
+  to = meraki_organizations_appliance_vpn_vpn_firewall_rules.example
+  identity = {
+    organization_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_appliance_vpn_vpn_firewall_rules.example
+  id = "organization_id"
+}
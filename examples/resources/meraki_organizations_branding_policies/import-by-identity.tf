@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_branding_policies.example
+  identity = {
+    organization_id    = "string"
+    branding_policy_id = "string"
+  }
+}
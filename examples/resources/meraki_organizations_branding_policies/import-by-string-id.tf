@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_branding_policies.example
+  id = "organization_id,branding_policy_id"
+}
@@ -1 +1 @@
-terraform import meraki_organizations_branding_policies.example "branding_policy_id,organization_id"
\ No newline at end of file
+terraform import meraki_organizations_branding_policies.example "organization_id,branding_policy_id"
\ No newline at end of file
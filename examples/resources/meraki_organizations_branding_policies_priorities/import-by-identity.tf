@@ -0,0 +1,6 @@
+import {
+  to = meraki_organizations_branding_policies_priorities.example
+  identity = {
+    organization_id = "string"
+  }
+}
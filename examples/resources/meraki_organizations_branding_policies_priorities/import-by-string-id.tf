@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_branding_policies_priorities.example
+  id = "organization_id"
+}
@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_camera_custom_analytics_artifacts.example
+  identity = {
+    organization_id = "string"
+    artifact_id     = "string"
+  }
+}
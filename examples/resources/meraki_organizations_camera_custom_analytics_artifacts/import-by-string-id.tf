@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_camera_custom_analytics_artifacts.example
+  id = "organization_id,artifact_id"
+}
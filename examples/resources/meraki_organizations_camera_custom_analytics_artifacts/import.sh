@@ -1 +1 @@
-terraform import meraki_organizations_camera_custom_analytics_artifacts.example "artifact_id,organization_id"
\ No newline at end of file
+terraform import meraki_organizations_camera_custom_analytics_artifacts.example "organization_id,artifact_id"
\ No newline at end of file
@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_cellular_gateway_esims_service_providers_accounts.example
+  identity = {
+    organization_id = "string"
+    username        = "string"
+  }
+}
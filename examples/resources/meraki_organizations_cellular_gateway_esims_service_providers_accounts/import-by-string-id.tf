@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_cellular_gateway_esims_service_providers_accounts.example
+  id = "organization_id,username"
+}
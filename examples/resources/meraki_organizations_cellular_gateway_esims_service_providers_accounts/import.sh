@@ -1 +1 @@
-terraform import meraki_organizations_cellular_gateway_esims_service_providers_accounts.example "organization_id"
\ No newline at end of file
+terraform import meraki_organizations_cellular_gateway_esims_service_providers_accounts.example "organization_id,username"
\ No newline at end of file
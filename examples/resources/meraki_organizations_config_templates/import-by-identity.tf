@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_config_templates.example
+  identity = {
+    organization_id    = "string"
+    config_template_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_config_templates.example
+  id = "organization_id,config_template_id"
+}
@@ -1 +1 @@
-terraform import meraki_organizations_config_templates.example "config_template_id,organization_id"
\ No newline at end of file
+terraform import meraki_organizations_config_templates.example "organization_id,config_template_id"
\ No newline at end of file
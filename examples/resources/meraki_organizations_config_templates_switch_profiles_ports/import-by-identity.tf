@@ -0,0 +1,9 @@
+import {
+  to = meraki_organizations_config_templates_switch_profiles_ports.example
+  identity = {
+    organization_id    = "string"
+    config_template_id = "string"
+    profile_id         = "string"
+    port_id            = "string"
+  }
+}
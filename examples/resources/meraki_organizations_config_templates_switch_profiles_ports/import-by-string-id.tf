@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_config_templates_switch_profiles_ports.example
+  id = "organization_id,config_template_id,profile_id,port_id"
+}
@@ -1 +1 @@
-terraform import meraki_organizations_config_templates_switch_profiles_ports.example "config_template_id,organization_id,port_id,profile_id"
\ No newline at end of file
+terraform import meraki_organizations_config_templates_switch_profiles_ports.example "organization_id,config_template_id,profile_id,port_id"
\ No newline at end of file
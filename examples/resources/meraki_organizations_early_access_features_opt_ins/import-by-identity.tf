@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_early_access_features_opt_ins.example
+  identity = {
+    organization_id = "string"
+    opt_in_id       = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_early_access_features_opt_ins.example
+  id = "organization_id,opt_in_id"
+}
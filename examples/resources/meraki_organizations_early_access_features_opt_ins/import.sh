@@ -1 +1 @@
-terraform import meraki_organizations_early_access_features_opt_ins.example "opt_in_id,organization_id"
\ No newline at end of file
+terraform import meraki_organizations_early_access_features_opt_ins.example "organization_id,opt_in_id"
\ No newline at end of file
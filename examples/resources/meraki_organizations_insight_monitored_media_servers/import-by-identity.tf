@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_insight_monitored_media_servers.example
+  identity = {
+    organization_id           = "string"
+    monitored_media_server_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_insight_monitored_media_servers.example
+  id = "organization_id,monitored_media_server_id"
+}
@@ -1 +1 @@
-terraform import meraki_organizations_insight_monitored_media_servers.example "monitored_media_server_id,organization_id"
\ No newline at end of file
+terraform import meraki_organizations_insight_monitored_media_servers.example "organization_id,monitored_media_server_id"
\ No newline at end of file
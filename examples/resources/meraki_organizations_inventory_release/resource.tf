@@ -1,8 +1,9 @@
 
 resource "meraki_organizations_inventory_release" "example" {
 
+  confirm_target  = "string"
   organization_id = "string"
-  parameters = {
+  parameters      = {
 
     serials = ["Q234-ABCD-5678"]
   }
@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_licenses.example
+  identity = {
+    organization_id = "string"
+    license_id      = "string"
+  }
+}
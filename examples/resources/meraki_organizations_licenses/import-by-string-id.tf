@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_licenses.example
+  id = "organization_id,license_id"
+}
@@ -1 +1 @@
-terraform import meraki_organizations_licenses.example "license_id,organization_id"
\ No newline at end of file
+terraform import meraki_organizations_licenses.example "organization_id,license_id"
\ No newline at end of file
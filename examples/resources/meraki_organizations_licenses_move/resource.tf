@@ -1,8 +1,9 @@
 
 resource "meraki_organizations_licenses_move" "example" {
 
+  confirm_target  = "string"
   organization_id = "string"
-  parameters = {
+  parameters      = {
 
     dest_organization_id = "2930418"
     license_ids          = ["123", "456"]
@@ -0,0 +1,6 @@
+import {
+  to = meraki_organizations_login_security.example
+  identity = {
+    organization_id = "string"
+  }
+}
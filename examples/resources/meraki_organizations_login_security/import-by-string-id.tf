@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_login_security.example
+  id = "organization_id"
+}
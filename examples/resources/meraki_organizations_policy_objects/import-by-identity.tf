@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_policy_objects.example
+  identity = {
+    organization_id  = "string"
+    policy_object_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_policy_objects.example
+  id = "organization_id,policy_object_id"
+}
@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_policy_objects_groups.example
+  identity = {
+    organization_id        = "string"
+    policy_object_group_id = "string"
+  }
+}
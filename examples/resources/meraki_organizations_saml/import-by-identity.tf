@@ -0,0 +1,6 @@
+import {
+  to = meraki_organizations_saml.example
+  identity = {
+    organization_id = "string"
+  }
+}
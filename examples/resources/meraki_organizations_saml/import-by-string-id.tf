@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_saml.example
+  id = "organization_id"
+}
@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_saml_idps.example
+  identity = {
+    organization_id = "string"
+    idp_id          = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_saml_idps.example
+  id = "organization_id,idp_id"
+}
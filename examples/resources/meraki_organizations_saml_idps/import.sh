@@ -1 +1 @@
-terraform import meraki_organizations_saml_idps.example "idp_id,organization_id"
\ No newline at end of file
+terraform import meraki_organizations_saml_idps.example "organization_id,idp_id"
\ No newline at end of file
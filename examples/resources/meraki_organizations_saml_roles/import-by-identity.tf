@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_saml_roles.example
+  identity = {
+    organization_id = "string"
+    saml_role_id    = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_saml_roles.example
+  id = "organization_id,saml_role_id"
+}
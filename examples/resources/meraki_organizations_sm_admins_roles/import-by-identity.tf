@@ -0,0 +1,7 @@
+import {
+  to = meraki_organizations_sm_admins_roles.example
+  identity = {
+    organization_id = "string"
+    role_id         = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_sm_admins_roles.example
+  id = "organization_id,role_id"
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_snmp.example
+  id = "organization_id"
+}
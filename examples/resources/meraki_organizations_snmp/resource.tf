@@ -12,5 +12,6 @@ resource "meraki_organizations_snmp" "example" {
 }
 
 output "meraki_organizations_snmp_example" {
-  value = meraki_organizations_snmp.example
+  value     = meraki_organizations_snmp.example
+  sensitive = true
 }
\ No newline at end of file
@@ -0,0 +1,6 @@
+import {
+  to = meraki_organizations_splash_themes.example
+  identity = {
+    organization_id = "string"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = meraki_organizations_splash_themes.example
+  id = "organization_id"
+}
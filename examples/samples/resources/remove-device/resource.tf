@@ -10,7 +10,8 @@ terraform {
 
 resource "meraki_networks_devices_remove" "example" {
 
-  network_id = "L_828099381482771185"
+  confirm_target = "QBSA-D8CD-5LR6"
+  network_id     = "L_828099381482771185"
   parameters = {
     serial = "QBSA-D8CD-5LR6"
   }
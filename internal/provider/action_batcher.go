@@ -0,0 +1,311 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	// actionBatchMaxActions is the largest number of actions in an action batch.
+	actionBatchMaxActions = 100
+	// actionBatchMaxSynchronousActions is the largest number of actions in a synchronous
+	// action batch. Larger batches run asynchronously and are polled until they finish.
+	actionBatchMaxSynchronousActions = 20
+)
+
+// actionBatchFlushDelay is the time without new actions after which the queue of an
+// organization is sent. Terraform runs at most -parallelism operations at a time, so the queue
+// stops growing once all of them are waiting on it, which is the end of the graph walk as far
+// as the provider can tell.
+var actionBatchFlushDelay = 2 * time.Second
+
+// actionBatchAction is a write queued in an action batch. Resource is the path of the API
+// call without the /api/v1 prefix, like /devices/{serial}/switch/ports/{portId}.
+type actionBatchAction struct {
+	Resource  string
+	Operation string
+	Body      interface{}
+}
+
+// actionBatchWrite is the group of actions of one resource. The actions of a write always go
+// in the same batch, in order.
+type actionBatchWrite struct {
+	actions []actionBatchAction
+	done    chan error
+}
+
+// actionBatchQueue holds the writes of an organization that are not sent yet.
+type actionBatchQueue struct {
+	writes []*actionBatchWrite
+	size   int
+	timer  *time.Timer
+	// sending keeps one batch of the organization running at a time.
+	sending sync.Mutex
+}
+
+// actionBatcher queues the writes of the resources that support meraki_batch_writes into
+// organizations/{organizationId}/actionBatches.
+type actionBatcher struct {
+	clients *merakiClientPool
+	// resolver finds the organization of the networks and devices, with the lookups of the
+	// rate limiter.
+	resolver *organizationResolver
+
+	mu     sync.Mutex
+	queues map[string]*actionBatchQueue
+}
+
+func newActionBatcher(clients *merakiClientPool, resolver *organizationResolver) *actionBatcher {
+	return &actionBatcher{
+		clients:  clients,
+		resolver: resolver,
+		queues:   map[string]*actionBatchQueue{},
+	}
+}
+
+// RunForNetwork runs the actions in an action batch of the organization of the network.
+func (b *actionBatcher) RunForNetwork(ctx context.Context, networkID string, actions ...actionBatchAction) error {
+	organizationID, err := b.resolver.organizationOfNetwork(nil, networkID)
+	if err != nil {
+		return err
+	}
+	return b.Run(ctx, organizationID, actions...)
+}
+
+// RunForDevice runs the actions in an action batch of the organization of the device.
+func (b *actionBatcher) RunForDevice(ctx context.Context, serial string, actions ...actionBatchAction) error {
+	networkID, err := b.resolver.networkOfDevice(nil, serial)
+	if err != nil {
+		return err
+	}
+	return b.RunForNetwork(ctx, networkID, actions...)
+}
+
+// Run queues the actions in an action batch of the organization and waits until the batch
+// finished. The error holds the errors of the batch that name one of the actions, or all the
+// errors of the batch when none does, since a failed batch is rolled back as a whole.
+func (b *actionBatcher) Run(ctx context.Context, organizationID string, actions ...actionBatchAction) error {
+	write := &actionBatchWrite{actions: actions, done: make(chan error, 1)}
+
+	b.mu.Lock()
+	queue, ok := b.queues[organizationID]
+	if !ok {
+		queue = &actionBatchQueue{}
+		b.queues[organizationID] = queue
+	}
+	queue.writes = append(queue.writes, write)
+	queue.size += len(actions)
+	if queue.timer != nil {
+		queue.timer.Stop()
+	}
+	queue.timer = time.AfterFunc(actionBatchFlushDelay, func() { b.flush(organizationID) })
+	if queue.size >= actionBatchMaxActions {
+		go b.flush(organizationID)
+	}
+	b.mu.Unlock()
+
+	select {
+	case err := <-write.done:
+		return err
+	case <-ctx.Done():
+		if b.cancel(organizationID, write) {
+			return fmt.Errorf("waiting for the action batch, the write was not sent: %w", ctx.Err())
+		}
+		return fmt.Errorf("waiting for the action batch, the write was already sent and may be applied: %w", ctx.Err())
+	}
+}
+
+// cancel removes a write from the queue of the organization. It returns false when a flush
+// already took the write, which is then sent anyway.
+func (b *actionBatcher) cancel(organizationID string, write *actionBatchWrite) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	queue := b.queues[organizationID]
+	for i, queued := range queue.writes {
+		if queued != write {
+			continue
+		}
+		queue.writes = append(queue.writes[:i:i], queue.writes[i+1:]...)
+		queue.size -= len(write.actions)
+		if len(queue.writes) == 0 && queue.timer != nil {
+			queue.timer.Stop()
+			queue.timer = nil
+		}
+		return true
+	}
+	return false
+}
+
+// flush sends the oldest queued writes of the organization, up to actionBatchMaxActions
+// actions, in one batch. The writes left in the queue are sent by the next flush.
+func (b *actionBatcher) flush(organizationID string) {
+	b.mu.Lock()
+	queue := b.queues[organizationID]
+	size := 0
+	n := 0
+	for n < len(queue.writes) && (n == 0 || size+len(queue.writes[n].actions) <= actionBatchMaxActions) {
+		size += len(queue.writes[n].actions)
+		n++
+	}
+	writes := queue.writes[:n:n]
+	queue.writes = queue.writes[n:]
+	queue.size -= size
+	if queue.size >= actionBatchMaxActions {
+		go b.flush(organizationID)
+	}
+	b.mu.Unlock()
+	if len(writes) == 0 {
+		return
+	}
+
+	queue.sending.Lock()
+	defer queue.sending.Unlock()
+	b.send(organizationID, writes)
+}
+
+// send runs the writes in one action batch and reports the result to each of them.
+func (b *actionBatcher) send(organizationID string, writes []*actionBatchWrite) {
+	var actions []merakigosdk.RequestOrganizationsCreateOrganizationActionBatchActions
+	for _, write := range writes {
+		for _, action := range write.actions {
+			request := merakigosdk.RequestOrganizationsCreateOrganizationActionBatchActions{
+				Resource:  action.Resource,
+				Operation: action.Operation,
+			}
+			if action.Body != nil {
+				var body merakigosdk.RequestOrganizationsCreateOrganizationActionBatchActionsBody = action.Body
+				request.Body = &body
+			}
+			actions = append(actions, request)
+		}
+	}
+	synchronous := len(actions) <= actionBatchMaxSynchronousActions
+	errs, err := b.runActionBatch(organizationID, actions, synchronous)
+	for _, write := range writes {
+		if err != nil {
+			write.done <- err
+			continue
+		}
+		write.done <- actionBatchWriteError(write, errs)
+	}
+}
+
+// runActionBatch creates a confirmed action batch and returns the errors of the batch once it
+// finished.
+func (b *actionBatcher) runActionBatch(organizationID string, actions []merakigosdk.RequestOrganizationsCreateOrganizationActionBatchActions, synchronous bool) ([]string, error) {
+	client := b.clients.Client(organizationID)
+	confirmed := true
+	response, restyResp, err := client.Organizations.CreateOrganizationActionBatch(organizationID, &merakigosdk.RequestOrganizationsCreateOrganizationActionBatch{
+		Actions:     &actions,
+		Confirmed:   &confirmed,
+		Synchronous: &synchronous,
+	})
+	if err != nil || response == nil {
+		return nil, actionBatcherError("CreateOrganizationActionBatch", restyResp, err)
+	}
+	if response.Status != nil && response.Status.Failed != nil && *response.Status.Failed {
+		return actionBatchErrorMessages(response.ID, response.Status.Errors), nil
+	}
+	if response.Status != nil && response.Status.Completed != nil && *response.Status.Completed {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), actionBatchDefaultTimeout)
+	defer cancel()
+	if err := waitForActionBatch(ctx, client, organizationID, response.ID); err != nil {
+		return nil, fmt.Errorf("action batch %s: %w", response.ID, err)
+	}
+	responseGet, restyResp, err := client.Organizations.GetOrganizationActionBatch(organizationID, response.ID)
+	if err != nil || responseGet == nil {
+		return nil, actionBatcherError("GetOrganizationActionBatch", restyResp, err)
+	}
+	if responseGet.Status != nil && responseGet.Status.Failed != nil && *responseGet.Status.Failed {
+		return actionBatchErrorMessages(responseGet.ID, responseGet.Status.Errors), nil
+	}
+	return nil, nil
+}
+
+func actionBatchErrorMessages(actionBatchID string, errs []string) []string {
+	if len(errs) == 0 {
+		return []string{"action batch " + actionBatchID + " failed and Meraki did not return its errors"}
+	}
+	messages := make([]string, len(errs))
+	for i, message := range errs {
+		messages[i] = "action batch " + actionBatchID + ": " + message
+	}
+	return messages
+}
+
+// actionBatchWriteError returns the error of a write from the errors of its batch.
+func actionBatchWriteError(write *actionBatchWrite, errs []string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	var own []string
+	for _, message := range errs {
+		for _, action := range write.actions {
+			if mentionsResource(message, action.Resource) {
+				own = append(own, message)
+				break
+			}
+		}
+	}
+	if len(own) > 0 {
+		return fmt.Errorf("%s", strings.Join(own, "\n"))
+	}
+	return fmt.Errorf("the batch was rolled back because it failed:\n%s", strings.Join(errs, "\n"))
+}
+
+// mentionsResource reports whether the message names the resource, and not a resource whose
+// path starts with it, like port 10 for port 1.
+func mentionsResource(message string, resource string) bool {
+	for i := strings.Index(message, resource); i >= 0; {
+		end := i + len(resource)
+		if end == len(message) || !isPathChar(message[end]) {
+			return true
+		}
+		next := strings.Index(message[end:], resource)
+		if next < 0 {
+			return false
+		}
+		i = end + next
+	}
+	return false
+}
+
+func isPathChar(c byte) bool {
+	return c == '_' || c == '-' || c == '/' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func actionBatcherError(operation string, restyResp *resty.Response, err error) error {
+	if restyResp != nil {
+		return fmt.Errorf("failure when executing %s: %s", operation, restyResp.String())
+	}
+	if err == nil {
+		err = fmt.Errorf("empty response")
+	}
+	return fmt.Errorf("failure when executing %s: %w", operation, err)
+}
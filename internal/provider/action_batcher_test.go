@@ -0,0 +1,333 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+)
+
+const testActionBatchesPath = "/api/v1/organizations/2930418/actionBatches"
+
+func newTestActionBatcher(t *testing.T, mock *merakiMock) *actionBatcher {
+	t.Helper()
+	clients := newMerakiClientPool()
+	clients.defaultClient = mock.client(t)
+	return newActionBatcher(clients, newTestOrganizationResolver(clients))
+}
+
+// newTestOrganizationResolver returns a resolver that looks up with the clients of the pool.
+func newTestOrganizationResolver(clients *merakiClientPool) *organizationResolver {
+	resolver := newOrganizationResolver(context.Background())
+	for _, client := range clients.All() {
+		resolver.addClient(client.RestyClient())
+	}
+	return resolver
+}
+
+func TestActionBatcherGroupsWrites(t *testing.T) {
+	defer func(delay time.Duration) { actionBatchFlushDelay = delay }(actionBatchFlushDelay)
+	actionBatchFlushDelay = 100 * time.Millisecond
+
+	mock := newMerakiMock(t, "action_batcher")
+	batcher := newTestActionBatcher(t, mock)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	vlan := 20
+	writes := []func() error{
+		func() error {
+			return batcher.RunForDevice(ctx, "Q234-ABCD-0001", actionBatchAction{
+				Resource:  "/devices/Q234-ABCD-0001/switch/ports/1",
+				Operation: "update",
+				Body:      &merakigosdk.RequestSwitchUpdateDeviceSwitchPort{Name: "Uplink"},
+			})
+		},
+		func() error {
+			return batcher.RunForDevice(ctx, "Q234-ABCD-0001", actionBatchAction{
+				Resource:  "/devices/Q234-ABCD-0001/switch/ports/2",
+				Operation: "update",
+				Body:      &merakigosdk.RequestSwitchUpdateDeviceSwitchPort{VLAN: &vlan},
+			})
+		},
+		func() error {
+			return batcher.RunForNetwork(ctx, "N_24329156", actionBatchAction{
+				Resource:  "/networks/N_24329156/appliance/vlans",
+				Operation: "create",
+				Body:      &merakigosdk.RequestApplianceCreateNetworkApplianceVLAN{ID: "20", Name: "Voice"},
+			}, actionBatchAction{
+				Resource:  "/networks/N_24329156/appliance/vlans/20",
+				Operation: "update",
+				Body:      &merakigosdk.RequestApplianceUpdateNetworkApplianceVLAN{Name: "Voice phones"},
+			})
+		},
+		func() error {
+			return batcher.RunForNetwork(ctx, "N_24329156", actionBatchAction{
+				Resource:  "/networks/N_24329156/appliance/vlans/10",
+				Operation: "destroy",
+			})
+		},
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, len(writes))
+	for i, write := range writes {
+		wg.Add(1)
+		go func(i int, write func() error) {
+			defer wg.Done()
+			errs[i] = write()
+		}(i, write)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("write %d: %s", i, err)
+		}
+	}
+
+	requests := mock.requestsFor("POST", testActionBatchesPath)
+	if len(requests) != 1 {
+		t.Fatalf("%d action batches sent, want 1", len(requests))
+	}
+	batch := requests[0].Body.(map[string]interface{})
+	if actions := batch["actions"].([]interface{}); len(actions) != 5 {
+		t.Errorf("%d actions in the batch, want 5", len(actions))
+	}
+	if batch["synchronous"] != true || batch["confirmed"] != true {
+		t.Errorf("batch not confirmed and synchronous: %v", batch)
+	}
+	if len(mock.requestsFor("GET", "/api/v1/networks/N_24329156")) != 1 {
+		t.Errorf("organization of the network not cached")
+	}
+
+	port, _ := mock.body("/api/v1/devices/Q234-ABCD-0001/switch/ports/1")
+	if name := port.(map[string]interface{})["name"]; name != "Uplink" {
+		t.Errorf("port 1 name = %v, want Uplink", name)
+	}
+	created, _ := mock.body("/api/v1/networks/N_24329156/appliance/vlans/20")
+	if name := created.(map[string]interface{})["name"]; name != "Voice phones" {
+		t.Errorf("VLAN 20 name = %v, want Voice phones", name)
+	}
+	if _, ok := mock.body("/api/v1/networks/N_24329156/appliance/vlans/10"); ok {
+		t.Errorf("VLAN 10 not destroyed")
+	}
+}
+
+func TestActionBatcherErrors(t *testing.T) {
+	defer func(delay time.Duration) { actionBatchFlushDelay = delay }(actionBatchFlushDelay)
+	actionBatchFlushDelay = 100 * time.Millisecond
+
+	mock := newMerakiMock(t, "action_batcher")
+	batcher := newTestActionBatcher(t, mock)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, portID := range []string{"1", "12"} {
+		wg.Add(1)
+		go func(i int, portID string) {
+			defer wg.Done()
+			errs[i] = batcher.Run(ctx, "2930418", actionBatchAction{
+				Resource:  "/devices/Q234-ABCD-0001/switch/ports/" + portID,
+				Operation: "update",
+				Body:      &merakigosdk.RequestSwitchUpdateDeviceSwitchPort{Name: "Port " + portID},
+			})
+		}(i, portID)
+	}
+	wg.Wait()
+
+	if errs[1] == nil || !strings.Contains(errs[1].Error(), "/switch/ports/12 not found") {
+		t.Errorf("error of port 12 = %v, want the error of its action", errs[1])
+	}
+	if errs[0] == nil || !strings.Contains(errs[0].Error(), "rolled back") {
+		t.Errorf("error of port 1 = %v, want the rollback of the batch", errs[0])
+	}
+	port, _ := mock.body("/api/v1/devices/Q234-ABCD-0001/switch/ports/1")
+	if name := port.(map[string]interface{})["name"]; name != "Port 1" {
+		t.Errorf("port 1 name = %v, want the update rolled back", name)
+	}
+}
+
+func TestActionBatcherSplitsBatches(t *testing.T) {
+	defer func(delay time.Duration) { actionBatchFlushDelay = delay }(actionBatchFlushDelay)
+	actionBatchFlushDelay = 100 * time.Millisecond
+
+	mock := newMerakiMock(t, "action_batcher")
+	batcher := newTestActionBatcher(t, mock)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i := 0; i < actionBatchMaxActions+actionBatchMaxSynchronousActions; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			err := batcher.Run(ctx, "2930418", actionBatchAction{
+				Resource:  "/networks/N_24329156/appliance/vlans",
+				Operation: "create",
+				Body:      &merakigosdk.RequestApplianceCreateNetworkApplianceVLAN{ID: id, Name: "VLAN " + id},
+			})
+			if err != nil {
+				t.Errorf("VLAN %s: %s", id, err)
+			}
+		}(strconv.Itoa(100 + i))
+	}
+	wg.Wait()
+
+	requests := mock.requestsFor("POST", testActionBatchesPath)
+	var sizes []int
+	for _, request := range requests {
+		batch := request.Body.(map[string]interface{})
+		size := len(batch["actions"].([]interface{}))
+		if synchronous := size <= actionBatchMaxSynchronousActions; batch["synchronous"] != synchronous {
+			t.Errorf("batch of %d actions has synchronous = %v", size, batch["synchronous"])
+		}
+		sizes = append(sizes, size)
+	}
+	sort.Ints(sizes)
+	if len(sizes) != 2 || sizes[0] != actionBatchMaxSynchronousActions || sizes[1] != actionBatchMaxActions {
+		t.Errorf("batch sizes = %v, want [%d %d]", sizes, actionBatchMaxSynchronousActions, actionBatchMaxActions)
+	}
+}
+
+func TestActionBatcherCancel(t *testing.T) {
+	defer func(delay time.Duration) { actionBatchFlushDelay = delay }(actionBatchFlushDelay)
+	actionBatchFlushDelay = 200 * time.Millisecond
+
+	mock := newMerakiMock(t, "action_batcher")
+	batcher := newTestActionBatcher(t, mock)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		errs <- batcher.Run(cancelled, "2930418", actionBatchAction{
+			Resource:  "/devices/Q234-ABCD-0001/switch/ports/1",
+			Operation: "update",
+			Body:      &merakigosdk.RequestSwitchUpdateDeviceSwitchPort{Name: "Cancelled"},
+		})
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	if err := <-errs; err == nil || !strings.Contains(err.Error(), "not sent") {
+		t.Fatalf("error of the cancelled write = %v, want the write not sent", err)
+	}
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelCtx()
+	err := batcher.Run(ctx, "2930418", actionBatchAction{
+		Resource:  "/devices/Q234-ABCD-0001/switch/ports/2",
+		Operation: "update",
+		Body:      &merakigosdk.RequestSwitchUpdateDeviceSwitchPort{Name: "Sent"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	requests := mock.requestsFor("POST", testActionBatchesPath)
+	if len(requests) != 1 {
+		t.Fatalf("%d action batches sent, want 1", len(requests))
+	}
+	if actions := requests[0].Body.(map[string]interface{})["actions"].([]interface{}); len(actions) != 1 {
+		t.Errorf("%d actions in the batch, want only the write that was not cancelled", len(actions))
+	}
+	port, _ := mock.body("/api/v1/devices/Q234-ABCD-0001/switch/ports/1")
+	if name := port.(map[string]interface{})["name"]; name != "Port 1" {
+		t.Errorf("port 1 name = %v, want the cancelled write not applied", name)
+	}
+}
+
+func TestActionBatcherLookupWithCredentials(t *testing.T) {
+	defer func(delay time.Duration) { actionBatchFlushDelay = delay }(actionBatchFlushDelay)
+	actionBatchFlushDelay = 100 * time.Millisecond
+
+	mock := newMerakiMock(t, "action_batcher")
+	mock.ownPaths("organization-key", "/api/v1/networks/N_24329156", "/api/v1/devices/Q234-ABCD-0001")
+	batcher := newTestActionBatcher(t, mock)
+	organizationClient := mock.clientWithKey(t, "organization-key")
+	batcher.clients.Add("2930418", organizationClient)
+	batcher.resolver.addClient(organizationClient.RestyClient())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := batcher.RunForDevice(ctx, "Q234-ABCD-0001", actionBatchAction{
+		Resource:  "/devices/Q234-ABCD-0001/switch/ports/1",
+		Operation: "update",
+		Body:      &merakigosdk.RequestSwitchUpdateDeviceSwitchPort{Name: "Uplink"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mock.requestsFor("POST", testActionBatchesPath)) != 1 {
+		t.Errorf("the write was not sent in an action batch")
+	}
+}
+
+func TestActionBatcherSharesLimiterLookups(t *testing.T) {
+	defer func(delay time.Duration) { actionBatchFlushDelay = delay }(actionBatchFlushDelay)
+	actionBatchFlushDelay = 100 * time.Millisecond
+
+	mock := newMerakiMock(t, "action_batcher")
+	client := mock.client(t)
+	limiter := newMerakiRateLimiter(context.Background(), 100)
+	limiter.install(client)
+	clients := newMerakiClientPool()
+	clients.defaultClient = client
+	batcher := newActionBatcher(clients, limiter.resolver)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, _, err := client.Switch.GetDeviceSwitchPort("Q234-ABCD-0001", "1"); err != nil {
+		t.Fatal(err)
+	}
+	err := batcher.RunForDevice(ctx, "Q234-ABCD-0001", actionBatchAction{
+		Resource:  "/devices/Q234-ABCD-0001/switch/ports/1",
+		Operation: "update",
+		Body:      &merakigosdk.RequestSwitchUpdateDeviceSwitchPort{Name: "Uplink"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"/api/v1/devices/Q234-ABCD-0001", "/api/v1/networks/N_24329156"} {
+		if n := len(mock.requestsFor("GET", path)); n != 1 {
+			t.Errorf("%s looked up %d times by the limiter and the batcher, want 1", path, n)
+		}
+	}
+}
+
+func TestMentionsResource(t *testing.T) {
+	tests := []struct {
+		message string
+		want    bool
+	}{
+		{"Resource /devices/Q234-ABCD-0001/switch/ports/1 not found", true},
+		{"/devices/Q234-ABCD-0001/switch/ports/1: invalid VLAN", true},
+		{"Resource /devices/Q234-ABCD-0001/switch/ports/12 not found", false},
+		{"Port 12 /devices/Q234-ABCD-0001/switch/ports/12, port 1 /devices/Q234-ABCD-0001/switch/ports/1", true},
+		{"Invalid VLAN", false},
+	}
+	for _, test := range tests {
+		if got := mentionsResource(test.message, "/devices/Q234-ABCD-0001/switch/ports/1"); got != test.want {
+			t.Errorf("mentionsResource(%q) = %v, want %v", test.message, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// ACTION
+
+import (
+	"context"
+	"fmt"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &DeviceBlinkLedsAction{}
+	_ action.ActionWithConfigure = &DeviceBlinkLedsAction{}
+)
+
+func NewDeviceBlinkLedsAction() action.Action {
+	return &DeviceBlinkLedsAction{}
+}
+
+type DeviceBlinkLedsAction struct {
+	client *merakigosdk.Client
+}
+
+func (a *DeviceBlinkLedsAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(MerakiProviderData).Client
+	a.client = client
+}
+
+// Metadata returns the action type name.
+func (a *DeviceBlinkLedsAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_blink_leds"
+}
+
+func (a *DeviceBlinkLedsAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Blinks the LEDs of a device, to find it in a rack.`,
+		Attributes: map[string]schema.Attribute{
+			"serial": schema.StringAttribute{
+				MarkdownDescription: `Serial of the device.`,
+				Required:            true,
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: `The duration in seconds. Must be between 5 and 120. Default is 20 seconds`,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(5, 120),
+				},
+			},
+			"duty": schema.Int64Attribute{
+				MarkdownDescription: `The duty cycle as the percent active. Must be between 10 and 90. Default is 50.`,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(10, 90),
+				},
+			},
+			"period": schema.Int64Attribute{
+				MarkdownDescription: `The period in milliseconds. Must be between 100 and 1000. Default is 160 milliseconds`,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(100, 1000),
+				},
+			},
+		},
+	}
+}
+
+// Invoke blinks the LEDs with the request of the meraki_devices_blink_leds resource.
+func (a *DeviceBlinkLedsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data DeviceBlinkLedsActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	operation := DevicesBlinkLeds{
+		Serial: data.Serial,
+		Parameters: &RequestDevicesBlinkDeviceLedsRs{
+			Duration: data.Duration,
+			Duty:     data.Duty,
+			Period:   data.Period,
+		},
+	}
+	vvSerial := data.Serial.ValueString()
+	response, restyResp1, err := a.client.Devices.BlinkDeviceLeds(vvSerial, operation.toSdkApiRequestCreate(ctx))
+	if err != nil || response == nil {
+		if restyResp1 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing BlinkDeviceLeds",
+				restyResp1.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing BlinkDeviceLeds",
+			err.Error(),
+		)
+		return
+	}
+	if response.Duration != nil {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("The LEDs of %s blink for %d seconds.", vvSerial, *response.Duration),
+		})
+	}
+}
+
+type DeviceBlinkLedsActionModel struct {
+	Serial   types.String `tfsdk:"serial"`
+	Duration types.Int64  `tfsdk:"duration"`
+	Duty     types.Int64  `tfsdk:"duty"`
+	Period   types.Int64  `tfsdk:"period"`
+}
@@ -0,0 +1,118 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// ACTION
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &DeviceSwitchPortsCycleAction{}
+	_ action.ActionWithConfigure = &DeviceSwitchPortsCycleAction{}
+)
+
+func NewDeviceSwitchPortsCycleAction() action.Action {
+	return &DeviceSwitchPortsCycleAction{}
+}
+
+type DeviceSwitchPortsCycleAction struct {
+	client *merakigosdk.Client
+}
+
+func (a *DeviceSwitchPortsCycleAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(MerakiProviderData).Client
+	a.client = client
+}
+
+// Metadata returns the action type name.
+func (a *DeviceSwitchPortsCycleAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_switch_ports_cycle"
+}
+
+func (a *DeviceSwitchPortsCycleAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cycles the power of ports of a switch, like the PoE devices plugged into them.`,
+		Attributes: map[string]schema.Attribute{
+			"serial": schema.StringAttribute{
+				MarkdownDescription: `Serial of the switch.`,
+				Required:            true,
+			},
+			"ports": schema.ListAttribute{
+				MarkdownDescription: `List of switch ports, like ` + "`1`" + ` or ` + "`2-4`" + `.`,
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Invoke cycles the ports with the request of the meraki_devices_switch_ports_cycle resource.
+func (a *DeviceSwitchPortsCycleAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data DeviceSwitchPortsCycleActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	operation := DevicesSwitchPortsCycle{
+		Serial: data.Serial,
+		Parameters: &RequestSwitchCycleDeviceSwitchPortsRs{
+			Ports: data.Ports,
+		},
+	}
+	vvSerial := data.Serial.ValueString()
+	response, restyResp1, err := a.client.Switch.CycleDeviceSwitchPorts(vvSerial, operation.toSdkApiRequestCreate(ctx))
+	if err != nil || response == nil {
+		if restyResp1 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing CycleDeviceSwitchPorts",
+				restyResp1.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing CycleDeviceSwitchPorts",
+			err.Error(),
+		)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Cycled the ports %s of %s.", strings.Join(response.Ports, ", "), vvSerial),
+	})
+}
+
+type DeviceSwitchPortsCycleActionModel struct {
+	Serial types.String `tfsdk:"serial"`
+	Ports  types.List   `tfsdk:"ports"`
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// ACTION
+
+import (
+	"context"
+	"fmt"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &NetworkApplianceWarmSpareSwapAction{}
+	_ action.ActionWithConfigure = &NetworkApplianceWarmSpareSwapAction{}
+)
+
+func NewNetworkApplianceWarmSpareSwapAction() action.Action {
+	return &NetworkApplianceWarmSpareSwapAction{}
+}
+
+type NetworkApplianceWarmSpareSwapAction struct {
+	client *merakigosdk.Client
+}
+
+func (a *NetworkApplianceWarmSpareSwapAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(MerakiProviderData).Client
+	a.client = client
+}
+
+// Metadata returns the action type name.
+func (a *NetworkApplianceWarmSpareSwapAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_appliance_warm_spare_swap"
+}
+
+func (a *NetworkApplianceWarmSpareSwapAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Swaps the primary and the warm spare appliances of a network.`,
+		Attributes: map[string]schema.Attribute{
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: `Network ID`,
+				Required:            true,
+			},
+		},
+	}
+}
+
+// Invoke swaps the appliances of the network.
+func (a *NetworkApplianceWarmSpareSwapAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data NetworkApplianceWarmSpareSwapActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	vvNetworkID := data.NetworkID.ValueString()
+	response, restyResp1, err := a.client.Appliance.SwapNetworkApplianceWarmSpare(vvNetworkID)
+	if err != nil || response == nil {
+		if restyResp1 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing SwapNetworkApplianceWarmSpare",
+				restyResp1.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing SwapNetworkApplianceWarmSpare",
+			err.Error(),
+		)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("The primary appliance of network %s is now %s, the spare is %s.", vvNetworkID, response.PrimarySerial, response.SpareSerial),
+	})
+}
+
+type NetworkApplianceWarmSpareSwapActionModel struct {
+	NetworkID types.String `tfsdk:"network_id"`
+}
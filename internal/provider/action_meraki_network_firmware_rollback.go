@@ -0,0 +1,149 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// ACTION
+
+import (
+	"context"
+	"fmt"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &NetworkFirmwareRollbackAction{}
+	_ action.ActionWithConfigure = &NetworkFirmwareRollbackAction{}
+)
+
+func NewNetworkFirmwareRollbackAction() action.Action {
+	return &NetworkFirmwareRollbackAction{}
+}
+
+type NetworkFirmwareRollbackAction struct {
+	client *merakigosdk.Client
+}
+
+func (a *NetworkFirmwareRollbackAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(MerakiProviderData).Client
+	a.client = client
+}
+
+// Metadata returns the action type name.
+func (a *NetworkFirmwareRollbackAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_firmware_rollback"
+}
+
+func (a *NetworkFirmwareRollbackAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Rolls back the firmware of a network, or of one product of a combined network.`,
+		Attributes: map[string]schema.Attribute{
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: `Network ID`,
+				Required:            true,
+			},
+			"product": schema.StringAttribute{
+				MarkdownDescription: `Product type to rollback (if the network is a combined network)
+                                        Allowed values: [appliance,camera,cellularGateway,secureConnect,switch,switchCatalyst,wireless,wirelessController]`,
+				Optional: true,
+			},
+			"reasons": schema.SetNestedAttribute{
+				MarkdownDescription: `Reasons for the rollback`,
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"category": schema.StringAttribute{
+							MarkdownDescription: `Reason for the rollback
+                                              Allowed values: [broke old features,other,performance,stability,testing,unifying networks versions]`,
+							Required: true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: `Additional comment about the rollback`,
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"time": schema.StringAttribute{
+				MarkdownDescription: `Scheduled time for the rollback`,
+				Optional:            true,
+			},
+			"to_version": schema.SingleNestedAttribute{
+				MarkdownDescription: `Version to downgrade to (if the network has firmware flexibility)`,
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: `The version ID`,
+						Required:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Invoke schedules the rollback with the request of the meraki_networks_firmware_upgrades_rollbacks resource.
+func (a *NetworkFirmwareRollbackAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data NetworkFirmwareRollbackActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	operation := NetworksFirmwareUpgradesRollbacks{
+		NetworkID: data.NetworkID,
+		Parameters: &RequestNetworksCreateNetworkFirmwareUpgradesRollbackRs{
+			Product:   data.Product,
+			Reasons:   data.Reasons,
+			Time:      data.Time,
+			ToVersion: data.ToVersion,
+		},
+	}
+	vvNetworkID := data.NetworkID.ValueString()
+	response, restyResp1, err := a.client.Networks.CreateNetworkFirmwareUpgradesRollback(vvNetworkID, operation.toSdkApiRequestCreate(ctx))
+	if err != nil || response == nil {
+		if restyResp1 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing CreateNetworkFirmwareUpgradesRollback",
+				restyResp1.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing CreateNetworkFirmwareUpgradesRollback",
+			err.Error(),
+		)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Firmware rollback %s of network %s is %s.", response.UpgradeBatchID, vvNetworkID, response.Status),
+	})
+}
+
+type NetworkFirmwareRollbackActionModel struct {
+	NetworkID types.String                                                     `tfsdk:"network_id"`
+	Product   types.String                                                     `tfsdk:"product"`
+	Reasons   *[]RequestNetworksCreateNetworkFirmwareUpgradesRollbackReasonsRs `tfsdk:"reasons"`
+	Time      types.String                                                     `tfsdk:"time"`
+	ToVersion *RequestNetworksCreateNetworkFirmwareUpgradesRollbackToVersionRs `tfsdk:"to_version"`
+}
@@ -0,0 +1,174 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// ACTION
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &NetworkSmDevicesRebootAction{}
+	_ action.ActionWithConfigure = &NetworkSmDevicesRebootAction{}
+)
+
+func NewNetworkSmDevicesRebootAction() action.Action {
+	return &NetworkSmDevicesRebootAction{}
+}
+
+type NetworkSmDevicesRebootAction struct {
+	client *merakigosdk.Client
+}
+
+func (a *NetworkSmDevicesRebootAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(MerakiProviderData).Client
+	a.client = client
+}
+
+// Metadata returns the action type name.
+func (a *NetworkSmDevicesRebootAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_sm_devices_reboot"
+}
+
+func (a *NetworkSmDevicesRebootAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	endpoints := []validator.List{
+		listvalidator.AtLeastOneOf(
+			path.MatchRoot("ids"),
+			path.MatchRoot("scope"),
+			path.MatchRoot("serials"),
+			path.MatchRoot("wifi_macs"),
+		),
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Reboots Systems Manager endpoints of a network, selected by ID, serial, Wi-Fi MAC or scope.`,
+		Attributes: map[string]schema.Attribute{
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: `Network ID`,
+				Required:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: `The ids of the endpoints to be rebooted.`,
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators:          endpoints,
+			},
+			"kext_paths": schema.ListAttribute{
+				MarkdownDescription: `The KextPaths of the endpoints to be rebooted. Available for macOS 11+`,
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"notify_user": schema.BoolAttribute{
+				MarkdownDescription: `Whether or not to notify the user before rebooting the endpoint. Available for macOS 11.3+`,
+				Optional:            true,
+			},
+			"rebuild_kernel_cache": schema.BoolAttribute{
+				MarkdownDescription: `Whether or not to rebuild the kernel cache when rebooting the endpoint. Available for macOS 11+`,
+				Optional:            true,
+			},
+			"request_requires_network_tether": schema.BoolAttribute{
+				MarkdownDescription: `Whether or not the request requires network tethering. Available for macOS and supervised iOS or tvOS`,
+				Optional:            true,
+			},
+			"scope": schema.ListAttribute{
+				MarkdownDescription: `The scope (one of all, none, withAny, withAll, withoutAny, or withoutAll) and a set of tags of the endpoints to be rebooted.`,
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators:          endpoints,
+			},
+			"serials": schema.ListAttribute{
+				MarkdownDescription: `The serials of the endpoints to be rebooted.`,
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators:          endpoints,
+			},
+			"wifi_macs": schema.ListAttribute{
+				MarkdownDescription: `The wifiMacs of the endpoints to be rebooted.`,
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators:          endpoints,
+			},
+		},
+	}
+}
+
+// Invoke reboots the endpoints with the request of the meraki_networks_sm_devices_reboot resource.
+func (a *NetworkSmDevicesRebootAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data NetworkSmDevicesRebootActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	operation := NetworksSmDevicesReboot{
+		NetworkID: data.NetworkID,
+		Parameters: &RequestSmRebootNetworkSmDevicesRs{
+			IDs:                          data.IDs,
+			KextPaths:                    data.KextPaths,
+			NotifyUser:                   data.NotifyUser,
+			RebuildKernelCache:           data.RebuildKernelCache,
+			RequestRequiresNetworkTether: data.RequestRequiresNetworkTether,
+			Scope:                        data.Scope,
+			Serials:                      data.Serials,
+			WifiMacs:                     data.WifiMacs,
+		},
+	}
+	vvNetworkID := data.NetworkID.ValueString()
+	response, restyResp1, err := a.client.Sm.RebootNetworkSmDevices(vvNetworkID, operation.toSdkApiRequestCreate(ctx))
+	if err != nil || response == nil {
+		if restyResp1 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing RebootNetworkSmDevices",
+				restyResp1.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing RebootNetworkSmDevices",
+			err.Error(),
+		)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rebooting the endpoints %s of network %s.", strings.Join(response.IDs, ", "), vvNetworkID),
+	})
+}
+
+type NetworkSmDevicesRebootActionModel struct {
+	NetworkID                    types.String `tfsdk:"network_id"`
+	IDs                          types.List   `tfsdk:"ids"`
+	KextPaths                    types.List   `tfsdk:"kext_paths"`
+	NotifyUser                   types.Bool   `tfsdk:"notify_user"`
+	RebuildKernelCache           types.Bool   `tfsdk:"rebuild_kernel_cache"`
+	RequestRequiresNetworkTether types.Bool   `tfsdk:"request_requires_network_tether"`
+	Scope                        types.List   `tfsdk:"scope"`
+	Serials                      types.List   `tfsdk:"serials"`
+	WifiMacs                     types.List   `tfsdk:"wifi_macs"`
+}
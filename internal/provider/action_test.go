@@ -0,0 +1,140 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// invokeAction invokes an action configured with the mock and returns its progress messages.
+func invokeAction(t *testing.T, mock *merakiMock, a action.Action, config map[string]tftypes.Value) ([]string, *action.InvokeResponse) {
+	t.Helper()
+	ctx := context.Background()
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: MerakiProviderData{Client: mock.client(t)}}, &action.ConfigureResponse{})
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range typ.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range config {
+		values[name] = value
+	}
+	var progress []string
+	resp := &action.InvokeResponse{SendProgress: func(event action.InvokeProgressEvent) {
+		progress = append(progress, event.Message)
+	}}
+	a.Invoke(ctx, action.InvokeRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, values)}}, resp)
+	return progress, resp
+}
+
+func TestDeviceBlinkLedsAction(t *testing.T) {
+	mock := newMerakiMock(t)
+	progress, resp := invokeAction(t, mock, NewDeviceBlinkLedsAction(), map[string]tftypes.Value{
+		"serial":   tftypes.NewValue(tftypes.String, "Q234-ABCD-5678"),
+		"duration": tftypes.NewValue(tftypes.Number, 30),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	request, ok := mock.lastRequest("POST", "/api/v1/devices/Q234-ABCD-5678/blinkLeds")
+	if !ok {
+		t.Fatal("the LEDs were not blinked")
+	}
+	if want := map[string]interface{}{"duration": float64(30)}; !reflect.DeepEqual(request.Body, want) {
+		t.Errorf("request = %v, want %v", request.Body, want)
+	}
+	if want := []string{"The LEDs of Q234-ABCD-5678 blink for 30 seconds."}; !reflect.DeepEqual(progress, want) {
+		t.Errorf("progress = %q, want %q", progress, want)
+	}
+}
+
+func TestNetworkFirmwareRollbackAction(t *testing.T) {
+	mock := newMerakiMock(t)
+	reason := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"category": tftypes.String, "comment": tftypes.String}}
+	_, resp := invokeAction(t, mock, NewNetworkFirmwareRollbackAction(), map[string]tftypes.Value{
+		"network_id": tftypes.NewValue(tftypes.String, "N_1"),
+		"product":    tftypes.NewValue(tftypes.String, "switch"),
+		"reasons": tftypes.NewValue(tftypes.Set{ElementType: reason}, []tftypes.Value{
+			tftypes.NewValue(reason, map[string]tftypes.Value{
+				"category": tftypes.NewValue(tftypes.String, "stability"),
+				"comment":  tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	request, ok := mock.lastRequest("POST", "/api/v1/networks/N_1/firmwareUpgrades/rollbacks")
+	if !ok {
+		t.Fatal("the firmware was not rolled back")
+	}
+	body := request.Body.(map[string]interface{})
+	if body["product"] != "switch" || !reflect.DeepEqual(body["reasons"], []interface{}{map[string]interface{}{"category": "stability"}}) {
+		t.Errorf("request = %v, want the stability rollback of the switches", body)
+	}
+}
+
+func TestActionFailure(t *testing.T) {
+	mock := newMerakiMock(t)
+	mock.failNext("POST", "/api/v1/networks/N_1/appliance/warmSpare/swap", 400)
+	_, resp := invokeAction(t, mock, NewNetworkApplianceWarmSpareSwapAction(), map[string]tftypes.Value{
+		"network_id": tftypes.NewValue(tftypes.String, "N_1"),
+	})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Failure when executing SwapNetworkApplianceWarmSpare" {
+		t.Errorf("diagnostics = %v, want the failure of the swap", resp.Diagnostics)
+	}
+}
+
+// TestDeprecatedActionResources checks that the resources replaced by actions name an action
+// of the provider.
+func TestDeprecatedActionResources(t *testing.T) {
+	ctx := context.Background()
+	p := &MerakiProvider{}
+	actions := map[string]bool{}
+	for _, newAction := range p.Actions(ctx) {
+		resp := &action.MetadataResponse{}
+		newAction().Metadata(ctx, action.MetadataRequest{ProviderTypeName: "meraki"}, resp)
+		actions[resp.TypeName] = true
+	}
+	name := regexp.MustCompile("`(meraki_[a-z_]+)` action")
+	deprecated := 0
+	for _, newResource := range p.Resources(ctx) {
+		resp := &resource.SchemaResponse{}
+		newResource().Schema(ctx, resource.SchemaRequest{}, resp)
+		match := name.FindStringSubmatch(resp.Schema.DeprecationMessage)
+		if match == nil {
+			continue
+		}
+		deprecated++
+		if !actions[match[1]] {
+			t.Errorf("deprecation message %q names an unknown action", resp.Schema.DeprecationMessage)
+		}
+	}
+	if deprecated != len(actions) {
+		t.Errorf("%d resources are replaced by the %d actions", deprecated, len(actions))
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"sort"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// merakiClientPool holds the client of every organization listed in the
+// provider credentials and the default client used for everything else. It is
+// not changed once the provider is configured.
+type merakiClientPool struct {
+	defaultClient *merakigosdk.Client
+	clients       map[string]*merakigosdk.Client
+}
+
+func newMerakiClientPool() *merakiClientPool {
+	return &merakiClientPool{
+		clients: map[string]*merakigosdk.Client{},
+	}
+}
+
+// Add registers the client of an organization.
+func (p *merakiClientPool) Add(organizationID string, client *merakigosdk.Client) {
+	p.clients[organizationID] = client
+}
+
+// Client returns the client of the organization, or the default client when
+// the organization has no credentials of its own.
+func (p *merakiClientPool) Client(organizationID string) *merakigosdk.Client {
+	if p == nil {
+		return nil
+	}
+	if client, ok := p.clients[organizationID]; ok {
+		return client
+	}
+	return p.defaultClient
+}
+
+// All returns the default client, then the client of every organization sorted by
+// organization ID, to look up a network or a device whose organization is not known yet.
+func (p *merakiClientPool) All() []*merakigosdk.Client {
+	clients := []*merakigosdk.Client{p.defaultClient}
+	organizationIDs := make([]string, 0, len(p.clients))
+	for organizationID := range p.clients {
+		organizationIDs = append(organizationIDs, organizationID)
+	}
+	sort.Strings(organizationIDs)
+	for _, organizationID := range organizationIDs {
+		if client := p.clients[organizationID]; client != p.defaultClient {
+			clients = append(clients, client)
+		}
+	}
+	return clients
+}
+
+// install makes the client send each request to an organization of the credentials, or to
+// one of its networks or devices, with the API key of the organization. The limiter finds
+// the organization of the path of the request, like for its limits.
+func (p *merakiClientPool) install(client *merakigosdk.Client, limiter *merakiRateLimiter) {
+	client.RestyClient().OnBeforeRequest(func(c *resty.Client, r *resty.Request) error {
+		if len(p.clients) == 0 || r.Context().Value(rateLimitLookup{}) != nil {
+			return nil
+		}
+		organizationClient, ok := p.clients[limiter.organizationOf(c, r.URL)]
+		if !ok || organizationClient.RestyClient() == c {
+			return nil
+		}
+		r.Header.Set("Authorization", organizationClient.RestyClient().Header.Get("Authorization"))
+		return nil
+	})
+}
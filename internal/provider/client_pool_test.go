@@ -0,0 +1,102 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+)
+
+func TestMerakiClientPool(t *testing.T) {
+	defaultClient := &merakigosdk.Client{}
+	orgClient := &merakigosdk.Client{}
+
+	pool := newMerakiClientPool()
+	pool.defaultClient = defaultClient
+	pool.Add("123", orgClient)
+
+	if got := pool.Client("123"); got != orgClient {
+		t.Errorf("Client(%q) did not return the organization client", "123")
+	}
+	if got := pool.Client("456"); got != defaultClient {
+		t.Errorf("Client(%q) did not return the default client", "456")
+	}
+	if got := pool.Client(""); got != defaultClient {
+		t.Errorf("Client(%q) did not return the default client", "")
+	}
+
+	if got := pool.All(); len(got) != 2 || got[0] != defaultClient || got[1] != orgClient {
+		t.Errorf("All() did not return the default client, then the organization client")
+	}
+
+	data := MerakiProviderData{Client: defaultClient}
+	if got := data.ClientFor("123"); got != defaultClient {
+		t.Errorf("ClientFor() without a pool did not return the default client")
+	}
+	data.Clients = pool
+	if got := data.ClientFor("123"); got != orgClient {
+		t.Errorf("ClientFor(%q) did not return the organization client", "123")
+	}
+}
+
+func TestMerakiClientPoolRoutesNetworksAndDevices(t *testing.T) {
+	mock := newMerakiMock(t, "action_batcher")
+	mock.ownPaths("organization-key", "/api/v1/networks/N_24329156", "/api/v1/devices/Q234-ABCD-0001")
+	limiter := newMerakiRateLimiter(context.Background(), 100)
+	pool := newMerakiClientPool()
+	pool.defaultClient = mock.client(t)
+	pool.Add("2930418", mock.clientWithKey(t, "organization-key"))
+	for _, client := range pool.All() {
+		limiter.install(client)
+		pool.install(client, limiter)
+	}
+
+	if _, _, err := pool.defaultClient.Switch.GetDeviceSwitchPort("Q234-ABCD-0001", "1"); err != nil {
+		t.Errorf("device request not sent with the key of its organization: %s", err)
+	}
+	if _, _, err := pool.defaultClient.Appliance.GetNetworkApplianceVLAN("N_24329156", "10"); err != nil {
+		t.Errorf("network request not sent with the key of its organization: %s", err)
+	}
+	if organizationID := limiter.organizationOf(pool.defaultClient.RestyClient(), "/api/v1/networks/N_24329156"); organizationID != "2930418" {
+		t.Errorf("organization of the network = %q, want 2930418", organizationID)
+	}
+}
+
+func TestMerakiClientPoolRoutesAfterFailedLookup(t *testing.T) {
+	mock := newMerakiMock(t, "action_batcher")
+	mock.ownPaths("organization-key", "/api/v1/networks/N_24329156")
+	limiter := newMerakiRateLimiter(context.Background(), 100)
+	pool := newMerakiClientPool()
+	pool.defaultClient = mock.client(t)
+	pool.Add("2930418", mock.clientWithKey(t, "organization-key"))
+	for _, client := range pool.All() {
+		limiter.install(client)
+		pool.install(client, limiter)
+	}
+	// The lookup fails with both keys, like during a maintenance of the Meraki API.
+	mock.failNext("GET", "/api/v1/networks/N_24329156", http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+
+	if _, _, err := pool.defaultClient.Appliance.GetNetworkApplianceVLAN("N_24329156", "10"); err == nil {
+		t.Error("network request sent with the key of its organization although its lookup failed")
+	}
+	if _, _, err := pool.defaultClient.Appliance.GetNetworkApplianceVLAN("N_24329156", "10"); err != nil {
+		t.Errorf("network request not sent with the key of its organization once the lookup succeeded: %s", err)
+	}
+}
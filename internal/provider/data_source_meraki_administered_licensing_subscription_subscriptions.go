@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -66,6 +68,10 @@ func (d *AdministeredLicensingSubscriptionSubscriptionsDataSource) Schema(_ cont
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: `name query parameter. Search for subscription name`,
 				Optional:            true,
@@ -104,6 +110,10 @@ func (d *AdministeredLicensingSubscriptionSubscriptionsDataSource) Schema(_ cont
 				ElementType:         types.StringType,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseLicensingGetAdministeredLicensingSubscriptionSubscriptions`,
 				Computed:            true,
@@ -300,7 +310,10 @@ func (d *AdministeredLicensingSubscriptionSubscriptionsDataSource) Read(ctx cont
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Licensing.GetAdministeredLicensingSubscriptionSubscriptions(&queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, administeredLicensingSubscriptionSubscriptions.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseLicensingGetAdministeredLicensingSubscriptionSubscriptions, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.client.Licensing.GetAdministeredLicensingSubscriptionSubscriptions(&queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -314,6 +327,7 @@ func (d *AdministeredLicensingSubscriptionSubscriptionsDataSource) Read(ctx cont
 		}
 
 		administeredLicensingSubscriptionSubscriptions = ResponseLicensingGetAdministeredLicensingSubscriptionSubscriptionsItemsToBody(administeredLicensingSubscriptionSubscriptions, response1)
+		administeredLicensingSubscriptionSubscriptions.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &administeredLicensingSubscriptionSubscriptions)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -328,6 +342,8 @@ type AdministeredLicensingSubscriptionSubscriptions struct {
 	PerPage         types.Int64                                                               `tfsdk:"per_page"`
 	StartingAfter   types.String                                                              `tfsdk:"starting_after"`
 	EndingBefore    types.String                                                              `tfsdk:"ending_before"`
+	MaxItems        types.Int64                                                               `tfsdk:"max_items"`
+	TotalPages      types.Int64                                                               `tfsdk:"total_pages"`
 	SubscriptionIDs types.List                                                                `tfsdk:"subscription_ids"`
 	OrganizationIDs types.List                                                                `tfsdk:"organization_ids"`
 	Statuses        types.List                                                                `tfsdk:"statuses"`
@@ -0,0 +1,168 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &DeviceDataSource{}
+	_ datasource.DataSourceWithConfigure = &DeviceDataSource{}
+)
+
+func NewDeviceDataSource() datasource.DataSource {
+	return &DeviceDataSource{}
+}
+
+// DeviceDataSource looks up the only device of an organization with a name, a serial, a MAC
+// address or a tag.
+type DeviceDataSource struct {
+	client  *merakigosdk.Client
+	clients *merakiClientPool
+}
+
+func (d *DeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(MerakiProviderData).Client
+	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
+}
+
+// Metadata returns the data source type name.
+func (d *DeviceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (d *DeviceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the device of an organization with a name, a serial, a MAC address, a tag or several of them. The read fails unless exactly one device matches.",
+		Attributes: map[string]schema.Attribute{
+			"mac": schema.StringAttribute{
+				MarkdownDescription: `MAC address of the device, in any case and with colons, dashes, dots or no separator.`,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: `Name of the device.`,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("serial"), path.MatchRoot("mac"), path.MatchRoot("tag")),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: `organizationId path parameter. Organization ID`,
+				Required:            true,
+			},
+			"serial": schema.StringAttribute{
+				MarkdownDescription: `Serial of the device, in any case.`,
+				Optional:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: `Tag of the device.`,
+				Optional:            true,
+			},
+			"item": schema.SingleNestedAttribute{
+				MarkdownDescription: `The device that matches.`,
+				Computed:            true,
+				Attributes:          lookupItemAttributes(NewDevicesDataSource(), "items"),
+			},
+		},
+	}
+}
+
+func (d *DeviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var device Device
+	diags := req.Config.Get(ctx, &device)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vvOrganizationID := device.OrganizationID.ValueString()
+	// The name, serial and MAC filters of the API match substrings, the items are matched
+	// exactly below.
+	queryParams := merakigosdk.GetOrganizationDevicesQueryParams{
+		Name:   device.Name.ValueString(),
+		Serial: device.Serial.ValueString(),
+	}
+	if !device.Tag.IsNull() {
+		queryParams.Tags = []string{device.Tag.ValueString()}
+	}
+	response, restyResp, _, err := paginateList("", 0, func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationDevices, *resty.Response, error) {
+		queryParams.StartingAfter = startingAfter
+		return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationDevices(vvOrganizationID, &queryParams)
+	})
+	if err != nil || response == nil {
+		if restyResp != nil {
+			log.Printf("[DEBUG] Retrieved error response %s", restyResp.String())
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing GetOrganizationDevices",
+			err.Error(),
+		)
+		return
+	}
+
+	match, diags := lookupOne(*response, func(item merakigosdk.ResponseItemOrganizationsGetOrganizationDevices) bool {
+		return lookupEqual(item.Name, device.Name) &&
+			(device.Serial.IsNull() || strings.EqualFold(item.Serial, device.Serial.ValueString())) &&
+			(device.Mac.IsNull() || normalizeMAC(item.Mac) == normalizeMAC(device.Mac.ValueString())) &&
+			lookupTagged(item.Tags, device.Tag)
+	}, func(item merakigosdk.ResponseItemOrganizationsGetOrganizationDevices) string {
+		return item.Serial
+	}, "device", "devices", "organization "+vvOrganizationID,
+		lookupCriteria("name", device.Name, "serial", device.Serial, "MAC", device.Mac, "tag", device.Tag))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items := ResponseDevicesGetOrganizationDevicesItemsToBody(Devices{}, &merakigosdk.ResponseOrganizationsGetOrganizationDevices{match})
+	device.Item = &(*items.Items)[0]
+	diags = resp.State.Set(ctx, &device)
+	resp.Diagnostics.Append(diags...)
+}
+
+// normalizeMAC returns a MAC address in lower case without separators.
+func normalizeMAC(mac string) string {
+	return strings.NewReplacer(":", "", "-", "", ".", "").Replace(strings.ToLower(mac))
+}
+
+// structs
+type Device struct {
+	OrganizationID types.String                                     `tfsdk:"organization_id"`
+	Name           types.String                                     `tfsdk:"name"`
+	Serial         types.String                                     `tfsdk:"serial"`
+	Mac            types.String                                     `tfsdk:"mac"`
+	Tag            types.String                                     `tfsdk:"tag"`
+	Item           *ResponseItemOrganizationsGetOrganizationDevices `tfsdk:"item"`
+}
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewDevicesDataSource() datasource.DataSource {
 }
 
 type DevicesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *DevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *DevicesDataSource) Configure(ctx context.Context, req datasource.Config
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -74,6 +78,10 @@ func (d *DevicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"model": schema.StringAttribute{
 				MarkdownDescription: `model query parameter. Optional parameter to filter devices by model. All returned devices will have a model that contains the search term or is an exact match.`,
 				Optional:            true,
@@ -137,6 +145,10 @@ func (d *DevicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				MarkdownDescription: `tagsFilterType query parameter. Optional parameter of value 'withAnyTags' or 'withAllTags' to indicate whether to return networks which contain ANY or ALL of the included tags. If no type is included, 'withAnyTags' will be selected.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -391,7 +403,10 @@ func (d *DevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		queryParams2.SensorAlertProfileIDs = elementsToStrings(ctx, devices.SensorAlertProfileIDs)
 		queryParams2.Models = elementsToStrings(ctx, devices.Models)
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationDevices(vvOrganizationID, &queryParams2)
+		response2, restyResp2, totalPages, err := paginateList(queryParams2.StartingAfter, devices.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationDevices, *resty.Response, error) {
+			queryParams2.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationDevices(vvOrganizationID, &queryParams2)
+		})
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -405,6 +420,7 @@ func (d *DevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		}
 
 		devices = ResponseDevicesGetOrganizationDevicesItemsToBody(devices, response2)
+		devices.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &devices)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -421,6 +437,8 @@ type Devices struct {
 	PerPage                   types.Int64                                        `tfsdk:"per_page"`
 	StartingAfter             types.String                                       `tfsdk:"starting_after"`
 	EndingBefore              types.String                                       `tfsdk:"ending_before"`
+	MaxItems                  types.Int64                                        `tfsdk:"max_items"`
+	TotalPages                types.Int64                                        `tfsdk:"total_pages"`
 	ConfigurationUpdatedAfter types.String                                       `tfsdk:"configuration_updated_after"`
 	NetworkIDs                types.List                                         `tfsdk:"network_ids"`
 	ProductTypes              types.List                                         `tfsdk:"product_types"`
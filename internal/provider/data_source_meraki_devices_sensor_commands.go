@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -65,6 +67,10 @@ func (d *DevicesSensorCommandsDataSource) Schema(_ context.Context, _ datasource
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"operations": schema.ListAttribute{
 				MarkdownDescription: `operations query parameter. Optional parameter to filter commands by operation. Allowed values are disableDownstreamPower, enableDownstreamPower, cycleDownstreamPower, and refreshData.`,
 				Optional:            true,
@@ -98,6 +104,10 @@ func (d *DevicesSensorCommandsDataSource) Schema(_ context.Context, _ datasource
 				MarkdownDescription: `timespan query parameter. The timespan for which the information will be fetched. If specifying timespan, do not specify parameters t0 and t1. The value must be in seconds and be less than or equal to 30 days. The default is 30 days.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -235,7 +245,10 @@ func (d *DevicesSensorCommandsDataSource) Read(ctx context.Context, req datasour
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Sensor.GetDeviceSensorCommands(vvSerial, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, devicesSensorCommands.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseSensorGetDeviceSensorCommands, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.client.Sensor.GetDeviceSensorCommands(vvSerial, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -249,6 +262,7 @@ func (d *DevicesSensorCommandsDataSource) Read(ctx context.Context, req datasour
 		}
 
 		devicesSensorCommands = ResponseSensorGetDeviceSensorCommandsItemsToBody(devicesSensorCommands, response1)
+		devicesSensorCommands.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &devicesSensorCommands)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -304,6 +318,8 @@ type DevicesSensorCommands struct {
 	PerPage       types.Int64                                  `tfsdk:"per_page"`
 	StartingAfter types.String                                 `tfsdk:"starting_after"`
 	EndingBefore  types.String                                 `tfsdk:"ending_before"`
+	MaxItems      types.Int64                                  `tfsdk:"max_items"`
+	TotalPages    types.Int64                                  `tfsdk:"total_pages"`
 	SortOrder     types.String                                 `tfsdk:"sort_order"`
 	T0            types.String                                 `tfsdk:"t0"`
 	T1            types.String                                 `tfsdk:"t1"`
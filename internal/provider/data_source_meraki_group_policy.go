@@ -0,0 +1,141 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &GroupPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &GroupPolicyDataSource{}
+)
+
+func NewGroupPolicyDataSource() datasource.DataSource {
+	return &GroupPolicyDataSource{}
+}
+
+// GroupPolicyDataSource looks up the only group policy of a network with a name.
+type GroupPolicyDataSource struct {
+	client *merakigosdk.Client
+}
+
+func (d *GroupPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(MerakiProviderData).Client
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *GroupPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_policy"
+}
+
+func (d *GroupPolicyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the group policy of a network with its name. The read fails unless exactly one group policy matches.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: `Name of the group policy.`,
+				Required:            true,
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: `networkId path parameter. Network ID`,
+				Required:            true,
+			},
+			"item": schema.SingleNestedAttribute{
+				MarkdownDescription: `The group policy that matches.`,
+				Computed:            true,
+				Attributes:          lookupItemAttributes(NewNetworksGroupPoliciesDataSource(), "item"),
+			},
+		},
+	}
+}
+
+// groupPolicyName is a group policy of GetNetworkGroupPolicies. The SDK type of the list has
+// no name, the list is read without the SDK.
+type groupPolicyName struct {
+	GroupPolicyID string `json:"groupPolicyId"`
+	Name          string `json:"name"`
+}
+
+func (d *GroupPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var groupPolicy GroupPolicy
+	diags := req.Config.Get(ctx, &groupPolicy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vvNetworkID := groupPolicy.NetworkID.ValueString()
+	var policies []groupPolicyName
+	restyResp, err := d.client.RestyClient().R().SetResult(&policies).Get("/api/v1/networks/" + url.PathEscape(vvNetworkID) + "/groupPolicies")
+	if err == nil && restyResp.IsError() {
+		err = fmt.Errorf("error with operation: %s Error:\n %s", restyResp.Request.URL, restyResp)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing GetNetworkGroupPolicies",
+			err.Error(),
+		)
+		return
+	}
+
+	match, diags := lookupOne(policies, func(item groupPolicyName) bool {
+		return lookupEqual(item.Name, groupPolicy.Name)
+	}, func(item groupPolicyName) string {
+		return item.GroupPolicyID
+	}, "group policy", "group policies", "network "+vvNetworkID, lookupCriteria("name", groupPolicy.Name))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, restyResp2, err := d.client.Networks.GetNetworkGroupPolicy(vvNetworkID, match.GroupPolicyID)
+	if err != nil || response == nil {
+		if restyResp2 != nil {
+			log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing GetNetworkGroupPolicy",
+			err.Error(),
+		)
+		return
+	}
+
+	groupPolicy.Item = ResponseNetworksGetNetworkGroupPolicyItemToBody(NetworksGroupPolicies{}, response).Item
+	diags = resp.State.Set(ctx, &groupPolicy)
+	resp.Diagnostics.Append(diags...)
+}
+
+// structs
+type GroupPolicy struct {
+	NetworkID types.String                           `tfsdk:"network_id"`
+	Name      types.String                           `tfsdk:"name"`
+	Item      *ResponseNetworksGetNetworkGroupPolicy `tfsdk:"item"`
+}
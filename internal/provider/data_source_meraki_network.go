@@ -0,0 +1,142 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"log"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &NetworkDataSource{}
+	_ datasource.DataSourceWithConfigure = &NetworkDataSource{}
+)
+
+func NewNetworkDataSource() datasource.DataSource {
+	return &NetworkDataSource{}
+}
+
+// NetworkDataSource looks up the only network of an organization with a name or a tag.
+type NetworkDataSource struct {
+	client  *merakigosdk.Client
+	clients *merakiClientPool
+}
+
+func (d *NetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(MerakiProviderData).Client
+	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
+}
+
+// Metadata returns the data source type name.
+func (d *NetworkDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+func (d *NetworkDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the network of an organization with a name, a tag or both. The read fails unless exactly one network matches.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: `Name of the network.`,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("tag")),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: `organizationId path parameter. Organization ID`,
+				Required:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: `Tag of the network.`,
+				Optional:            true,
+			},
+			"item": schema.SingleNestedAttribute{
+				MarkdownDescription: `The network that matches.`,
+				Computed:            true,
+				Attributes:          lookupItemAttributes(NewNetworksDataSource(), "items"),
+			},
+		},
+	}
+}
+
+func (d *NetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var network Network
+	diags := req.Config.Get(ctx, &network)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vvOrganizationID := network.OrganizationID.ValueString()
+	queryParams := merakigosdk.GetOrganizationNetworksQueryParams{}
+	if !network.Tag.IsNull() {
+		queryParams.Tags = []string{network.Tag.ValueString()}
+	}
+	response, restyResp, _, err := paginateList("", 0, func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationNetworks, *resty.Response, error) {
+		queryParams.StartingAfter = startingAfter
+		return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationNetworks(vvOrganizationID, &queryParams)
+	})
+	if err != nil || response == nil {
+		if restyResp != nil {
+			log.Printf("[DEBUG] Retrieved error response %s", restyResp.String())
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing GetOrganizationNetworks",
+			err.Error(),
+		)
+		return
+	}
+
+	match, diags := lookupOne(*response, func(item merakigosdk.ResponseItemOrganizationsGetOrganizationNetworks) bool {
+		return lookupEqual(item.Name, network.Name) && lookupTagged(item.Tags, network.Tag)
+	}, func(item merakigosdk.ResponseItemOrganizationsGetOrganizationNetworks) string {
+		return item.ID
+	}, "network", "networks", "organization "+vvOrganizationID, lookupCriteria("name", network.Name, "tag", network.Tag))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items := ResponseNetworksGetOrganizationNetworksItemsToBody(Networks{}, &merakigosdk.ResponseOrganizationsGetOrganizationNetworks{match})
+	network.Item = &(*items.Items)[0]
+	diags = resp.State.Set(ctx, &network)
+	resp.Diagnostics.Append(diags...)
+}
+
+// structs
+type Network struct {
+	OrganizationID types.String                                      `tfsdk:"organization_id"`
+	Name           types.String                                      `tfsdk:"name"`
+	Tag            types.String                                      `tfsdk:"tag"`
+	Item           *ResponseItemOrganizationsGetOrganizationNetworks `tfsdk:"item"`
+}
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewNetworksDataSource() datasource.DataSource {
 }
 
 type NetworksDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *NetworksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *NetworksDataSource) Configure(ctx context.Context, req datasource.Confi
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -69,6 +73,10 @@ func (d *NetworksDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				MarkdownDescription: `isBoundToConfigTemplate query parameter. An optional parameter to filter config template bound networks. If configTemplateId is set, this cannot be false.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Optional:            true,
@@ -99,6 +107,10 @@ func (d *NetworksDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				MarkdownDescription: `tagsFilterType query parameter. An optional parameter of value 'withAnyTags' or 'withAllTags' to indicate whether to return networks which contain ANY or ALL of the included tags. If no type is included, 'withAnyTags' will be selected.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -259,7 +271,10 @@ func (d *NetworksDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationNetworks(vvOrganizationID, &queryParams2)
+		response2, restyResp2, totalPages, err := paginateList(queryParams2.StartingAfter, networks.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationNetworks, *resty.Response, error) {
+			queryParams2.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationNetworks(vvOrganizationID, &queryParams2)
+		})
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -273,6 +288,7 @@ func (d *NetworksDataSource) Read(ctx context.Context, req datasource.ReadReques
 		}
 
 		networks = ResponseNetworksGetOrganizationNetworksItemsToBody(networks, response2)
+		networks.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &networks)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -294,6 +310,8 @@ type Networks struct {
 	PerPage                 types.Int64                                         `tfsdk:"per_page"`
 	StartingAfter           types.String                                        `tfsdk:"starting_after"`
 	EndingBefore            types.String                                        `tfsdk:"ending_before"`
+	MaxItems                types.Int64                                         `tfsdk:"max_items"`
+	TotalPages              types.Int64                                         `tfsdk:"total_pages"`
 	Items                   *[]ResponseItemOrganizationsGetOrganizationNetworks `tfsdk:"items"`
 	Item                    *ResponseNetworksGetNetwork                         `tfsdk:"item"`
 }
@@ -24,6 +24,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -62,6 +64,10 @@ func (d *NetworksAlertsHistoryDataSource) Schema(_ context.Context, _ datasource
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
@@ -75,6 +81,10 @@ func (d *NetworksAlertsHistoryDataSource) Schema(_ context.Context, _ datasource
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseNetworksGetNetworkAlertsHistory`,
 				Computed:            true,
@@ -187,7 +197,10 @@ func (d *NetworksAlertsHistoryDataSource) Read(ctx context.Context, req datasour
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Networks.GetNetworkAlertsHistory(vvNetworkID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, networksAlertsHistory.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseNetworksGetNetworkAlertsHistory, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.client.Networks.GetNetworkAlertsHistory(vvNetworkID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -201,6 +214,7 @@ func (d *NetworksAlertsHistoryDataSource) Read(ctx context.Context, req datasour
 		}
 
 		networksAlertsHistory = ResponseNetworksGetNetworkAlertsHistoryItemsToBody(networksAlertsHistory, response1)
+		networksAlertsHistory.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &networksAlertsHistory)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -216,6 +230,8 @@ type NetworksAlertsHistory struct {
 	PerPage       types.Int64                                    `tfsdk:"per_page"`
 	StartingAfter types.String                                   `tfsdk:"starting_after"`
 	EndingBefore  types.String                                   `tfsdk:"ending_before"`
+	MaxItems      types.Int64                                    `tfsdk:"max_items"`
+	TotalPages    types.Int64                                    `tfsdk:"total_pages"`
 	Items         *[]ResponseItemNetworksGetNetworkAlertsHistory `tfsdk:"items"`
 }
 
@@ -116,6 +116,7 @@ func (d *NetworksCameraWirelessProfilesDataSource) Schema(_ context.Context, _ d
 							},
 							"psk": schema.StringAttribute{
 								MarkdownDescription: `The pre-shared key of the SSID, if mode is PSK`,
+								Sensitive:           true,
 								Computed:            true,
 							},
 						},
@@ -176,6 +177,7 @@ func (d *NetworksCameraWirelessProfilesDataSource) Schema(_ context.Context, _ d
 								},
 								"psk": schema.StringAttribute{
 									MarkdownDescription: `The pre-shared key of the SSID, if mode is PSK`,
+									Sensitive:           true,
 									Computed:            true,
 								},
 							},
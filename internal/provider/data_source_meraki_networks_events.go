@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -107,6 +109,10 @@ func (d *NetworksEventsDataSource) Schema(_ context.Context, _ datasource.Schema
 				MarkdownDescription: `isCatalyst query parameter. Boolean indicating that whether it is a Catalyst device. For Catalyst device, eventDetails and eventSeverity can be used to filter events.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
@@ -131,6 +137,10 @@ func (d *NetworksEventsDataSource) Schema(_ context.Context, _ datasource.Schema
 				MarkdownDescription: `startingAfter query parameter. A token used by the server to indicate the start of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -274,7 +284,15 @@ func (d *NetworksEventsDataSource) Read(ctx context.Context, req datasource.Read
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Networks.GetNetworkEvents(vvNetworkID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, networksEvents.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseNetworksGetNetworkEvents, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.client.Networks.GetNetworkEvents(vvNetworkID, &queryParams1)
+		}, func(page *merakigosdk.ResponseNetworksGetNetworkEvents) *[]merakigosdk.ResponseNetworksGetNetworkEventsEvents {
+			if page.Events == nil {
+				page.Events = &[]merakigosdk.ResponseNetworksGetNetworkEventsEvents{}
+			}
+			return page.Events
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -288,6 +306,7 @@ func (d *NetworksEventsDataSource) Read(ctx context.Context, req datasource.Read
 		}
 
 		networksEvents = ResponseNetworksGetNetworkEventsItemToBody(networksEvents, response1)
+		networksEvents.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &networksEvents)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -317,6 +336,8 @@ type NetworksEvents struct {
 	PerPage            types.Int64                       `tfsdk:"per_page"`
 	StartingAfter      types.String                      `tfsdk:"starting_after"`
 	EndingBefore       types.String                      `tfsdk:"ending_before"`
+	MaxItems           types.Int64                       `tfsdk:"max_items"`
+	TotalPages         types.Int64                       `tfsdk:"total_pages"`
 	Item               *ResponseNetworksGetNetworkEvents `tfsdk:"item"`
 }
 
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -77,6 +79,10 @@ func (d *NetworksSmDevicesDataSource) Schema(_ context.Context, _ datasource.Sch
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
@@ -104,6 +110,10 @@ func (d *NetworksSmDevicesDataSource) Schema(_ context.Context, _ datasource.Sch
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"uuids": schema.ListAttribute{
 				MarkdownDescription: `uuids query parameter. Filter devices by uuid(s).`,
 				Optional:            true,
@@ -203,7 +213,10 @@ func (d *NetworksSmDevicesDataSource) Read(ctx context.Context, req datasource.R
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Sm.GetNetworkSmDevices(vvNetworkID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, networksSmDevices.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseSmGetNetworkSmDevices, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.client.Sm.GetNetworkSmDevices(vvNetworkID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -217,6 +230,7 @@ func (d *NetworksSmDevicesDataSource) Read(ctx context.Context, req datasource.R
 		}
 
 		networksSmDevices = ResponseSmGetNetworkSmDevicesItemsToBody(networksSmDevices, response1)
+		networksSmDevices.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &networksSmDevices)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -239,6 +253,8 @@ type NetworksSmDevices struct {
 	PerPage       types.Int64                          `tfsdk:"per_page"`
 	StartingAfter types.String                         `tfsdk:"starting_after"`
 	EndingBefore  types.String                         `tfsdk:"ending_before"`
+	MaxItems      types.Int64                          `tfsdk:"max_items"`
+	TotalPages    types.Int64                          `tfsdk:"total_pages"`
 	Items         *[]ResponseItemSmGetNetworkSmDevices `tfsdk:"items"`
 }
 
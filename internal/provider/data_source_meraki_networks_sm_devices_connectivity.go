@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -65,6 +67,10 @@ func (d *NetworksSmDevicesConnectivityDataSource) Schema(_ context.Context, _ da
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
@@ -78,6 +84,10 @@ func (d *NetworksSmDevicesConnectivityDataSource) Schema(_ context.Context, _ da
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseSmGetNetworkSmDeviceConnectivity`,
 				Computed:            true,
@@ -119,7 +129,10 @@ func (d *NetworksSmDevicesConnectivityDataSource) Read(ctx context.Context, req
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Sm.GetNetworkSmDeviceConnectivity(vvNetworkID, vvDeviceID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, networksSmDevicesConnectivity.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseSmGetNetworkSmDeviceConnectivity, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.client.Sm.GetNetworkSmDeviceConnectivity(vvNetworkID, vvDeviceID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -133,6 +146,7 @@ func (d *NetworksSmDevicesConnectivityDataSource) Read(ctx context.Context, req
 		}
 
 		networksSmDevicesConnectivity = ResponseSmGetNetworkSmDeviceConnectivityItemsToBody(networksSmDevicesConnectivity, response1)
+		networksSmDevicesConnectivity.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &networksSmDevicesConnectivity)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -149,6 +163,8 @@ type NetworksSmDevicesConnectivity struct {
 	PerPage       types.Int64                                     `tfsdk:"per_page"`
 	StartingAfter types.String                                    `tfsdk:"starting_after"`
 	EndingBefore  types.String                                    `tfsdk:"ending_before"`
+	MaxItems      types.Int64                                     `tfsdk:"max_items"`
+	TotalPages    types.Int64                                     `tfsdk:"total_pages"`
 	Items         *[]ResponseItemSmGetNetworkSmDeviceConnectivity `tfsdk:"items"`
 }
 
@@ -71,6 +71,7 @@ func (d *NetworksSNMPDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 					},
 					"community_string": schema.StringAttribute{
 						MarkdownDescription: `SNMP community string if access is 'community'.`,
+						Sensitive:           true,
 						Computed:            true,
 					},
 					"users": schema.SetNestedAttribute{
@@ -81,6 +82,7 @@ func (d *NetworksSNMPDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 
 								"passphrase": schema.StringAttribute{
 									MarkdownDescription: `The passphrase for the SNMP user.`,
+									Sensitive:           true,
 									Computed:            true,
 								},
 								"username": schema.StringAttribute{
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -61,6 +63,10 @@ func (d *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersDataSource) Sc
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
@@ -74,6 +80,10 @@ func (d *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersDataSource) Sc
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseSwitchGetNetworkSwitchDhcpServerPolicyArpInspectionTrustedServers`,
 				Computed:            true,
@@ -129,7 +139,10 @@ func (d *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersDataSource) Re
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Switch.GetNetworkSwitchDhcpServerPolicyArpInspectionTrustedServers(vvNetworkID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, networksSwitchDhcpServerPolicyArpInspectionTrustedServers.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseSwitchGetNetworkSwitchDhcpServerPolicyArpInspectionTrustedServers, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.client.Switch.GetNetworkSwitchDhcpServerPolicyArpInspectionTrustedServers(vvNetworkID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -143,6 +156,7 @@ func (d *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersDataSource) Re
 		}
 
 		networksSwitchDhcpServerPolicyArpInspectionTrustedServers = ResponseSwitchGetNetworkSwitchDhcpServerPolicyArpInspectionTrustedServersItemsToBody(networksSwitchDhcpServerPolicyArpInspectionTrustedServers, response1)
+		networksSwitchDhcpServerPolicyArpInspectionTrustedServers.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &networksSwitchDhcpServerPolicyArpInspectionTrustedServers)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -158,6 +172,8 @@ type NetworksSwitchDhcpServerPolicyArpInspectionTrustedServers struct {
 	PerPage       types.Int64                                                                      `tfsdk:"per_page"`
 	StartingAfter types.String                                                                     `tfsdk:"starting_after"`
 	EndingBefore  types.String                                                                     `tfsdk:"ending_before"`
+	MaxItems      types.Int64                                                                      `tfsdk:"max_items"`
+	TotalPages    types.Int64                                                                      `tfsdk:"total_pages"`
 	Items         *[]ResponseItemSwitchGetNetworkSwitchDhcpServerPolicyArpInspectionTrustedServers `tfsdk:"items"`
 }
 
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -61,6 +63,10 @@ func (d *NetworksSwitchDhcpV4ServersSeenDataSource) Schema(_ context.Context, _
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
@@ -82,6 +88,10 @@ func (d *NetworksSwitchDhcpV4ServersSeenDataSource) Schema(_ context.Context, _
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseSwitchGetNetworkSwitchDhcpV4ServersSeen`,
 				Computed:            true,
@@ -455,7 +465,10 @@ func (d *NetworksSwitchDhcpV4ServersSeenDataSource) Read(ctx context.Context, re
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Switch.GetNetworkSwitchDhcpV4ServersSeen(vvNetworkID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, networksSwitchDhcpV4ServersSeen.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseSwitchGetNetworkSwitchDhcpV4ServersSeen, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.client.Switch.GetNetworkSwitchDhcpV4ServersSeen(vvNetworkID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -469,6 +482,7 @@ func (d *NetworksSwitchDhcpV4ServersSeenDataSource) Read(ctx context.Context, re
 		}
 
 		networksSwitchDhcpV4ServersSeen = ResponseSwitchGetNetworkSwitchDhcpV4ServersSeenItemsToBody(networksSwitchDhcpV4ServersSeen, response1)
+		networksSwitchDhcpV4ServersSeen.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &networksSwitchDhcpV4ServersSeen)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -486,6 +500,8 @@ type NetworksSwitchDhcpV4ServersSeen struct {
 	PerPage       types.Int64                                            `tfsdk:"per_page"`
 	StartingAfter types.String                                           `tfsdk:"starting_after"`
 	EndingBefore  types.String                                           `tfsdk:"ending_before"`
+	MaxItems      types.Int64                                            `tfsdk:"max_items"`
+	TotalPages    types.Int64                                            `tfsdk:"total_pages"`
 	Items         *[]ResponseItemSwitchGetNetworkSwitchDhcpV4ServersSeen `tfsdk:"items"`
 }
 
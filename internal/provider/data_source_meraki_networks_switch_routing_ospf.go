@@ -113,6 +113,7 @@ func (d *NetworksSwitchRoutingOspfDataSource) Schema(_ context.Context, _ dataso
 							},
 							"passphrase": schema.StringAttribute{
 								MarkdownDescription: `MD5 authentication passphrase`,
+								Sensitive:           true,
 								Computed:            true,
 							},
 						},
@@ -0,0 +1,62 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMerakiNetworksDataSource_pagination(t *testing.T) {
+	mock := newMerakiMock(t, "organizations_networks")
+	dataSourceName := "data.meraki_networks.example"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMerakiNetworksDataSourceConfig(mock, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "total_pages", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "items.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "items.0.id", "N_24329156"),
+					resource.TestCheckResourceAttr(dataSourceName, "items.0.name", "Main Office"),
+					resource.TestCheckResourceAttr(dataSourceName, "items.2.id", "N_24329158"),
+					resource.TestCheckResourceAttr(dataSourceName, "items.2.time_zone", "Europe/Paris"),
+				),
+			},
+			{
+				Config: testAccMerakiNetworksDataSourceConfig(mock, "max_items = 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "total_pages", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "items.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMerakiNetworksDataSourceConfig(mock *merakiMock, extra string) string {
+	return testAccProviderConfig(mock) + fmt.Sprintf(`
+data "meraki_networks" "example" {
+  organization_id = "2930418"
+  %s
+}
+`, extra)
+}
@@ -95,6 +95,7 @@ func (d *NetworksWirelessSSIDsIDentityPsksDataSource) Schema(_ context.Context,
 					},
 					"passphrase": schema.StringAttribute{
 						MarkdownDescription: `The passphrase for client authentication`,
+						Sensitive:           true,
 						Computed:            true,
 					},
 					"wifi_personal_network_id": schema.StringAttribute{
@@ -132,6 +133,7 @@ func (d *NetworksWirelessSSIDsIDentityPsksDataSource) Schema(_ context.Context,
 						},
 						"passphrase": schema.StringAttribute{
 							MarkdownDescription: `The passphrase for client authentication`,
+							Sensitive:           true,
 							Computed:            true,
 						},
 						"wifi_personal_network_id": schema.StringAttribute{
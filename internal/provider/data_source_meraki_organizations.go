@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsDataSource() datasource.DataSource {
 }
 
 type OrganizationsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsDataSource) Configure(ctx context.Context, req datasource.
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Optional:            true,
@@ -73,6 +81,10 @@ func (d *OrganizationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				MarkdownDescription: `startingAfter query parameter. A token used by the server to indicate the start of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -109,6 +121,10 @@ func (d *OrganizationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 											},
 										},
 									},
+									"meraki_region": schema.StringAttribute{
+										MarkdownDescription: `Value of the provider meraki_region that reaches this organization`,
+										Computed:            true,
+									},
 									"name": schema.StringAttribute{
 										MarkdownDescription: `Name of region`,
 										Computed:            true,
@@ -205,6 +221,10 @@ func (d *OrganizationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 												},
 											},
 										},
+										"meraki_region": schema.StringAttribute{
+											MarkdownDescription: `Value of the provider meraki_region that reaches this organization`,
+											Computed:            true,
+										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: `Name of region`,
 											Computed:            true,
@@ -290,7 +310,10 @@ func (d *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadR
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizations(&queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizations.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizations, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.client.Organizations.GetOrganizations(&queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -304,6 +327,7 @@ func (d *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadR
 		}
 
 		organizations = ResponseOrganizationsGetOrganizationsItemsToBody(organizations, response1)
+		organizations.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizations)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -317,7 +341,7 @@ func (d *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadR
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganization(vvOrganizationID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganization(vvOrganizationID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -356,6 +380,8 @@ type Organizations struct {
 	PerPage        types.Int64                                  `tfsdk:"per_page"`
 	StartingAfter  types.String                                 `tfsdk:"starting_after"`
 	EndingBefore   types.String                                 `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                  `tfsdk:"max_items"`
+	TotalPages     types.Int64                                  `tfsdk:"total_pages"`
 	OrganizationID types.String                                 `tfsdk:"organization_id"`
 	Items          *[]ResponseItemOrganizationsGetOrganizations `tfsdk:"items"`
 	Item           *ResponseOrganizationsGetOrganization        `tfsdk:"item"`
@@ -380,8 +406,9 @@ type ResponseItemOrganizationsGetOrganizationsCloud struct {
 }
 
 type ResponseItemOrganizationsGetOrganizationsCloudRegion struct {
-	Host *ResponseItemOrganizationsGetOrganizationsCloudRegionHost `tfsdk:"host"`
-	Name types.String                                              `tfsdk:"name"`
+	Host         *ResponseItemOrganizationsGetOrganizationsCloudRegionHost `tfsdk:"host"`
+	MerakiRegion types.String                                              `tfsdk:"meraki_region"`
+	Name         types.String                                              `tfsdk:"name"`
 }
 
 type ResponseItemOrganizationsGetOrganizationsCloudRegionHost struct {
@@ -420,8 +447,9 @@ type ResponseOrganizationsGetOrganizationCloud struct {
 }
 
 type ResponseOrganizationsGetOrganizationCloudRegion struct {
-	Host *ResponseOrganizationsGetOrganizationCloudRegionHost `tfsdk:"host"`
-	Name types.String                                         `tfsdk:"name"`
+	Host         *ResponseOrganizationsGetOrganizationCloudRegionHost `tfsdk:"host"`
+	MerakiRegion types.String                                         `tfsdk:"meraki_region"`
+	Name         types.String                                         `tfsdk:"name"`
 }
 
 type ResponseOrganizationsGetOrganizationCloudRegionHost struct {
@@ -478,6 +506,16 @@ func ResponseOrganizationsGetOrganizationsItemsToBody(state Organizations, respo
 										}
 										return nil
 									}(),
+									MerakiRegion: func() types.String {
+										region := regionOfCloud(item.Cloud.Region.Name, "")
+										if item.Cloud.Region.Host != nil {
+											region = regionOfCloud(item.Cloud.Region.Name, item.Cloud.Region.Host.Name)
+										}
+										if region != "" {
+											return types.StringValue(region)
+										}
+										return types.String{}
+									}(),
 									Name: func() types.String {
 										if item.Cloud.Region.Name != "" {
 											return types.StringValue(item.Cloud.Region.Name)
@@ -594,6 +632,16 @@ func ResponseOrganizationsGetOrganizationItemToBody(state Organizations, respons
 									}
 									return nil
 								}(),
+								MerakiRegion: func() types.String {
+									region := regionOfCloud(response.Cloud.Region.Name, "")
+									if response.Cloud.Region.Host != nil {
+										region = regionOfCloud(response.Cloud.Region.Name, response.Cloud.Region.Host.Name)
+									}
+									if region != "" {
+										return types.StringValue(region)
+									}
+									return types.String{}
+								}(),
 								Name: func() types.String {
 									if response.Cloud.Region.Name != "" {
 										return types.StringValue(response.Cloud.Region.Name)
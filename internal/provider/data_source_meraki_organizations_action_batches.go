@@ -38,7 +38,8 @@ func NewOrganizationsActionBatchesDataSource() datasource.DataSource {
 }
 
 type OrganizationsActionBatchesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsActionBatchesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsActionBatchesDataSource) Configure(ctx context.Context, re
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -282,7 +284,7 @@ func (d *OrganizationsActionBatchesDataSource) Read(ctx context.Context, req dat
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationActionBatches(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationActionBatches(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -310,7 +312,7 @@ func (d *OrganizationsActionBatchesDataSource) Read(ctx context.Context, req dat
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationActionBatch(vvOrganizationID, vvActionBatchID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationActionBatch(vvOrganizationID, vvActionBatchID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
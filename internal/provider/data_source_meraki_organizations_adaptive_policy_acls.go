@@ -38,7 +38,8 @@ func NewOrganizationsAdaptivePolicyACLsDataSource() datasource.DataSource {
 }
 
 type OrganizationsAdaptivePolicyACLsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAdaptivePolicyACLsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsAdaptivePolicyACLsDataSource) Configure(ctx context.Contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -222,7 +224,7 @@ func (d *OrganizationsAdaptivePolicyACLsDataSource) Read(ctx context.Context, re
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAdaptivePolicyACLs(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyACLs(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -250,7 +252,7 @@ func (d *OrganizationsAdaptivePolicyACLsDataSource) Read(ctx context.Context, re
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
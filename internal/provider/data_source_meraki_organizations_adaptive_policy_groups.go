@@ -38,7 +38,8 @@ func NewOrganizationsAdaptivePolicyGroupsDataSource() datasource.DataSource {
 }
 
 type OrganizationsAdaptivePolicyGroupsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAdaptivePolicyGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsAdaptivePolicyGroupsDataSource) Configure(ctx context.Cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -204,7 +206,7 @@ func (d *OrganizationsAdaptivePolicyGroupsDataSource) Read(ctx context.Context,
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAdaptivePolicyGroups(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyGroups(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -232,7 +234,7 @@ func (d *OrganizationsAdaptivePolicyGroupsDataSource) Read(ctx context.Context,
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsAdaptivePolicyOverviewDataSource() datasource.DataSource {
 }
 
 type OrganizationsAdaptivePolicyOverviewDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAdaptivePolicyOverviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsAdaptivePolicyOverviewDataSource) Configure(ctx context.Co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -143,7 +145,7 @@ func (d *OrganizationsAdaptivePolicyOverviewDataSource) Read(ctx context.Context
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAdaptivePolicyOverview(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyOverview(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
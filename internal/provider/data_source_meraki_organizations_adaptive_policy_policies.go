@@ -38,7 +38,8 @@ func NewOrganizationsAdaptivePolicyPoliciesDataSource() datasource.DataSource {
 }
 
 type OrganizationsAdaptivePolicyPoliciesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAdaptivePolicyPoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsAdaptivePolicyPoliciesDataSource) Configure(ctx context.Co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -246,7 +248,7 @@ func (d *OrganizationsAdaptivePolicyPoliciesDataSource) Read(ctx context.Context
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAdaptivePolicyPolicies(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyPolicies(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -274,7 +276,7 @@ func (d *OrganizationsAdaptivePolicyPoliciesDataSource) Read(ctx context.Context
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationAdaptivePolicyPolicy(vvOrganizationID, vvID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyPolicy(vvOrganizationID, vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsAdaptivePolicySettingsDataSource() datasource.DataSource {
 }
 
 type OrganizationsAdaptivePolicySettingsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAdaptivePolicySettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsAdaptivePolicySettingsDataSource) Configure(ctx context.Co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -90,7 +92,7 @@ func (d *OrganizationsAdaptivePolicySettingsDataSource) Read(ctx context.Context
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAdaptivePolicySettings(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicySettings(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
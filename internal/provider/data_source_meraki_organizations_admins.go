@@ -38,7 +38,8 @@ func NewOrganizationsAdminsDataSource() datasource.DataSource {
 }
 
 type OrganizationsAdminsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAdminsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsAdminsDataSource) Configure(ctx context.Context, req datas
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -167,7 +169,7 @@ func (d *OrganizationsAdminsDataSource) Read(ctx context.Context, req datasource
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAdmins(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdmins(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
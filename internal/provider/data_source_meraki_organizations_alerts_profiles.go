@@ -38,7 +38,8 @@ func NewOrganizationsAlertsProfilesDataSource() datasource.DataSource {
 }
 
 type OrganizationsAlertsProfilesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAlertsProfilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsAlertsProfilesDataSource) Configure(ctx context.Context, r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -150,7 +152,7 @@ func (d *OrganizationsAlertsProfilesDataSource) Read(ctx context.Context, req da
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAlertsProfiles(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAlertsProfiles(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
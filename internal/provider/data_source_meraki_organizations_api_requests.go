@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsAPIRequestsDataSource() datasource.DataSource {
 }
 
 type OrganizationsAPIRequestsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAPIRequestsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsAPIRequestsDataSource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -65,6 +69,10 @@ func (d *OrganizationsAPIRequestsDataSource) Schema(_ context.Context, _ datasou
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"method": schema.StringAttribute{
 				MarkdownDescription: `method query parameter. Filter the results by the method of the API requests (must be 'GET', 'PUT', 'POST' or 'DELETE')`,
 				Optional:            true,
@@ -110,6 +118,10 @@ func (d *OrganizationsAPIRequestsDataSource) Schema(_ context.Context, _ datasou
 				MarkdownDescription: `timespan query parameter. The timespan for which the information will be fetched. If specifying timespan, do not specify parameters t0 and t1. The value must be in seconds and be less than or equal to 31 days. The default is 31 days.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"user_agent": schema.StringAttribute{
 				MarkdownDescription: `userAgent query parameter. Filter the results by the user agent string of the API request`,
 				Optional:            true,
@@ -221,7 +233,10 @@ func (d *OrganizationsAPIRequestsDataSource) Read(ctx context.Context, req datas
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAPIRequests(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsAPIRequests.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationAPIRequests, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAPIRequests(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -235,6 +250,7 @@ func (d *OrganizationsAPIRequestsDataSource) Read(ctx context.Context, req datas
 		}
 
 		organizationsAPIRequests = ResponseOrganizationsGetOrganizationAPIRequestsItemsToBody(organizationsAPIRequests, response1)
+		organizationsAPIRequests.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsAPIRequests)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -253,6 +269,8 @@ type OrganizationsAPIRequests struct {
 	PerPage        types.Int64                                            `tfsdk:"per_page"`
 	StartingAfter  types.String                                           `tfsdk:"starting_after"`
 	EndingBefore   types.String                                           `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                            `tfsdk:"max_items"`
+	TotalPages     types.Int64                                            `tfsdk:"total_pages"`
 	AdminID        types.String                                           `tfsdk:"admin_id"`
 	Path           types.String                                           `tfsdk:"path"`
 	Method         types.String                                           `tfsdk:"method"`
@@ -38,7 +38,8 @@ func NewOrganizationsAPIRequestsOverviewDataSource() datasource.DataSource {
 }
 
 type OrganizationsAPIRequestsOverviewDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAPIRequestsOverviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsAPIRequestsOverviewDataSource) Configure(ctx context.Conte
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -301,7 +303,7 @@ func (d *OrganizationsAPIRequestsOverviewDataSource) Read(ctx context.Context, r
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAPIRequestsOverview(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAPIRequestsOverview(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsAPIRequestsOverviewResponseCodesByIntervalDataSource() data
 }
 
 type OrganizationsAPIRequestsOverviewResponseCodesByIntervalDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAPIRequestsOverviewResponseCodesByIntervalDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsAPIRequestsOverviewResponseCodesByIntervalDataSource) Conf
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -164,7 +166,7 @@ func (d *OrganizationsAPIRequestsOverviewResponseCodesByIntervalDataSource) Read
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAPIRequestsOverviewResponseCodesByInterval(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAPIRequestsOverviewResponseCodesByInterval(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsApplianceDNSLocalProfilesDataSource() datasource.DataSource
 }
 
 type OrganizationsApplianceDNSLocalProfilesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsApplianceDNSLocalProfilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsApplianceDNSLocalProfilesDataSource) Configure(ctx context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -105,7 +107,7 @@ func (d *OrganizationsApplianceDNSLocalProfilesDataSource) Read(ctx context.Cont
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Appliance.GetOrganizationApplianceDNSLocalProfiles(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSLocalProfiles(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsApplianceDNSLocalProfilesAssignmentsDataSource() datasource
 }
 
 type OrganizationsApplianceDNSLocalProfilesAssignmentsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsApplianceDNSLocalProfilesAssignmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsApplianceDNSLocalProfilesAssignmentsDataSource) Configure(
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -163,7 +165,7 @@ func (d *OrganizationsApplianceDNSLocalProfilesAssignmentsDataSource) Read(ctx c
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Appliance.GetOrganizationApplianceDNSLocalProfilesAssignments(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSLocalProfilesAssignments(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
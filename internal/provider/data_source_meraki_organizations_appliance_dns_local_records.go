@@ -38,7 +38,8 @@ func NewOrganizationsApplianceDNSLocalRecordsDataSource() datasource.DataSource
 }
 
 type OrganizationsApplianceDNSLocalRecordsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsApplianceDNSLocalRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsApplianceDNSLocalRecordsDataSource) Configure(ctx context.
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -120,7 +122,7 @@ func (d *OrganizationsApplianceDNSLocalRecordsDataSource) Read(ctx context.Conte
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Appliance.GetOrganizationApplianceDNSLocalRecords(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSLocalRecords(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
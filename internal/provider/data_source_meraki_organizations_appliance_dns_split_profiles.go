@@ -38,7 +38,8 @@ func NewOrganizationsApplianceDNSSplitProfilesDataSource() datasource.DataSource
 }
 
 type OrganizationsApplianceDNSSplitProfilesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsApplianceDNSSplitProfilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsApplianceDNSSplitProfilesDataSource) Configure(ctx context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -122,7 +124,7 @@ func (d *OrganizationsApplianceDNSSplitProfilesDataSource) Read(ctx context.Cont
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Appliance.GetOrganizationApplianceDNSSplitProfiles(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSSplitProfiles(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
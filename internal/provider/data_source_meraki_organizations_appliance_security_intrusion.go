@@ -38,7 +38,8 @@ func NewOrganizationsApplianceSecurityIntrusionDataSource() datasource.DataSourc
 }
 
 type OrganizationsApplianceSecurityIntrusionDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsApplianceSecurityIntrusionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsApplianceSecurityIntrusionDataSource) Configure(ctx contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -99,7 +101,7 @@ func (d *OrganizationsApplianceSecurityIntrusionDataSource) Read(ctx context.Con
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Appliance.GetOrganizationApplianceSecurityIntrusion(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceSecurityIntrusion(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
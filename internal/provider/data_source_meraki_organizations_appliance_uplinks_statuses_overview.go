@@ -38,7 +38,8 @@ func NewOrganizationsApplianceUplinksStatusesOverviewDataSource() datasource.Dat
 }
 
 type OrganizationsApplianceUplinksStatusesOverviewDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsApplianceUplinksStatusesOverviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsApplianceUplinksStatusesOverviewDataSource) Configure(ctx
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -119,7 +121,7 @@ func (d *OrganizationsApplianceUplinksStatusesOverviewDataSource) Read(ctx conte
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Appliance.GetOrganizationApplianceUplinksStatusesOverview(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceUplinksStatusesOverview(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
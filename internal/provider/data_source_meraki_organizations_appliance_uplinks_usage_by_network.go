@@ -38,7 +38,8 @@ func NewOrganizationsApplianceUplinksUsageByNetworkDataSource() datasource.DataS
 }
 
 type OrganizationsApplianceUplinksUsageByNetworkDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsApplianceUplinksUsageByNetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsApplianceUplinksUsageByNetworkDataSource) Configure(ctx co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -139,7 +141,7 @@ func (d *OrganizationsApplianceUplinksUsageByNetworkDataSource) Read(ctx context
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Appliance.GetOrganizationApplianceUplinksUsageByNetwork(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceUplinksUsageByNetwork(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsApplianceVpnThirdPartyVpnpeersDataSource() datasource.DataS
 }
 
 type OrganizationsApplianceVpnThirdPartyVpnpeersDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsApplianceVpnThirdPartyVpnpeersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsApplianceVpnThirdPartyVpnpeersDataSource) Configure(ctx co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -157,6 +159,7 @@ func (d *OrganizationsApplianceVpnThirdPartyVpnpeersDataSource) Schema(_ context
 								},
 								"secret": schema.StringAttribute{
 									MarkdownDescription: `The shared secret with the VPN peer`,
+									Sensitive:           true,
 									Computed:            true,
 								},
 							},
@@ -182,7 +185,7 @@ func (d *OrganizationsApplianceVpnThirdPartyVpnpeersDataSource) Read(ctx context
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Appliance.GetOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsApplianceVpnVpnFirewallRulesDataSource() datasource.DataSou
 }
 
 type OrganizationsApplianceVpnVpnFirewallRulesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsApplianceVpnVpnFirewallRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsApplianceVpnVpnFirewallRulesDataSource) Configure(ctx cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -126,7 +128,7 @@ func (d *OrganizationsApplianceVpnVpnFirewallRulesDataSource) Read(ctx context.C
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Appliance.GetOrganizationApplianceVpnVpnFirewallRules(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceVpnVpnFirewallRules(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsAssuranceAlertsDataSource() datasource.DataSource {
 }
 
 type OrganizationsAssuranceAlertsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAssuranceAlertsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsAssuranceAlertsDataSource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -83,6 +87,10 @@ func (d *OrganizationsAssuranceAlertsDataSource) Schema(_ context.Context, _ dat
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId query parameter. Optional parameter to filter alerts by network ids.`,
 				Optional:            true,
@@ -124,6 +132,10 @@ func (d *OrganizationsAssuranceAlertsDataSource) Schema(_ context.Context, _ dat
 				MarkdownDescription: `suppressAlertsForOfflineNodes query parameter. When set to true the api will only return connectivity alerts for a given device if that device is in an offline state. This only applies to devices. This is ignored when resolved is true. Example:  If a Switch has a VLan Mismatch and is Unreachable. only the Unreachable alert will be returned. Defaults to false.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"ts_end": schema.StringAttribute{
 				MarkdownDescription: `tsEnd query parameter. Optional parameter to filter by end timestamp`,
 				Optional:            true,
@@ -305,7 +317,10 @@ func (d *OrganizationsAssuranceAlertsDataSource) Read(ctx context.Context, req d
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAssuranceAlerts(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsAssuranceAlerts.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationAssuranceAlerts, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAssuranceAlerts(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -319,6 +334,7 @@ func (d *OrganizationsAssuranceAlertsDataSource) Read(ctx context.Context, req d
 		}
 
 		organizationsAssuranceAlerts = ResponseOrganizationsGetOrganizationAssuranceAlertsItemsToBody(organizationsAssuranceAlerts, response1)
+		organizationsAssuranceAlerts.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsAssuranceAlerts)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -334,6 +350,8 @@ type OrganizationsAssuranceAlerts struct {
 	PerPage                       types.Int64                                                `tfsdk:"per_page"`
 	StartingAfter                 types.String                                               `tfsdk:"starting_after"`
 	EndingBefore                  types.String                                               `tfsdk:"ending_before"`
+	MaxItems                      types.Int64                                                `tfsdk:"max_items"`
+	TotalPages                    types.Int64                                                `tfsdk:"total_pages"`
 	SortOrder                     types.String                                               `tfsdk:"sort_order"`
 	NetworkID                     types.String                                               `tfsdk:"network_id"`
 	Severity                      types.String                                               `tfsdk:"severity"`
@@ -38,7 +38,8 @@ func NewOrganizationsAssuranceAlertsOverviewDataSource() datasource.DataSource {
 }
 
 type OrganizationsAssuranceAlertsOverviewDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAssuranceAlertsOverviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsAssuranceAlertsOverviewDataSource) Configure(ctx context.C
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -184,7 +186,7 @@ func (d *OrganizationsAssuranceAlertsOverviewDataSource) Read(ctx context.Contex
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAssuranceAlertsOverview(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAssuranceAlertsOverview(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsAssuranceAlertsOverviewHistoricalDataSource() datasource.Da
 }
 
 type OrganizationsAssuranceAlertsOverviewHistoricalDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsAssuranceAlertsOverviewHistoricalDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsAssuranceAlertsOverviewHistoricalDataSource) Configure(ctx
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -212,7 +214,7 @@ func (d *OrganizationsAssuranceAlertsOverviewHistoricalDataSource) Read(ctx cont
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationAssuranceAlertsOverviewHistorical(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationAssuranceAlertsOverviewHistorical(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsBrandingPoliciesDataSource() datasource.DataSource {
 }
 
 type OrganizationsBrandingPoliciesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsBrandingPoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsBrandingPoliciesDataSource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -420,7 +422,7 @@ func (d *OrganizationsBrandingPoliciesDataSource) Read(ctx context.Context, req
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationBrandingPolicies(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationBrandingPolicies(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -448,7 +450,7 @@ func (d *OrganizationsBrandingPoliciesDataSource) Read(ctx context.Context, req
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsBrandingPoliciesPrioritiesDataSource() datasource.DataSourc
 }
 
 type OrganizationsBrandingPoliciesPrioritiesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsBrandingPoliciesPrioritiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsBrandingPoliciesPrioritiesDataSource) Configure(ctx contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -91,7 +93,7 @@ func (d *OrganizationsBrandingPoliciesPrioritiesDataSource) Read(ctx context.Con
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationBrandingPoliciesPriorities(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationBrandingPoliciesPriorities(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsCameraBoundariesAreasByDeviceDataSource() datasource.DataSo
 }
 
 type OrganizationsCameraBoundariesAreasByDeviceDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsCameraBoundariesAreasByDeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsCameraBoundariesAreasByDeviceDataSource) Configure(ctx con
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -141,7 +143,7 @@ func (d *OrganizationsCameraBoundariesAreasByDeviceDataSource) Read(ctx context.
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Camera.GetOrganizationCameraBoundariesAreasByDevice(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraBoundariesAreasByDevice(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
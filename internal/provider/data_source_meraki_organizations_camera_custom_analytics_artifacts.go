@@ -38,7 +38,8 @@ func NewOrganizationsCameraCustomAnalyticsArtifactsDataSource() datasource.DataS
 }
 
 type OrganizationsCameraCustomAnalyticsArtifactsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsCameraCustomAnalyticsArtifactsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsCameraCustomAnalyticsArtifactsDataSource) Configure(ctx co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -158,7 +160,7 @@ func (d *OrganizationsCameraCustomAnalyticsArtifactsDataSource) Read(ctx context
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Camera.GetOrganizationCameraCustomAnalyticsArtifacts(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraCustomAnalyticsArtifacts(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -186,7 +188,7 @@ func (d *OrganizationsCameraCustomAnalyticsArtifactsDataSource) Read(ctx context
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Camera.GetOrganizationCameraCustomAnalyticsArtifact(vvOrganizationID, vvArtifactID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraCustomAnalyticsArtifact(vvOrganizationID, vvArtifactID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
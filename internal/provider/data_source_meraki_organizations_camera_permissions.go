@@ -38,7 +38,8 @@ func NewOrganizationsCameraPermissionsDataSource() datasource.DataSource {
 }
 
 type OrganizationsCameraPermissionsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsCameraPermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsCameraPermissionsDataSource) Configure(ctx context.Context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -102,7 +104,7 @@ func (d *OrganizationsCameraPermissionsDataSource) Read(ctx context.Context, req
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Camera.GetOrganizationCameraPermission(vvOrganizationID, vvPermissionScopeID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraPermission(vvOrganizationID, vvPermissionScopeID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsCameraRolesDataSource() datasource.DataSource {
 }
 
 type OrganizationsCameraRolesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsCameraRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsCameraRolesDataSource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -242,7 +244,7 @@ func (d *OrganizationsCameraRolesDataSource) Read(ctx context.Context, req datas
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Camera.GetOrganizationCameraRoles(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraRoles(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -270,7 +272,7 @@ func (d *OrganizationsCameraRolesDataSource) Read(ctx context.Context, req datas
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Camera.GetOrganizationCameraRole(vvOrganizationID, vvRoleID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraRole(vvOrganizationID, vvRoleID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsCellularGatewayEsimsInventoryDataSource() datasource.DataSo
 }
 
 type OrganizationsCellularGatewayEsimsInventoryDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsCellularGatewayEsimsInventoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsCellularGatewayEsimsInventoryDataSource) Configure(ctx con
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -231,7 +233,7 @@ func (d *OrganizationsCellularGatewayEsimsInventoryDataSource) Read(ctx context.
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.CellularGateway.GetOrganizationCellularGatewayEsimsInventory(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).CellularGateway.GetOrganizationCellularGatewayEsimsInventory(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
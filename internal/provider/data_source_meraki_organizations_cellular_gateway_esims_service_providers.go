@@ -38,7 +38,8 @@ func NewOrganizationsCellularGatewayEsimsServiceProvidersDataSource() datasource
 }
 
 type OrganizationsCellularGatewayEsimsServiceProvidersDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsCellularGatewayEsimsServiceProvidersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsCellularGatewayEsimsServiceProvidersDataSource) Configure(
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -157,7 +159,7 @@ func (d *OrganizationsCellularGatewayEsimsServiceProvidersDataSource) Read(ctx c
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.CellularGateway.GetOrganizationCellularGatewayEsimsServiceProviders(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).CellularGateway.GetOrganizationCellularGatewayEsimsServiceProviders(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsCellularGatewayEsimsServiceProvidersAccountsDataSource() da
 }
 
 type OrganizationsCellularGatewayEsimsServiceProvidersAccountsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsCellularGatewayEsimsServiceProvidersAccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsCellularGatewayEsimsServiceProvidersAccountsDataSource) Co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -173,7 +175,7 @@ func (d *OrganizationsCellularGatewayEsimsServiceProvidersAccountsDataSource) Re
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.CellularGateway.GetOrganizationCellularGatewayEsimsServiceProvidersAccounts(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).CellularGateway.GetOrganizationCellularGatewayEsimsServiceProvidersAccounts(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsCellularGatewayEsimsServiceProvidersAccountsCommunicationPl
 }
 
 type OrganizationsCellularGatewayEsimsServiceProvidersAccountsCommunicationPlansDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsCellularGatewayEsimsServiceProvidersAccountsCommunicationPlansDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsCellularGatewayEsimsServiceProvidersAccountsCommunicationP
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -152,7 +154,7 @@ func (d *OrganizationsCellularGatewayEsimsServiceProvidersAccountsCommunicationP
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.CellularGateway.GetOrganizationCellularGatewayEsimsServiceProvidersAccountsCommunicationPlans(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).CellularGateway.GetOrganizationCellularGatewayEsimsServiceProvidersAccountsCommunicationPlans(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
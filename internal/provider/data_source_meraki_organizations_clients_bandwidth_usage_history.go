@@ -38,7 +38,8 @@ func NewOrganizationsClientsBandwidthUsageHistoryDataSource() datasource.DataSou
 }
 
 type OrganizationsClientsBandwidthUsageHistoryDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsClientsBandwidthUsageHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsClientsBandwidthUsageHistoryDataSource) Configure(ctx cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -142,7 +144,7 @@ func (d *OrganizationsClientsBandwidthUsageHistoryDataSource) Read(ctx context.C
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationClientsBandwidthUsageHistory(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationClientsBandwidthUsageHistory(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsClientsSearchDataSource() datasource.DataSource {
 }
 
 type OrganizationsClientsSearchDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsClientsSearchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsClientsSearchDataSource) Configure(ctx context.Context, re
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -65,6 +69,10 @@ func (d *OrganizationsClientsSearchDataSource) Schema(_ context.Context, _ datas
 				MarkdownDescription: `mac query parameter. The MAC address of the client. Required.`,
 				Required:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Required:            true,
@@ -77,6 +85,10 @@ func (d *OrganizationsClientsSearchDataSource) Schema(_ context.Context, _ datas
 				MarkdownDescription: `startingAfter query parameter. A token used by the server to indicate the start of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -261,7 +273,15 @@ func (d *OrganizationsClientsSearchDataSource) Read(ctx context.Context, req dat
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationClientsSearch(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsClientsSearch.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationClientsSearch, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationClientsSearch(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseOrganizationsGetOrganizationClientsSearch) *[]merakigosdk.ResponseOrganizationsGetOrganizationClientsSearchRecords {
+			if page.Records == nil {
+				page.Records = &[]merakigosdk.ResponseOrganizationsGetOrganizationClientsSearchRecords{}
+			}
+			return page.Records
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -275,6 +295,7 @@ func (d *OrganizationsClientsSearchDataSource) Read(ctx context.Context, req dat
 		}
 
 		organizationsClientsSearch = ResponseOrganizationsGetOrganizationClientsSearchItemToBody(organizationsClientsSearch, response1)
+		organizationsClientsSearch.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsClientsSearch)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -290,6 +311,8 @@ type OrganizationsClientsSearch struct {
 	PerPage        types.Int64                                        `tfsdk:"per_page"`
 	StartingAfter  types.String                                       `tfsdk:"starting_after"`
 	EndingBefore   types.String                                       `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                        `tfsdk:"max_items"`
+	TotalPages     types.Int64                                        `tfsdk:"total_pages"`
 	Mac            types.String                                       `tfsdk:"mac"`
 	Item           *ResponseOrganizationsGetOrganizationClientsSearch `tfsdk:"item"`
 }
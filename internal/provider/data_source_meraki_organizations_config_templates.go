@@ -38,7 +38,8 @@ func NewOrganizationsConfigTemplatesDataSource() datasource.DataSource {
 }
 
 type OrganizationsConfigTemplatesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsConfigTemplatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsConfigTemplatesDataSource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -138,7 +140,7 @@ func (d *OrganizationsConfigTemplatesDataSource) Read(ctx context.Context, req d
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationConfigTemplates(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationConfigTemplates(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -166,7 +168,7 @@ func (d *OrganizationsConfigTemplatesDataSource) Read(ctx context.Context, req d
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
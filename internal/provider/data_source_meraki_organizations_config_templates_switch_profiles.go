@@ -38,7 +38,8 @@ func NewOrganizationsConfigTemplatesSwitchProfilesDataSource() datasource.DataSo
 }
 
 type OrganizationsConfigTemplatesSwitchProfilesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsConfigTemplatesSwitchProfilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsConfigTemplatesSwitchProfilesDataSource) Configure(ctx con
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -106,7 +108,7 @@ func (d *OrganizationsConfigTemplatesSwitchProfilesDataSource) Read(ctx context.
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Switch.GetOrganizationConfigTemplateSwitchProfiles(vvOrganizationID, vvConfigTemplateID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Switch.GetOrganizationConfigTemplateSwitchProfiles(vvOrganizationID, vvConfigTemplateID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
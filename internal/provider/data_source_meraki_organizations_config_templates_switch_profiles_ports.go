@@ -38,7 +38,8 @@ func NewOrganizationsConfigTemplatesSwitchProfilesPortsDataSource() datasource.D
 }
 
 type OrganizationsConfigTemplatesSwitchProfilesPortsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsConfigTemplatesSwitchProfilesPortsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsConfigTemplatesSwitchProfilesPortsDataSource) Configure(ct
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -478,7 +480,7 @@ func (d *OrganizationsConfigTemplatesSwitchProfilesPortsDataSource) Read(ctx con
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Switch.GetOrganizationConfigTemplateSwitchProfilePorts(vvOrganizationID, vvConfigTemplateID, vvProfileID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Switch.GetOrganizationConfigTemplateSwitchProfilePorts(vvOrganizationID, vvConfigTemplateID, vvProfileID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -508,7 +510,7 @@ func (d *OrganizationsConfigTemplatesSwitchProfilesPortsDataSource) Read(ctx con
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Switch.GetOrganizationConfigTemplateSwitchProfilePort(vvOrganizationID, vvConfigTemplateID, vvProfileID, vvPortID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Switch.GetOrganizationConfigTemplateSwitchProfilePort(vvOrganizationID, vvConfigTemplateID, vvProfileID, vvPortID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsDevicesDataSource() datasource.DataSource {
 }
 
 type OrganizationsDevicesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsDevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsDevicesDataSource) Configure(ctx context.Context, req data
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -74,6 +78,10 @@ func (d *OrganizationsDevicesDataSource) Schema(_ context.Context, _ datasource.
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"model": schema.StringAttribute{
 				MarkdownDescription: `model query parameter. Optional parameter to filter devices by model. All returned devices will have a model that contains the search term or is an exact match.`,
 				Optional:            true,
@@ -138,6 +146,10 @@ func (d *OrganizationsDevicesDataSource) Schema(_ context.Context, _ datasource.
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseOrganizationsGetOrganizationDevices`,
 				Computed:            true,
@@ -258,7 +270,10 @@ func (d *OrganizationsDevicesDataSource) Read(ctx context.Context, req datasourc
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationDevices(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsDevices.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationDevices, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationDevices(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -272,6 +287,7 @@ func (d *OrganizationsDevicesDataSource) Read(ctx context.Context, req datasourc
 		}
 
 		organizationsDevices = ResponseOrganizationsGetOrganizationDevicesItemsToBody(organizationsDevices, response1)
+		organizationsDevices.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsDevices)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -287,6 +303,8 @@ type OrganizationsDevices struct {
 	PerPage                   types.Int64                                        `tfsdk:"per_page"`
 	StartingAfter             types.String                                       `tfsdk:"starting_after"`
 	EndingBefore              types.String                                       `tfsdk:"ending_before"`
+	MaxItems                  types.Int64                                        `tfsdk:"max_items"`
+	TotalPages                types.Int64                                        `tfsdk:"total_pages"`
 	ConfigurationUpdatedAfter types.String                                       `tfsdk:"configuration_updated_after"`
 	NetworkIDs                types.List                                         `tfsdk:"network_ids"`
 	ProductTypes              types.List                                         `tfsdk:"product_types"`
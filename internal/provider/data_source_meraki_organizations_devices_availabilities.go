@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsDevicesAvailabilitiesDataSource() datasource.DataSource {
 }
 
 type OrganizationsDevicesAvailabilitiesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsDevicesAvailabilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsDevicesAvailabilitiesDataSource) Configure(ctx context.Con
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsDevicesAvailabilitiesDataSource) Schema(_ context.Context,
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Optional parameter to filter device availabilities by network ID. This filter uses multiple exact matches.`,
 				Optional:            true,
@@ -103,6 +111,10 @@ func (d *OrganizationsDevicesAvailabilitiesDataSource) Schema(_ context.Context,
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseOrganizationsGetOrganizationDevicesAvailabilities`,
 				Computed:            true,
@@ -177,7 +189,10 @@ func (d *OrganizationsDevicesAvailabilitiesDataSource) Read(ctx context.Context,
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationDevicesAvailabilities(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsDevicesAvailabilities.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationDevicesAvailabilities, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationDevicesAvailabilities(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -191,6 +206,7 @@ func (d *OrganizationsDevicesAvailabilitiesDataSource) Read(ctx context.Context,
 		}
 
 		organizationsDevicesAvailabilities = ResponseOrganizationsGetOrganizationDevicesAvailabilitiesItemsToBody(organizationsDevicesAvailabilities, response1)
+		organizationsDevicesAvailabilities.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsDevicesAvailabilities)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -206,6 +222,8 @@ type OrganizationsDevicesAvailabilities struct {
 	PerPage        types.Int64                                                      `tfsdk:"per_page"`
 	StartingAfter  types.String                                                     `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                     `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                      `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                      `tfsdk:"total_pages"`
 	NetworkIDs     types.List                                                       `tfsdk:"network_ids"`
 	ProductTypes   types.List                                                       `tfsdk:"product_types"`
 	Serials        types.List                                                       `tfsdk:"serials"`
@@ -38,7 +38,8 @@ func NewOrganizationsDevicesOverviewByModelDataSource() datasource.DataSource {
 }
 
 type OrganizationsDevicesOverviewByModelDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsDevicesOverviewByModelDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsDevicesOverviewByModelDataSource) Configure(ctx context.Co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -122,7 +124,7 @@ func (d *OrganizationsDevicesOverviewByModelDataSource) Read(ctx context.Context
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationDevicesOverviewByModel(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationDevicesOverviewByModel(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
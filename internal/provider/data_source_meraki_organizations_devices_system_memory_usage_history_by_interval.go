@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsDevicesSystemMemoryUsageHistoryByIntervalDataSource() datas
 }
 
 type OrganizationsDevicesSystemMemoryUsageHistoryByIntervalDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsDevicesSystemMemoryUsageHistoryByIntervalDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsDevicesSystemMemoryUsageHistoryByIntervalDataSource) Confi
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -65,6 +69,10 @@ func (d *OrganizationsDevicesSystemMemoryUsageHistoryByIntervalDataSource) Schem
 				MarkdownDescription: `interval query parameter. The time interval in seconds for returned data. The valid intervals are: 300, 1200, 3600, 14400. The default is 300. Interval is calculated if time params are provided.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Optional parameter to filter the result set by the included set of network IDs`,
 				Optional:            true,
@@ -104,6 +112,10 @@ func (d *OrganizationsDevicesSystemMemoryUsageHistoryByIntervalDataSource) Schem
 				MarkdownDescription: `timespan query parameter. The timespan for which the information will be fetched. If specifying timespan, do not specify parameters t0 and t1. The value must be in seconds and be less than or equal to 31 days. The default is 2 hours. If interval is provided, the timespan will be autocalculated.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -318,7 +330,15 @@ func (d *OrganizationsDevicesSystemMemoryUsageHistoryByIntervalDataSource) Read(
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationDevicesSystemMemoryUsageHistoryByInterval(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsDevicesSystemMemoryUsageHistoryByInterval.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationDevicesSystemMemoryUsageHistoryByInterval, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationDevicesSystemMemoryUsageHistoryByInterval(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseOrganizationsGetOrganizationDevicesSystemMemoryUsageHistoryByInterval) *[]merakigosdk.ResponseOrganizationsGetOrganizationDevicesSystemMemoryUsageHistoryByIntervalItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseOrganizationsGetOrganizationDevicesSystemMemoryUsageHistoryByIntervalItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -332,6 +352,7 @@ func (d *OrganizationsDevicesSystemMemoryUsageHistoryByIntervalDataSource) Read(
 		}
 
 		organizationsDevicesSystemMemoryUsageHistoryByInterval = ResponseOrganizationsGetOrganizationDevicesSystemMemoryUsageHistoryByIntervalItemToBody(organizationsDevicesSystemMemoryUsageHistoryByInterval, response1)
+		organizationsDevicesSystemMemoryUsageHistoryByInterval.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsDevicesSystemMemoryUsageHistoryByInterval)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -347,6 +368,8 @@ type OrganizationsDevicesSystemMemoryUsageHistoryByInterval struct {
 	PerPage        types.Int64                                                                    `tfsdk:"per_page"`
 	StartingAfter  types.String                                                                   `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                                   `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                                    `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                                    `tfsdk:"total_pages"`
 	T0             types.String                                                                   `tfsdk:"t0"`
 	T1             types.String                                                                   `tfsdk:"t1"`
 	Timespan       types.Float64                                                                  `tfsdk:"timespan"`
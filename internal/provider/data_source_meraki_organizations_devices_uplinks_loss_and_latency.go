@@ -38,7 +38,8 @@ func NewOrganizationsDevicesUplinksLossAndLatencyDataSource() datasource.DataSou
 }
 
 type OrganizationsDevicesUplinksLossAndLatencyDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsDevicesUplinksLossAndLatencyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsDevicesUplinksLossAndLatencyDataSource) Configure(ctx cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -153,7 +155,7 @@ func (d *OrganizationsDevicesUplinksLossAndLatencyDataSource) Read(ctx context.C
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationDevicesUplinksLossAndLatency(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationDevicesUplinksLossAndLatency(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsEarlyAccessFeaturesDataSource() datasource.DataSource {
 }
 
 type OrganizationsEarlyAccessFeaturesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsEarlyAccessFeaturesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsEarlyAccessFeaturesDataSource) Configure(ctx context.Conte
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -128,7 +130,7 @@ func (d *OrganizationsEarlyAccessFeaturesDataSource) Read(ctx context.Context, r
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationEarlyAccessFeatures(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationEarlyAccessFeatures(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
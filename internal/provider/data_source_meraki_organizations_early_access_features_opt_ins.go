@@ -38,7 +38,8 @@ func NewOrganizationsEarlyAccessFeaturesOptInsDataSource() datasource.DataSource
 }
 
 type OrganizationsEarlyAccessFeaturesOptInsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsEarlyAccessFeaturesOptInsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsEarlyAccessFeaturesOptInsDataSource) Configure(ctx context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -153,7 +155,7 @@ func (d *OrganizationsEarlyAccessFeaturesOptInsDataSource) Read(ctx context.Cont
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationEarlyAccessFeaturesOptIns(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationEarlyAccessFeaturesOptIns(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -174,7 +176,7 @@ func (d *OrganizationsEarlyAccessFeaturesOptInsDataSource) Read(ctx context.Cont
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, vvOptInID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, vvOptInID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsFirmwareUpgradesByDeviceDataSource() datasource.DataSource
 }
 
 type OrganizationsFirmwareUpgradesByDeviceDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsFirmwareUpgradesByDeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsFirmwareUpgradesByDeviceDataSource) Configure(ctx context.
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -75,6 +79,10 @@ func (d *OrganizationsFirmwareUpgradesByDeviceDataSource) Schema(_ context.Conte
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Optional parameter to filter by network`,
 				Optional:            true,
@@ -97,6 +105,10 @@ func (d *OrganizationsFirmwareUpgradesByDeviceDataSource) Schema(_ context.Conte
 				MarkdownDescription: `startingAfter query parameter. A token used by the server to indicate the start of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"upgradestatuses": schema.ListAttribute{
 				MarkdownDescription: `upgradeStatuses query parameter. Optional parameter to filter by firmware upgrade statuses.`,
 				Optional:            true,
@@ -240,7 +252,10 @@ func (d *OrganizationsFirmwareUpgradesByDeviceDataSource) Read(ctx context.Conte
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationFirmwareUpgradesByDevice(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsFirmwareUpgradesByDevice.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationFirmwareUpgradesByDevice, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationFirmwareUpgradesByDevice(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -254,6 +269,7 @@ func (d *OrganizationsFirmwareUpgradesByDeviceDataSource) Read(ctx context.Conte
 		}
 
 		organizationsFirmwareUpgradesByDevice = ResponseOrganizationsGetOrganizationFirmwareUpgradesByDeviceItemsToBody(organizationsFirmwareUpgradesByDevice, response1)
+		organizationsFirmwareUpgradesByDevice.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsFirmwareUpgradesByDevice)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -269,6 +285,8 @@ type OrganizationsFirmwareUpgradesByDevice struct {
 	PerPage                 types.Int64                                                         `tfsdk:"per_page"`
 	StartingAfter           types.String                                                        `tfsdk:"starting_after"`
 	EndingBefore            types.String                                                        `tfsdk:"ending_before"`
+	MaxItems                types.Int64                                                         `tfsdk:"max_items"`
+	TotalPages              types.Int64                                                         `tfsdk:"total_pages"`
 	NetworkIDs              types.List                                                          `tfsdk:"network_ids"`
 	Serials                 types.List                                                          `tfsdk:"serials"`
 	Macs                    types.List                                                          `tfsdk:"macs"`
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsFloorPlansAutoLocateDevicesDataSource() datasource.DataSour
 }
 
 type OrganizationsFloorPlansAutoLocateDevicesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsFloorPlansAutoLocateDevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsFloorPlansAutoLocateDevicesDataSource) Configure(ctx conte
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -66,6 +70,10 @@ func (d *OrganizationsFloorPlansAutoLocateDevicesDataSource) Schema(_ context.Co
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Optional parameter to filter devices by one or more network IDs`,
 				Optional:            true,
@@ -84,6 +92,10 @@ func (d *OrganizationsFloorPlansAutoLocateDevicesDataSource) Schema(_ context.Co
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseOrganizationsGetOrganizationFloorPlansAutoLocateDevices`,
 				Computed:            true,
@@ -238,7 +250,10 @@ func (d *OrganizationsFloorPlansAutoLocateDevicesDataSource) Read(ctx context.Co
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationFloorPlansAutoLocateDevices(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsFloorPlansAutoLocateDevices.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationFloorPlansAutoLocateDevices, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationFloorPlansAutoLocateDevices(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -252,6 +267,7 @@ func (d *OrganizationsFloorPlansAutoLocateDevicesDataSource) Read(ctx context.Co
 		}
 
 		organizationsFloorPlansAutoLocateDevices = ResponseOrganizationsGetOrganizationFloorPlansAutoLocateDevicesItemsToBody(organizationsFloorPlansAutoLocateDevices, response1)
+		organizationsFloorPlansAutoLocateDevices.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsFloorPlansAutoLocateDevices)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -267,6 +283,8 @@ type OrganizationsFloorPlansAutoLocateDevices struct {
 	PerPage        types.Int64                                                            `tfsdk:"per_page"`
 	StartingAfter  types.String                                                           `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                           `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                            `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                            `tfsdk:"total_pages"`
 	NetworkIDs     types.List                                                             `tfsdk:"network_ids"`
 	FloorPlanIDs   types.List                                                             `tfsdk:"floor_plan_ids"`
 	Items          *[]ResponseItemOrganizationsGetOrganizationFloorPlansAutoLocateDevices `tfsdk:"items"`
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsFloorPlansAutoLocateStatusesDataSource() datasource.DataSou
 }
 
 type OrganizationsFloorPlansAutoLocateStatusesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsFloorPlansAutoLocateStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsFloorPlansAutoLocateStatusesDataSource) Configure(ctx cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -66,6 +70,10 @@ func (d *OrganizationsFloorPlansAutoLocateStatusesDataSource) Schema(_ context.C
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Optional parameter to filter floorplans by one or more network IDs`,
 				Optional:            true,
@@ -84,6 +92,10 @@ func (d *OrganizationsFloorPlansAutoLocateStatusesDataSource) Schema(_ context.C
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseOrganizationsGetOrganizationFloorPlansAutoLocateStatuses`,
 				Computed:            true,
@@ -286,7 +298,10 @@ func (d *OrganizationsFloorPlansAutoLocateStatusesDataSource) Read(ctx context.C
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationFloorPlansAutoLocateStatuses(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsFloorPlansAutoLocateStatuses.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationFloorPlansAutoLocateStatuses, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationFloorPlansAutoLocateStatuses(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -300,6 +315,7 @@ func (d *OrganizationsFloorPlansAutoLocateStatusesDataSource) Read(ctx context.C
 		}
 
 		organizationsFloorPlansAutoLocateStatuses = ResponseOrganizationsGetOrganizationFloorPlansAutoLocateStatusesItemsToBody(organizationsFloorPlansAutoLocateStatuses, response1)
+		organizationsFloorPlansAutoLocateStatuses.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsFloorPlansAutoLocateStatuses)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -315,6 +331,8 @@ type OrganizationsFloorPlansAutoLocateStatuses struct {
 	PerPage        types.Int64                                                             `tfsdk:"per_page"`
 	StartingAfter  types.String                                                            `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                            `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                             `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                             `tfsdk:"total_pages"`
 	NetworkIDs     types.List                                                              `tfsdk:"network_ids"`
 	FloorPlanIDs   types.List                                                              `tfsdk:"floor_plan_ids"`
 	Items          *[]ResponseItemOrganizationsGetOrganizationFloorPlansAutoLocateStatuses `tfsdk:"items"`
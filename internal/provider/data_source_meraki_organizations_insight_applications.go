@@ -38,7 +38,8 @@ func NewOrganizationsInsightApplicationsDataSource() datasource.DataSource {
 }
 
 type OrganizationsInsightApplicationsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsInsightApplicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsInsightApplicationsDataSource) Configure(ctx context.Conte
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -129,7 +131,7 @@ func (d *OrganizationsInsightApplicationsDataSource) Read(ctx context.Context, r
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Insight.GetOrganizationInsightApplications(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Insight.GetOrganizationInsightApplications(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
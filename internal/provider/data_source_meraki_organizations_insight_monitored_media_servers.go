@@ -38,7 +38,8 @@ func NewOrganizationsInsightMonitoredMediaServersDataSource() datasource.DataSou
 }
 
 type OrganizationsInsightMonitoredMediaServersDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsInsightMonitoredMediaServersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsInsightMonitoredMediaServersDataSource) Configure(ctx cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -136,7 +138,7 @@ func (d *OrganizationsInsightMonitoredMediaServersDataSource) Read(ctx context.C
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Insight.GetOrganizationInsightMonitoredMediaServers(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Insight.GetOrganizationInsightMonitoredMediaServers(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -164,7 +166,7 @@ func (d *OrganizationsInsightMonitoredMediaServersDataSource) Read(ctx context.C
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Insight.GetOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Insight.GetOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
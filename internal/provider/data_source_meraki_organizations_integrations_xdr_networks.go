@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsIntegrationsXdrNetworksDataSource() datasource.DataSource {
 }
 
 type OrganizationsIntegrationsXdrNetworksDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsIntegrationsXdrNetworksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsIntegrationsXdrNetworksDataSource) Configure(ctx context.C
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsIntegrationsXdrNetworksDataSource) Schema(_ context.Contex
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Optional parameter to filter the results by network IDs`,
 				Optional:            true,
@@ -78,6 +86,10 @@ func (d *OrganizationsIntegrationsXdrNetworksDataSource) Schema(_ context.Contex
 				MarkdownDescription: `startingAfter query parameter. A token used by the server to indicate the start of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -167,7 +179,15 @@ func (d *OrganizationsIntegrationsXdrNetworksDataSource) Read(ctx context.Contex
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationIntegrationsXdrNetworks(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsIntegrationsXdrNetworks.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationIntegrationsXdrNetworks, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationIntegrationsXdrNetworks(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseOrganizationsGetOrganizationIntegrationsXdrNetworks) *[]merakigosdk.ResponseOrganizationsGetOrganizationIntegrationsXdrNetworksItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseOrganizationsGetOrganizationIntegrationsXdrNetworksItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -181,6 +201,7 @@ func (d *OrganizationsIntegrationsXdrNetworksDataSource) Read(ctx context.Contex
 		}
 
 		organizationsIntegrationsXdrNetworks = ResponseOrganizationsGetOrganizationIntegrationsXdrNetworksItemToBody(organizationsIntegrationsXdrNetworks, response1)
+		organizationsIntegrationsXdrNetworks.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsIntegrationsXdrNetworks)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -197,6 +218,8 @@ type OrganizationsIntegrationsXdrNetworks struct {
 	PerPage        types.Int64                                                  `tfsdk:"per_page"`
 	StartingAfter  types.String                                                 `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                 `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                  `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                  `tfsdk:"total_pages"`
 	Item           *ResponseOrganizationsGetOrganizationIntegrationsXdrNetworks `tfsdk:"item"`
 }
 
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsInventoryDevicesDataSource() datasource.DataSource {
 }
 
 type OrganizationsInventoryDevicesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsInventoryDevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsInventoryDevicesDataSource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -66,6 +70,10 @@ func (d *OrganizationsInventoryDevicesDataSource) Schema(_ context.Context, _ da
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"models": schema.ListAttribute{
 				MarkdownDescription: `models query parameter. Search for devices in inventory based on model.`,
 				Optional:            true,
@@ -120,6 +128,10 @@ func (d *OrganizationsInventoryDevicesDataSource) Schema(_ context.Context, _ da
 				MarkdownDescription: `tagsFilterType query parameter. To use with 'tags' parameter, to filter devices which contain ANY or ALL given tags. Accepted values are 'withAnyTags' or 'withAllTags', default is 'withAnyTags'.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"used_state": schema.StringAttribute{
 				MarkdownDescription: `usedState query parameter. Filter results by used or unused inventory. Accepted values are 'used' or 'unused'.`,
 				Optional:            true,
@@ -302,7 +314,10 @@ func (d *OrganizationsInventoryDevicesDataSource) Read(ctx context.Context, req
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationInventoryDevices(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsInventoryDevices.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationInventoryDevices, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationInventoryDevices(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -316,6 +331,7 @@ func (d *OrganizationsInventoryDevicesDataSource) Read(ctx context.Context, req
 		}
 
 		organizationsInventoryDevices = ResponseOrganizationsGetOrganizationInventoryDevicesItemsToBody(organizationsInventoryDevices, response1)
+		organizationsInventoryDevices.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsInventoryDevices)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -330,7 +346,7 @@ func (d *OrganizationsInventoryDevicesDataSource) Read(ctx context.Context, req
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationInventoryDevice(vvOrganizationID, vvSerial)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationInventoryDevice(vvOrganizationID, vvSerial)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -370,6 +386,8 @@ type OrganizationsInventoryDevices struct {
 	PerPage        types.Int64                                                 `tfsdk:"per_page"`
 	StartingAfter  types.String                                                `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                 `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                 `tfsdk:"total_pages"`
 	UsedState      types.String                                                `tfsdk:"used_state"`
 	Search         types.String                                                `tfsdk:"search"`
 	Macs           types.List                                                  `tfsdk:"macs"`
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsInventoryOnboardingCloudMonitoringNetworksDataSource() data
 }
 
 type OrganizationsInventoryOnboardingCloudMonitoringNetworksDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsInventoryOnboardingCloudMonitoringNetworksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsInventoryOnboardingCloudMonitoringNetworksDataSource) Conf
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -65,6 +69,10 @@ func (d *OrganizationsInventoryOnboardingCloudMonitoringNetworksDataSource) Sche
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Required:            true,
@@ -82,6 +90,10 @@ func (d *OrganizationsInventoryOnboardingCloudMonitoringNetworksDataSource) Sche
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseOrganizationsGetOrganizationInventoryOnboardingCloudMonitoringNetworks`,
 				Computed:            true,
@@ -159,7 +171,10 @@ func (d *OrganizationsInventoryOnboardingCloudMonitoringNetworksDataSource) Read
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationInventoryOnboardingCloudMonitoringNetworks(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsInventoryOnboardingCloudMonitoringNetworks.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationInventoryOnboardingCloudMonitoringNetworks, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationInventoryOnboardingCloudMonitoringNetworks(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -173,6 +188,7 @@ func (d *OrganizationsInventoryOnboardingCloudMonitoringNetworksDataSource) Read
 		}
 
 		organizationsInventoryOnboardingCloudMonitoringNetworks = ResponseOrganizationsGetOrganizationInventoryOnboardingCloudMonitoringNetworksItemsToBody(organizationsInventoryOnboardingCloudMonitoringNetworks, response1)
+		organizationsInventoryOnboardingCloudMonitoringNetworks.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsInventoryOnboardingCloudMonitoringNetworks)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -190,6 +206,8 @@ type OrganizationsInventoryOnboardingCloudMonitoringNetworks struct {
 	PerPage        types.Int64                                                                           `tfsdk:"per_page"`
 	StartingAfter  types.String                                                                          `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                                          `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                                           `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                                           `tfsdk:"total_pages"`
 	Items          *[]ResponseItemOrganizationsGetOrganizationInventoryOnboardingCloudMonitoringNetworks `tfsdk:"items"`
 }
 
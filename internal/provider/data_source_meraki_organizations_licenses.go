@@ -38,7 +38,8 @@ func NewOrganizationsLicensesDataSource() datasource.DataSource {
 }
 
 type OrganizationsLicensesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsLicensesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsLicensesDataSource) Configure(ctx context.Context, req dat
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -175,7 +177,7 @@ func (d *OrganizationsLicensesDataSource) Read(ctx context.Context, req datasour
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationLicense(vvOrganizationID, vvLicenseID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationLicense(vvOrganizationID, vvLicenseID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
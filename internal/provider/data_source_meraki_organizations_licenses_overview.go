@@ -38,7 +38,8 @@ func NewOrganizationsLicensesOverviewDataSource() datasource.DataSource {
 }
 
 type OrganizationsLicensesOverviewDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsLicensesOverviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsLicensesOverviewDataSource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -290,7 +292,7 @@ func (d *OrganizationsLicensesOverviewDataSource) Read(ctx context.Context, req
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationLicensesOverview(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationLicensesOverview(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
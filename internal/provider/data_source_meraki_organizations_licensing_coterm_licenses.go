@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsLicensingCotermLicensesDataSource() datasource.DataSource {
 }
 
 type OrganizationsLicensingCotermLicensesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsLicensingCotermLicensesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsLicensingCotermLicensesDataSource) Configure(ctx context.C
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -69,6 +73,10 @@ func (d *OrganizationsLicensingCotermLicensesDataSource) Schema(_ context.Contex
 				MarkdownDescription: `invalidated query parameter. Filter for licenses that are invalidated`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Required:            true,
@@ -82,6 +90,10 @@ func (d *OrganizationsLicensingCotermLicensesDataSource) Schema(_ context.Contex
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseLicensingGetOrganizationLicensingCotermLicenses`,
 				Computed:            true,
@@ -186,7 +198,10 @@ func (d *OrganizationsLicensingCotermLicensesDataSource) Read(ctx context.Contex
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Licensing.GetOrganizationLicensingCotermLicenses(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsLicensingCotermLicenses.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseLicensingGetOrganizationLicensingCotermLicenses, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Licensing.GetOrganizationLicensingCotermLicenses(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -200,6 +215,7 @@ func (d *OrganizationsLicensingCotermLicensesDataSource) Read(ctx context.Contex
 		}
 
 		organizationsLicensingCotermLicenses = ResponseLicensingGetOrganizationLicensingCotermLicensesItemsToBody(organizationsLicensingCotermLicenses, response1)
+		organizationsLicensingCotermLicenses.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsLicensingCotermLicenses)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -215,6 +231,8 @@ type OrganizationsLicensingCotermLicenses struct {
 	PerPage        types.Int64                                                    `tfsdk:"per_page"`
 	StartingAfter  types.String                                                   `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                   `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                    `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                    `tfsdk:"total_pages"`
 	Invalidated    types.Bool                                                     `tfsdk:"invalidated"`
 	Expired        types.Bool                                                     `tfsdk:"expired"`
 	Items          *[]ResponseItemLicensingGetOrganizationLicensingCotermLicenses `tfsdk:"items"`
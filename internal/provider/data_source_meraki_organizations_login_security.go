@@ -38,7 +38,8 @@ func NewOrganizationsLoginSecurityDataSource() datasource.DataSource {
 }
 
 type OrganizationsLoginSecurityDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsLoginSecurityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsLoginSecurityDataSource) Configure(ctx context.Context, re
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -161,7 +163,7 @@ func (d *OrganizationsLoginSecurityDataSource) Read(ctx context.Context, req dat
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationLoginSecurity(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationLoginSecurity(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsPolicyObjectsGroupsDataSource() datasource.DataSource {
 }
 
 type OrganizationsPolicyObjectsGroupsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsPolicyObjectsGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsPolicyObjectsGroupsDataSource) Configure(ctx context.Conte
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsPolicyObjectsGroupsDataSource) Schema(_ context.Context, _
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Optional:            true,
@@ -77,6 +85,10 @@ func (d *OrganizationsPolicyObjectsGroupsDataSource) Schema(_ context.Context, _
 				MarkdownDescription: `startingAfter query parameter. A token used by the server to indicate the start of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -142,7 +154,10 @@ func (d *OrganizationsPolicyObjectsGroupsDataSource) Read(ctx context.Context, r
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationPolicyObjectsGroups(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsPolicyObjectsGroups.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationPolicyObjectsGroupsArray, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationPolicyObjectsGroups(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -155,6 +170,13 @@ func (d *OrganizationsPolicyObjectsGroupsDataSource) Read(ctx context.Context, r
 			return
 		}
 
+		organizationsPolicyObjectsGroups.TotalPages = types.Int64Value(totalPages)
+		diags = resp.State.Set(ctx, &organizationsPolicyObjectsGroups)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 	}
 	if selectedMethod == 2 {
 		log.Printf("[DEBUG] Selected method: GetOrganizationPolicyObjectsGroup")
@@ -163,7 +185,7 @@ func (d *OrganizationsPolicyObjectsGroupsDataSource) Read(ctx context.Context, r
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -192,6 +214,8 @@ type OrganizationsPolicyObjectsGroups struct {
 	PerPage             types.Int64                                             `tfsdk:"per_page"`
 	StartingAfter       types.String                                            `tfsdk:"starting_after"`
 	EndingBefore        types.String                                            `tfsdk:"ending_before"`
+	MaxItems            types.Int64                                             `tfsdk:"max_items"`
+	TotalPages          types.Int64                                             `tfsdk:"total_pages"`
 	PolicyObjectGroupID types.String                                            `tfsdk:"policy_object_group_id"`
 	Item                *ResponseOrganizationsGetOrganizationPolicyObjectsGroup `tfsdk:"item"`
 }
@@ -38,7 +38,8 @@ func NewOrganizationsSamlDataSource() datasource.DataSource {
 }
 
 type OrganizationsSamlDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSamlDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSamlDataSource) Configure(ctx context.Context, req datasou
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -89,7 +91,7 @@ func (d *OrganizationsSamlDataSource) Read(ctx context.Context, req datasource.R
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationSaml(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSaml(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsSamlIDpsDataSource() datasource.DataSource {
 }
 
 type OrganizationsSamlIDpsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSamlIDpsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSamlIDpsDataSource) Configure(ctx context.Context, req dat
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -136,7 +138,7 @@ func (d *OrganizationsSamlIDpsDataSource) Read(ctx context.Context, req datasour
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationSamlIDps(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSamlIDps(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -164,7 +166,7 @@ func (d *OrganizationsSamlIDpsDataSource) Read(ctx context.Context, req datasour
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationSamlIDp(vvOrganizationID, vvIDpID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSamlIDp(vvOrganizationID, vvIDpID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsSamlRolesDataSource() datasource.DataSource {
 }
 
 type OrganizationsSamlRolesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSamlRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSamlRolesDataSource) Configure(ctx context.Context, req da
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -230,7 +232,7 @@ func (d *OrganizationsSamlRolesDataSource) Read(ctx context.Context, req datasou
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationSamlRoles(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSamlRoles(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -258,7 +260,7 @@ func (d *OrganizationsSamlRolesDataSource) Read(ctx context.Context, req datasou
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Organizations.GetOrganizationSamlRole(vvOrganizationID, vvSamlRoleID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSamlRole(vvOrganizationID, vvSamlRoleID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsSensorReadingsHistoryDataSource() datasource.DataSource {
 }
 
 type OrganizationsSensorReadingsHistoryDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSensorReadingsHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsSensorReadingsHistoryDataSource) Configure(ctx context.Con
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsSensorReadingsHistoryDataSource) Schema(_ context.Context,
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"metrics": schema.ListAttribute{
 				MarkdownDescription: `metrics query parameter. Types of sensor readings to retrieve. If no metrics are supplied, all available types of readings will be retrieved.`,
 				Optional:            true,
@@ -101,6 +109,10 @@ func (d *OrganizationsSensorReadingsHistoryDataSource) Schema(_ context.Context,
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseSensorGetOrganizationSensorReadingsHistory`,
 				Computed:            true,
@@ -386,7 +398,10 @@ func (d *OrganizationsSensorReadingsHistoryDataSource) Read(ctx context.Context,
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Sensor.GetOrganizationSensorReadingsHistory(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsSensorReadingsHistory.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseSensorGetOrganizationSensorReadingsHistory, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Sensor.GetOrganizationSensorReadingsHistory(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -400,6 +415,7 @@ func (d *OrganizationsSensorReadingsHistoryDataSource) Read(ctx context.Context,
 		}
 
 		organizationsSensorReadingsHistory = ResponseSensorGetOrganizationSensorReadingsHistoryItemsToBody(organizationsSensorReadingsHistory, response1)
+		organizationsSensorReadingsHistory.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsSensorReadingsHistory)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -415,6 +431,8 @@ type OrganizationsSensorReadingsHistory struct {
 	PerPage        types.Int64                                               `tfsdk:"per_page"`
 	StartingAfter  types.String                                              `tfsdk:"starting_after"`
 	EndingBefore   types.String                                              `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                               `tfsdk:"max_items"`
+	TotalPages     types.Int64                                               `tfsdk:"total_pages"`
 	T0             types.String                                              `tfsdk:"t0"`
 	T1             types.String                                              `tfsdk:"t1"`
 	Timespan       types.Float64                                             `tfsdk:"timespan"`
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsSensorReadingsLatestDataSource() datasource.DataSource {
 }
 
 type OrganizationsSensorReadingsLatestDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSensorReadingsLatestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsSensorReadingsLatestDataSource) Configure(ctx context.Cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsSensorReadingsLatestDataSource) Schema(_ context.Context,
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"metrics": schema.ListAttribute{
 				MarkdownDescription: `metrics query parameter. Types of sensor readings to retrieve. If no metrics are supplied, all available types of readings will be retrieved.`,
 				Optional:            true,
@@ -89,6 +97,10 @@ func (d *OrganizationsSensorReadingsLatestDataSource) Schema(_ context.Context,
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseSensorGetOrganizationSensorReadingsLatest`,
 				Computed:            true,
@@ -380,7 +392,10 @@ func (d *OrganizationsSensorReadingsLatestDataSource) Read(ctx context.Context,
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Sensor.GetOrganizationSensorReadingsLatest(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsSensorReadingsLatest.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseSensorGetOrganizationSensorReadingsLatest, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Sensor.GetOrganizationSensorReadingsLatest(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -394,6 +409,7 @@ func (d *OrganizationsSensorReadingsLatestDataSource) Read(ctx context.Context,
 		}
 
 		organizationsSensorReadingsLatest = ResponseSensorGetOrganizationSensorReadingsLatestItemsToBody(organizationsSensorReadingsLatest, response1)
+		organizationsSensorReadingsLatest.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsSensorReadingsLatest)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -409,6 +425,8 @@ type OrganizationsSensorReadingsLatest struct {
 	PerPage        types.Int64                                              `tfsdk:"per_page"`
 	StartingAfter  types.String                                             `tfsdk:"starting_after"`
 	EndingBefore   types.String                                             `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                              `tfsdk:"max_items"`
+	TotalPages     types.Int64                                              `tfsdk:"total_pages"`
 	NetworkIDs     types.List                                               `tfsdk:"network_ids"`
 	Serials        types.List                                               `tfsdk:"serials"`
 	Metrics        types.List                                               `tfsdk:"metrics"`
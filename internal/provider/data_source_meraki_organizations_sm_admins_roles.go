@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsSmAdminsRolesDataSource() datasource.DataSource {
 }
 
 type OrganizationsSmAdminsRolesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSmAdminsRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsSmAdminsRolesDataSource) Configure(ctx context.Context, re
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsSmAdminsRolesDataSource) Schema(_ context.Context, _ datas
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Optional:            true,
@@ -77,6 +85,10 @@ func (d *OrganizationsSmAdminsRolesDataSource) Schema(_ context.Context, _ datas
 				MarkdownDescription: `startingAfter query parameter. A token used by the server to indicate the start of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -128,7 +140,15 @@ func (d *OrganizationsSmAdminsRolesDataSource) Read(ctx context.Context, req dat
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Sm.GetOrganizationSmAdminsRoles(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsSmAdminsRoles.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseSmGetOrganizationSmAdminsRoles, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Sm.GetOrganizationSmAdminsRoles(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseSmGetOrganizationSmAdminsRoles) *[]merakigosdk.ResponseSmGetOrganizationSmAdminsRolesItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseSmGetOrganizationSmAdminsRolesItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -141,6 +161,13 @@ func (d *OrganizationsSmAdminsRolesDataSource) Read(ctx context.Context, req dat
 			return
 		}
 
+		organizationsSmAdminsRoles.TotalPages = types.Int64Value(totalPages)
+		diags = resp.State.Set(ctx, &organizationsSmAdminsRoles)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 	}
 	if selectedMethod == 2 {
 		log.Printf("[DEBUG] Selected method: GetOrganizationSmAdminsRole")
@@ -149,7 +176,7 @@ func (d *OrganizationsSmAdminsRolesDataSource) Read(ctx context.Context, req dat
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Sm.GetOrganizationSmAdminsRole(vvOrganizationID, vvRoleID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Sm.GetOrganizationSmAdminsRole(vvOrganizationID, vvRoleID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
@@ -189,6 +216,8 @@ type OrganizationsSmAdminsRoles struct {
 	PerPage        types.Int64                            `tfsdk:"per_page"`
 	StartingAfter  types.String                           `tfsdk:"starting_after"`
 	EndingBefore   types.String                           `tfsdk:"ending_before"`
+	MaxItems       types.Int64                            `tfsdk:"max_items"`
+	TotalPages     types.Int64                            `tfsdk:"total_pages"`
 	RoleID         types.String                           `tfsdk:"role_id"`
 	Item           *ResponseSmGetOrganizationSmAdminsRole `tfsdk:"item"`
 }
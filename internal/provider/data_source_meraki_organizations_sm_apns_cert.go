@@ -38,7 +38,8 @@ func NewOrganizationsSmApnsCertDataSource() datasource.DataSource {
 }
 
 type OrganizationsSmApnsCertDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSmApnsCertDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSmApnsCertDataSource) Configure(ctx context.Context, req d
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -89,7 +91,7 @@ func (d *OrganizationsSmApnsCertDataSource) Read(ctx context.Context, req dataso
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Sm.GetOrganizationSmApnsCert(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Sm.GetOrganizationSmApnsCert(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsSmVppAccountsDataSource() datasource.DataSource {
 }
 
 type OrganizationsSmVppAccountsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSmVppAccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSmVppAccountsDataSource) Configure(ctx context.Context, re
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -84,6 +86,7 @@ func (d *OrganizationsSmVppAccountsDataSource) Schema(_ context.Context, _ datas
 					},
 					"content_token": schema.StringAttribute{
 						MarkdownDescription: `The VPP service token`,
+						Sensitive:           true,
 						Computed:            true,
 					},
 					"email": schema.StringAttribute{
@@ -143,6 +146,7 @@ func (d *OrganizationsSmVppAccountsDataSource) Schema(_ context.Context, _ datas
 					},
 					"vpp_service_token": schema.StringAttribute{
 						MarkdownDescription: `The VPP Account's Service Token`,
+						Sensitive:           true,
 						Computed:            true,
 					},
 				},
@@ -169,6 +173,7 @@ func (d *OrganizationsSmVppAccountsDataSource) Schema(_ context.Context, _ datas
 						},
 						"content_token": schema.StringAttribute{
 							MarkdownDescription: `The VPP service token`,
+							Sensitive:           true,
 							Computed:            true,
 						},
 						"email": schema.StringAttribute{
@@ -228,6 +233,7 @@ func (d *OrganizationsSmVppAccountsDataSource) Schema(_ context.Context, _ datas
 						},
 						"vpp_service_token": schema.StringAttribute{
 							MarkdownDescription: `The VPP Account's Service Token`,
+							Sensitive:           true,
 							Computed:            true,
 						},
 					},
@@ -256,7 +262,7 @@ func (d *OrganizationsSmVppAccountsDataSource) Read(ctx context.Context, req dat
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Sm.GetOrganizationSmVppAccounts(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Sm.GetOrganizationSmVppAccounts(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -284,7 +290,7 @@ func (d *OrganizationsSmVppAccountsDataSource) Read(ctx context.Context, req dat
 
 		// has_unknown_response: None
 
-		response2, restyResp2, err := d.client.Sm.GetOrganizationSmVppAccount(vvOrganizationID, vvVppAccountID)
+		response2, restyResp2, err := d.clients.Client(vvOrganizationID).Sm.GetOrganizationSmVppAccount(vvOrganizationID, vvVppAccountID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
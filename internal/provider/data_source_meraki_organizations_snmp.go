@@ -38,7 +38,8 @@ func NewOrganizationsSNMPDataSource() datasource.DataSource {
 }
 
 type OrganizationsSNMPDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSNMPDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSNMPDataSource) Configure(ctx context.Context, req datasou
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -80,6 +82,7 @@ func (d *OrganizationsSNMPDataSource) Schema(_ context.Context, _ datasource.Sch
 					},
 					"v2_community_string": schema.StringAttribute{
 						MarkdownDescription: `The community string for SNMP version 2c, if enabled.`,
+						Sensitive:           true,
 						Computed:            true,
 					},
 					"v2c_enabled": schema.BoolAttribute{
@@ -122,7 +125,7 @@ func (d *OrganizationsSNMPDataSource) Read(ctx context.Context, req datasource.R
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationSNMP(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSNMP(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
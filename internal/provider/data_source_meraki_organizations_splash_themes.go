@@ -38,7 +38,8 @@ func NewOrganizationsSplashThemesDataSource() datasource.DataSource {
 }
 
 type OrganizationsSplashThemesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSplashThemesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSplashThemesDataSource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -114,7 +116,7 @@ func (d *OrganizationsSplashThemesDataSource) Read(ctx context.Context, req data
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationSplashThemes(vvOrganizationID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSplashThemes(vvOrganizationID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
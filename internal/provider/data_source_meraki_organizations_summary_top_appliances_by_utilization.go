@@ -38,7 +38,8 @@ func NewOrganizationsSummaryTopAppliancesByUtilizationDataSource() datasource.Da
 }
 
 type OrganizationsSummaryTopAppliancesByUtilizationDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSummaryTopAppliancesByUtilizationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSummaryTopAppliancesByUtilizationDataSource) Configure(ctx
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -180,7 +182,7 @@ func (d *OrganizationsSummaryTopAppliancesByUtilizationDataSource) Read(ctx cont
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationSummaryTopAppliancesByUtilization(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSummaryTopAppliancesByUtilization(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsSummaryTopApplicationsCategoriesByUsageDataSource() datasou
 }
 
 type OrganizationsSummaryTopApplicationsCategoriesByUsageDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSummaryTopApplicationsCategoriesByUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSummaryTopApplicationsCategoriesByUsageDataSource) Configu
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -156,7 +158,7 @@ func (d *OrganizationsSummaryTopApplicationsCategoriesByUsageDataSource) Read(ct
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationSummaryTopApplicationsCategoriesByUsage(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSummaryTopApplicationsCategoriesByUsage(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -38,7 +38,8 @@ func NewOrganizationsSummaryTopClientsByUsageDataSource() datasource.DataSource
 }
 
 type OrganizationsSummaryTopClientsByUsageDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSummaryTopClientsByUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSummaryTopClientsByUsageDataSource) Configure(ctx context.
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -180,7 +182,7 @@ func (d *OrganizationsSummaryTopClientsByUsageDataSource) Read(ctx context.Conte
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationSummaryTopClientsByUsage(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSummaryTopClientsByUsage(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
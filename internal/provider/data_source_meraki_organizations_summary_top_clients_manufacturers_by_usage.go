@@ -38,7 +38,8 @@ func NewOrganizationsSummaryTopClientsManufacturersByUsageDataSource() datasourc
 }
 
 type OrganizationsSummaryTopClientsManufacturersByUsageDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSummaryTopClientsManufacturersByUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSummaryTopClientsManufacturersByUsageDataSource) Configure
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -172,7 +174,7 @@ func (d *OrganizationsSummaryTopClientsManufacturersByUsageDataSource) Read(ctx
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationSummaryTopClientsManufacturersByUsage(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSummaryTopClientsManufacturersByUsage(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
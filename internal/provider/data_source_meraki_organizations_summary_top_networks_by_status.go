@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsSummaryTopNetworksByStatusDataSource() datasource.DataSourc
 }
 
 type OrganizationsSummaryTopNetworksByStatusDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSummaryTopNetworksByStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsSummaryTopNetworksByStatusDataSource) Configure(ctx contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -65,6 +69,10 @@ func (d *OrganizationsSummaryTopNetworksByStatusDataSource) Schema(_ context.Con
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_tag": schema.StringAttribute{
 				MarkdownDescription: `networkTag query parameter. Match result to an exact network tag`,
 				Optional:            true,
@@ -89,6 +97,10 @@ func (d *OrganizationsSummaryTopNetworksByStatusDataSource) Schema(_ context.Con
 				MarkdownDescription: `startingAfter query parameter. A token used by the server to indicate the start of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"usage_uplink": schema.StringAttribute{
 				MarkdownDescription: `usageUplink query parameter. Filter results by usage uplink`,
 				Optional:            true,
@@ -257,7 +269,10 @@ func (d *OrganizationsSummaryTopNetworksByStatusDataSource) Read(ctx context.Con
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationSummaryTopNetworksByStatus(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsSummaryTopNetworksByStatus.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationSummaryTopNetworksByStatus, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSummaryTopNetworksByStatus(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -271,6 +286,7 @@ func (d *OrganizationsSummaryTopNetworksByStatusDataSource) Read(ctx context.Con
 		}
 
 		organizationsSummaryTopNetworksByStatus = ResponseOrganizationsGetOrganizationSummaryTopNetworksByStatusItemsToBody(organizationsSummaryTopNetworksByStatus, response1)
+		organizationsSummaryTopNetworksByStatus.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsSummaryTopNetworksByStatus)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -291,6 +307,8 @@ type OrganizationsSummaryTopNetworksByStatus struct {
 	PerPage        types.Int64                                                           `tfsdk:"per_page"`
 	StartingAfter  types.String                                                          `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                          `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                           `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                           `tfsdk:"total_pages"`
 	Items          *[]ResponseItemOrganizationsGetOrganizationSummaryTopNetworksByStatus `tfsdk:"items"`
 }
 
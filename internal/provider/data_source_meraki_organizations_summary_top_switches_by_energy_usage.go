@@ -38,7 +38,8 @@ func NewOrganizationsSummaryTopSwitchesByEnergyUsageDataSource() datasource.Data
 }
 
 type OrganizationsSummaryTopSwitchesByEnergyUsageDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSummaryTopSwitchesByEnergyUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSummaryTopSwitchesByEnergyUsageDataSource) Configure(ctx c
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -169,7 +171,7 @@ func (d *OrganizationsSummaryTopSwitchesByEnergyUsageDataSource) Read(ctx contex
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationSummaryTopSwitchesByEnergyUsage(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationSummaryTopSwitchesByEnergyUsage(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
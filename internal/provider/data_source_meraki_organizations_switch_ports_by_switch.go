@@ -38,7 +38,8 @@ func NewOrganizationsSwitchPortsBySwitchDataSource() datasource.DataSource {
 }
 
 type OrganizationsSwitchPortsBySwitchDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSwitchPortsBySwitchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsSwitchPortsBySwitchDataSource) Configure(ctx context.Conte
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -248,7 +250,7 @@ func (d *OrganizationsSwitchPortsBySwitchDataSource) Read(ctx context.Context, r
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Switch.GetOrganizationSwitchPortsBySwitch(vvOrganizationID, &queryParams1)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Switch.GetOrganizationSwitchPortsBySwitch(vvOrganizationID, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
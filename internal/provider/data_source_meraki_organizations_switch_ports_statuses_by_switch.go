@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsSwitchPortsStatusesBySwitchDataSource() datasource.DataSour
 }
 
 type OrganizationsSwitchPortsStatusesBySwitchDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSwitchPortsStatusesBySwitchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsSwitchPortsStatusesBySwitchDataSource) Configure(ctx conte
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -74,6 +78,10 @@ func (d *OrganizationsSwitchPortsStatusesBySwitchDataSource) Schema(_ context.Co
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: `name query parameter. Optional parameter to filter items to switches with names that contain the search term or are an exact match.`,
 				Optional:            true,
@@ -109,6 +117,10 @@ func (d *OrganizationsSwitchPortsStatusesBySwitchDataSource) Schema(_ context.Co
 				MarkdownDescription: `startingAfter query parameter. A token used by the server to indicate the start of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -296,7 +308,15 @@ func (d *OrganizationsSwitchPortsStatusesBySwitchDataSource) Read(ctx context.Co
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Switch.GetOrganizationSwitchPortsStatusesBySwitch(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsSwitchPortsStatusesBySwitch.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseSwitchGetOrganizationSwitchPortsStatusesBySwitch, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Switch.GetOrganizationSwitchPortsStatusesBySwitch(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseSwitchGetOrganizationSwitchPortsStatusesBySwitch) *[]merakigosdk.ResponseSwitchGetOrganizationSwitchPortsStatusesBySwitchItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseSwitchGetOrganizationSwitchPortsStatusesBySwitchItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -310,6 +330,7 @@ func (d *OrganizationsSwitchPortsStatusesBySwitchDataSource) Read(ctx context.Co
 		}
 
 		organizationsSwitchPortsStatusesBySwitch = ResponseSwitchGetOrganizationSwitchPortsStatusesBySwitchItemToBody(organizationsSwitchPortsStatusesBySwitch, response1)
+		organizationsSwitchPortsStatusesBySwitch.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsSwitchPortsStatusesBySwitch)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -325,6 +346,8 @@ type OrganizationsSwitchPortsStatusesBySwitch struct {
 	PerPage                   types.Int64                                               `tfsdk:"per_page"`
 	StartingAfter             types.String                                              `tfsdk:"starting_after"`
 	EndingBefore              types.String                                              `tfsdk:"ending_before"`
+	MaxItems                  types.Int64                                               `tfsdk:"max_items"`
+	TotalPages                types.Int64                                               `tfsdk:"total_pages"`
 	ConfigurationUpdatedAfter types.String                                              `tfsdk:"configuration_updated_after"`
 	Mac                       types.String                                              `tfsdk:"mac"`
 	Macs                      types.List                                                `tfsdk:"macs"`
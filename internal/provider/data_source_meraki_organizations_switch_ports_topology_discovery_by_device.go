@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsSwitchPortsTopologyDiscoveryByDeviceDataSource() datasource
 }
 
 type OrganizationsSwitchPortsTopologyDiscoveryByDeviceDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSwitchPortsTopologyDiscoveryByDeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsSwitchPortsTopologyDiscoveryByDeviceDataSource) Configure(
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -74,6 +78,10 @@ func (d *OrganizationsSwitchPortsTopologyDiscoveryByDeviceDataSource) Schema(_ c
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: `name query parameter. Optional parameter to filter items to switches with names that contain the search term or are an exact match.`,
 				Optional:            true,
@@ -117,6 +125,10 @@ func (d *OrganizationsSwitchPortsTopologyDiscoveryByDeviceDataSource) Schema(_ c
 				MarkdownDescription: `timespan query parameter. The timespan for which the information will be fetched. If specifying timespan, do not specify parameter t0. The value must be in seconds and be less than or equal to 31 days. The default is 1 day.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -276,7 +288,15 @@ func (d *OrganizationsSwitchPortsTopologyDiscoveryByDeviceDataSource) Read(ctx c
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Switch.GetOrganizationSwitchPortsTopologyDiscoveryByDevice(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsSwitchPortsTopologyDiscoveryByDevice.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseSwitchGetOrganizationSwitchPortsTopologyDiscoveryByDevice, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Switch.GetOrganizationSwitchPortsTopologyDiscoveryByDevice(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseSwitchGetOrganizationSwitchPortsTopologyDiscoveryByDevice) *[]merakigosdk.ResponseSwitchGetOrganizationSwitchPortsTopologyDiscoveryByDeviceItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseSwitchGetOrganizationSwitchPortsTopologyDiscoveryByDeviceItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -290,6 +310,7 @@ func (d *OrganizationsSwitchPortsTopologyDiscoveryByDeviceDataSource) Read(ctx c
 		}
 
 		organizationsSwitchPortsTopologyDiscoveryByDevice = ResponseSwitchGetOrganizationSwitchPortsTopologyDiscoveryByDeviceItemToBody(organizationsSwitchPortsTopologyDiscoveryByDevice, response1)
+		organizationsSwitchPortsTopologyDiscoveryByDevice.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsSwitchPortsTopologyDiscoveryByDevice)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -307,6 +328,8 @@ type OrganizationsSwitchPortsTopologyDiscoveryByDevice struct {
 	PerPage                   types.Int64                                                        `tfsdk:"per_page"`
 	StartingAfter             types.String                                                       `tfsdk:"starting_after"`
 	EndingBefore              types.String                                                       `tfsdk:"ending_before"`
+	MaxItems                  types.Int64                                                        `tfsdk:"max_items"`
+	TotalPages                types.Int64                                                        `tfsdk:"total_pages"`
 	ConfigurationUpdatedAfter types.String                                                       `tfsdk:"configuration_updated_after"`
 	Mac                       types.String                                                       `tfsdk:"mac"`
 	Macs                      types.List                                                         `tfsdk:"macs"`
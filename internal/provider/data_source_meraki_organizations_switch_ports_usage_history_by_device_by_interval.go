@@ -24,6 +24,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -39,7 +41,8 @@ func NewOrganizationsSwitchPortsUsageHistoryByDeviceByIntervalDataSource() datas
 }
 
 type OrganizationsSwitchPortsUsageHistoryByDeviceByIntervalDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsSwitchPortsUsageHistoryByDeviceByIntervalDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -48,6 +51,7 @@ func (d *OrganizationsSwitchPortsUsageHistoryByDeviceByIntervalDataSource) Confi
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -79,6 +83,10 @@ func (d *OrganizationsSwitchPortsUsageHistoryByDeviceByIntervalDataSource) Schem
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: `name query parameter. Optional parameter to filter items to switches with names that contain the search term or are an exact match.`,
 				Optional:            true,
@@ -126,6 +134,10 @@ func (d *OrganizationsSwitchPortsUsageHistoryByDeviceByIntervalDataSource) Schem
 				MarkdownDescription: `timespan query parameter. The timespan for which the information will be fetched. If specifying timespan, do not specify parameters t0 and t1. The value must be in seconds and be less than or equal to 31 days. The default is 1 day. If interval is provided, the timespan will be autocalculated.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -336,7 +348,15 @@ func (d *OrganizationsSwitchPortsUsageHistoryByDeviceByIntervalDataSource) Read(
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Switch.GetOrganizationSwitchPortsUsageHistoryByDeviceByInterval(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsSwitchPortsUsageHistoryByDeviceByInterval.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseSwitchGetOrganizationSwitchPortsUsageHistoryByDeviceByInterval, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Switch.GetOrganizationSwitchPortsUsageHistoryByDeviceByInterval(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseSwitchGetOrganizationSwitchPortsUsageHistoryByDeviceByInterval) *[]merakigosdk.ResponseSwitchGetOrganizationSwitchPortsUsageHistoryByDeviceByIntervalItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseSwitchGetOrganizationSwitchPortsUsageHistoryByDeviceByIntervalItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -350,6 +370,7 @@ func (d *OrganizationsSwitchPortsUsageHistoryByDeviceByIntervalDataSource) Read(
 		}
 
 		organizationsSwitchPortsUsageHistoryByDeviceByInterval = ResponseSwitchGetOrganizationSwitchPortsUsageHistoryByDeviceByIntervalItemToBody(organizationsSwitchPortsUsageHistoryByDeviceByInterval, response1)
+		organizationsSwitchPortsUsageHistoryByDeviceByInterval.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsSwitchPortsUsageHistoryByDeviceByInterval)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -369,6 +390,8 @@ type OrganizationsSwitchPortsUsageHistoryByDeviceByInterval struct {
 	PerPage                   types.Int64                                                             `tfsdk:"per_page"`
 	StartingAfter             types.String                                                            `tfsdk:"starting_after"`
 	EndingBefore              types.String                                                            `tfsdk:"ending_before"`
+	MaxItems                  types.Int64                                                             `tfsdk:"max_items"`
+	TotalPages                types.Int64                                                             `tfsdk:"total_pages"`
 	ConfigurationUpdatedAfter types.String                                                            `tfsdk:"configuration_updated_after"`
 	Mac                       types.String                                                            `tfsdk:"mac"`
 	Macs                      types.List                                                              `tfsdk:"macs"`
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsUplinksStatusesDataSource() datasource.DataSource {
 }
 
 type OrganizationsUplinksStatusesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsUplinksStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsUplinksStatusesDataSource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -66,6 +70,10 @@ func (d *OrganizationsUplinksStatusesDataSource) Schema(_ context.Context, _ dat
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. A list of network IDs. The returned devices will be filtered to only include these networks.`,
 				Optional:            true,
@@ -89,6 +97,10 @@ func (d *OrganizationsUplinksStatusesDataSource) Schema(_ context.Context, _ dat
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseOrganizationsGetOrganizationUplinksStatuses`,
 				Computed:            true,
@@ -270,7 +282,10 @@ func (d *OrganizationsUplinksStatusesDataSource) Read(ctx context.Context, req d
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationUplinksStatuses(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsUplinksStatuses.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationUplinksStatuses, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationUplinksStatuses(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -284,6 +299,7 @@ func (d *OrganizationsUplinksStatusesDataSource) Read(ctx context.Context, req d
 		}
 
 		organizationsUplinksStatuses = ResponseOrganizationsGetOrganizationUplinksStatusesItemsToBody(organizationsUplinksStatuses, response1)
+		organizationsUplinksStatuses.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsUplinksStatuses)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -299,6 +315,8 @@ type OrganizationsUplinksStatuses struct {
 	PerPage        types.Int64                                                `tfsdk:"per_page"`
 	StartingAfter  types.String                                               `tfsdk:"starting_after"`
 	EndingBefore   types.String                                               `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                `tfsdk:"total_pages"`
 	NetworkIDs     types.List                                                 `tfsdk:"network_ids"`
 	Serials        types.List                                                 `tfsdk:"serials"`
 	Iccids         types.List                                                 `tfsdk:"iccids"`
@@ -38,7 +38,8 @@ func NewOrganizationsWebhooksCallbacksStatusesDataSource() datasource.DataSource
 }
 
 type OrganizationsWebhooksCallbacksStatusesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWebhooksCallbacksStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +48,7 @@ func (d *OrganizationsWebhooksCallbacksStatusesDataSource) Configure(ctx context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -151,7 +153,7 @@ func (d *OrganizationsWebhooksCallbacksStatusesDataSource) Read(ctx context.Cont
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationWebhooksCallbacksStatus(vvOrganizationID, vvCallbackID)
+		response1, restyResp1, err := d.clients.Client(vvOrganizationID).Organizations.GetOrganizationWebhooksCallbacksStatus(vvOrganizationID, vvCallbackID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
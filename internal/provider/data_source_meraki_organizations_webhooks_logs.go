@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWebhooksLogsDataSource() datasource.DataSource {
 }
 
 type OrganizationsWebhooksLogsDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWebhooksLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWebhooksLogsDataSource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsWebhooksLogsDataSource) Schema(_ context.Context, _ dataso
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Required:            true,
@@ -85,6 +93,10 @@ func (d *OrganizationsWebhooksLogsDataSource) Schema(_ context.Context, _ dataso
 				MarkdownDescription: `timespan query parameter. The timespan for which the information will be fetched. If specifying timespan, do not specify parameters t0 and t1. The value must be in seconds and be less than or equal to 31 days. The default is 1 day.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"url": schema.StringAttribute{
 				MarkdownDescription: `url query parameter. The URL the webhook was sent to`,
 				Optional:            true,
@@ -158,7 +170,10 @@ func (d *OrganizationsWebhooksLogsDataSource) Read(ctx context.Context, req data
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Organizations.GetOrganizationWebhooksLogs(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsWebhooksLogs.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationWebhooksLogs, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Organizations.GetOrganizationWebhooksLogs(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -172,6 +187,7 @@ func (d *OrganizationsWebhooksLogsDataSource) Read(ctx context.Context, req data
 		}
 
 		organizationsWebhooksLogs = ResponseOrganizationsGetOrganizationWebhooksLogsItemsToBody(organizationsWebhooksLogs, response1)
+		organizationsWebhooksLogs.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWebhooksLogs)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -190,6 +206,8 @@ type OrganizationsWebhooksLogs struct {
 	PerPage        types.Int64                                             `tfsdk:"per_page"`
 	StartingAfter  types.String                                            `tfsdk:"starting_after"`
 	EndingBefore   types.String                                            `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                             `tfsdk:"max_items"`
+	TotalPages     types.Int64                                             `tfsdk:"total_pages"`
 	URL            types.String                                            `tfsdk:"url"`
 	Items          *[]ResponseItemOrganizationsGetOrganizationWebhooksLogs `tfsdk:"items"`
 }
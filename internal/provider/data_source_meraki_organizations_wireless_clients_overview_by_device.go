@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessClientsOverviewByDeviceDataSource() datasource.Data
 }
 
 type OrganizationsWirelessClientsOverviewByDeviceDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessClientsOverviewByDeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessClientsOverviewByDeviceDataSource) Configure(ctx c
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -66,6 +70,10 @@ func (d *OrganizationsWirelessClientsOverviewByDeviceDataSource) Schema(_ contex
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Optional parameter to filter access points client counts by network ID. This filter uses multiple exact matches.`,
 				Optional:            true,
@@ -88,6 +96,10 @@ func (d *OrganizationsWirelessClientsOverviewByDeviceDataSource) Schema(_ contex
 				MarkdownDescription: `startingAfter query parameter. A token used by the server to indicate the start of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -191,7 +203,15 @@ func (d *OrganizationsWirelessClientsOverviewByDeviceDataSource) Read(ctx contex
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Wireless.GetOrganizationWirelessClientsOverviewByDevice(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsWirelessClientsOverviewByDevice.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessGetOrganizationWirelessClientsOverviewByDevice, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Wireless.GetOrganizationWirelessClientsOverviewByDevice(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseWirelessGetOrganizationWirelessClientsOverviewByDevice) *[]merakigosdk.ResponseWirelessGetOrganizationWirelessClientsOverviewByDeviceItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseWirelessGetOrganizationWirelessClientsOverviewByDeviceItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -205,6 +225,7 @@ func (d *OrganizationsWirelessClientsOverviewByDeviceDataSource) Read(ctx contex
 		}
 
 		organizationsWirelessClientsOverviewByDevice = ResponseWirelessGetOrganizationWirelessClientsOverviewByDeviceItemToBody(organizationsWirelessClientsOverviewByDevice, response1)
+		organizationsWirelessClientsOverviewByDevice.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessClientsOverviewByDevice)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -223,6 +244,8 @@ type OrganizationsWirelessClientsOverviewByDevice struct {
 	PerPage                 types.Int64                                                     `tfsdk:"per_page"`
 	StartingAfter           types.String                                                    `tfsdk:"starting_after"`
 	EndingBefore            types.String                                                    `tfsdk:"ending_before"`
+	MaxItems                types.Int64                                                     `tfsdk:"max_items"`
+	TotalPages              types.Int64                                                     `tfsdk:"total_pages"`
 	Item                    *ResponseWirelessGetOrganizationWirelessClientsOverviewByDevice `tfsdk:"item"`
 }
 
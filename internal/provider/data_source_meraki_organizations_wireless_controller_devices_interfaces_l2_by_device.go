@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessControllerDevicesInterfacesL2ByDeviceDataSource() d
 }
 
 type OrganizationsWirelessControllerDevicesInterfacesL2ByDeviceDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessControllerDevicesInterfacesL2ByDeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL2ByDeviceDataSource) C
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL2ByDeviceDataSource) S
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Required:            true,
@@ -90,6 +98,10 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL2ByDeviceDataSource) S
 				MarkdownDescription: `timespan query parameter. The timespan for which the information will be fetched. If specifying timespan, do not specify parameters t0 and t1. The value must be in seconds and be less than or equal to 31 days. The default is 7 days.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -236,7 +248,15 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL2ByDeviceDataSource) R
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.WirelessController.GetOrganizationWirelessControllerDevicesInterfacesL2ByDevice(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsWirelessControllerDevicesInterfacesL2ByDevice.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL2ByDevice, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).WirelessController.GetOrganizationWirelessControllerDevicesInterfacesL2ByDevice(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL2ByDevice) *[]merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL2ByDeviceItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL2ByDeviceItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -250,6 +270,7 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL2ByDeviceDataSource) R
 		}
 
 		organizationsWirelessControllerDevicesInterfacesL2ByDevice = ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL2ByDeviceItemToBody(organizationsWirelessControllerDevicesInterfacesL2ByDevice, response1)
+		organizationsWirelessControllerDevicesInterfacesL2ByDevice.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessControllerDevicesInterfacesL2ByDevice)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -269,6 +290,8 @@ type OrganizationsWirelessControllerDevicesInterfacesL2ByDevice struct {
 	PerPage        types.Int64                                                                             `tfsdk:"per_page"`
 	StartingAfter  types.String                                                                            `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                                            `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                                             `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                                             `tfsdk:"total_pages"`
 	Item           *ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL2ByDevice `tfsdk:"item"`
 }
 
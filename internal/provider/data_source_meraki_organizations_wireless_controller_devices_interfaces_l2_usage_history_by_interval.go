@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessControllerDevicesInterfacesL2UsageHistoryByInterval
 }
 
 type OrganizationsWirelessControllerDevicesInterfacesL2UsageHistoryByIntervalDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessControllerDevicesInterfacesL2UsageHistoryByIntervalDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL2UsageHistoryByInterva
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL2UsageHistoryByInterva
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Required:            true,
@@ -90,6 +98,10 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL2UsageHistoryByInterva
 				MarkdownDescription: `timespan query parameter. The timespan for which the information will be fetched. If specifying timespan, do not specify parameters t0 and t1. The value must be in seconds and be less than or equal to 31 days. The default is 7 days.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -190,7 +202,15 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL2UsageHistoryByInterva
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.WirelessController.GetOrganizationWirelessControllerDevicesInterfacesL2UsageHistoryByInterval(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsWirelessControllerDevicesInterfacesL2UsageHistoryByInterval.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL2UsageHistoryByInterval, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).WirelessController.GetOrganizationWirelessControllerDevicesInterfacesL2UsageHistoryByInterval(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL2UsageHistoryByInterval) *[]merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL2UsageHistoryByIntervalItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL2UsageHistoryByIntervalItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -204,6 +224,7 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL2UsageHistoryByInterva
 		}
 
 		organizationsWirelessControllerDevicesInterfacesL2UsageHistoryByInterval = ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL2UsageHistoryByIntervalItemToBody(organizationsWirelessControllerDevicesInterfacesL2UsageHistoryByInterval, response1)
+		organizationsWirelessControllerDevicesInterfacesL2UsageHistoryByInterval.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessControllerDevicesInterfacesL2UsageHistoryByInterval)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -223,6 +244,8 @@ type OrganizationsWirelessControllerDevicesInterfacesL2UsageHistoryByInterval st
 	PerPage        types.Int64                                                                                           `tfsdk:"per_page"`
 	StartingAfter  types.String                                                                                          `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                                                          `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                                                           `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                                                           `tfsdk:"total_pages"`
 	Item           *ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL2UsageHistoryByInterval `tfsdk:"item"`
 }
 
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistoryB
 }
 
 type OrganizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDeviceDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistory
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -65,6 +69,10 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistory
 				MarkdownDescription: `includeInterfacesWithoutChanges query parameter. By default, interfaces without changes are omitted from the response for brevity. If you want to include the interfaces even if they have no changes, set to true. (default: false)`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Required:            true,
@@ -94,6 +102,10 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistory
 				MarkdownDescription: `timespan query parameter. The timespan for which the information will be fetched. If specifying timespan, do not specify parameters t0 and t1. The value must be in seconds and be less than or equal to 31 days. The default is 7 days.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -214,7 +226,15 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistory
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.WirelessController.GetOrganizationWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDevice(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDevice.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDevice, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).WirelessController.GetOrganizationWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDevice(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDevice) *[]merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDeviceItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDeviceItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -228,6 +248,7 @@ func (d *OrganizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistory
 		}
 
 		organizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDevice = ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDeviceItemToBody(organizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDevice, response1)
+		organizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDevice.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDevice)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -248,6 +269,8 @@ type OrganizationsWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDe
 	PerPage                         types.Int64                                                                                                  `tfsdk:"per_page"`
 	StartingAfter                   types.String                                                                                                 `tfsdk:"starting_after"`
 	EndingBefore                    types.String                                                                                                 `tfsdk:"ending_before"`
+	MaxItems                        types.Int64                                                                                                  `tfsdk:"max_items"`
+	TotalPages                      types.Int64                                                                                                  `tfsdk:"total_pages"`
 	Item                            *ResponseWirelessControllerGetOrganizationWirelessControllerDevicesInterfacesL3StatusesChangeHistoryByDevice `tfsdk:"item"`
 }
 
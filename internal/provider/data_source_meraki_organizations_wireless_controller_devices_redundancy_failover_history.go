@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessControllerDevicesRedundancyFailoverHistoryDataSourc
 }
 
 type OrganizationsWirelessControllerDevicesRedundancyFailoverHistoryDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessControllerDevicesRedundancyFailoverHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessControllerDevicesRedundancyFailoverHistoryDataSour
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsWirelessControllerDevicesRedundancyFailoverHistoryDataSour
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Required:            true,
@@ -91,6 +99,10 @@ func (d *OrganizationsWirelessControllerDevicesRedundancyFailoverHistoryDataSour
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseWirelessControllerGetOrganizationWirelessControllerDevicesRedundancyFailoverHistory`,
 				Computed:            true,
@@ -213,7 +225,10 @@ func (d *OrganizationsWirelessControllerDevicesRedundancyFailoverHistoryDataSour
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.WirelessController.GetOrganizationWirelessControllerDevicesRedundancyFailoverHistory(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsWirelessControllerDevicesRedundancyFailoverHistory.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessControllerGetOrganizationWirelessControllerDevicesRedundancyFailoverHistory, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).WirelessController.GetOrganizationWirelessControllerDevicesRedundancyFailoverHistory(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -227,6 +242,7 @@ func (d *OrganizationsWirelessControllerDevicesRedundancyFailoverHistoryDataSour
 		}
 
 		organizationsWirelessControllerDevicesRedundancyFailoverHistory = ResponseWirelessControllerGetOrganizationWirelessControllerDevicesRedundancyFailoverHistoryItemsToBody(organizationsWirelessControllerDevicesRedundancyFailoverHistory, response1)
+		organizationsWirelessControllerDevicesRedundancyFailoverHistory.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessControllerDevicesRedundancyFailoverHistory)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -246,6 +262,8 @@ type OrganizationsWirelessControllerDevicesRedundancyFailoverHistory struct {
 	PerPage        types.Int64                                                                                        `tfsdk:"per_page"`
 	StartingAfter  types.String                                                                                       `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                                                       `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                                                        `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                                                        `tfsdk:"total_pages"`
 	Items          *[]ResponseItemWirelessControllerGetOrganizationWirelessControllerDevicesRedundancyFailoverHistory `tfsdk:"items"`
 }
 
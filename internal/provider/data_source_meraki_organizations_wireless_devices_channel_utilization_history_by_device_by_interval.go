@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessDevicesChannelUtilizationHistoryByDeviceByIntervalD
 }
 
 type OrganizationsWirelessDevicesChannelUtilizationHistoryByDeviceByIntervalDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessDevicesChannelUtilizationHistoryByDeviceByIntervalDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessDevicesChannelUtilizationHistoryByDeviceByInterval
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -65,6 +69,10 @@ func (d *OrganizationsWirelessDevicesChannelUtilizationHistoryByDeviceByInterval
 				MarkdownDescription: `interval query parameter. The time interval in seconds for returned data. The valid intervals are: 300, 600, 3600, 7200, 14400, 21600. The default is 3600.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Filter results by network.`,
 				Optional:            true,
@@ -100,6 +108,10 @@ func (d *OrganizationsWirelessDevicesChannelUtilizationHistoryByDeviceByInterval
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseWirelessGetOrganizationWirelessDevicesChannelUtilizationHistoryByDeviceByInterval`,
 				Computed:            true,
@@ -211,7 +223,10 @@ func (d *OrganizationsWirelessDevicesChannelUtilizationHistoryByDeviceByInterval
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Wireless.GetOrganizationWirelessDevicesChannelUtilizationHistoryByDeviceByInterval(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsWirelessDevicesChannelUtilizationHistoryByDeviceByInterval.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesChannelUtilizationHistoryByDeviceByInterval, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Wireless.GetOrganizationWirelessDevicesChannelUtilizationHistoryByDeviceByInterval(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -225,6 +240,7 @@ func (d *OrganizationsWirelessDevicesChannelUtilizationHistoryByDeviceByInterval
 		}
 
 		organizationsWirelessDevicesChannelUtilizationHistoryByDeviceByInterval = ResponseWirelessGetOrganizationWirelessDevicesChannelUtilizationHistoryByDeviceByIntervalItemsToBody(organizationsWirelessDevicesChannelUtilizationHistoryByDeviceByInterval, response1)
+		organizationsWirelessDevicesChannelUtilizationHistoryByDeviceByInterval.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessDevicesChannelUtilizationHistoryByDeviceByInterval)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -242,6 +258,8 @@ type OrganizationsWirelessDevicesChannelUtilizationHistoryByDeviceByInterval str
 	PerPage        types.Int64                                                                                      `tfsdk:"per_page"`
 	StartingAfter  types.String                                                                                     `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                                                     `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                                                      `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                                                      `tfsdk:"total_pages"`
 	T0             types.String                                                                                     `tfsdk:"t0"`
 	T1             types.String                                                                                     `tfsdk:"t1"`
 	Timespan       types.Float64                                                                                    `tfsdk:"timespan"`
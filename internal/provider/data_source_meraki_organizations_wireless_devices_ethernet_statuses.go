@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessDevicesEthernetStatusesDataSource() datasource.Data
 }
 
 type OrganizationsWirelessDevicesEthernetStatusesDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessDevicesEthernetStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessDevicesEthernetStatusesDataSource) Configure(ctx c
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsWirelessDevicesEthernetStatusesDataSource) Schema(_ contex
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. A list of Meraki network IDs to filter results to contain only specified networks. E.g.: networkIds[]=N_12345678&networkIds[]=L_3456`,
 				Optional:            true,
@@ -79,6 +87,10 @@ func (d *OrganizationsWirelessDevicesEthernetStatusesDataSource) Schema(_ contex
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseWirelessGetOrganizationWirelessDevicesEthernetStatuses`,
 				Computed:            true,
@@ -218,7 +230,10 @@ func (d *OrganizationsWirelessDevicesEthernetStatusesDataSource) Read(ctx contex
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Wireless.GetOrganizationWirelessDevicesEthernetStatuses(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsWirelessDevicesEthernetStatuses.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesEthernetStatuses, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Wireless.GetOrganizationWirelessDevicesEthernetStatuses(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -232,6 +247,7 @@ func (d *OrganizationsWirelessDevicesEthernetStatusesDataSource) Read(ctx contex
 		}
 
 		organizationsWirelessDevicesEthernetStatuses = ResponseWirelessGetOrganizationWirelessDevicesEthernetStatusesItemsToBody(organizationsWirelessDevicesEthernetStatuses, response1)
+		organizationsWirelessDevicesEthernetStatuses.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessDevicesEthernetStatuses)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -247,6 +263,8 @@ type OrganizationsWirelessDevicesEthernetStatuses struct {
 	PerPage        types.Int64                                                           `tfsdk:"per_page"`
 	StartingAfter  types.String                                                          `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                          `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                           `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                           `tfsdk:"total_pages"`
 	NetworkIDs     types.List                                                            `tfsdk:"network_ids"`
 	Items          *[]ResponseItemWirelessGetOrganizationWirelessDevicesEthernetStatuses `tfsdk:"items"`
 }
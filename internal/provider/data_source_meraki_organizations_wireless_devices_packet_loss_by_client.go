@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessDevicesPacketLossByClientDataSource() datasource.Da
 }
 
 type OrganizationsWirelessDevicesPacketLossByClientDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessDevicesPacketLossByClientDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessDevicesPacketLossByClientDataSource) Configure(ctx
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -71,6 +75,10 @@ func (d *OrganizationsWirelessDevicesPacketLossByClientDataSource) Schema(_ cont
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Filter results by network.`,
 				Optional:            true,
@@ -106,6 +114,10 @@ func (d *OrganizationsWirelessDevicesPacketLossByClientDataSource) Schema(_ cont
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseWirelessGetOrganizationWirelessDevicesPacketLossByClient`,
 				Computed:            true,
@@ -213,7 +225,10 @@ func (d *OrganizationsWirelessDevicesPacketLossByClientDataSource) Read(ctx cont
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Wireless.GetOrganizationWirelessDevicesPacketLossByClient(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsWirelessDevicesPacketLossByClient.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesPacketLossByClient, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Wireless.GetOrganizationWirelessDevicesPacketLossByClient(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -227,6 +242,7 @@ func (d *OrganizationsWirelessDevicesPacketLossByClientDataSource) Read(ctx cont
 		}
 
 		organizationsWirelessDevicesPacketLossByClient = ResponseWirelessGetOrganizationWirelessDevicesPacketLossByClientItemsToBody(organizationsWirelessDevicesPacketLossByClient, response1)
+		organizationsWirelessDevicesPacketLossByClient.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessDevicesPacketLossByClient)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -246,6 +262,8 @@ type OrganizationsWirelessDevicesPacketLossByClient struct {
 	PerPage        types.Int64                                                             `tfsdk:"per_page"`
 	StartingAfter  types.String                                                            `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                            `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                             `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                             `tfsdk:"total_pages"`
 	T0             types.String                                                            `tfsdk:"t0"`
 	T1             types.String                                                            `tfsdk:"t1"`
 	Timespan       types.Float64                                                           `tfsdk:"timespan"`
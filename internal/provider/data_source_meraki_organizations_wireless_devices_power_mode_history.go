@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessDevicesPowerModeHistoryDataSource() datasource.Data
 }
 
 type OrganizationsWirelessDevicesPowerModeHistoryDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessDevicesPowerModeHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessDevicesPowerModeHistoryDataSource) Configure(ctx c
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsWirelessDevicesPowerModeHistoryDataSource) Schema(_ contex
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Optional parameter to filter the result set by the included set of network IDs`,
 				Optional:            true,
@@ -95,6 +103,10 @@ func (d *OrganizationsWirelessDevicesPowerModeHistoryDataSource) Schema(_ contex
 				MarkdownDescription: `timespan query parameter. The timespan for which the information will be fetched. If specifying timespan, do not specify parameters t0 and t1. The value must be in seconds and be less than or equal to 1 day. The default is 1 day.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -196,7 +208,15 @@ func (d *OrganizationsWirelessDevicesPowerModeHistoryDataSource) Read(ctx contex
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Wireless.GetOrganizationWirelessDevicesPowerModeHistory(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsWirelessDevicesPowerModeHistory.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesPowerModeHistory, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Wireless.GetOrganizationWirelessDevicesPowerModeHistory(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesPowerModeHistory) *[]merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesPowerModeHistoryItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesPowerModeHistoryItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -210,6 +230,7 @@ func (d *OrganizationsWirelessDevicesPowerModeHistoryDataSource) Read(ctx contex
 		}
 
 		organizationsWirelessDevicesPowerModeHistory = ResponseWirelessGetOrganizationWirelessDevicesPowerModeHistoryItemToBody(organizationsWirelessDevicesPowerModeHistory, response1)
+		organizationsWirelessDevicesPowerModeHistory.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessDevicesPowerModeHistory)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -228,6 +249,8 @@ type OrganizationsWirelessDevicesPowerModeHistory struct {
 	PerPage        types.Int64                                                     `tfsdk:"per_page"`
 	StartingAfter  types.String                                                    `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                    `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                     `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                     `tfsdk:"total_pages"`
 	NetworkIDs     types.List                                                      `tfsdk:"network_ids"`
 	Serials        types.List                                                      `tfsdk:"serials"`
 	Item           *ResponseWirelessGetOrganizationWirelessDevicesPowerModeHistory `tfsdk:"item"`
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessDevicesSystemCPULoadHistoryDataSource() datasource.
 }
 
 type OrganizationsWirelessDevicesSystemCPULoadHistoryDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessDevicesSystemCPULoadHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessDevicesSystemCPULoadHistoryDataSource) Configure(c
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,10 @@ func (d *OrganizationsWirelessDevicesSystemCPULoadHistoryDataSource) Schema(_ co
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Optional parameter to filter the result set by the included set of network IDs`,
 				Optional:            true,
@@ -95,6 +103,10 @@ func (d *OrganizationsWirelessDevicesSystemCPULoadHistoryDataSource) Schema(_ co
 				MarkdownDescription: `timespan query parameter. The timespan for which the information will be fetched. If specifying timespan, do not specify parameters t0 and t1. The value must be in seconds and be less than or equal to 1 day. The default is 1 day.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -200,7 +212,15 @@ func (d *OrganizationsWirelessDevicesSystemCPULoadHistoryDataSource) Read(ctx co
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Wireless.GetOrganizationWirelessDevicesSystemCPULoadHistory(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsWirelessDevicesSystemCPULoadHistory.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesSystemCPULoadHistory, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Wireless.GetOrganizationWirelessDevicesSystemCPULoadHistory(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesSystemCPULoadHistory) *[]merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesSystemCPULoadHistoryItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesSystemCPULoadHistoryItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -214,6 +234,7 @@ func (d *OrganizationsWirelessDevicesSystemCPULoadHistoryDataSource) Read(ctx co
 		}
 
 		organizationsWirelessDevicesSystemCPULoadHistory = ResponseWirelessGetOrganizationWirelessDevicesSystemCPULoadHistoryItemToBody(organizationsWirelessDevicesSystemCPULoadHistory, response1)
+		organizationsWirelessDevicesSystemCPULoadHistory.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessDevicesSystemCPULoadHistory)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -232,6 +253,8 @@ type OrganizationsWirelessDevicesSystemCPULoadHistory struct {
 	PerPage        types.Int64                                                         `tfsdk:"per_page"`
 	StartingAfter  types.String                                                        `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                        `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                         `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                         `tfsdk:"total_pages"`
 	NetworkIDs     types.List                                                          `tfsdk:"network_ids"`
 	Serials        types.List                                                          `tfsdk:"serials"`
 	Item           *ResponseWirelessGetOrganizationWirelessDevicesSystemCpuLoadHistory `tfsdk:"item"`
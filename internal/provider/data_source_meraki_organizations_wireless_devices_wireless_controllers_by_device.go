@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessDevicesWirelessControllersByDeviceDataSource() data
 }
 
 type OrganizationsWirelessDevicesWirelessControllersByDeviceDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessDevicesWirelessControllersByDeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessDevicesWirelessControllersByDeviceDataSource) Conf
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -66,6 +70,10 @@ func (d *OrganizationsWirelessDevicesWirelessControllersByDeviceDataSource) Sche
 				MarkdownDescription: `endingBefore query parameter. A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Optional parameter to filter access points by network ID. This filter uses multiple exact matches.`,
 				Optional:            true,
@@ -88,6 +96,10 @@ func (d *OrganizationsWirelessDevicesWirelessControllersByDeviceDataSource) Sche
 				MarkdownDescription: `startingAfter query parameter. A token used by the server to indicate the start of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -238,7 +250,15 @@ func (d *OrganizationsWirelessDevicesWirelessControllersByDeviceDataSource) Read
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Wireless.GetOrganizationWirelessDevicesWirelessControllersByDevice(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsWirelessDevicesWirelessControllersByDevice.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesWirelessControllersByDevice, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Wireless.GetOrganizationWirelessDevicesWirelessControllersByDevice(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesWirelessControllersByDevice) *[]merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesWirelessControllersByDeviceItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseWirelessGetOrganizationWirelessDevicesWirelessControllersByDeviceItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -252,6 +272,7 @@ func (d *OrganizationsWirelessDevicesWirelessControllersByDeviceDataSource) Read
 		}
 
 		organizationsWirelessDevicesWirelessControllersByDevice = ResponseWirelessGetOrganizationWirelessDevicesWirelessControllersByDeviceItemToBody(organizationsWirelessDevicesWirelessControllersByDevice, response1)
+		organizationsWirelessDevicesWirelessControllersByDevice.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessDevicesWirelessControllersByDevice)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -270,6 +291,8 @@ type OrganizationsWirelessDevicesWirelessControllersByDevice struct {
 	PerPage           types.Int64                                                                `tfsdk:"per_page"`
 	StartingAfter     types.String                                                               `tfsdk:"starting_after"`
 	EndingBefore      types.String                                                               `tfsdk:"ending_before"`
+	MaxItems          types.Int64                                                                `tfsdk:"max_items"`
+	TotalPages        types.Int64                                                                `tfsdk:"total_pages"`
 	Item              *ResponseWirelessGetOrganizationWirelessDevicesWirelessControllersByDevice `tfsdk:"item"`
 }
 
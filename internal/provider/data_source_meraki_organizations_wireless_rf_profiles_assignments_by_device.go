@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessRfProfilesAssignmentsByDeviceDataSource() datasourc
 }
 
 type OrganizationsWirelessRfProfilesAssignmentsByDeviceDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessRfProfilesAssignmentsByDeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessRfProfilesAssignmentsByDeviceDataSource) Configure
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -70,6 +74,10 @@ func (d *OrganizationsWirelessRfProfilesAssignmentsByDeviceDataSource) Schema(_
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"model": schema.StringAttribute{
 				MarkdownDescription: `model query parameter. Optional parameter to filter RF profiles by device model. All returned devices will have a model that contains the search term or is an exact match.`,
 				Optional:            true,
@@ -115,6 +123,10 @@ func (d *OrganizationsWirelessRfProfilesAssignmentsByDeviceDataSource) Schema(_
 				Optional:            true,
 			},
 
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"items": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of ResponseWirelessGetOrganizationWirelessRfProfilesAssignmentsByDevice`,
 				Computed:            true,
@@ -240,7 +252,10 @@ func (d *OrganizationsWirelessRfProfilesAssignmentsByDeviceDataSource) Read(ctx
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Wireless.GetOrganizationWirelessRfProfilesAssignmentsByDevice(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginateList(queryParams1.StartingAfter, organizationsWirelessRfProfilesAssignmentsByDevice.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessGetOrganizationWirelessRfProfilesAssignmentsByDevice, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Wireless.GetOrganizationWirelessRfProfilesAssignmentsByDevice(vvOrganizationID, &queryParams1)
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -254,6 +269,7 @@ func (d *OrganizationsWirelessRfProfilesAssignmentsByDeviceDataSource) Read(ctx
 		}
 
 		organizationsWirelessRfProfilesAssignmentsByDevice = ResponseWirelessGetOrganizationWirelessRfProfilesAssignmentsByDeviceItemsToBody(organizationsWirelessRfProfilesAssignmentsByDevice, response1)
+		organizationsWirelessRfProfilesAssignmentsByDevice.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessRfProfilesAssignmentsByDevice)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -269,6 +285,8 @@ type OrganizationsWirelessRfProfilesAssignmentsByDevice struct {
 	PerPage        types.Int64                                                                 `tfsdk:"per_page"`
 	StartingAfter  types.String                                                                `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                                `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                                 `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                                 `tfsdk:"total_pages"`
 	NetworkIDs     types.List                                                                  `tfsdk:"network_ids"`
 	ProductTypes   types.List                                                                  `tfsdk:"product_types"`
 	Name           types.String                                                                `tfsdk:"name"`
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -38,7 +40,8 @@ func NewOrganizationsWirelessSSIDsStatusesByDeviceDataSource() datasource.DataSo
 }
 
 type OrganizationsWirelessSSIDsStatusesByDeviceDataSource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (d *OrganizationsWirelessSSIDsStatusesByDeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,6 +50,7 @@ func (d *OrganizationsWirelessSSIDsStatusesByDeviceDataSource) Configure(ctx con
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	d.client = client
+	d.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -70,6 +74,10 @@ func (d *OrganizationsWirelessSSIDsStatusesByDeviceDataSource) Schema(_ context.
 				MarkdownDescription: `hideDisabled query parameter. If true, the returned devices will not include disabled SSIDs. (default: true)`,
 				Optional:            true,
 			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of items to read across all the pages. If not set, every page is read.`,
+				Optional:            true,
+			},
 			"network_ids": schema.ListAttribute{
 				MarkdownDescription: `networkIds query parameter. Optional parameter to filter the result set by the included set of network IDs`,
 				Optional:            true,
@@ -92,6 +100,10 @@ func (d *OrganizationsWirelessSSIDsStatusesByDeviceDataSource) Schema(_ context.
 				MarkdownDescription: `startingAfter query parameter. A token used by the server to indicate the start of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.`,
 				Optional:            true,
 			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: `Number of pages read from the Meraki API.`,
+				Computed:            true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -253,7 +265,15 @@ func (d *OrganizationsWirelessSSIDsStatusesByDeviceDataSource) Read(ctx context.
 
 		// has_unknown_response: None
 
-		response1, restyResp1, err := d.client.Wireless.GetOrganizationWirelessSSIDsStatusesByDevice(vvOrganizationID, &queryParams1)
+		response1, restyResp1, totalPages, err := paginate(queryParams1.StartingAfter, organizationsWirelessSSIDsStatusesByDevice.MaxItems.ValueInt64(), func(startingAfter string) (*merakigosdk.ResponseWirelessGetOrganizationWirelessSSIDsStatusesByDevice, *resty.Response, error) {
+			queryParams1.StartingAfter = startingAfter
+			return d.clients.Client(vvOrganizationID).Wireless.GetOrganizationWirelessSSIDsStatusesByDevice(vvOrganizationID, &queryParams1)
+		}, func(page *merakigosdk.ResponseWirelessGetOrganizationWirelessSSIDsStatusesByDevice) *[]merakigosdk.ResponseWirelessGetOrganizationWirelessSSIDsStatusesByDeviceItems {
+			if page.Items == nil {
+				page.Items = &[]merakigosdk.ResponseWirelessGetOrganizationWirelessSSIDsStatusesByDeviceItems{}
+			}
+			return page.Items
+		})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
@@ -267,6 +287,7 @@ func (d *OrganizationsWirelessSSIDsStatusesByDeviceDataSource) Read(ctx context.
 		}
 
 		organizationsWirelessSSIDsStatusesByDevice = ResponseWirelessGetOrganizationWirelessSSIDsStatusesByDeviceItemToBody(organizationsWirelessSSIDsStatusesByDevice, response1)
+		organizationsWirelessSSIDsStatusesByDevice.TotalPages = types.Int64Value(totalPages)
 		diags = resp.State.Set(ctx, &organizationsWirelessSSIDsStatusesByDevice)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -286,6 +307,8 @@ type OrganizationsWirelessSSIDsStatusesByDevice struct {
 	PerPage        types.Int64                                                   `tfsdk:"per_page"`
 	StartingAfter  types.String                                                  `tfsdk:"starting_after"`
 	EndingBefore   types.String                                                  `tfsdk:"ending_before"`
+	MaxItems       types.Int64                                                   `tfsdk:"max_items"`
+	TotalPages     types.Int64                                                   `tfsdk:"total_pages"`
 	Item           *ResponseWirelessGetOrganizationWirelessSsidsStatusesByDevice `tfsdk:"item"`
 }
 
@@ -0,0 +1,124 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &SSIDDataSource{}
+	_ datasource.DataSourceWithConfigure = &SSIDDataSource{}
+)
+
+func NewSSIDDataSource() datasource.DataSource {
+	return &SSIDDataSource{}
+}
+
+// SSIDDataSource looks up the only SSID of a network with a name.
+type SSIDDataSource struct {
+	client *merakigosdk.Client
+}
+
+func (d *SSIDDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(MerakiProviderData).Client
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *SSIDDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssid"
+}
+
+func (d *SSIDDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the wireless SSID of a network with its name. The read fails unless exactly one SSID matches.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: `Name of the SSID.`,
+				Required:            true,
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: `networkId path parameter. Network ID`,
+				Required:            true,
+			},
+			"item": schema.SingleNestedAttribute{
+				MarkdownDescription: `The SSID that matches.`,
+				Computed:            true,
+				Attributes:          lookupItemAttributes(NewNetworksWirelessSSIDsDataSource(), "items"),
+			},
+		},
+	}
+}
+
+func (d *SSIDDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var ssid SSID
+	diags := req.Config.Get(ctx, &ssid)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vvNetworkID := ssid.NetworkID.ValueString()
+	response, restyResp, err := d.client.Wireless.GetNetworkWirelessSSIDs(vvNetworkID)
+	if err != nil || response == nil {
+		if restyResp != nil {
+			log.Printf("[DEBUG] Retrieved error response %s", restyResp.String())
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing GetNetworkWirelessSSIDs",
+			err.Error(),
+		)
+		return
+	}
+
+	match, diags := lookupOne(*response, func(item merakigosdk.ResponseItemWirelessGetNetworkWirelessSSIDs) bool {
+		return lookupEqual(item.Name, ssid.Name)
+	}, func(item merakigosdk.ResponseItemWirelessGetNetworkWirelessSSIDs) string {
+		if item.Number == nil {
+			return ""
+		}
+		return "number " + strconv.Itoa(*item.Number)
+	}, "SSID", "SSIDs", "network "+vvNetworkID, lookupCriteria("name", ssid.Name))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items := ResponseWirelessGetNetworkWirelessSSIDsItemsToBody(NetworksWirelessSSIDs{}, &merakigosdk.ResponseWirelessGetNetworkWirelessSSIDs{match})
+	ssid.Item = &(*items.Items)[0]
+	diags = resp.State.Set(ctx, &ssid)
+	resp.Diagnostics.Append(diags...)
+}
+
+// structs
+type SSID struct {
+	NetworkID types.String                                 `tfsdk:"network_id"`
+	Name      types.String                                 `tfsdk:"name"`
+	Item      *ResponseItemWirelessGetNetworkWirelessSsids `tfsdk:"item"`
+}
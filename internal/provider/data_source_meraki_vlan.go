@@ -0,0 +1,124 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &VLANDataSource{}
+	_ datasource.DataSourceWithConfigure = &VLANDataSource{}
+)
+
+func NewVLANDataSource() datasource.DataSource {
+	return &VLANDataSource{}
+}
+
+// VLANDataSource looks up the only appliance VLAN of a network with a name.
+type VLANDataSource struct {
+	client *merakigosdk.Client
+}
+
+func (d *VLANDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(MerakiProviderData).Client
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *VLANDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vlan"
+}
+
+func (d *VLANDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the appliance VLAN of a network with its name. The read fails unless exactly one VLAN matches.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: `Name of the VLAN.`,
+				Required:            true,
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: `networkId path parameter. Network ID`,
+				Required:            true,
+			},
+			"item": schema.SingleNestedAttribute{
+				MarkdownDescription: `The VLAN that matches.`,
+				Computed:            true,
+				Attributes:          lookupItemAttributes(NewNetworksApplianceVLANsDataSource(), "items"),
+			},
+		},
+	}
+}
+
+func (d *VLANDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var vlan VLAN
+	diags := req.Config.Get(ctx, &vlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vvNetworkID := vlan.NetworkID.ValueString()
+	response, restyResp, err := d.client.Appliance.GetNetworkApplianceVLANs(vvNetworkID)
+	if err != nil || response == nil {
+		if restyResp != nil {
+			log.Printf("[DEBUG] Retrieved error response %s", restyResp.String())
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing GetNetworkApplianceVLANs",
+			err.Error(),
+		)
+		return
+	}
+
+	match, diags := lookupOne(*response, func(item merakigosdk.ResponseItemApplianceGetNetworkApplianceVLANs) bool {
+		return lookupEqual(item.Name, vlan.Name)
+	}, func(item merakigosdk.ResponseItemApplianceGetNetworkApplianceVLANs) string {
+		if item.ID == nil {
+			return ""
+		}
+		return "VLAN " + strconv.Itoa(*item.ID)
+	}, "VLAN", "VLANs", "network "+vvNetworkID, lookupCriteria("name", vlan.Name))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items := ResponseApplianceGetNetworkApplianceVLANsItemsToBody(NetworksApplianceVLANs{}, &merakigosdk.ResponseApplianceGetNetworkApplianceVLANs{match})
+	vlan.Item = &(*items.Items)[0]
+	diags = resp.State.Set(ctx, &vlan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// structs
+type VLAN struct {
+	NetworkID types.String                                   `tfsdk:"network_id"`
+	Name      types.String                                   `tfsdk:"name"`
+	Item      *ResponseItemApplianceGetNetworkApplianceVlans `tfsdk:"item"`
+}
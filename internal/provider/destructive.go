@@ -0,0 +1,140 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// destructiveOperation is the irreversible call that a resource runs when it is created, like
+// wiping a device or releasing serials from an inventory. It only runs when the provider
+// meraki_allow_destructive_operations flag is set and confirm_target echoes its target.
+type destructiveOperation struct {
+	// Action describes the call and what it changes, like "remove the device Q2XX-XXXX-XXXX from network N_1".
+	Action string
+	// Target is the serial, network or organization acted on, which confirm_target must echo.
+	Target types.String
+	// TargetKind names the target in the diagnostics, like "the serial".
+	TargetKind string
+}
+
+// confirmTargetAttribute returns the confirm_target attribute of a resource with a destructive operation.
+func confirmTargetAttribute(target string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Must be set to %s, to confirm the operation, which cannot be undone. The operation also requires `meraki_allow_destructive_operations` in the provider configuration.", target),
+		Required:            true,
+	}
+}
+
+// checkDestructiveOperation returns an error when destructive operations are not allowed or
+// confirm_target does not echo the target of the operation. Unknown values are checked on apply.
+func checkDestructiveOperation(confirm types.String, op destructiveOperation, allowed bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !allowed {
+		diags.AddError(
+			"Destructive operations are not allowed",
+			fmt.Sprintf("This resource would %s, which cannot be undone. Set meraki_allow_destructive_operations to true in the provider configuration, or the MERAKI_ALLOW_DESTRUCTIVE_OPERATIONS environment variable to true, to allow it.", op.Action),
+		)
+	}
+	if confirm.IsUnknown() || op.Target.IsUnknown() || op.Target.IsNull() {
+		return diags
+	}
+	if confirm.ValueString() != op.Target.ValueString() {
+		diags.AddAttributeError(
+			path.Root("confirm_target"),
+			"Unconfirmed destructive operation",
+			fmt.Sprintf("confirm_target must be set to %s %q to %s, got %q.", op.TargetKind, op.Target.ValueString(), op.Action, confirm.ValueString()),
+		)
+	}
+	return diags
+}
+
+// planDestructiveOperation checks the plan of a new resource with a destructive operation and
+// warns about what the operation changes. The meraki_allow_destructive_operations flag is only
+// checked once the provider is configured, otherwise it is checked on apply.
+func planDestructiveOperation(confirm types.String, op destructiveOperation, allowed, configured bool) diag.Diagnostics {
+	diags := checkDestructiveOperation(confirm, op, allowed || !configured)
+	diags.AddWarning(
+		"Destructive operation",
+		fmt.Sprintf("Applying this plan will %s. This cannot be undone.", op.Action),
+	)
+	return diags
+}
+
+// destructiveConfig reads the configuration of a resource with a destructive operation. Unlike
+// the plan, the configuration tells the parameters that are not set from the ones known after apply.
+func destructiveConfig[T any](ctx context.Context, config tfsdk.Config, data *T) diag.Diagnostics {
+	var item types.Object
+	diags := config.Get(ctx, &item)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(item.As(ctx, data, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})...)
+	return diags
+}
+
+// updateConfirmTarget stores a new confirm_target. The operation already ran, so the rest of the
+// state is kept and nothing is sent to the API.
+func updateConfirmTarget(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var confirm types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("confirm_target"), &confirm)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.Raw = req.State.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("confirm_target"), confirm)...)
+}
+
+// destructiveValue returns the value of a target in the description of an operation.
+func destructiveValue(value types.String) string {
+	if value.IsUnknown() {
+		return "(known after apply)"
+	}
+	if value.IsNull() {
+		return "(not set)"
+	}
+	return value.ValueString()
+}
+
+// destructiveValues returns the values of a list of targets in the description of an operation.
+func destructiveValues(ctx context.Context, list types.List) string {
+	if list.IsUnknown() {
+		return "(known after apply)"
+	}
+	var values []types.String
+	if diags := list.ElementsAs(ctx, &values, false); diags.HasError() {
+		return "(known after apply)"
+	}
+	items := make([]string, 0, len(values))
+	for _, value := range values {
+		items = append(items, destructiveValue(value))
+	}
+	return strings.Join(items, ", ")
+}
@@ -0,0 +1,155 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCheckDestructiveOperation(t *testing.T) {
+	op := destructiveOperation{
+		Action:     "remove the device Q234-ABCD-5678 from network N_1",
+		Target:     types.StringValue("Q234-ABCD-5678"),
+		TargetKind: "the serial",
+	}
+	for _, test := range []struct {
+		name    string
+		confirm types.String
+		target  types.String
+		allowed bool
+		errors  []string
+	}{
+		{"confirmed", types.StringValue("Q234-ABCD-5678"), op.Target, true, nil},
+		{"not allowed", types.StringValue("Q234-ABCD-5678"), op.Target, false, []string{"Destructive operations are not allowed"}},
+		{"other target", types.StringValue("Q234-ABCD-0000"), op.Target, true, []string{"Unconfirmed destructive operation"}},
+		{"unknown confirm_target", types.StringUnknown(), op.Target, true, nil},
+		{"unknown target", types.StringValue("Q234-ABCD-0000"), types.StringUnknown(), true, nil},
+		{"neither", types.StringValue("Q234-ABCD-0000"), op.Target, false, []string{"Destructive operations are not allowed", "Unconfirmed destructive operation"}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			op := op
+			op.Target = test.target
+			var errors []string
+			for _, d := range checkDestructiveOperation(test.confirm, op, test.allowed).Errors() {
+				errors = append(errors, d.Summary())
+			}
+			if strings.Join(errors, ",") != strings.Join(test.errors, ",") {
+				t.Errorf("errors = %q, want %q", errors, test.errors)
+			}
+		})
+	}
+}
+
+// devicesRemovePlan returns the configuration of a new meraki_networks_devices_remove.
+func devicesRemovePlan(t *testing.T, confirm string) (tfsdk.Config, tfsdk.Plan) {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewNetworksDevicesRemoveResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	raw := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"confirm_target": tftypes.NewValue(tftypes.String, confirm),
+		"network_id":     tftypes.NewValue(tftypes.String, "N_1"),
+		"parameters": tftypes.NewValue(typ.AttributeTypes["parameters"], map[string]tftypes.Value{
+			"serial": tftypes.NewValue(tftypes.String, "Q234-ABCD-5678"),
+		}),
+	})
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}, tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw}
+}
+
+func TestDestructiveOperationPlan(t *testing.T) {
+	ctx := context.Background()
+	mock := newMerakiMock(t)
+	for _, test := range []struct {
+		name     string
+		resource *NetworksDevicesRemoveResource
+		confirm  string
+		errors   int
+	}{
+		{"allowed", &NetworksDevicesRemoveResource{client: mock.client(t), allowDestructiveOperations: true}, "Q234-ABCD-5678", 0},
+		{"not allowed", &NetworksDevicesRemoveResource{client: mock.client(t)}, "Q234-ABCD-5678", 1},
+		{"provider not configured", &NetworksDevicesRemoveResource{}, "Q234-ABCD-5678", 0},
+		{"other serial", &NetworksDevicesRemoveResource{client: mock.client(t), allowDestructiveOperations: true}, "N_1", 1},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			config, plan := devicesRemovePlan(t, test.confirm)
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			test.resource.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Config: config,
+				Plan:   plan,
+				State:  tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)},
+			}, resp)
+			if got := resp.Diagnostics.ErrorsCount(); got != test.errors {
+				t.Errorf("errors = %d, want %d: %v", got, test.errors, resp.Diagnostics)
+			}
+			warnings := resp.Diagnostics.Warnings()
+			if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "remove the device Q234-ABCD-5678 from network N_1") {
+				t.Errorf("warnings = %v, want the removal of Q234-ABCD-5678", warnings)
+			}
+		})
+	}
+}
+
+func TestDestructiveOperationCreate(t *testing.T) {
+	ctx := context.Background()
+	mock := newMerakiMock(t)
+	r := &NetworksDevicesRemoveResource{client: mock.client(t)}
+	config, plan := devicesRemovePlan(t, "Q234-ABCD-5678")
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Config: config, Plan: plan}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Create removed the device without meraki_allow_destructive_operations")
+	}
+	if requests := len(mock.requestsFor("POST", "/api/v1/networks/N_1/devices/remove")); requests != 0 {
+		t.Errorf("POST requests = %d, want none", requests)
+	}
+
+	r.allowDestructiveOperations = true
+	resp = &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Config: config, Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	if requests := len(mock.requestsFor("POST", "/api/v1/networks/N_1/devices/remove")); requests != 1 {
+		t.Errorf("POST requests = %d, want 1", requests)
+	}
+}
+
+func TestUpdateConfirmTarget(t *testing.T) {
+	ctx := context.Background()
+	_, plan := devicesRemovePlan(t, "Q234-ABCD-5678")
+	state := tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}
+	if diags := state.SetAttribute(ctx, path.Root("confirm_target"), types.StringNull()); diags.HasError() {
+		t.Fatal(diags)
+	}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	NewNetworksDevicesRemoveResource().Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	if !resp.State.Raw.Equal(plan.Raw) {
+		t.Errorf("state = %s, want %s", resp.State.Raw, plan.Raw)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// EPHEMERAL RESOURCE
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apiKeyRenewInterval is how often Terraform checks that an opened API key was not revoked.
+const apiKeyRenewInterval = 10 * time.Minute
+
+const apiKeyPrivateSuffix = "suffix"
+
+var (
+	_ ephemeral.EphemeralResource              = &APIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &APIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew     = &APIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &APIKeyEphemeralResource{}
+)
+
+func NewAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &APIKeyEphemeralResource{}
+}
+
+type APIKeyEphemeralResource struct {
+	client *merakigosdk.Client
+}
+
+func (e *APIKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(MerakiProviderData).Client
+	e.client = client
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *APIKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (e *APIKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Generates a new API key for the current user when it is opened and revokes it when it is closed. The key is never stored in the plan or the state.`,
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				MarkdownDescription: `API key in plaintext. This value will not be accessible outside of key generation`,
+				Sensitive:           true,
+				Computed:            true,
+			},
+			"suffix": schema.StringAttribute{
+				MarkdownDescription: `Last 4 characters of the API key`,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *APIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data APIKeyEphemeral
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	response, restyResp1, err := e.client.Administered.GenerateAdministeredIDentitiesMeAPIKeys()
+	if err != nil || response == nil {
+		if restyResp1 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing GenerateAdministeredIDentitiesMeAPIKeys",
+				restyResp1.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing GenerateAdministeredIDentitiesMeAPIKeys",
+			err.Error(),
+		)
+		return
+	}
+	suffix := response.Key
+	if len(suffix) > 4 {
+		suffix = suffix[len(suffix)-4:]
+	}
+	data.Key = types.StringValue(response.Key)
+	data.Suffix = types.StringValue(suffix)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	privateSuffix, _ := json.Marshal(suffix)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyPrivateSuffix, privateSuffix)...)
+	resp.RenewAt = time.Now().Add(apiKeyRenewInterval)
+}
+
+// Renew checks that the API key was not revoked while Terraform is still using it.
+func (e *APIKeyEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	suffix, diags := apiKeySuffix(ctx, req.Private)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	response, restyResp1, err := e.client.Administered.GetAdministeredIDentitiesMeAPIKeys()
+	if err != nil || response == nil {
+		if restyResp1 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing GetAdministeredIDentitiesMeAPIKeys",
+				restyResp1.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing GetAdministeredIDentitiesMeAPIKeys",
+			err.Error(),
+		)
+		return
+	}
+	for _, item := range *response {
+		if item.Suffix == suffix {
+			resp.RenewAt = time.Now().Add(apiKeyRenewInterval)
+			return
+		}
+	}
+	resp.Diagnostics.AddError(
+		"API key revoked",
+		"The API key ending with "+suffix+" is no longer listed for the current user.",
+	)
+}
+
+// Close revokes the API key generated by Open.
+func (e *APIKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	suffix, diags := apiKeySuffix(ctx, req.Private)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	restyResp1, err := e.client.Administered.RevokeAdministeredIDentitiesMeAPIKeys(suffix)
+	if err != nil {
+		if restyResp1 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing RevokeAdministeredIDentitiesMeAPIKeys",
+				"Status: "+strconv.Itoa(restyResp1.StatusCode())+"\n"+restyResp1.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing RevokeAdministeredIDentitiesMeAPIKeys",
+			err.Error(),
+		)
+	}
+}
+
+// apiKeySuffix reads the suffix of the API key saved in the private data by Open.
+func apiKeySuffix(ctx context.Context, private interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}) (string, diag.Diagnostics) {
+	var suffix string
+	value, diags := private.GetKey(ctx, apiKeyPrivateSuffix)
+	if diags.HasError() {
+		return suffix, diags
+	}
+	if err := json.Unmarshal(value, &suffix); err != nil || suffix == "" {
+		diags.AddError("Missing API key suffix", "The suffix of the generated API key was not found in the private data.")
+	}
+	return suffix, diags
+}
+
+type APIKeyEphemeral struct {
+	Key    types.String `tfsdk:"key"`
+	Suffix types.String `tfsdk:"suffix"`
+}
@@ -0,0 +1,168 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testAPIKeysPath = "/api/v1/administered/identities/me/api/keys"
+
+// testOpenAPIKey opens a meraki_api_key, whose private data holds the suffix of the key.
+func testOpenAPIKey(t *testing.T, server tfprotov6.ProviderServer) *tfprotov6.OpenEphemeralResourceResponse {
+	t.Helper()
+	ctx := context.Background()
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "meraki_api_key",
+		Config:   testDynamicValue(t, schemas.EphemeralResourceSchemas["meraki_api_key"], nil),
+	})
+	if err != nil || testDiagnosticsError(resp.Diagnostics) != "" {
+		t.Fatalf("opening API key: %v %s", err, testDiagnosticsError(resp.Diagnostics))
+	}
+	return resp
+}
+
+func TestAPIKeyEphemeralOpen(t *testing.T) {
+	mock := newMerakiMock(t, "ephemeral_resources")
+	server := testConfiguredServer(t, mock)
+	ctx := context.Background()
+
+	resp := testOpenAPIKey(t, server)
+	if len(mock.requestsFor("POST", testAPIKeysPath+"/generate")) != 1 {
+		t.Error("Open did not generate an API key")
+	}
+	schemas, _ := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	typ := schemas.EphemeralResourceSchemas["meraki_api_key"].ValueType()
+	result, err := resp.Result.Unmarshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var values map[string]tftypes.Value
+	var key, suffix string
+	if err := result.As(&values); err != nil {
+		t.Fatal(err)
+	}
+	values["key"].As(&key)
+	values["suffix"].As(&suffix)
+	if key != "1234567890abcdef1234567890abcdef1234abcd" || suffix != "abcd" {
+		t.Errorf("opened key = %q with suffix %q, want the generated key with suffix abcd", key, suffix)
+	}
+	if resp.RenewAt.IsZero() {
+		t.Error("Open did not schedule a renewal")
+	}
+	if len(mock.requestsFor("POST", testAPIKeysPath+"/abcd/revoke")) != 0 {
+		t.Error("Open revoked the API key")
+	}
+}
+
+func TestAPIKeyEphemeralRenew(t *testing.T) {
+	mock := newMerakiMock(t, "ephemeral_resources")
+	server := testConfiguredServer(t, mock)
+	ctx := context.Background()
+	opened := testOpenAPIKey(t, server)
+
+	resp, err := server.RenewEphemeralResource(ctx, &tfprotov6.RenewEphemeralResourceRequest{
+		TypeName: "meraki_api_key",
+		Private:  opened.Private,
+	})
+	if err != nil || testDiagnosticsError(resp.Diagnostics) != "" {
+		t.Fatalf("renewing listed API key: %v %s", err, testDiagnosticsError(resp.Diagnostics))
+	}
+	if resp.RenewAt.IsZero() {
+		t.Error("Renew did not schedule the next renewal")
+	}
+
+	// The key was revoked outside of Terraform.
+	mock.setBody(testAPIKeysPath, []interface{}{map[string]interface{}{"suffix": "wxyz"}})
+	resp, err = server.RenewEphemeralResource(ctx, &tfprotov6.RenewEphemeralResourceRequest{
+		TypeName: "meraki_api_key",
+		Private:  opened.Private,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := testDiagnosticsError(resp.Diagnostics); got != "API key revoked" {
+		t.Errorf("renewing revoked API key: error %q, want %q", got, "API key revoked")
+	}
+	if len(mock.requestsFor("POST", testAPIKeysPath+"/abcd/revoke")) != 0 {
+		t.Error("Renew revoked the API key")
+	}
+
+	resp, err = server.RenewEphemeralResource(ctx, &tfprotov6.RenewEphemeralResourceRequest{
+		TypeName: "meraki_api_key",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := testDiagnosticsError(resp.Diagnostics); got != "Missing API key suffix" {
+		t.Errorf("renewing without suffix: error %q, want %q", got, "Missing API key suffix")
+	}
+}
+
+func TestAPIKeyEphemeralClose(t *testing.T) {
+	mock := newMerakiMock(t, "ephemeral_resources")
+	server := testConfiguredServer(t, mock)
+	ctx := context.Background()
+	opened := testOpenAPIKey(t, server)
+
+	resp, err := server.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "meraki_api_key",
+		Private:  opened.Private,
+	})
+	if err != nil || testDiagnosticsError(resp.Diagnostics) != "" {
+		t.Fatalf("closing API key: %v %s", err, testDiagnosticsError(resp.Diagnostics))
+	}
+	if len(mock.requestsFor("POST", testAPIKeysPath+"/abcd/revoke")) != 1 {
+		t.Error("Close did not revoke the API key ending with abcd")
+	}
+
+	// Once revoked, the key is no longer listed and a renewal reports it.
+	mock.setBody(testAPIKeysPath, []interface{}{})
+	renewed, err := server.RenewEphemeralResource(ctx, &tfprotov6.RenewEphemeralResourceRequest{
+		TypeName: "meraki_api_key",
+		Private:  opened.Private,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := testDiagnosticsError(renewed.Diagnostics); got != "API key revoked" {
+		t.Errorf("renewing closed API key: error %q, want %q", got, "API key revoked")
+	}
+
+	// Without the suffix, Close cannot know which key to revoke.
+	resp, err = server.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "meraki_api_key",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := testDiagnosticsError(resp.Diagnostics); !strings.Contains(got, "Missing API key suffix") {
+		t.Errorf("closing without suffix: error %q, want %q", got, "Missing API key suffix")
+	}
+	for _, request := range mock.requestsFor("POST", testAPIKeysPath+"//revoke") {
+		t.Errorf("Close revoked a key without suffix: %+v", request)
+	}
+}
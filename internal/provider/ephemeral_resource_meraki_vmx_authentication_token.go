@@ -0,0 +1,110 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// EPHEMERAL RESOURCE
+
+import (
+	"context"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &VmxAuthenticationTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &VmxAuthenticationTokenEphemeralResource{}
+)
+
+func NewVmxAuthenticationTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &VmxAuthenticationTokenEphemeralResource{}
+}
+
+type VmxAuthenticationTokenEphemeralResource struct {
+	client *merakigosdk.Client
+}
+
+func (e *VmxAuthenticationTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(MerakiProviderData).Client
+	e.client = client
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *VmxAuthenticationTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vmx_authentication_token"
+}
+
+func (e *VmxAuthenticationTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Generates a new vMX authentication token every time it is opened. The token is never stored in the plan or the state.`,
+		Attributes: map[string]schema.Attribute{
+			"serial": schema.StringAttribute{
+				MarkdownDescription: `Serial of the vMX appliance.`,
+				Required:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: `The expiration time for the token, in ISO 8601 format`,
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: `The newly generated authentication token for the vMX instance`,
+				Sensitive:           true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Open generates the token. A vMX token cannot be renewed nor revoked, it expires on its own.
+func (e *VmxAuthenticationTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data VmxAuthenticationTokenEphemeral
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	vvSerial := data.Serial.ValueString()
+	response, restyResp1, err := e.client.Appliance.CreateDeviceApplianceVmxAuthenticationToken(vvSerial)
+	if err != nil || response == nil {
+		if restyResp1 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing CreateDeviceApplianceVmxAuthenticationToken",
+				restyResp1.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing CreateDeviceApplianceVmxAuthenticationToken",
+			err.Error(),
+		)
+		return
+	}
+	data.ExpiresAt = types.StringValue(response.ExpiresAt)
+	data.Token = types.StringValue(response.Token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+type VmxAuthenticationTokenEphemeral struct {
+	Serial    types.String `tfsdk:"serial"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Token     types.String `tfsdk:"token"`
+}
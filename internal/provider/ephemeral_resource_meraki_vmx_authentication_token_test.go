@@ -0,0 +1,63 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestVmxAuthenticationTokenEphemeralOpen(t *testing.T) {
+	mock := newMerakiMock(t, "ephemeral_resources")
+	server := testConfiguredServer(t, mock)
+	ctx := context.Background()
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := schemas.EphemeralResourceSchemas["meraki_vmx_authentication_token"]
+
+	resp, err := server.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "meraki_vmx_authentication_token",
+		Config: testDynamicValue(t, s, map[string]tftypes.Value{
+			"serial": tftypes.NewValue(tftypes.String, "Q2XX-VMX0-0001"),
+		}),
+	})
+	if err != nil || testDiagnosticsError(resp.Diagnostics) != "" {
+		t.Fatalf("opening vMX token: %v %s", err, testDiagnosticsError(resp.Diagnostics))
+	}
+	if len(mock.requestsFor("POST", "/api/v1/devices/Q2XX-VMX0-0001/appliance/vmx/authenticationToken")) != 1 {
+		t.Error("Open did not generate a vMX authentication token")
+	}
+	result, err := resp.Result.Unmarshal(s.ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var values map[string]tftypes.Value
+	var token, expiresAt string
+	if err := result.As(&values); err != nil {
+		t.Fatal(err)
+	}
+	values["token"].As(&token)
+	values["expires_at"].As(&expiresAt)
+	if token != "vmx-token-1234" || expiresAt != "2026-01-01T01:00:00Z" {
+		t.Errorf("opened token = %q expiring at %q, want vmx-token-1234 expiring at 2026-01-01T01:00:00Z", token, expiresAt)
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ExportOptions configures Export.
+type ExportOptions struct {
+	// OrganizationID is the organization to export.
+	OrganizationID string
+	// Dir is the directory where the .tf files are written.
+	Dir string
+	// Warnf reports the networks and devices that were skipped.
+	Warnf func(format string, args ...interface{})
+}
+
+// NewExportClient creates the client of the export command. It is configured from the
+// environment variables of the provider, so the export uses the same region, proxy, TLS,
+// retry, timeout and rate limit settings as Terraform.
+func NewExportClient(ctx context.Context) (*merakigosdk.Client, error) {
+	clients, _, diags := newMerakiClients(ctx, MerakiProviderModel{})
+	for _, d := range diags.Errors() {
+		return nil, fmt.Errorf("%s: %s", d.Summary(), d.Detail())
+	}
+	return clients.defaultClient, nil
+}
+
+// exportedResource is a resource read from the organization.
+type exportedResource struct {
+	typeName string
+	label    string
+	importID string
+	schema   schema.Schema
+	state    tfsdk.State
+}
+
+// exportFiles maps every exported resource type to the file it is written to.
+var exportFiles = []struct {
+	typeName string
+	file     string
+}{
+	{"meraki_networks", "networks.tf"},
+	{"meraki_devices", "devices.tf"},
+	{"meraki_networks_wireless_ssids", "wireless_ssids.tf"},
+	{"meraki_networks_appliance_vlans", "appliance_vlans.tf"},
+	{"meraki_networks_appliance_firewall_l3_firewall_rules", "appliance_firewall_l3_firewall_rules.tf"},
+	{"meraki_devices_switch_ports", "switch_ports.tf"},
+}
+
+const exportImportsFile = "imports.tf"
+
+type exporter struct {
+	ctx       context.Context
+	client    *merakigosdk.Client
+	opts      ExportOptions
+	resources []exportedResource
+	labels    map[string]bool
+}
+
+// Export reads the networks, devices, SSIDs, VLANs, layer 3 firewall rules
+// and switch ports of an organization and writes them as resources of this
+// provider, with an import block for each of them. It returns the files it
+// wrote.
+func Export(ctx context.Context, client *merakigosdk.Client, opts ExportOptions) ([]string, error) {
+	if opts.Warnf == nil {
+		opts.Warnf = func(string, ...interface{}) {}
+	}
+	e := &exporter{
+		ctx:    ctx,
+		client: client,
+		opts:   opts,
+		labels: map[string]bool{},
+	}
+	if err := e.exportNetworks(); err != nil {
+		return nil, err
+	}
+	if err := e.exportDevices(); err != nil {
+		return nil, err
+	}
+	return e.write()
+}
+
+func (e *exporter) exportNetworks() error {
+	organizationID := e.opts.OrganizationID
+	queryParams := merakigosdk.GetOrganizationNetworksQueryParams{PerPage: 1000}
+	networks, restyResp, _, err := paginateList("", 0, func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationNetworks, *resty.Response, error) {
+		queryParams.StartingAfter = startingAfter
+		return e.client.Organizations.GetOrganizationNetworks(organizationID, &queryParams)
+	})
+	if err != nil || networks == nil {
+		return exportError("GetOrganizationNetworks", restyResp, err)
+	}
+
+	for _, network := range *networks {
+		networkLabel := exportLabel(network.Name, network.ID)
+		err := exportResource(e, NewNetworksResource(), networkLabel, []string{"id", network.ID}, network, ResponseNetworksGetNetworkItemToRs)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(network.ProductTypes, "wireless") {
+			e.exportSSIDs(network.ID, networkLabel)
+		}
+		if slices.Contains(network.ProductTypes, "appliance") {
+			e.exportVLANs(network.ID, networkLabel)
+			e.exportL3FirewallRules(network.ID, networkLabel)
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportSSIDs(networkID string, networkLabel string) {
+	ssids, restyResp, err := e.client.Wireless.GetNetworkWirelessSSIDs(networkID)
+	if err != nil || ssids == nil {
+		e.opts.Warnf("skipping the SSIDs of network %s: %s", networkID, exportError("GetNetworkWirelessSSIDs", restyResp, err))
+		return
+	}
+	for _, ssid := range *ssids {
+		// Every network has 15 SSIDs, only export the ones that were configured.
+		if ssid.Number == nil || strings.HasPrefix(ssid.Name, "Unconfigured SSID") {
+			continue
+		}
+		number := strconv.Itoa(*ssid.Number)
+		err := exportResource(e, NewNetworksWirelessSSIDsResource(), networkLabel+"_ssid_"+number, []string{"network_id", networkID, "number", number}, ssid, ResponseWirelessGetNetworkWirelessSSIDItemToRs)
+		if err != nil {
+			e.opts.Warnf("skipping SSID %s of network %s: %s", number, networkID, err)
+		}
+	}
+}
+
+func (e *exporter) exportVLANs(networkID string, networkLabel string) {
+	vlans, restyResp, err := e.client.Appliance.GetNetworkApplianceVLANs(networkID)
+	if err != nil || vlans == nil {
+		// Meraki answers 400 when VLANs are not enabled on the network.
+		e.opts.Warnf("skipping the VLANs of network %s: %s", networkID, exportError("GetNetworkApplianceVLANs", restyResp, err))
+		return
+	}
+	for _, vlan := range *vlans {
+		if vlan.ID == nil {
+			continue
+		}
+		vlanID := strconv.Itoa(*vlan.ID)
+		err := exportResource(e, NewNetworksApplianceVLANsResource(), networkLabel+"_vlan_"+vlanID, []string{"network_id", networkID, "id", vlanID}, vlan, ResponseApplianceGetNetworkApplianceVLANItemToRs)
+		if err != nil {
+			e.opts.Warnf("skipping VLAN %s of network %s: %s", vlanID, networkID, err)
+		}
+	}
+}
+
+func (e *exporter) exportL3FirewallRules(networkID string, networkLabel string) {
+	rules, restyResp, err := e.client.Appliance.GetNetworkApplianceFirewallL3FirewallRules(networkID)
+	if err != nil || rules == nil {
+		e.opts.Warnf("skipping the layer 3 firewall rules of network %s: %s", networkID, exportError("GetNetworkApplianceFirewallL3FirewallRules", restyResp, err))
+		return
+	}
+	err = exportResource(e, NewNetworksApplianceFirewallL3FirewallRulesResource(), networkLabel, []string{"network_id", networkID}, rules, ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesItemToRs)
+	if err != nil {
+		e.opts.Warnf("skipping the layer 3 firewall rules of network %s: %s", networkID, err)
+	}
+}
+
+func (e *exporter) exportDevices() error {
+	organizationID := e.opts.OrganizationID
+	queryParams := merakigosdk.GetOrganizationDevicesQueryParams{PerPage: 1000}
+	devices, restyResp, _, err := paginateList("", 0, func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationDevices, *resty.Response, error) {
+		queryParams.StartingAfter = startingAfter
+		return e.client.Organizations.GetOrganizationDevices(organizationID, &queryParams)
+	})
+	if err != nil || devices == nil {
+		return exportError("GetOrganizationDevices", restyResp, err)
+	}
+
+	for _, device := range *devices {
+		deviceLabel := exportLabel(device.Name, device.Serial)
+		err := exportResource(e, NewDevicesResource(), deviceLabel, []string{"serial", device.Serial}, device, ResponseDevicesGetDeviceItemToRs)
+		if err != nil {
+			return err
+		}
+		if device.ProductType == "switch" {
+			e.exportSwitchPorts(device.Serial, deviceLabel)
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportSwitchPorts(serial string, deviceLabel string) {
+	ports, restyResp, err := e.client.Switch.GetDeviceSwitchPorts(serial)
+	if err != nil || ports == nil {
+		e.opts.Warnf("skipping the switch ports of device %s: %s", serial, exportError("GetDeviceSwitchPorts", restyResp, err))
+		return
+	}
+	for _, port := range *ports {
+		err := exportResource(e, NewDevicesSwitchPortsResource(), deviceLabel+"_port_"+port.PortID, []string{"serial", serial, "port_id", port.PortID}, port, ResponseSwitchGetDeviceSwitchPortItemToRs)
+		if err != nil {
+			e.opts.Warnf("skipping port %s of device %s: %s", port.PortID, serial, err)
+		}
+	}
+}
+
+// exportResource builds the state of a resource from the response of the API,
+// with the ItemToRs function of the resource, and from the import attributes.
+// item is a list item of the API, it is converted to the response type T of
+// the resource.
+func exportResource[Rs any, T any](e *exporter, r resource.Resource, label string, importAttributes []string, item interface{}, itemToRs func(state Rs, response *T) Rs) error {
+	var metadataResp resource.MetadataResponse
+	r.Metadata(e.ctx, resource.MetadataRequest{ProviderTypeName: "meraki"}, &metadataResp)
+	var schemaResp resource.SchemaResponse
+	r.Schema(e.ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return fmt.Errorf("%s schema: %v", metadataResp.TypeName, schemaResp.Diagnostics)
+	}
+
+	response := new(T)
+	body, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, response); err != nil {
+		return err
+	}
+	// The attributes of the state start null, with their types.
+	objectType := schemaResp.Schema.Type().TerraformType(e.ctx).(tftypes.Object)
+	nulls := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		nulls[name] = tftypes.NewValue(attributeType, nil)
+	}
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, nulls),
+	}
+	var data Rs
+	if diags := state.Get(e.ctx, &data); diags.HasError() {
+		return fmt.Errorf("%s: %v", metadataResp.TypeName, diags)
+	}
+	data = itemToRs(data, response)
+	if diags := state.Set(e.ctx, &data); diags.HasError() {
+		return fmt.Errorf("%s: %v", metadataResp.TypeName, diags)
+	}
+	var importID []string
+	for i := 0; i < len(importAttributes); i += 2 {
+		diags := state.SetAttribute(e.ctx, path.Root(importAttributes[i]), importAttributes[i+1])
+		if diags.HasError() {
+			return fmt.Errorf("%s: %v", metadataResp.TypeName, diags)
+		}
+		importID = append(importID, importAttributes[i+1])
+	}
+
+	e.resources = append(e.resources, exportedResource{
+		typeName: metadataResp.TypeName,
+		label:    e.uniqueLabel(metadataResp.TypeName, label),
+		importID: strings.Join(importID, importIDSeparator),
+		schema:   schemaResp.Schema,
+		state:    state,
+	})
+	return nil
+}
+
+var exportLabelRegexp = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// exportLabel returns a resource label made of the name, or of the ID when
+// the name is empty.
+func exportLabel(name string, id string) string {
+	label := strings.Trim(exportLabelRegexp.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" {
+		label = strings.Trim(exportLabelRegexp.ReplaceAllString(strings.ToLower(id), "_"), "_")
+	}
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "r_" + label
+	}
+	return label
+}
+
+// uniqueLabel adds a numeric suffix to labels that are already used by a
+// resource of the same type.
+func (e *exporter) uniqueLabel(typeName string, label string) string {
+	unique := label
+	for i := 2; e.labels[typeName+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	e.labels[typeName+"."+unique] = true
+	return unique
+}
+
+func (e *exporter) write() ([]string, error) {
+	if err := os.MkdirAll(e.opts.Dir, 0o755); err != nil {
+		return nil, err
+	}
+	header := fmt.Sprintf("# Exported from the Meraki organization %s by terraform-provider-meraki export.\n\n", e.opts.OrganizationID)
+
+	var written []string
+	imports := hclwrite.NewEmptyFile()
+	for _, exportFile := range exportFiles {
+		f := hclwrite.NewEmptyFile()
+		count := 0
+		for _, r := range e.resources {
+			if r.typeName != exportFile.typeName {
+				continue
+			}
+			if count > 0 {
+				f.Body().AppendNewline()
+			}
+			block := f.Body().AppendNewBlock("resource", []string{r.typeName, r.label})
+			if err := writeExportAttributes(block.Body(), r.schema.Attributes, r.state.Raw); err != nil {
+				return written, fmt.Errorf("%s.%s: %w", r.typeName, r.label, err)
+			}
+
+			if len(imports.Body().Blocks()) > 0 {
+				imports.Body().AppendNewline()
+			}
+			importBlock := imports.Body().AppendNewBlock("import", nil)
+			importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+				hcl.TraverseRoot{Name: r.typeName},
+				hcl.TraverseAttr{Name: r.label},
+			})
+			importBlock.Body().SetAttributeValue("id", ctyString(r.importID))
+			count++
+		}
+		if count == 0 {
+			continue
+		}
+		name := filepath.Join(e.opts.Dir, exportFile.file)
+		if err := os.WriteFile(name, append([]byte(header), hclwrite.Format(f.Bytes())...), 0o644); err != nil {
+			return written, err
+		}
+		written = append(written, name)
+	}
+	name := filepath.Join(e.opts.Dir, exportImportsFile)
+	if err := os.WriteFile(name, append([]byte(header), hclwrite.Format(imports.Bytes())...), 0o644); err != nil {
+		return written, err
+	}
+	return append(written, name), nil
+}
+
+func exportError(operation string, restyResp *resty.Response, err error) error {
+	if restyResp != nil {
+		return fmt.Errorf("failure when executing %s: Status: %d\n%s", operation, restyResp.StatusCode(), restyResp.String())
+	}
+	if err != nil {
+		return fmt.Errorf("failure when executing %s: %w", operation, err)
+	}
+	return fmt.Errorf("failure when executing %s: empty response", operation)
+}
+
+// sortedAttributeNames returns the attribute names in alphabetical order.
+func sortedAttributeNames(attributes map[string]schema.Attribute) []string {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,203 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// writeExportAttributes writes the configurable attributes of an object to
+// an HCL body. Computed-only, write-only and null attributes are skipped, and
+// sensitive attributes are replaced with a comment.
+func writeExportAttributes(body *hclwrite.Body, attributes map[string]schema.Attribute, value tftypes.Value) error {
+	values := map[string]tftypes.Value{}
+	if err := value.As(&values); err != nil {
+		return err
+	}
+	for _, name := range sortedAttributeNames(attributes) {
+		attribute := attributes[name]
+		if !isExportedAttribute(attribute, values[name]) {
+			continue
+		}
+		if attribute.IsSensitive() {
+			body.AppendUnstructuredTokens(hclwrite.Tokens{
+				{Type: hclsyntax.TokenComment, Bytes: []byte(fmt.Sprintf("# %s is sensitive and was not exported.\n", name))},
+			})
+			continue
+		}
+		v, ok, err := exportAttributeValue(attribute, values[name])
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if ok {
+			body.SetAttributeValue(name, v)
+		}
+	}
+	return nil
+}
+
+func isExportedAttribute(attribute schema.Attribute, value tftypes.Value) bool {
+	if !attribute.IsRequired() && !attribute.IsOptional() {
+		return false
+	}
+	if attribute.IsWriteOnly() {
+		return false
+	}
+	return value.IsKnown() && !value.IsNull()
+}
+
+// exportAttributeValue converts the value of an attribute to cty. Nested
+// attributes only keep their configurable attributes, and it returns false
+// for nested objects that have none.
+func exportAttributeValue(attribute schema.Attribute, value tftypes.Value) (cty.Value, bool, error) {
+	switch a := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return exportObjectValue(a.Attributes, value)
+	case schema.ListNestedAttribute:
+		return exportNestedObjects(a.NestedObject.Attributes, value)
+	case schema.SetNestedAttribute:
+		return exportNestedObjects(a.NestedObject.Attributes, value)
+	case schema.MapNestedAttribute:
+		items := map[string]tftypes.Value{}
+		if err := value.As(&items); err != nil {
+			return cty.NilVal, false, err
+		}
+		result := map[string]cty.Value{}
+		for key, item := range items {
+			v, _, err := exportObjectValue(a.NestedObject.Attributes, item)
+			if err != nil {
+				return cty.NilVal, false, err
+			}
+			result[key] = v
+		}
+		return cty.ObjectVal(result), true, nil
+	}
+	v, err := exportValue(value)
+	return v, err == nil, err
+}
+
+func exportNestedObjects(attributes map[string]schema.Attribute, value tftypes.Value) (cty.Value, bool, error) {
+	var items []tftypes.Value
+	if err := value.As(&items); err != nil {
+		return cty.NilVal, false, err
+	}
+	if len(items) == 0 {
+		return cty.EmptyTupleVal, true, nil
+	}
+	result := make([]cty.Value, 0, len(items))
+	for _, item := range items {
+		v, _, err := exportObjectValue(attributes, item)
+		if err != nil {
+			return cty.NilVal, false, err
+		}
+		result = append(result, v)
+	}
+	return cty.TupleVal(result), true, nil
+}
+
+func exportObjectValue(attributes map[string]schema.Attribute, value tftypes.Value) (cty.Value, bool, error) {
+	values := map[string]tftypes.Value{}
+	if err := value.As(&values); err != nil {
+		return cty.NilVal, false, err
+	}
+	result := map[string]cty.Value{}
+	for name, attribute := range attributes {
+		if !isExportedAttribute(attribute, values[name]) || attribute.IsSensitive() {
+			continue
+		}
+		v, ok, err := exportAttributeValue(attribute, values[name])
+		if err != nil {
+			return cty.NilVal, false, fmt.Errorf("%s: %w", name, err)
+		}
+		if ok {
+			result[name] = v
+		}
+	}
+	if len(result) == 0 {
+		return cty.EmptyObjectVal, false, nil
+	}
+	return cty.ObjectVal(result), true, nil
+}
+
+// exportValue converts a value that has no schema, like the value of a list
+// attribute, to cty.
+func exportValue(value tftypes.Value) (cty.Value, error) {
+	if value.IsNull() || !value.IsKnown() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+	typ := value.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := value.As(&s)
+		return cty.StringVal(s), err
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		err := value.As(&n)
+		return cty.NumberVal(n), err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+		return cty.BoolVal(b), err
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var items []tftypes.Value
+		if err := value.As(&items); err != nil {
+			return cty.NilVal, err
+		}
+		if len(items) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+		result := make([]cty.Value, 0, len(items))
+		for _, item := range items {
+			v, err := exportValue(item)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			result = append(result, v)
+		}
+		return cty.TupleVal(result), nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		items := map[string]tftypes.Value{}
+		if err := value.As(&items); err != nil {
+			return cty.NilVal, err
+		}
+		result := map[string]cty.Value{}
+		for key, item := range items {
+			if item.IsNull() {
+				continue
+			}
+			v, err := exportValue(item)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			result[key] = v
+		}
+		return cty.ObjectVal(result), nil
+	}
+	return cty.NilVal, fmt.Errorf("unsupported type %s", typ)
+}
+
+func ctyString(s string) cty.Value {
+	return cty.StringVal(s)
+}
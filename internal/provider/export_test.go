@@ -0,0 +1,140 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExport(t *testing.T) {
+	mock := newMerakiMock(t, "organizations_networks", "organization_export")
+	dir := t.TempDir()
+	var warnings []string
+	files, err := Export(context.Background(), mock.client(t), ExportOptions{
+		OrganizationID: "2930418",
+		Dir:            dir,
+		Warnf: func(format string, args ...interface{}) {
+			warnings = append(warnings, fmt.Sprintf(format, args...))
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 7 {
+		t.Errorf("Export() wrote %d files, want 7: %v", len(files), files)
+	}
+
+	read := func(name string) string {
+		t.Helper()
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(content)
+	}
+
+	networks := read("networks.tf")
+	for _, want := range []string{
+		`resource "meraki_networks" "main_office" {`,
+		`resource "meraki_networks" "branch_2" {`,
+		`  name              = "Main Office"`,
+		`  organization_id   = "2930418"`,
+	} {
+		if !strings.Contains(networks, want) {
+			t.Errorf("networks.tf does not contain %q:\n%s", want, networks)
+		}
+	}
+
+	ssids := read("wireless_ssids.tf")
+	if !strings.Contains(ssids, `resource "meraki_networks_wireless_ssids" "main_office_ssid_0" {`) {
+		t.Errorf("wireless_ssids.tf does not contain the Corp SSID:\n%s", ssids)
+	}
+	if strings.Contains(ssids, "Unconfigured SSID") {
+		t.Errorf("wireless_ssids.tf contains an unconfigured SSID:\n%s", ssids)
+	}
+	if strings.Contains(ssids, "deadbeef") || strings.Contains(ssids, "psk =") {
+		t.Errorf("wireless_ssids.tf exports the PSK:\n%s", ssids)
+	}
+
+	rules := read("appliance_firewall_l3_firewall_rules.tf")
+	if !strings.Contains(rules, `dest_cidr      = "8.8.8.8/32"`) {
+		t.Errorf("appliance_firewall_l3_firewall_rules.tf does not contain the rule:\n%s", rules)
+	}
+
+	imports := read("imports.tf")
+	for _, want := range []string{
+		"  to = meraki_networks.main_office\n  id = \"N_24329156\"",
+		"  to = meraki_networks_wireless_ssids.main_office_ssid_0\n  id = \"N_24329156,0\"",
+		"  to = meraki_networks_appliance_vlans.main_office_vlan_10\n  id = \"N_24329156,10\"",
+		"  to = meraki_devices.core_switch\n  id = \"Q2XX-AAAA-0001\"",
+		"  to = meraki_devices_switch_ports.core_switch_port_1\n  id = \"Q2XX-AAAA-0001,1\"",
+	} {
+		if !strings.Contains(imports, want) {
+			t.Errorf("imports.tf does not contain %q:\n%s", want, imports)
+		}
+	}
+
+	// Branch 1 is an appliance network without VLANs or firewall rules in the mock.
+	if len(warnings) != 2 {
+		t.Errorf("got %d warnings, want 2: %v", len(warnings), warnings)
+	}
+}
+
+func TestExportLabel(t *testing.T) {
+	cases := map[string][2]string{
+		"main_office":  {"Main Office", "N_1"},
+		"n_1":          {"", "N_1"},
+		"r_2nd_floor":  {"2nd floor", "N_1"},
+		"caf_wi_fi":    {"Café Wi-Fi", "N_1"},
+		"q2xx_aaaa_01": {"", "Q2XX-AAAA-01"},
+	}
+	for want, args := range cases {
+		if got := exportLabel(args[0], args[1]); got != want {
+			t.Errorf("exportLabel(%q, %q) = %q, want %q", args[0], args[1], got, want)
+		}
+	}
+}
+
+func TestNewExportClient(t *testing.T) {
+	mock := newMerakiMock(t, "organizations_networks")
+	// The base URL is not resolvable, the requests only reach the mock through the proxy.
+	t.Setenv("MERAKI_BASE_URL", "http://api.meraki.invalid/")
+	t.Setenv("MERAKI_PROXY_URL", mock.Server.URL)
+	t.Setenv("MERAKI_DASHBOARD_API_KEY", testAccApiKey)
+	client, err := NewExportClient(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	networks, _, err := client.Organizations.GetOrganizationNetworks("2930418", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if networks == nil || len(*networks) == 0 {
+		t.Error("GetOrganizationNetworks() through the proxy returned no networks")
+	}
+
+	t.Setenv("MERAKI_BASE_URL", "")
+	t.Setenv("MERAKI_REGION", "mars")
+	if _, err := NewExportClient(context.Background()); err == nil || !strings.Contains(err.Error(), "MERAKI_REGION") {
+		t.Errorf("NewExportClient() with an invalid region = %v, want a MERAKI_REGION error", err)
+	}
+}
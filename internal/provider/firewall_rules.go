@@ -0,0 +1,281 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// firewallRuleLocks holds a mutex per rule list. The rule resources of a list are applied in
+// parallel and each one reads and writes the whole list, so the updates must not interleave.
+var firewallRuleLocks sync.Map
+
+// errFirewallRulesNotFound is returned when the network or the SSID of a rule list does not
+// exist.
+var errFirewallRulesNotFound = errors.New("rule list not found")
+
+// firewallRules is the ordered rule list of a firewall, managed one rule at a time. T is the
+// rule type of the update request: the rules of the list are converted to it, so the rules
+// owned elsewhere are written back unchanged.
+//
+// A rule has no ID. It is found by comparing it with equal, and its priority is its 1-based
+// position in the list. When the list has less rules than the priority, the rule is the last
+// one.
+type firewallRules[T any] struct {
+	// key identifies the list, like "networks/N_1/appliance/firewall/l3FirewallRules".
+	key          string
+	getOperation string
+	putOperation string
+	get          func() ([]T, *resty.Response, error)
+	put          func(rules []T) (*resty.Response, error)
+	equal        func(a, b T) bool
+}
+
+func (f firewallRules[T]) lock() func() {
+	mu, _ := firewallRuleLocks.LoadOrStore(f.key, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+func (f firewallRules[T]) list() ([]T, error) {
+	rules, restyResp, err := f.get()
+	if err != nil || restyResp == nil || restyResp.IsError() {
+		if restyResp != nil && restyResp.StatusCode() == http.StatusNotFound {
+			return nil, errFirewallRulesNotFound
+		}
+		return nil, firewallRulesError(f.getOperation, restyResp, err)
+	}
+	return rules, nil
+}
+
+func (f firewallRules[T]) write(rules []T) error {
+	restyResp, err := f.put(rules)
+	if err != nil || restyResp == nil || restyResp.IsError() {
+		return firewallRulesError(f.putOperation, restyResp, err)
+	}
+	return nil
+}
+
+// Read returns the priority of the rule, or false when the list does not have it anymore. The
+// priority is unchanged while the rule is where a rule with this priority is inserted.
+func (f firewallRules[T]) Read(rule T, priority int64) (int64, bool, error) {
+	rules, err := f.list()
+	if err != nil {
+		return 0, false, err
+	}
+	i := firewallRuleIndex(rules, rule, priority, f.equal)
+	if i < 0 {
+		return 0, false, nil
+	}
+	if i == firewallRulePosition(priority, len(rules)-1) {
+		return priority, true, nil
+	}
+	return int64(i) + 1, true, nil
+}
+
+// At returns the rule with the priority, or false when the list has less rules. It is used on
+// import, when the state only has the priority.
+func (f firewallRules[T]) At(priority int64) (T, bool, error) {
+	var rule T
+	rules, err := f.list()
+	if err != nil {
+		return rule, false, err
+	}
+	if priority < 1 || priority > int64(len(rules)) {
+		return rule, false, nil
+	}
+	return rules[priority-1], true, nil
+}
+
+// Insert adds the rule at the position of the priority. The rules after it move down.
+func (f firewallRules[T]) Insert(rule T, priority int64) error {
+	defer f.lock()()
+	rules, err := f.list()
+	if err != nil {
+		return err
+	}
+	return f.write(firewallRuleInsert(rules, rule, priority))
+}
+
+// Replace removes the old rule and inserts the new one at the position of its priority. The new
+// rule is inserted even when the old one was removed from the list outside of Terraform.
+func (f firewallRules[T]) Replace(old T, oldPriority int64, rule T, priority int64) error {
+	defer f.lock()()
+	rules, err := f.list()
+	if err != nil {
+		return err
+	}
+	if i := firewallRuleIndex(rules, old, oldPriority, f.equal); i >= 0 {
+		rules = append(rules[:i:i], rules[i+1:]...)
+	}
+	return f.write(firewallRuleInsert(rules, rule, priority))
+}
+
+// Remove deletes the rule from the list. It does nothing when the list does not have the rule.
+func (f firewallRules[T]) Remove(rule T, priority int64) error {
+	defer f.lock()()
+	rules, err := f.list()
+	if errors.Is(err, errFirewallRulesNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	i := firewallRuleIndex(rules, rule, priority, f.equal)
+	if i < 0 {
+		return nil
+	}
+	return f.write(append(rules[:i:i], rules[i+1:]...))
+}
+
+// firewallRuleIndex returns the index of the rule in the list, or -1. When the list has several
+// equal rules, it returns the one nearest to the position of the priority.
+func firewallRuleIndex[T any](rules []T, rule T, priority int64, equal func(a, b T) bool) int {
+	index := -1
+	position := firewallRulePosition(priority, len(rules)-1)
+	for i := range rules {
+		if !equal(rules[i], rule) {
+			continue
+		}
+		if index < 0 || firewallRuleDistance(i, position) < firewallRuleDistance(index, position) {
+			index = i
+		}
+	}
+	return index
+}
+
+// firewallRulePosition returns the index of a rule with the priority in a list that has n other
+// rules.
+func firewallRulePosition(priority int64, n int) int {
+	if priority < 1 {
+		return 0
+	}
+	if priority > int64(n) {
+		return n
+	}
+	return int(priority) - 1
+}
+
+func firewallRuleInsert[T any](rules []T, rule T, priority int64) []T {
+	i := firewallRulePosition(priority, len(rules))
+	inserted := make([]T, 0, len(rules)+1)
+	inserted = append(inserted, rules[:i]...)
+	inserted = append(inserted, rule)
+	return append(inserted, rules[i:]...)
+}
+
+func firewallRuleDistance(a, b int) int {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// firewallRuleFieldEqual compares a field of two rules. The API changes the case of some
+// values, like "any", removes the spaces after the commas of lists and returns "Any" for the
+// fields that were not set.
+func firewallRuleFieldEqual(a, b string) bool {
+	normalize := func(value string) string {
+		if value == "" {
+			return "any"
+		}
+		parts := strings.Split(value, ",")
+		for i, part := range parts {
+			parts[i] = strings.TrimSpace(part)
+		}
+		return strings.Join(parts, ",")
+	}
+	return strings.EqualFold(normalize(a), normalize(b))
+}
+
+// firewallRuleBoolEqual compares an optional flag of two rules, where nil is false.
+func firewallRuleBoolEqual(a, b *bool) bool {
+	return (a != nil && *a) == (b != nil && *b)
+}
+
+// importFirewallRule imports a rule resource from an identifier made of the attributes of its
+// list, like "network_id", followed by the priority of the rule, or from the identity of an
+// import block with the same attributes. Read fills the rule.
+func importFirewallRule(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes ...string) {
+	parts := importIdentifierParts(ctx, req, resp, parseImportID, append(attributes, "priority")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	priority, err := strconv.ParseInt(parts[len(attributes)], 10, 64)
+	if err != nil || priority < 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a priority of 1 or more as the last part of the import identifier. Got: %q.", parts[len(attributes)]),
+		)
+		return
+	}
+	for i, attribute := range attributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), parts[i])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("priority"), priority)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func firewallRulesError(operation string, restyResp *resty.Response, err error) error {
+	if restyResp != nil {
+		return fmt.Errorf("failure when executing %s: Status: %d\n%s", operation, restyResp.StatusCode(), restyResp.String())
+	}
+	if err == nil {
+		err = fmt.Errorf("empty response")
+	}
+	return fmt.Errorf("failure when executing %s: %w", operation, err)
+}
+
+// firewallRuleStringToRs returns a field of an imported rule.
+func firewallRuleStringToRs(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// firewallL7RuleValue returns the value of an L7 rule as a string: the ID of an application or
+// of a category, or the sorted countries joined with commas.
+func firewallL7RuleValue(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case []string:
+		countries := append([]string{}, value...)
+		sort.Strings(countries)
+		return strings.Join(countries, ",")
+	case merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL7FirewallRulesRulesValue:
+		return value.ID
+	case merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRulesValue:
+		return value.ID
+	}
+	return ""
+}
@@ -0,0 +1,276 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testL3FirewallRulesPath = "/api/v1/networks/N_24329156/appliance/firewall/l3FirewallRules"
+
+// testFirewallRuleComments returns the comments of the rules of a list stored in the mock.
+func testFirewallRuleComments(t *testing.T, mock *merakiMock, path string) []string {
+	t.Helper()
+	body, ok := mock.body(path)
+	if !ok {
+		t.Fatalf("%s not found", path)
+	}
+	comments := []string{}
+	for _, rule := range body.(map[string]interface{})["rules"].([]interface{}) {
+		comments = append(comments, fmt.Sprint(rule.(map[string]interface{})["comment"]))
+	}
+	return comments
+}
+
+func TestFirewallRulesKeepRulesOwnedElsewhere(t *testing.T) {
+	mock := newMerakiMock(t, "firewall_rules")
+	rules := networksApplianceFirewallL3FirewallRules(mock.client(t), "N_24329156")
+
+	web := merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules{
+		Comment:  "Allow web",
+		Policy:   "allow",
+		Protocol: "tcp",
+		SrcCidr:  "Any",
+		DestCidr: "10.0.1.0/24",
+		DestPort: "443",
+	}
+	ssh := merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules{
+		Comment:  "Block SSH",
+		Policy:   "deny",
+		Protocol: "tcp",
+		SrcCidr:  "any",
+		DestCidr: "10.0.0.0/8, 172.16.0.0/12",
+		DestPort: "22",
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, insert := range []func() error{
+		func() error { return rules.Insert(web, 2) },
+		func() error { return rules.Insert(ssh, 10) },
+	} {
+		wg.Add(1)
+		go func(i int, insert func() error) {
+			defer wg.Done()
+			errs[i] = insert()
+		}(i, insert)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("insert %d: %s", i, err)
+		}
+	}
+	want := []string{"Allow DNS", "Allow web", "Block guests", "Block SSH"}
+	if got := testFirewallRuleComments(t, mock, testL3FirewallRulesPath); !reflect.DeepEqual(got, want) {
+		t.Fatalf("rules = %v, want %v", got, want)
+	}
+
+	// The API returns the rule with other spaces and case, and without a source port.
+	body, _ := mock.body(testL3FirewallRulesPath)
+	stored := body.(map[string]interface{})["rules"].([]interface{})
+	stored[3] = map[string]interface{}{
+		"comment":       "Block SSH",
+		"policy":        "deny",
+		"protocol":      "tcp",
+		"srcPort":       "Any",
+		"srcCidr":       "Any",
+		"destPort":      "22",
+		"destCidr":      "10.0.0.0/8,172.16.0.0/12",
+		"syslogEnabled": false,
+	}
+	mock.setBody(testL3FirewallRulesPath, map[string]interface{}{"rules": stored})
+	priority, found, err := rules.Read(ssh, 10)
+	if err != nil || !found {
+		t.Fatalf("read = %t, %v", found, err)
+	}
+	if priority != 10 {
+		t.Errorf("priority of the last rule = %d, want 10", priority)
+	}
+	priority, found, err = rules.Read(web, 1)
+	if err != nil || !found {
+		t.Fatalf("read = %t, %v", found, err)
+	}
+	if priority != 2 {
+		t.Errorf("priority of the moved rule = %d, want 2", priority)
+	}
+
+	if err := rules.Replace(web, 2, web, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := rules.Remove(ssh, 10); err != nil {
+		t.Fatal(err)
+	}
+	want = []string{"Allow web", "Allow DNS", "Block guests"}
+	if got := testFirewallRuleComments(t, mock, testL3FirewallRulesPath); !reflect.DeepEqual(got, want) {
+		t.Fatalf("rules = %v, want %v", got, want)
+	}
+	body, _ = mock.body(testL3FirewallRulesPath)
+	guests := body.(map[string]interface{})["rules"].([]interface{})[2].(map[string]interface{})
+	if guests["srcCidr"] != "192.168.100.0/24" || guests["syslogEnabled"] != true {
+		t.Errorf("rule owned elsewhere changed: %v", guests)
+	}
+}
+
+func TestFirewallRulesSkipBuiltInRules(t *testing.T) {
+	mock := newMerakiMock(t, "firewall_rules")
+	rules := networksWirelessSSIDsFirewallL3FirewallRules(mock.client(t), "N_24329156", "0")
+
+	rule := merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules{
+		Comment:  "Block cameras",
+		Policy:   "deny",
+		Protocol: "any",
+		DestCidr: "10.2.0.0/16",
+	}
+	if err := rules.Insert(rule, 5); err != nil {
+		t.Fatal(err)
+	}
+	path := "/api/v1/networks/N_24329156/wireless/ssids/0/firewall/l3FirewallRules"
+	want := []string{"Block printers", "Block cameras"}
+	if got := testFirewallRuleComments(t, mock, path); !reflect.DeepEqual(got, want) {
+		t.Fatalf("rules = %v, want %v", got, want)
+	}
+	request, _ := mock.lastRequest("PUT", path)
+	printers := request.Body.(map[string]interface{})["rules"].([]interface{})[0].(map[string]interface{})
+	if printers["ipVer"] != "ipv4" {
+		t.Errorf("ipVer of the rule owned elsewhere not written back: %v", printers)
+	}
+}
+
+func TestFirewallRulesL7Values(t *testing.T) {
+	mock := newMerakiMock(t, "firewall_rules")
+	rules := networksApplianceFirewallL7FirewallRules(mock.client(t), "N_24329156")
+
+	host := merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL7FirewallRulesRules{
+		Policy: "deny",
+		Type:   "host",
+		Value:  "example.com",
+	}
+	if err := rules.Insert(host, 1); err != nil {
+		t.Fatal(err)
+	}
+	countries := merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL7FirewallRulesRules{
+		Policy: "deny",
+		Type:   "blockedCountries",
+		Value:  []string{"CN", "RU"},
+	}
+	priority, found, err := rules.Read(countries, 2)
+	if err != nil || !found || priority != 2 {
+		t.Fatalf("read = %d, %t, %v, want 2, true", priority, found, err)
+	}
+	body, _ := mock.body("/api/v1/networks/N_24329156/appliance/firewall/l7FirewallRules")
+	got := body.(map[string]interface{})["rules"].([]interface{})
+	if len(got) != 2 || got[0].(map[string]interface{})["value"] != "example.com" {
+		t.Errorf("rules = %v", got)
+	}
+}
+
+func TestFirewallRulePosition(t *testing.T) {
+	tests := []struct {
+		priority int64
+		n        int
+		want     int
+	}{
+		{1, 0, 0},
+		{1, 3, 0},
+		{3, 3, 2},
+		{4, 3, 3},
+		{10, 3, 3},
+	}
+	for _, test := range tests {
+		if got := firewallRulePosition(test.priority, test.n); got != test.want {
+			t.Errorf("firewallRulePosition(%d, %d) = %d, want %d", test.priority, test.n, got, test.want)
+		}
+	}
+}
+
+func TestAccMerakiNetworksApplianceFirewallL3FirewallRule_basic(t *testing.T) {
+	mock := newMerakiMock(t, "firewall_rules")
+	resourceName := "meraki_networks_appliance_firewall_l3_firewall_rule.web"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMerakiFirewallRules(t, mock, "Allow DNS", "Block guests"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMerakiNetworksApplianceFirewallL3FirewallRuleConfig(mock, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "2"),
+					testAccCheckMerakiFirewallRules(t, mock, "Allow DNS", "Allow web", "Block guests", "Block SSH"),
+				),
+			},
+			{
+				Config: testAccMerakiNetworksApplianceFirewallL3FirewallRuleConfig(mock, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					testAccCheckMerakiFirewallRules(t, mock, "Allow web", "Allow DNS", "Block guests", "Block SSH"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        "N_24329156,1",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "priority",
+				ImportStateVerifyIgnore:              []string{"src_port", "syslog_enabled"},
+			},
+		},
+	})
+}
+
+// testAccCheckMerakiFirewallRules checks the comments of the L3 firewall rules of the network.
+func testAccCheckMerakiFirewallRules(t *testing.T, mock *merakiMock, comments ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if got := testFirewallRuleComments(t, mock, testL3FirewallRulesPath); !reflect.DeepEqual(got, comments) {
+			return fmt.Errorf("rules = %v, want %v", got, comments)
+		}
+		return nil
+	}
+}
+
+func testAccMerakiNetworksApplianceFirewallL3FirewallRuleConfig(mock *merakiMock, priority int) string {
+	return testAccProviderConfig(mock) + fmt.Sprintf(`
+resource "meraki_networks_appliance_firewall_l3_firewall_rule" "web" {
+  network_id = "N_24329156"
+  priority   = %d
+  comment    = "Allow web"
+  policy     = "allow"
+  protocol   = "tcp"
+  src_cidr   = "Any"
+  dest_cidr  = "10.0.1.0/24"
+  dest_port  = "443"
+}
+
+resource "meraki_networks_appliance_firewall_l3_firewall_rule" "ssh" {
+  network_id = "N_24329156"
+  priority   = 100
+  comment    = "Block SSH"
+  policy     = "deny"
+  protocol   = "tcp"
+  src_cidr   = "Any"
+  dest_cidr  = "10.0.0.0/8"
+  dest_port  = "22"
+}
+`, priority)
+}
@@ -0,0 +1,190 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// FUNCTION
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ipLikeRegexp = regexp.MustCompile(`^[0-9./]+$`)
+
+var _ function.Function = &FirewallRuleFunction{}
+
+func NewFirewallRuleFunction() function.Function {
+	return &FirewallRuleFunction{}
+}
+
+type FirewallRuleFunction struct{}
+
+func (f *FirewallRuleFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "firewall_rule"
+}
+
+func (f *FirewallRuleFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a layer 3 firewall rule",
+		MarkdownDescription: "Returns an object that can be used as an item of the `rules` attribute of `meraki_networks_appliance_firewall_l3_firewall_rules`. The CIDR lists are joined with commas, an empty list is `Any`, and the ports are normalized like `normalize_port_range`. `syslog_enabled` is false.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "comment",
+				MarkdownDescription: "Description of the rule.",
+			},
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "`allow` or `deny`.",
+			},
+			function.StringParameter{
+				Name:                "protocol",
+				MarkdownDescription: "`tcp`, `udp`, `icmp`, `icmp6` or `any`.",
+			},
+			function.ListParameter{
+				Name:                "src_cidrs",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Source IP addresses, CIDRs, FQDNs, VLANs or policy objects.",
+			},
+			function.StringParameter{
+				Name:                "src_port",
+				MarkdownDescription: "Source port, range or comma-separated list. Must be `Any` unless the protocol is `tcp` or `udp`.",
+			},
+			function.ListParameter{
+				Name:                "dest_cidrs",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Destination IP addresses, CIDRs, FQDNs, VLANs or policy objects.",
+			},
+			function.StringParameter{
+				Name:                "dest_port",
+				MarkdownDescription: "Destination port, range or comma-separated list. Must be `Any` unless the protocol is `tcp` or `udp`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: firewallRuleAttributeTypes,
+		},
+	}
+}
+
+var firewallRuleAttributeTypes = map[string]attr.Type{
+	"comment":        types.StringType,
+	"dest_cidr":      types.StringType,
+	"dest_port":      types.StringType,
+	"policy":         types.StringType,
+	"protocol":       types.StringType,
+	"src_cidr":       types.StringType,
+	"src_port":       types.StringType,
+	"syslog_enabled": types.BoolType,
+}
+
+func (f *FirewallRuleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var comment, policy, protocol, srcPort, destPort string
+	var srcCidrs, destCidrs []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &comment, &policy, &protocol, &srcCidrs, &srcPort, &destCidrs, &destPort))
+	if resp.Error != nil {
+		return
+	}
+
+	policy = strings.ToLower(strings.TrimSpace(policy))
+	if policy != "allow" && policy != "deny" {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("policy must be allow or deny, got %q", policy))
+		return
+	}
+	protocol = strings.ToLower(strings.TrimSpace(protocol))
+	switch protocol {
+	case "tcp", "udp", "icmp", "icmp6", "any":
+	default:
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("protocol must be tcp, udp, icmp, icmp6 or any, got %q", protocol))
+		return
+	}
+	srcCidr, err := joinFirewallCidrs(srcCidrs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(3, err.Error())
+		return
+	}
+	srcPort, err = firewallRulePort(protocol, srcPort)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(4, err.Error())
+		return
+	}
+	destCidr, err := joinFirewallCidrs(destCidrs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(5, err.Error())
+		return
+	}
+	destPort, err = firewallRulePort(protocol, destPort)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(6, err.Error())
+		return
+	}
+
+	rule := ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesRulesRs{
+		Comment:       types.StringValue(comment),
+		DestCidr:      types.StringValue(destCidr),
+		DestPort:      types.StringValue(destPort),
+		Policy:        types.StringValue(policy),
+		Protocol:      types.StringValue(protocol),
+		SrcCidr:       types.StringValue(srcCidr),
+		SrcPort:       types.StringValue(srcPort),
+		SyslogEnabled: types.BoolValue(false),
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, rule))
+}
+
+// joinFirewallCidrs joins the sources or destinations of a rule. Values that look like an IP
+// address must be a valid address or CIDR, other values (FQDN, VLAN, policy objects) are kept.
+func joinFirewallCidrs(cidrs []string) (string, error) {
+	var result []string
+	for _, cidr := range cidrs {
+		value := strings.TrimSpace(cidr)
+		if value == "" {
+			return "", fmt.Errorf("empty CIDR in list")
+		}
+		if strings.EqualFold(value, "any") {
+			return "Any", nil
+		}
+		if ipLikeRegexp.MatchString(value) {
+			_, _, errCidr := net.ParseCIDR(value)
+			if errCidr != nil && net.ParseIP(value) == nil {
+				return "", fmt.Errorf("%q is not a valid IP address or CIDR", value)
+			}
+		}
+		result = append(result, value)
+	}
+	if len(result) == 0 {
+		return "Any", nil
+	}
+	return strings.Join(result, ","), nil
+}
+
+// firewallRulePort normalizes a port of a rule. Only tcp and udp rules can filter on ports.
+func firewallRulePort(protocol, ports string) (string, error) {
+	normalized, err := normalizePortRange(ports)
+	if err != nil {
+		return "", err
+	}
+	if normalized != "Any" && protocol != "tcp" && protocol != "udp" {
+		return "", fmt.Errorf("ports can only be set for tcp and udp rules, got %q for %s", normalized, protocol)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirewallRuleFunction(t *testing.T) {
+	list := func(values ...string) attr.Value {
+		elements := make([]attr.Value, len(values))
+		for i, value := range values {
+			elements[i] = types.StringValue(value)
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+	run := func(policy, protocol string, srcCidrs attr.Value, srcPort string, destCidrs attr.Value, destPort string) (attr.Value, *function.FuncError) {
+		return testRunFunction(t, NewFirewallRuleFunction(), types.ObjectUnknown(firewallRuleAttributeTypes),
+			types.StringValue("Allow web"), types.StringValue(policy), types.StringValue(protocol),
+			srcCidrs, types.StringValue(srcPort), destCidrs, types.StringValue(destPort))
+	}
+
+	got, err := run("Allow", "TCP", list("10.0.0.0/24", "192.168.1.10"), "any", list(), "80, 443")
+	if err != nil {
+		t.Fatalf("firewall_rule() error: %s", err)
+	}
+	want := types.ObjectValueMust(firewallRuleAttributeTypes, map[string]attr.Value{
+		"comment":        types.StringValue("Allow web"),
+		"dest_cidr":      types.StringValue("Any"),
+		"dest_port":      types.StringValue("80,443"),
+		"policy":         types.StringValue("allow"),
+		"protocol":       types.StringValue("tcp"),
+		"src_cidr":       types.StringValue("10.0.0.0/24,192.168.1.10"),
+		"src_port":       types.StringValue("Any"),
+		"syslog_enabled": types.BoolValue(false),
+	})
+	if !got.Equal(want) {
+		t.Errorf("firewall_rule() = %s, want %s", got, want)
+	}
+
+	for name, args := range map[string][]interface{}{
+		"invalid policy":   {"permit", "tcp", list("Any"), "Any", list("Any"), "443"},
+		"invalid protocol": {"deny", "gre", list("Any"), "Any", list("Any"), "Any"},
+		"invalid cidr":     {"deny", "tcp", list("10.0.0.300/24"), "Any", list("Any"), "443"},
+		"port with icmp":   {"deny", "icmp", list("Any"), "Any", list("Any"), "443"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := run(args[0].(string), args[1].(string), args[2].(attr.Value), args[3].(string), args[4].(attr.Value), args[5].(string)); err == nil {
+				t.Errorf("firewall_rule() succeeded, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// FUNCTION
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &NormalizePortRangeFunction{}
+
+func NewNormalizePortRangeFunction() function.Function {
+	return &NormalizePortRangeFunction{}
+}
+
+type NormalizePortRangeFunction struct{}
+
+func (f *NormalizePortRangeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_port_range"
+}
+
+func (f *NormalizePortRangeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalize a port or port range for the firewall rules",
+		MarkdownDescription: "Returns the value expected by the `src_port` and `dest_port` attributes of the firewall rules: `Any`, a port (`443`), a range (`8000-8080`) or a comma-separated list of ports and ranges. Spaces are removed, `any` is accepted in any case and a range whose bounds are equal is returned as a single port. Fails when a port is outside 1-65535 or a range is reversed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ports",
+				MarkdownDescription: "Port, range or comma-separated list of ports and ranges, such as `80, 443` or `8000 - 8080`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizePortRangeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ports string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ports))
+	if resp.Error != nil {
+		return
+	}
+	normalized, err := normalizePortRange(ports)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
+
+// normalizePortRange returns a port specification in the format used by the Meraki firewall rules.
+// An empty value is Any.
+func normalizePortRange(ports string) (string, error) {
+	value := strings.Join(strings.Fields(ports), "")
+	if value == "" || strings.EqualFold(value, "any") {
+		return "Any", nil
+	}
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		bounds := strings.Split(item, "-")
+		if len(bounds) > 2 {
+			return "", fmt.Errorf("%q is not a port range", item)
+		}
+		start, err := parsePort(bounds[0])
+		if err != nil {
+			return "", err
+		}
+		if len(bounds) == 1 {
+			result = append(result, strconv.Itoa(start))
+			continue
+		}
+		end, err := parsePort(bounds[1])
+		if err != nil {
+			return "", err
+		}
+		if start > end {
+			return "", fmt.Errorf("port range %q starts after it ends", item)
+		}
+		if start == end {
+			result = append(result, strconv.Itoa(start))
+			continue
+		}
+		result = append(result, strconv.Itoa(start)+"-"+strconv.Itoa(end))
+	}
+	return strings.Join(result, ","), nil
+}
+
+func parsePort(value string) (int, error) {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("%q is not a port between 1 and 65535", value)
+	}
+	return port, nil
+}
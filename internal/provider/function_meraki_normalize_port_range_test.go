@@ -0,0 +1,60 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizePortRangeFunction(t *testing.T) {
+	cases := []struct {
+		ports   string
+		want    string
+		wantErr bool
+	}{
+		{ports: "", want: "Any"},
+		{ports: "any", want: "Any"},
+		{ports: "443", want: "443"},
+		{ports: "8000 - 8080", want: "8000-8080"},
+		{ports: "80, 443, 8000-8080", want: "80,443,8000-8080"},
+		{ports: "22-22", want: "22"},
+		{ports: "8080-8000", wantErr: true},
+		{ports: "0", wantErr: true},
+		{ports: "65536", wantErr: true},
+		{ports: "1-2-3", wantErr: true},
+		{ports: "http", wantErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.ports, func(t *testing.T) {
+			got, err := testRunFunction(t, NewNormalizePortRangeFunction(), types.StringUnknown(), types.StringValue(c.ports))
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("normalize_port_range(%q) = %s, want an error", c.ports, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalize_port_range(%q) error: %s", c.ports, err)
+			}
+			if !got.Equal(types.StringValue(c.want)) {
+				t.Errorf("normalize_port_range(%q) = %s, want %q", c.ports, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// FUNCTION
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var serialRegexp = regexp.MustCompile(`^[A-Z0-9]{4}-[A-Z0-9]{4}-[A-Z0-9]{4}$`)
+
+var _ function.Function = &ParseSerialFunction{}
+
+func NewParseSerialFunction() function.Function {
+	return &ParseSerialFunction{}
+}
+
+type ParseSerialFunction struct{}
+
+func (f *ParseSerialFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_serial"
+}
+
+func (f *ParseSerialFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Validate and normalize a Meraki serial",
+		MarkdownDescription: "Returns the serial in the `XXXX-XXXX-XXXX` format used by the Meraki API. Lowercase letters, surrounding spaces and a missing dash separator are accepted. Fails when the value is not a Meraki serial.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "serial",
+				MarkdownDescription: "Serial to validate, such as `q2xx-xxxx-xxxx` or `Q2XXXXXXXXXX`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ParseSerialFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var serial string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &serial))
+	if resp.Error != nil {
+		return
+	}
+	parsed, err := parseSerial(serial)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, parsed))
+}
+
+// parseSerial returns a serial in the XXXX-XXXX-XXXX format.
+func parseSerial(serial string) (string, error) {
+	parsed := strings.ToUpper(strings.TrimSpace(serial))
+	if len(parsed) == 12 && !strings.Contains(parsed, "-") {
+		parsed = parsed[0:4] + "-" + parsed[4:8] + "-" + parsed[8:12]
+	}
+	if !serialRegexp.MatchString(parsed) {
+		return "", fmt.Errorf("%q is not a Meraki serial, expected the XXXX-XXXX-XXXX format", serial)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,55 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseSerialFunction(t *testing.T) {
+	cases := []struct {
+		serial  string
+		want    string
+		wantErr bool
+	}{
+		{serial: "Q2XX-XXXX-XXXX", want: "Q2XX-XXXX-XXXX"},
+		{serial: " q2ab-cd12-ef34 ", want: "Q2AB-CD12-EF34"},
+		{serial: "q2abcd12ef34", want: "Q2AB-CD12-EF34"},
+		{serial: "Q2AB-CD12", wantErr: true},
+		{serial: "Q2AB_CD12_EF34", wantErr: true},
+		{serial: "", wantErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.serial, func(t *testing.T) {
+			got, err := testRunFunction(t, NewParseSerialFunction(), types.StringUnknown(), types.StringValue(c.serial))
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("parse_serial(%q) = %s, want an error", c.serial, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parse_serial(%q) error: %s", c.serial, err)
+			}
+			if !got.Equal(types.StringValue(c.want)) {
+				t.Errorf("parse_serial(%q) = %s, want %q", c.serial, got, c.want)
+			}
+		})
+	}
+}
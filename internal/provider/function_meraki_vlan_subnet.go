@@ -0,0 +1,112 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// FUNCTION
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &VLANSubnetFunction{}
+
+func NewVLANSubnetFunction() function.Function {
+	return &VLANSubnetFunction{}
+}
+
+type VLANSubnetFunction struct{}
+
+func (f *VLANSubnetFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vlan_subnet"
+}
+
+func (f *VLANSubnetFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Compute the addressing attributes of an appliance VLAN",
+		MarkdownDescription: "Returns the `subnet`, `appliance_ip`, `cidr` and `mask` attributes of `meraki_networks_appliance_vlans` for an IPv4 CIDR. The appliance IP is the host number `appliance_host` of the subnet, and the CIDR is returned with its host bits cleared.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "IPv4 CIDR of the VLAN, such as `192.168.10.0/24`.",
+			},
+			function.Int64Parameter{
+				Name:                "appliance_host",
+				MarkdownDescription: "Host number of the appliance IP in the subnet, `1` for the first usable address.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: vlanSubnetAttributeTypes,
+		},
+	}
+}
+
+var vlanSubnetAttributeTypes = map[string]attr.Type{
+	"appliance_ip": types.StringType,
+	"cidr":         types.StringType,
+	"mask":         types.Int64Type,
+	"subnet":       types.StringType,
+}
+
+// VLANSubnet holds the addressing attributes of NetworksApplianceVLANsRs.
+type VLANSubnet struct {
+	ApplianceIP types.String `tfsdk:"appliance_ip"`
+	Cidr        types.String `tfsdk:"cidr"`
+	Mask        types.Int64  `tfsdk:"mask"`
+	Subnet      types.String `tfsdk:"subnet"`
+}
+
+func (f *VLANSubnetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var applianceHost int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &applianceHost))
+	if resp.Error != nil {
+		return
+	}
+	subnet, err := vlanSubnet(cidr, applianceHost)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, subnet))
+}
+
+func vlanSubnet(cidr string, applianceHost int64) (VLANSubnet, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil || network.IP.To4() == nil {
+		return VLANSubnet{}, fmt.Errorf("%q is not an IPv4 CIDR", cidr)
+	}
+	ones, bits := network.Mask.Size()
+	hosts := int64(1)<<(bits-ones) - 2
+	if applianceHost < 1 || applianceHost > hosts {
+		return VLANSubnet{}, fmt.Errorf("appliance host %d is outside the %d usable addresses of %s", applianceHost, max(hosts, 0), network)
+	}
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(network.IP.To4())+uint32(applianceHost))
+	return VLANSubnet{
+		ApplianceIP: types.StringValue(ip.String()),
+		Cidr:        types.StringValue(network.String()),
+		Mask:        types.Int64Value(int64(ones)),
+		Subnet:      types.StringValue(network.String()),
+	}, nil
+}
@@ -0,0 +1,57 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVLANSubnetFunction(t *testing.T) {
+	got, err := testRunFunction(t, NewVLANSubnetFunction(), types.ObjectUnknown(vlanSubnetAttributeTypes),
+		types.StringValue("192.168.10.17/24"), types.Int64Value(1))
+	if err != nil {
+		t.Fatalf("vlan_subnet() error: %s", err)
+	}
+	want := types.ObjectValueMust(vlanSubnetAttributeTypes, map[string]attr.Value{
+		"appliance_ip": types.StringValue("192.168.10.1"),
+		"cidr":         types.StringValue("192.168.10.0/24"),
+		"mask":         types.Int64Value(24),
+		"subnet":       types.StringValue("192.168.10.0/24"),
+	})
+	if !got.Equal(want) {
+		t.Errorf("vlan_subnet() = %s, want %s", got, want)
+	}
+
+	for _, c := range []struct {
+		cidr string
+		host int64
+	}{
+		{cidr: "192.168.10.0/24", host: 0},
+		{cidr: "192.168.10.0/24", host: 255},
+		{cidr: "192.168.10.0/31", host: 1},
+		{cidr: "2001:db8::/64", host: 1},
+		{cidr: "vlan10", host: 1},
+	} {
+		if got, err := testRunFunction(t, NewVLANSubnetFunction(), types.ObjectUnknown(vlanSubnetAttributeTypes),
+			types.StringValue(c.cidr), types.Int64Value(c.host)); err == nil {
+			t.Errorf("vlan_subnet(%q, %d) = %s, want an error", c.cidr, c.host, got)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// httpLogSubsystem is the tflog subsystem of the Meraki API calls. Its level
+// can be set with the TF_LOG_PROVIDER_MERAKI_HTTP environment variable.
+const httpLogSubsystem = "meraki_http"
+
+const redactedValue = "***"
+
+// redactedHeaders are the request headers that carry the API key.
+var redactedHeaders = []string{
+	"Authorization",
+	"X-Cisco-Meraki-API-Key",
+}
+
+// redactedBodyFields are matched against the lowercased JSON keys of the
+// request and response bodies. Fields named "key" or ending with "pass", like
+// the SNMP v3AuthPass, are redacted as well.
+var redactedBodyFields = []string{
+	"apikey",
+	"community",
+	"passphrase",
+	"password",
+	"psk",
+	"secret",
+	"token",
+}
+
+// newHTTPLogContext returns a context with the meraki_http subsystem.
+func newHTTPLogContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, httpLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_MERAKI_HTTP"))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, httpLogSubsystem, redactedHeaders...)
+	return ctx
+}
+
+// httpLogger logs every request of a client to the meraki_http subsystem.
+type httpLogger struct {
+	ctx context.Context
+
+	mu sync.Mutex
+	// retries are the retries of a method and URL whose last response was a 429. The SDK
+	// retries a 429 with a new request, resty retries the other errors within the request.
+	retries map[string]*httpLogRetries
+}
+
+// httpLogRetries is the retry count of the last response of a method and URL.
+type httpLogRetries struct {
+	req   *resty.Request
+	base  int
+	retry int
+}
+
+// enableHTTPLogging adds the logging hooks to the resty client of the SDK.
+func enableHTTPLogging(ctx context.Context, client *merakigosdk.Client) {
+	l := &httpLogger{
+		ctx:     ctx,
+		retries: map[string]*httpLogRetries{},
+	}
+	client.RestyClient().OnAfterResponse(l.logResponse)
+	client.RestyClient().OnError(l.logError)
+}
+
+func (l *httpLogger) logResponse(_ *resty.Client, resp *resty.Response) error {
+	req := resp.Request
+	key := req.Method + " " + req.URL
+	if req.RawRequest != nil {
+		key = req.Method + " " + req.RawRequest.URL.String()
+	}
+
+	l.mu.Lock()
+	retries := l.retries[key]
+	if retries == nil {
+		retries = &httpLogRetries{req: req}
+	} else if retries.req != req {
+		// A new request of the SDK after a 429.
+		retries = &httpLogRetries{req: req, base: retries.retry + 1}
+	}
+	retries.retry = retries.base + httpLogAttempt(req) - 1
+	retry := retries.retry
+	if resp.StatusCode() == http.StatusTooManyRequests {
+		l.retries[key] = retries
+	} else {
+		delete(l.retries, key)
+	}
+	l.mu.Unlock()
+
+	fields := requestLogFields(req)
+	fields["status"] = resp.StatusCode()
+	fields["latency_ms"] = resp.Time().Milliseconds()
+	fields["retry"] = retry
+	for name, value := range rateLimitHeaders(resp.Header()) {
+		fields[name] = value
+	}
+	if body := resp.Body(); len(body) > 0 {
+		fields["response_body"] = redactBody(body)
+	}
+	tflog.SubsystemDebug(l.ctx, httpLogSubsystem, "Meraki API call", fields)
+	return nil
+}
+
+func (l *httpLogger) logError(req *resty.Request, err error) {
+	fields := requestLogFields(req)
+	fields["error"] = err.Error()
+	fields["retry"] = httpLogAttempt(req) - 1
+	tflog.SubsystemError(l.ctx, httpLogSubsystem, "Meraki API call failed", fields)
+}
+
+// httpLogAttempt returns the attempt of a request, counting the resty retries.
+func httpLogAttempt(req *resty.Request) int {
+	if req.Attempt < 1 {
+		return 1
+	}
+	return req.Attempt
+}
+
+// requestLogFields returns the method, path, headers and body of the request.
+func requestLogFields(req *resty.Request) map[string]interface{} {
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL,
+	}
+	headers := req.Header
+	if req.RawRequest != nil {
+		fields["path"] = req.RawRequest.URL.Path
+		if query := req.RawRequest.URL.RawQuery; query != "" {
+			fields["query"] = query
+		}
+		headers = req.RawRequest.Header
+	}
+	fields["request_headers"] = redactHeaders(headers)
+	if req.Body != nil {
+		if body, err := json.Marshal(req.Body); err == nil && string(body) != "null" {
+			fields["request_body"] = redactBody(body)
+		}
+	}
+	return fields
+}
+
+// rateLimitHeaders returns the Retry-After and rate limit headers of a response.
+func rateLimitHeaders(header http.Header) map[string]string {
+	headers := map[string]string{}
+	for name := range header {
+		lower := strings.ToLower(name)
+		if lower == "retry-after" || strings.Contains(lower, "ratelimit") || strings.Contains(lower, "rate-limit") {
+			headers[lower] = header.Get(name)
+		}
+	}
+	return headers
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	headers := map[string]string{}
+	for name := range header {
+		headers[name] = header.Get(name)
+		for _, redacted := range redactedHeaders {
+			if strings.EqualFold(name, redacted) {
+				headers[name] = redactedValue
+			}
+		}
+	}
+	return headers
+}
+
+// redactBody masks the values of the sensitive fields of a JSON body. Bodies
+// that are not JSON are returned unchanged.
+func redactBody(body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			if isRedactedField(k) {
+				value[k] = redactedValue
+			} else {
+				value[k] = redactValue(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(item)
+		}
+	}
+	return v
+}
+
+func isRedactedField(name string) bool {
+	lower := strings.ToLower(name)
+	if lower == "key" || strings.HasSuffix(lower, "pass") {
+		return true
+	}
+	for _, field := range redactedBodyFields {
+		if strings.Contains(lower, field) {
+			return true
+		}
+	}
+	return false
+}
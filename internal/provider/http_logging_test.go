@@ -0,0 +1,187 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "nested fields",
+			body: `{"name":"Guests","psk":"deadbeef","radiusServers":[{"host":"10.0.0.1","secret":"s3cr3t"}]}`,
+			want: `{"name":"Guests","psk":"***","radiusServers":[{"host":"10.0.0.1","secret":"***"}]}`,
+		},
+		{
+			name: "api key",
+			body: `{"key":"0123456789abcdef","suffix":"cdef"}`,
+			want: `{"key":"***","suffix":"cdef"}`,
+		},
+		{
+			name: "snmp",
+			body: `{"v2CommunityString":"public","v3AuthPass":"password","v3AuthMode":"SHA"}`,
+			want: `{"v2CommunityString":"***","v3AuthMode":"SHA","v3AuthPass":"***"}`,
+		},
+		{
+			name: "not json",
+			body: `Bad Gateway`,
+			want: `Bad Gateway`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := redactBody([]byte(c.body)); got != c.want {
+				t.Errorf("redactBody() = %s, want %s", got, c.want)
+			}
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+testAccApiKey)
+	header.Set("X-Cisco-Meraki-API-Key", testAccApiKey)
+	header.Set("Accept", "application/json")
+
+	got := redactHeaders(header)
+	if got["Authorization"] != redactedValue || got["X-Cisco-Meraki-Api-Key"] != redactedValue {
+		t.Errorf("redactHeaders() did not mask the API key: %v", got)
+	}
+	if got["Accept"] != "application/json" {
+		t.Errorf("redactHeaders() Accept = %q, want %q", got["Accept"], "application/json")
+	}
+}
+
+func TestHTTPLogging(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"N_1","psk":"deadbeef"}`))
+	}))
+	defer server.Close()
+
+	client, err := merakigosdk.NewClientWithOptionsAndRequests(server.URL, testAccApiKey, "false", "test", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var output bytes.Buffer
+	ctx := newHTTPLogContext(tflogtest.RootLogger(context.Background(), &output))
+	enableHTTPLogging(ctx, client)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.RestyClient().R().Get("/api/v1/networks/N_1?perPage=10"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d log entries, want 2: %s", len(entries), output.String())
+	}
+	first, second := entries[0], entries[1]
+	if first["@module"] != "provider."+httpLogSubsystem {
+		t.Errorf("@module = %v, want provider.%s", first["@module"], httpLogSubsystem)
+	}
+	if first["method"] != "GET" || first["path"] != "/api/v1/networks/N_1" || first["query"] != "perPage=10" {
+		t.Errorf("unexpected request fields: %v", first)
+	}
+	if first["status"] != float64(429) || first["retry-after"] != "1" || first["retry"] != float64(0) {
+		t.Errorf("unexpected 429 fields: %v", first)
+	}
+	if second["status"] != float64(200) || second["retry"] != float64(1) {
+		t.Errorf("unexpected retry fields: %v", second)
+	}
+	if second["response_body"] != `{"id":"N_1","psk":"***"}` {
+		t.Errorf("response_body = %v", second["response_body"])
+	}
+	if strings.Contains(output.String(), testAccApiKey) {
+		t.Errorf("the API key was logged: %s", output.String())
+	}
+}
+
+func TestHTTPLoggingRetries(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"N_1"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := merakigosdk.NewClientWithOptionsAndRequests(server.URL, testAccApiKey, "false", "test", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newRetryPolicy(DEFAULT_RETRY_ON_STATUS).install(client, 3, time.Millisecond, 5*time.Second)
+	var output bytes.Buffer
+	enableHTTPLogging(newHTTPLogContext(tflogtest.RootLogger(context.Background(), &output)), client)
+
+	// The first request is retried by resty after the 503, the second one is the retry of the
+	// SDK after the 429.
+	for i := 0; i < 2; i++ {
+		if _, err := client.RestyClient().R().Get("/api/v1/networks/N_1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("got %d log entries, want 3: %s", len(entries), output.String())
+	}
+	for i, want := range []struct {
+		status float64
+		retry  float64
+	}{{503, 0}, {429, 1}, {200, 2}} {
+		if entries[i]["status"] != want.status || entries[i]["retry"] != want.retry {
+			t.Errorf("entry %d: status = %v, retry = %v, want %v and %v", i, entries[i]["status"], entries[i]["retry"], want.status, want.retry)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// importIDSeparator separates the parts of a composite import identifier,
+// like "network_id,number".
+const importIDSeparator = ","
+
+// parseImportID splits an import identifier into one value per attribute, in
+// the order of the attributes. Spaces around the parts are ignored.
+func parseImportID(id string, attributes ...string) ([]string, error) {
+	return splitImportID(strings.Split(id, importIDSeparator), id, attributes...)
+}
+
+// parseImportIDList is parseImportID for an identifier whose last attribute is a list
+// that keeps the separators, like the port_ids "1-24,49" of "serial,port_ids".
+func parseImportIDList(id string, attributes ...string) ([]string, error) {
+	return splitImportID(strings.SplitN(id, importIDSeparator, len(attributes)), id, attributes...)
+}
+
+func splitImportID(parts []string, id string, attributes ...string) ([]string, error) {
+	format := strings.Join(attributes, importIDSeparator)
+	if len(parts) != len(attributes) {
+		return nil, fmt.Errorf("Expected import identifier with format: %s. Got: %q, which has %d part(s) instead of %d.", format, id, len(parts), len(attributes))
+	}
+	for i, part := range parts {
+		parts[i] = strings.TrimSpace(part)
+		if parts[i] == "" {
+			return nil, fmt.Errorf("Expected import identifier with format: %s. Got: %q, where %s (part %d) is empty.", format, id, attributes[i], i+1)
+		}
+	}
+	return parts, nil
+}
+
+// importStateParts imports a resource from its import identifier, or from the
+// identity of an import block, into the attributes that identify it.
+func importStateParts(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes ...string) {
+	importState(ctx, req, resp, parseImportID, attributes...)
+}
+
+// importStatePartsList is importStateParts for an identifier whose last attribute is a
+// list that keeps the separators, parsed with parseImportIDList.
+func importStatePartsList(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes ...string) {
+	importState(ctx, req, resp, parseImportIDList, attributes...)
+}
+
+func importState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, parse func(string, ...string) ([]string, error), attributes ...string) {
+	values := importIdentifierParts(ctx, req, resp, parse, attributes...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, attribute := range attributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), values[i])...)
+		if resp.Identity != nil {
+			resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root(attribute), values[i])...)
+		}
+	}
+}
+
+// importIdentifierParts returns one value per attribute, from the import identifier parsed
+// with parse, or from the identity of an import block.
+func importIdentifierParts(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, parse func(string, ...string) ([]string, error), attributes ...string) []string {
+	values := make([]string, len(attributes))
+	if req.ID != "" {
+		parts, err := parse(req.ID, attributes...)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				err.Error(),
+			)
+			return nil
+		}
+		values = parts
+	} else if req.Identity != nil {
+		for i, attribute := range attributes {
+			var value types.String
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(attribute), &value)...)
+			if value.ValueString() == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute),
+					"Missing Import Identity Attribute",
+					fmt.Sprintf("The identity of the import block must set %s.", strings.Join(attributes, ", ")),
+				)
+			}
+			values[i] = value.ValueString()
+		}
+	}
+	return values
+}
+
+// importIdentitySchema returns the identity schema of a resource imported with
+// importStateParts.
+func importIdentitySchema(attributes ...string) identityschema.Schema {
+	identityAttributes := map[string]identityschema.Attribute{}
+	for _, attribute := range attributes {
+		identityAttributes[attribute] = identityschema.StringAttribute{
+			RequiredForImport: true,
+		}
+	}
+	return identityschema.Schema{
+		Attributes: identityAttributes,
+	}
+}
+
+// setIdentityFromState copies the identity attributes from the state. The
+// identity is left unset until every attribute of the state is known.
+func setIdentityFromState(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+	values := map[string]types.String{}
+	for attribute := range identity.Schema.GetAttributes() {
+		var stateValue attr.Value
+		diags.Append(state.GetAttribute(ctx, path.Root(attribute), &stateValue)...)
+		if diags.HasError() || stateValue.IsNull() || stateValue.IsUnknown() {
+			return diags
+		}
+		// The identity keeps the numbers of the state, like the priority of a rule, as strings.
+		value, ok := stateValue.(types.String)
+		if number, isNumber := stateValue.(types.Int64); isNumber {
+			value, ok = types.StringValue(strconv.FormatInt(number.ValueInt64(), 10)), true
+		}
+		if !ok || value.ValueString() == "" {
+			return diags
+		}
+		values[attribute] = value
+	}
+	for attribute, value := range values {
+		diags.Append(identity.SetAttribute(ctx, path.Root(attribute), value)...)
+	}
+	return diags
+}
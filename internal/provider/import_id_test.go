@@ -0,0 +1,144 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestParseImportID(t *testing.T) {
+	cases := []struct {
+		name       string
+		id         string
+		attributes []string
+		want       []string
+		err        string
+	}{
+		{
+			name:       "single",
+			id:         "Q2XX-XXXX-XXXX",
+			attributes: []string{"serial"},
+			want:       []string{"Q2XX-XXXX-XXXX"},
+		},
+		{
+			name:       "composite",
+			id:         "N_1234, 3",
+			attributes: []string{"network_id", "number"},
+			want:       []string{"N_1234", "3"},
+		},
+		{
+			name:       "missing part",
+			id:         "N_1234",
+			attributes: []string{"network_id", "number"},
+			err:        `Expected import identifier with format: network_id,number. Got: "N_1234", which has 1 part(s) instead of 2.`,
+		},
+		{
+			name:       "empty part",
+			id:         "N_1234,,1",
+			attributes: []string{"network_id", "switch_stack_id", "static_route_id"},
+			err:        `where switch_stack_id (part 2) is empty`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := parseImportID(c.id, c.attributes...)
+			if c.err != "" {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Fatalf("parseImportID() error = %v, want %q", err, c.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseImportID() error = %v", err)
+			}
+			if strings.Join(got, "|") != strings.Join(c.want, "|") {
+				t.Errorf("parseImportID() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestParseImportIDList(t *testing.T) {
+	got, err := parseImportIDList("Q2XX-XXXX-XXXX,1-24,49", "serial", "port_ids")
+	if err != nil {
+		t.Fatalf("parseImportIDList() error = %v", err)
+	}
+	if strings.Join(got, "|") != "Q2XX-XXXX-XXXX|1-24,49" {
+		t.Errorf("parseImportIDList() = %v, want [Q2XX-XXXX-XXXX 1-24,49]", got)
+	}
+	if _, err := parseImportIDList("Q2XX-XXXX-XXXX", "serial", "port_ids"); err == nil {
+		t.Error("parseImportIDList() accepted an identifier without port_ids")
+	}
+}
+
+func TestImportFirewallRuleIdentity(t *testing.T) {
+	ctx := context.Background()
+	mock := newMerakiMock(t, "firewall_rules")
+	r := &NetworksWirelessSSIDsFirewallL3FirewallRuleResource{client: mock.client(t)}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+	identityType := identityResp.IdentitySchema.Type().TerraformType(ctx)
+	newState := func() tfsdk.State {
+		return tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	}
+	newIdentity := func() *tfsdk.ResourceIdentity {
+		return &tfsdk.ResourceIdentity{Schema: identityResp.IdentitySchema, Raw: tftypes.NewValue(identityType, nil)}
+	}
+
+	resp := &resource.ImportStateResponse{State: newState(), Identity: newIdentity()}
+	r.ImportState(ctx, resource.ImportStateRequest{Identity: &tfsdk.ResourceIdentity{
+		Schema: identityResp.IdentitySchema,
+		Raw: tftypes.NewValue(identityType, map[string]tftypes.Value{
+			"network_id": tftypes.NewValue(tftypes.String, "N_24329156"),
+			"number":     tftypes.NewValue(tftypes.String, "0"),
+			"priority":   tftypes.NewValue(tftypes.String, "1"),
+		}),
+	}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: resp.State, Identity: newIdentity()}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatal(readResp.Diagnostics)
+	}
+	var data NetworksWirelessSSIDsFirewallL3FirewallRuleRs
+	if diags := readResp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if data.Priority.ValueInt64() != 1 || data.Comment.ValueString() != "Block printers" {
+		t.Errorf("imported rule %d %q, want 1 \"Block printers\"", data.Priority.ValueInt64(), data.Comment.ValueString())
+	}
+	var priority types.String
+	if diags := readResp.Identity.GetAttribute(ctx, path.Root("priority"), &priority); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if priority.ValueString() != "1" {
+		t.Errorf("identity priority = %s, want 1", priority)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// liveToolDefaultTimeout bounds the wait for a live tool job when the timeouts block does not
+// set one.
+const liveToolDefaultTimeout = 5 * time.Minute
+
+// liveToolFinished reports whether the status of a live tool job is final.
+func liveToolFinished(status string) bool {
+	return status == "complete" || status == "failed"
+}
+
+// waitForLiveTool polls a live tool job with get until its status is complete or failed, and
+// returns the last response.
+func waitForLiveTool[T any](ctx context.Context, get func() (*T, *resty.Response, error), status func(response *T) string) (*T, error) {
+	var response *T
+	err := poll(ctx, func() (bool, error) {
+		var restyResp *resty.Response
+		var err error
+		response, restyResp, err = get()
+		if err != nil || response == nil {
+			if restyResp != nil {
+				return false, fmt.Errorf("status %d: %s", restyResp.StatusCode(), restyResp.String())
+			}
+			if err == nil {
+				err = fmt.Errorf("empty response")
+			}
+			return false, err
+		}
+		return liveToolFinished(status(response)), nil
+	})
+	return response, err
+}
+
+// liveToolErrors returns an error diagnostic when a live tool job failed.
+func liveToolErrors(name string, id string, status string, message string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if status != "failed" {
+		return diags
+	}
+	if message == "" {
+		message = "Meraki did not return the error of the job."
+	}
+	diags.AddError(fmt.Sprintf("%s %s failed", name, id), message)
+	return diags
+}
@@ -0,0 +1,104 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const testLiveToolsPingPath = "/api/v1/devices/Q234-ABCD-5678/liveTools/ping/1284392014819"
+
+func TestWaitForLiveTool(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	mock := newMerakiMock(t, "devices_live_tools")
+	time.AfterFunc(50*time.Millisecond, func() {
+		mock.setBody(testLiveToolsPingPath, map[string]interface{}{
+			"pingId": "1284392014819",
+			"status": "complete",
+			"results": map[string]interface{}{
+				"sent":     2,
+				"received": 2,
+				"loss":     map[string]interface{}{"percentage": 0},
+				"latencies": map[string]interface{}{
+					"minimum": 14.5,
+					"average": 15.25,
+					"maximum": 16,
+				},
+				"replies": []map[string]interface{}{
+					{"sequenceId": 0, "size": 64, "latency": 14.5},
+					{"sequenceId": 1, "size": 64, "latency": 16},
+				},
+			},
+		})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := mock.client(t)
+	response, err := waitForLiveTool(ctx, func() (*merakigosdk.ResponseDevicesGetDeviceLiveToolsPing, *resty.Response, error) {
+		return client.Devices.GetDeviceLiveToolsPing("Q234-ABCD-5678", "1284392014819")
+	}, func(response *merakigosdk.ResponseDevicesGetDeviceLiveToolsPing) string {
+		return response.Status
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := ResponseDevicesGetDeviceLiveToolsPingItemToBody(DevicesLiveToolsPingInfo{}, response)
+	if data.Item.Status.ValueString() != "complete" {
+		t.Errorf("status = %s, want complete", data.Item.Status)
+	}
+	results := data.Item.Results
+	if results == nil || results.Received.ValueInt64() != 2 || results.Latencies.Average.ValueFloat64() != 15.25 {
+		t.Errorf("results not read: %+v", results)
+	}
+	if diags := liveToolErrors("Ping", "1284392014819", response.Status, ""); diags.HasError() {
+		t.Errorf("liveToolErrors() = %v for a completed job", diags)
+	}
+}
+
+func TestWaitForLiveToolTimeout(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	mock := newMerakiMock(t, "devices_live_tools")
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	client := mock.client(t)
+	_, err := waitForLiveTool(ctx, func() (*merakigosdk.ResponseDevicesGetDeviceLiveToolsPing, *resty.Response, error) {
+		return client.Devices.GetDeviceLiveToolsPing("Q234-ABCD-5678", "1284392014819")
+	}, func(response *merakigosdk.ResponseDevicesGetDeviceLiveToolsPing) string {
+		return response.Status
+	})
+	if err == nil {
+		t.Fatal("waitForLiveTool() did not time out on a running job")
+	}
+}
+
+func TestLiveToolErrors(t *testing.T) {
+	diags := liveToolErrors("Cable test", "123", "failed", "Port is down")
+	if !diags.HasError() || diags[0].Summary() != "Cable test 123 failed" || diags[0].Detail() != "Port is down" {
+		t.Errorf("liveToolErrors() = %v", diags)
+	}
+}
@@ -1,43 +0,0 @@
-// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
-// All rights reserved.
-//
-// Licensed under the Mozilla Public License, Version 2.0 (the "License");
-// you may not use this file except in compliance with the License.
-// You may obtain a copy of the License at
-//
-//	https://mozilla.org/MPL/2.0/
-//
-// Unless required by applicable law or agreed to in writing, software
-// distributed under the License is distributed on an "AS IS" BASIS,
-// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-// See the License for the specific language governing permissions and
-// limitations under the License.
-//
-// SPDX-License-Identifier: MPL-2.0
-package provider
-
-import (
-	"log"
-	"os"
-)
-
-func createLogger() *logger {
-	l := &logger{l: log.New(os.Stderr, "", log.LstdFlags)}
-	return l
-}
-
-type logger struct {
-	l *log.Logger
-}
-
-func (l *logger) Errorf(format string, v ...interface{}) {
-	log.Printf("[ERROR] [RESTY] "+format, v...)
-}
-
-func (l *logger) Warnf(format string, v ...interface{}) {
-	log.Printf("[WARN] [RESTY] "+format, v...)
-}
-
-func (l *logger) Debugf(format string, v ...interface{}) {
-	log.Printf("[DEBUG] [RESTY] "+format, v...)
-}
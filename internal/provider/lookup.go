@@ -0,0 +1,116 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// lookupMaxListed is the number of matches listed in the diagnostic of an ambiguous lookup.
+const lookupMaxListed = 10
+
+// lookupItemAttributes returns the attributes of the item or items attribute of a data source,
+// so the singular data sources return the same item as the list ones.
+func lookupItemAttributes(dataSource datasource.DataSource, name string) map[string]schema.Attribute {
+	resp := &datasource.SchemaResponse{}
+	dataSource.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	switch attribute := resp.Schema.Attributes[name].(type) {
+	case schema.SingleNestedAttribute:
+		return attribute.Attributes
+	case schema.ListNestedAttribute:
+		return attribute.NestedObject.Attributes
+	}
+	return nil
+}
+
+// lookupCriteria describes the criteria of a lookup from pairs of names and values, like
+// `name "Main Office" and tag "branch"`. Null values are skipped.
+func lookupCriteria(pairs ...interface{}) string {
+	var criteria []string
+	for i := 0; i+1 < len(pairs); i += 2 {
+		value, ok := pairs[i+1].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		criteria = append(criteria, fmt.Sprintf("%s %q", pairs[i], value.ValueString()))
+	}
+	return strings.Join(criteria, " and ")
+}
+
+// lookupOne returns the only item that matches. It adds an error to the diagnostics when no
+// item or several items match, kind and kinds name an item and several items, and scope is
+// where they were looked up, like "organization 2930418".
+func lookupOne[E any](items []E, match func(E) bool, id func(E) string, kind, kinds, scope, criteria string) (E, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var matches []E
+	for _, item := range items {
+		if match(item) {
+			matches = append(matches, item)
+		}
+	}
+	switch len(matches) {
+	case 1:
+		return matches[0], diags
+	case 0:
+		var none E
+		diags.AddError(
+			fmt.Sprintf("No matching %s", kind),
+			fmt.Sprintf("No %s of %s matches %s.", kind, scope, criteria),
+		)
+		return none, diags
+	}
+	ids := make([]string, 0, lookupMaxListed)
+	for i, item := range matches {
+		if i == lookupMaxListed {
+			ids = append(ids, fmt.Sprintf("and %d more", len(matches)-lookupMaxListed))
+			break
+		}
+		ids = append(ids, id(item))
+	}
+	var none E
+	diags.AddError(
+		fmt.Sprintf("Multiple matching %s", kinds),
+		fmt.Sprintf("%d %s of %s match %s: %s. Add criteria so that only one %s matches.",
+			len(matches), kinds, scope, criteria, strings.Join(ids, ", "), kind),
+	)
+	return none, diags
+}
+
+// lookupTagged returns true when tags has tag, or when tag is null.
+func lookupTagged(tags []string, tag types.String) bool {
+	if tag.IsNull() {
+		return true
+	}
+	for _, t := range tags {
+		if t == tag.ValueString() {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupEqual returns true when value is expected, or when expected is null.
+func lookupEqual(value string, expected types.String) bool {
+	return expected.IsNull() || value == expected.ValueString()
+}
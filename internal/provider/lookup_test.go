@@ -0,0 +1,135 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestLookupOne(t *testing.T) {
+	items := []string{"a", "b", "b"}
+	id := func(item string) string { return item }
+
+	match, diags := lookupOne(items, func(item string) bool { return item == "a" }, id, "item", "items", "the list", `name "a"`)
+	if diags.HasError() || match != "a" {
+		t.Errorf("lookupOne of a unique item = %q, %v, want a", match, diags)
+	}
+
+	_, diags = lookupOne(items, func(item string) bool { return item == "c" }, id, "item", "items", "the list", `name "c"`)
+	if !diags.HasError() || diags[0].Detail() != `No item of the list matches name "c".` {
+		t.Errorf("lookupOne of a missing item = %v, want a no match error", diags)
+	}
+
+	_, diags = lookupOne(items, func(item string) bool { return item == "b" }, id, "item", "items", "the list", `name "b"`)
+	if !diags.HasError() || diags[0].Detail() != `2 items of the list match name "b": b, b. Add criteria so that only one item matches.` {
+		t.Errorf("lookupOne of a duplicate item = %v, want a multiple matches error", diags)
+	}
+}
+
+func TestLookupCriteria(t *testing.T) {
+	got := lookupCriteria("name", types.StringValue("AP"), "serial", types.StringNull(), "tag", types.StringValue("lobby"))
+	if want := `name "AP" and tag "lobby"`; got != want {
+		t.Errorf("lookupCriteria = %q, want %q", got, want)
+	}
+}
+
+func TestNormalizeMAC(t *testing.T) {
+	for _, mac := range []string{"00:18:0A:00:00:01", "00-18-0a-00-00-01", "0018.0a00.0001", "00180a000001"} {
+		if got := normalizeMAC(mac); got != "00180a000001" {
+			t.Errorf("normalizeMAC(%q) = %q, want 00180a000001", mac, got)
+		}
+	}
+}
+
+func TestAccMerakiLookupDataSources(t *testing.T) {
+	mock := newMerakiMock(t, "organizations_networks", "lookup")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(mock) + `
+data "meraki_network" "branch" {
+  organization_id = "2930418"
+  name            = "Branch 2"
+}
+
+data "meraki_network" "tagged" {
+  organization_id = "2930418"
+  tag             = "tag1"
+}
+
+data "meraki_device" "by_mac" {
+  organization_id = "2930418"
+  mac             = "00-18-0A-00-00-03"
+}
+
+data "meraki_device" "by_name_and_tag" {
+  organization_id = "2930418"
+  name            = "AP"
+  tag             = "lobby"
+}
+
+data "meraki_ssid" "guest" {
+  network_id = "N_24329156"
+  name       = "Guest"
+}
+
+data "meraki_vlan" "voice" {
+  network_id = "N_24329156"
+  name       = "Voice"
+}
+
+data "meraki_group_policy" "guests" {
+  network_id = "N_24329156"
+  name       = "Guests"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meraki_network.branch", "item.id", "N_24329158"),
+					resource.TestCheckResourceAttr("data.meraki_network.tagged", "item.name", "Main Office"),
+					resource.TestCheckResourceAttr("data.meraki_device.by_mac", "item.serial", "Q2XX-AAAA-0003"),
+					resource.TestCheckResourceAttr("data.meraki_device.by_name_and_tag", "item.serial", "Q2XX-AAAA-0002"),
+					resource.TestCheckResourceAttr("data.meraki_ssid.guest", "item.number", "1"),
+					resource.TestCheckResourceAttr("data.meraki_vlan.voice", "item.id", "20"),
+					resource.TestCheckResourceAttr("data.meraki_group_policy.guests", "item.group_policy_id", "102"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(mock) + `
+data "meraki_device" "ambiguous" {
+  organization_id = "2930418"
+  name            = "AP"
+}
+`,
+				ExpectError: regexp.MustCompile(`2 devices of organization 2930418 match name "AP": Q2XX-AAAA-0002,\s+Q2XX-AAAA-0003`),
+			},
+			{
+				Config: testAccProviderConfig(mock) + `
+data "meraki_network" "missing" {
+  organization_id = "2930418"
+  name            = "Branch 3"
+}
+`,
+				ExpectError: regexp.MustCompile(`No network of organization 2930418 matches name "Branch 3"`),
+			},
+		},
+	})
+}
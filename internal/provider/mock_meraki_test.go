@@ -0,0 +1,411 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+)
+
+const testAccApiKey = "0123456789abcdef0123456789abcdef01234567"
+
+// merakiMock is an in-process stand-in for api.meraki.com used by the tests.
+//
+// The bodies served by the mock are seeded from the JSON fixtures under testdata/fixtures.
+// A PUT request is merged into the stored body, a POST request stores its body (a POST without
+// a body, like the generation of a key, replies with the stored body), and a DELETE request
+// removes it, so the create, read, update, import and destroy steps of resource.Test
+// see the same API behaviour as a live organization. A POST to the action batches of an
+// organization runs the actions of the batch against the stored bodies.
+type merakiMock struct {
+	Server *httptest.Server
+
+	mu            sync.Mutex
+	bodies        map[string]interface{}
+	pages         map[string][]interface{}
+	requests      []merakiMockRequest
+	actionBatches int
+	// failures are the statuses answered to the next requests of a method and path, before
+	// the request is served. A zero status closes the connection instead.
+	failures map[string][]int
+	// owners are the API keys of the paths that only one API key can reach, by path prefix.
+	owners map[string]string
+}
+
+// merakiMockRequest is a request received by the mock.
+type merakiMockRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Body   interface{}
+}
+
+// merakiMockFixture is the content of a fixture file. Every route seeds the body served
+// for a path, or the list of pages served for a path when pages is set.
+type merakiMockFixture struct {
+	Routes []struct {
+		Path  string            `json:"path"`
+		Body  json.RawMessage   `json:"body"`
+		Pages []json.RawMessage `json:"pages"`
+	} `json:"routes"`
+}
+
+// newMerakiMock starts a mock seeded with the given fixtures (file names without the .json
+// extension). The server is closed when the test ends.
+func newMerakiMock(t *testing.T, fixtures ...string) *merakiMock {
+	t.Helper()
+	m := &merakiMock{
+		bodies:   map[string]interface{}{},
+		pages:    map[string][]interface{}{},
+		failures: map[string][]int{},
+		owners:   map[string]string{},
+	}
+	for _, name := range fixtures {
+		m.load(t, name)
+	}
+	m.Server = httptest.NewServer(http.HandlerFunc(m.serveHTTP))
+	t.Cleanup(m.Server.Close)
+	return m
+}
+
+func (m *merakiMock) load(t *testing.T, name string) {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join("testdata", "fixtures", name+".json"))
+	if err != nil {
+		t.Fatalf("reading fixture %s: %s", name, err)
+	}
+	var fixture merakiMockFixture
+	if err := json.Unmarshal(content, &fixture); err != nil {
+		t.Fatalf("decoding fixture %s: %s", name, err)
+	}
+	for _, route := range fixture.Routes {
+		if route.Pages != nil {
+			pages := make([]interface{}, len(route.Pages))
+			for i, page := range route.Pages {
+				if err := json.Unmarshal(page, &pages[i]); err != nil {
+					t.Fatalf("decoding fixture %s page %d of %s: %s", name, i, route.Path, err)
+				}
+			}
+			m.pages[route.Path] = pages
+			continue
+		}
+		var body interface{}
+		if err := json.Unmarshal(route.Body, &body); err != nil {
+			t.Fatalf("decoding fixture %s body of %s: %s", name, route.Path, err)
+		}
+		m.bodies[route.Path] = body
+	}
+}
+
+// client returns a Meraki SDK client pointed at the mock.
+func (m *merakiMock) client(t *testing.T) *merakigosdk.Client {
+	t.Helper()
+	return m.clientWithKey(t, testAccApiKey)
+}
+
+// clientWithKey returns a Meraki SDK client pointed at the mock that uses another API key,
+// like the key of a credentials block.
+func (m *merakiMock) clientWithKey(t *testing.T, apiKey string) *merakigosdk.Client {
+	t.Helper()
+	client, err := merakigosdk.NewClientWithOptionsAndRequests(m.Server.URL, apiKey, "false", CUSTOM_USER_AGENT, 100)
+	if err != nil {
+		t.Fatalf("creating Meraki client: %s", err)
+	}
+	return client
+}
+
+// ownPaths makes the paths under the prefixes reachable only with apiKey, like the networks
+// and devices of an organization that the default API key cannot access. The other keys get
+// a 404 response for them.
+func (m *merakiMock) ownPaths(apiKey string, prefixes ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, prefix := range prefixes {
+		m.owners[prefix] = apiKey
+	}
+}
+
+// body returns the body currently stored for a path.
+func (m *merakiMock) body(path string) (interface{}, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	body, ok := m.bodies[path]
+	return body, ok
+}
+
+// setBody replaces the body stored for a path, like a change made outside of Terraform or an
+// asynchronous job that progresses.
+func (m *merakiMock) setBody(path string, body interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.bodies[path] = body
+}
+
+// failNext makes the next requests of a method and path fail with the statuses, in order, like
+// the Meraki API during maintenance. A zero status closes the connection.
+func (m *merakiMock) failNext(method, path string, statuses ...int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := method + " " + path
+	m.failures[key] = append(m.failures[key], statuses...)
+}
+
+// requestsFor returns the requests received for a method and path, in order.
+func (m *merakiMock) requestsFor(method, path string) []merakiMockRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var requests []merakiMockRequest
+	for _, request := range m.requests {
+		if request.Method == method && request.Path == path {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+// lastRequest returns the last request received for a method and path.
+func (m *merakiMock) lastRequest(method, path string) (merakiMockRequest, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := len(m.requests) - 1; i >= 0; i-- {
+		if m.requests[i].Method == method && m.requests[i].Path == path {
+			return m.requests[i], true
+		}
+	}
+	return merakiMockRequest{}, false
+}
+
+func (m *merakiMock) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	apiKey := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if apiKey != testAccApiKey && !m.isOwner(apiKey) {
+		writeMerakiMockResponse(w, http.StatusUnauthorized, merakiMockErrors("Invalid API key"))
+		return
+	}
+
+	var body interface{}
+	content, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeMerakiMockResponse(w, http.StatusBadRequest, merakiMockErrors(err.Error()))
+		return
+	}
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, &body); err != nil {
+			writeMerakiMockResponse(w, http.StatusBadRequest, merakiMockErrors(err.Error()))
+			return
+		}
+	}
+	m.requests = append(m.requests, merakiMockRequest{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.RawQuery,
+		Body:   body,
+	})
+
+	for prefix, owner := range m.owners {
+		if owner != apiKey && (r.URL.Path == prefix || strings.HasPrefix(r.URL.Path, prefix+"/")) {
+			writeMerakiMockResponse(w, http.StatusNotFound, merakiMockErrors("Not found"))
+			return
+		}
+	}
+
+	key := r.Method + " " + r.URL.Path
+	if failures := m.failures[key]; len(failures) > 0 {
+		m.failures[key] = failures[1:]
+		if failures[0] == 0 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+		writeMerakiMockResponse(w, failures[0], merakiMockErrors(http.StatusText(failures[0])))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if pages, ok := m.pages[r.URL.Path]; ok {
+			m.servePage(w, r, pages)
+			return
+		}
+		stored, ok := m.bodies[r.URL.Path]
+		if !ok {
+			writeMerakiMockResponse(w, http.StatusNotFound, merakiMockErrors("Not found"))
+			return
+		}
+		writeMerakiMockResponse(w, http.StatusOK, stored)
+	case http.MethodPut:
+		stored, ok := m.bodies[r.URL.Path]
+		if !ok {
+			writeMerakiMockResponse(w, http.StatusNotFound, merakiMockErrors("Not found"))
+			return
+		}
+		storedObject, storedIsObject := stored.(map[string]interface{})
+		bodyObject, bodyIsObject := body.(map[string]interface{})
+		if storedIsObject && bodyIsObject {
+			for key, value := range bodyObject {
+				storedObject[key] = value
+			}
+		} else {
+			m.bodies[r.URL.Path] = body
+		}
+		writeMerakiMockResponse(w, http.StatusOK, m.bodies[r.URL.Path])
+	case http.MethodPost:
+		if merakiMockActionBatchesPath.MatchString(r.URL.Path) {
+			m.serveActionBatch(w, r.URL.Path, body)
+			return
+		}
+		if stored, ok := m.bodies[r.URL.Path]; ok && body == nil {
+			writeMerakiMockResponse(w, http.StatusCreated, stored)
+			return
+		}
+		m.bodies[r.URL.Path] = body
+		writeMerakiMockResponse(w, http.StatusCreated, body)
+	case http.MethodDelete:
+		if _, ok := m.bodies[r.URL.Path]; !ok {
+			writeMerakiMockResponse(w, http.StatusNotFound, merakiMockErrors("Not found"))
+			return
+		}
+		delete(m.bodies, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMerakiMockResponse(w, http.StatusMethodNotAllowed, merakiMockErrors("Method not allowed"))
+	}
+}
+
+// isOwner reports whether the API key owns paths of the mock. m.mu must be held.
+func (m *merakiMock) isOwner(apiKey string) bool {
+	for _, owner := range m.owners {
+		if owner == apiKey {
+			return true
+		}
+	}
+	return false
+}
+
+var merakiMockActionBatchesPath = regexp.MustCompile(`^/api/v1/organizations/[^/]+/actionBatches$`)
+
+// serveActionBatch runs the actions of an action batch in order and stores the batch. Like the
+// Meraki API, the batch is rolled back as a whole when one of its actions fails, and it is
+// already completed when it is returned.
+func (m *merakiMock) serveActionBatch(w http.ResponseWriter, path string, body interface{}) {
+	request, _ := body.(map[string]interface{})
+	actions, _ := request["actions"].([]interface{})
+
+	staged := make(map[string]interface{}, len(m.bodies))
+	for key, value := range m.bodies {
+		staged[key] = value
+	}
+	errs := []string{}
+	for _, item := range actions {
+		action, _ := item.(map[string]interface{})
+		resource, _ := action["resource"].(string)
+		operation, _ := action["operation"].(string)
+		actionBody, _ := action["body"].(map[string]interface{})
+		resourcePath := "/api/v1" + resource
+		stored, ok := staged[resourcePath].(map[string]interface{})
+		switch operation {
+		case "create":
+			staged[fmt.Sprintf("%s/%v", resourcePath, actionBody["id"])] = actionBody
+		case "update":
+			if !ok {
+				errs = append(errs, "Resource "+resource+" not found")
+				continue
+			}
+			merged := map[string]interface{}{}
+			for key, value := range stored {
+				merged[key] = value
+			}
+			for key, value := range actionBody {
+				merged[key] = value
+			}
+			staged[resourcePath] = merged
+		case "destroy":
+			if !ok {
+				errs = append(errs, "Resource "+resource+" not found")
+				continue
+			}
+			delete(staged, resourcePath)
+		default:
+			errs = append(errs, "Unsupported operation "+operation+" for "+resource)
+		}
+	}
+	if len(errs) == 0 {
+		m.bodies = staged
+	}
+
+	m.actionBatches++
+	id := strconv.Itoa(m.actionBatches)
+	batch := map[string]interface{}{
+		"id":             id,
+		"organizationId": strings.Split(path, "/")[4],
+		"confirmed":      request["confirmed"],
+		"synchronous":    request["synchronous"],
+		"actions":        actions,
+		"status": map[string]interface{}{
+			"completed":        len(errs) == 0,
+			"failed":           len(errs) > 0,
+			"errors":           errs,
+			"createdResources": []interface{}{},
+		},
+	}
+	m.bodies[path+"/"+id] = batch
+	writeMerakiMockResponse(w, http.StatusCreated, batch)
+}
+
+// servePage serves one page of a paginated path. The startingAfter token is the index of the
+// page and the rel=next Link header is set until the last page, as the Meraki API does.
+func (m *merakiMock) servePage(w http.ResponseWriter, r *http.Request, pages []interface{}) {
+	index := 0
+	if startingAfter := r.URL.Query().Get("startingAfter"); startingAfter != "" {
+		page, err := strconv.Atoi(strings.TrimPrefix(startingAfter, "page-"))
+		if err != nil || page < 0 || page >= len(pages) {
+			writeMerakiMockResponse(w, http.StatusBadRequest, merakiMockErrors("Invalid startingAfter"))
+			return
+		}
+		index = page
+	}
+	if index+1 < len(pages) {
+		w.Header().Set("Link", fmt.Sprintf("<%s%s?startingAfter=page-%d>; rel=next", m.Server.URL, r.URL.Path, index+1))
+	}
+	writeMerakiMockResponse(w, http.StatusOK, pages[index])
+}
+
+func merakiMockErrors(message string) map[string]interface{} {
+	return map[string]interface{}{"errors": []string{message}}
+}
+
+func writeMerakiMockResponse(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
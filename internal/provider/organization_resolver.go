@@ -0,0 +1,188 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// organizationResolver finds the organization of the networks and devices of the API paths,
+// for the limits of the organizations, the API keys of the credentials and the action batches.
+// The organizations and networks found are kept for the run of the provider, a failed lookup
+// is made again by the next request.
+type organizationResolver struct {
+	ctx context.Context
+
+	// lookups keeps one lookup of a network or a device at a time, so that its requests share
+	// it. The organizations and networks found are stored under mu.
+	lookups       lookupLocks
+	mu            sync.Mutex
+	organizations map[string]string
+	networks      map[string]string
+	// clients are the resty clients of the provider, to look up the networks and devices that
+	// the API key of a request cannot reach.
+	clients []*resty.Client
+}
+
+func newOrganizationResolver(ctx context.Context) *organizationResolver {
+	return &organizationResolver{
+		ctx:           ctx,
+		organizations: map[string]string{},
+		networks:      map[string]string{},
+	}
+}
+
+// lookupLocks holds a mutex for each network and device, so that the lookups of different
+// networks and devices run in parallel.
+type lookupLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock locks the mutex of a key and returns its unlock function.
+func (l *lookupLocks) lock(key string) func() {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = map[string]*sync.Mutex{}
+	}
+	lock, ok := l.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		l.locks[key] = lock
+	}
+	l.mu.Unlock()
+	lock.Lock()
+	return lock.Unlock
+}
+
+// addClient adds a resty client of the provider to the clients of the lookups.
+func (r *organizationResolver) addClient(c *resty.Client) {
+	r.mu.Lock()
+	r.clients = append(r.clients, c)
+	r.mu.Unlock()
+}
+
+// organizationOf returns the organization of the path of a request, or an empty string when
+// the path has none or the lookup failed. Networks and devices are looked up with the client
+// of the request, then with the other clients of the provider.
+func (r *organizationResolver) organizationOf(c *resty.Client, url string) string {
+	if i := strings.Index(url, "/api/v1/"); i > 0 {
+		url = url[i:]
+	}
+	match := rateLimitPath.FindStringSubmatch(url)
+	if match == nil {
+		return ""
+	}
+	switch match[1] {
+	case "organizations":
+		return match[2]
+	case "networks":
+		organizationID, _ := r.organizationOfNetwork(c, match[2])
+		return organizationID
+	}
+	networkID, err := r.networkOfDevice(c, match[2])
+	if err != nil {
+		return ""
+	}
+	organizationID, _ := r.organizationOfNetwork(c, networkID)
+	return organizationID
+}
+
+// organizationOfNetwork returns the organization of a network. A nil client looks it up with
+// the clients of the provider only.
+func (r *organizationResolver) organizationOfNetwork(c *resty.Client, networkID string) (string, error) {
+	if networkID == "" {
+		return "", nil
+	}
+	defer r.lookups.lock("networks/" + networkID)()
+	r.mu.Lock()
+	organizationID, ok := r.organizations[networkID]
+	r.mu.Unlock()
+	if ok {
+		return organizationID, nil
+	}
+	var network merakigosdk.ResponseNetworksGetNetwork
+	if err := r.lookup(c, "/api/v1/networks/"+networkID, &network); err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	r.organizations[networkID] = network.OrganizationID
+	r.mu.Unlock()
+	return network.OrganizationID, nil
+}
+
+// networkOfDevice returns the network of a device like organizationOfNetwork.
+func (r *organizationResolver) networkOfDevice(c *resty.Client, serial string) (string, error) {
+	defer r.lookups.lock("devices/" + serial)()
+	r.mu.Lock()
+	networkID, ok := r.networks[serial]
+	r.mu.Unlock()
+	if ok {
+		return networkID, nil
+	}
+	var device merakigosdk.ResponseDevicesGetDevice
+	if err := r.lookup(c, "/api/v1/devices/"+serial, &device); err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	r.networks[serial] = device.NetworkID
+	r.mu.Unlock()
+	return device.NetworkID, nil
+}
+
+// lookup reads a path outside of the limits of the organizations, with the client of the
+// request first, since the API key of the request may not reach the network or the device. A
+// failed lookup is not cached, so that a 429, a 5xx or a timeout only leaves the request that
+// made it without an organization, and the next request looks it up again.
+func (r *organizationResolver) lookup(c *resty.Client, path string, result interface{}) error {
+	ctx := context.WithValue(context.Background(), rateLimitLookup{}, true)
+	r.mu.Lock()
+	clients := append([]*resty.Client{c}, r.clients...)
+	r.mu.Unlock()
+	var lookupErr error
+	for i, client := range clients {
+		if client == nil || i > 0 && client == c {
+			continue
+		}
+		response, err := client.R().SetContext(ctx).SetResult(result).Get(path)
+		if err == nil && !response.IsError() {
+			return nil
+		}
+		if lookupErr == nil {
+			if err == nil {
+				err = fmt.Errorf("status %d: %s", response.StatusCode(), response.String())
+			}
+			lookupErr = fmt.Errorf("failure when reading %s: %w", path, err)
+		}
+	}
+	if lookupErr == nil {
+		lookupErr = fmt.Errorf("failure when reading %s: no Meraki API client", path)
+	}
+	tflog.SubsystemDebug(r.ctx, httpLogSubsystem, "Unable to find the organization of a Meraki API path", map[string]interface{}{
+		"path":  path,
+		"error": lookupErr.Error(),
+	})
+	return lookupErr
+}
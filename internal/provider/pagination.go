@@ -0,0 +1,96 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/go-resty/resty/v2"
+)
+
+var linkNextRegexp = regexp.MustCompile(`<([^>]*)>\s*;\s*rel="?next"?`)
+
+// nextStartingAfter returns the startingAfter token of the rel=next link of a Meraki Link header.
+// It returns false when there is no next page.
+func nextStartingAfter(linkHeader string) (string, bool) {
+	match := linkNextRegexp.FindStringSubmatch(linkHeader)
+	if match == nil {
+		return "", false
+	}
+	next, err := url.Parse(match[1])
+	if err != nil {
+		return "", false
+	}
+	startingAfter := next.Query().Get("startingAfter")
+	if startingAfter == "" {
+		return "", false
+	}
+	return startingAfter, true
+}
+
+// paginate calls fetch once per page, following the rel=next Link header returned by Meraki,
+// and appends the items of every page to the first one. The items function returns the list
+// of a page that must be merged. Paging stops when there is no next page or when maxItems items
+// were read (0 means no limit). It returns the merged response, the last resty response and the
+// number of pages read.
+func paginate[T any, L ~[]E, E any](startingAfter string, maxItems int64, fetch func(startingAfter string) (*T, *resty.Response, error), items func(page *T) *L) (*T, *resty.Response, int64, error) {
+	var result *T
+	var pages int64
+	seen := map[string]bool{}
+	for {
+		response, restyResp, err := fetch(startingAfter)
+		if err != nil {
+			return response, restyResp, pages, err
+		}
+		if response == nil {
+			// The callers expect an error when there is no response.
+			return nil, restyResp, pages, fmt.Errorf("empty page after %d pages", pages)
+		}
+		pages++
+		if result == nil {
+			result = response
+		} else {
+			merged := items(result)
+			*merged = append(*merged, *items(response)...)
+		}
+		if maxItems > 0 {
+			merged := items(result)
+			if int64(len(*merged)) >= maxItems {
+				*merged = (*merged)[:maxItems]
+				return result, restyResp, pages, nil
+			}
+		}
+		if restyResp == nil {
+			return result, restyResp, pages, nil
+		}
+		next, ok := nextStartingAfter(restyResp.Header().Get("Link"))
+		if !ok || seen[next] {
+			return result, restyResp, pages, nil
+		}
+		seen[next] = true
+		startingAfter = next
+	}
+}
+
+// paginateList is paginate for list endpoints whose response is the list itself.
+func paginateList[S ~[]E, E any](startingAfter string, maxItems int64, fetch func(startingAfter string) (*S, *resty.Response, error)) (*S, *resty.Response, int64, error) {
+	return paginate(startingAfter, maxItems, fetch, func(page *S) *S {
+		return page
+	})
+}
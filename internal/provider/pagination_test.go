@@ -0,0 +1,177 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNextStartingAfter(t *testing.T) {
+	cases := []struct {
+		name   string
+		header string
+		want   string
+		ok     bool
+	}{
+		{
+			name:   "next page",
+			header: `<https://api.meraki.com/api/v1/organizations/1/networks?perPage=2&startingAfter=N_1>; rel=first, <https://api.meraki.com/api/v1/organizations/1/networks?perPage=2&startingAfter=N_2>; rel=next`,
+			want:   "N_2",
+			ok:     true,
+		},
+		{
+			name:   "quoted rel",
+			header: `<https://api.meraki.com/api/v1/organizations/1/networks?startingAfter=N_3>; rel="next"`,
+			want:   "N_3",
+			ok:     true,
+		},
+		{
+			name:   "last page",
+			header: `<https://api.meraki.com/api/v1/organizations/1/networks?startingAfter=N_1>; rel=first, <https://api.meraki.com/api/v1/organizations/1/networks?endingBefore=N_9>; rel=prev`,
+		},
+		{
+			name: "no header",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := nextStartingAfter(c.header)
+			if got != c.want || ok != c.ok {
+				t.Errorf("nextStartingAfter() = %q, %v, want %q, %v", got, ok, c.want, c.ok)
+			}
+		})
+	}
+}
+
+func TestPaginateList(t *testing.T) {
+	mock := newMerakiMock(t, "organizations_networks")
+	client := mock.client(t)
+	fetch := func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationNetworks, *resty.Response, error) {
+		return client.Organizations.GetOrganizationNetworks("2930418", &merakigosdk.GetOrganizationNetworksQueryParams{
+			StartingAfter: startingAfter,
+		})
+	}
+
+	cases := []struct {
+		name      string
+		maxItems  int64
+		wantItems int
+		wantPages int64
+	}{
+		{name: "all pages", wantItems: 3, wantPages: 2},
+		{name: "max items on first page", maxItems: 2, wantItems: 2, wantPages: 1},
+		{name: "max items on second page", maxItems: 3, wantItems: 3, wantPages: 2},
+		{name: "max items above total", maxItems: 10, wantItems: 3, wantPages: 2},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			response, _, pages, err := paginateList("", c.maxItems, fetch)
+			if err != nil {
+				t.Fatalf("paginateList() error: %s", err)
+			}
+			if len(*response) != c.wantItems || pages != c.wantPages {
+				t.Errorf("paginateList() read %d items in %d pages, want %d items in %d pages", len(*response), pages, c.wantItems, c.wantPages)
+			}
+		})
+	}
+}
+
+func TestPaginateListEmptyPage(t *testing.T) {
+	mock := newMerakiMock(t, "organizations_networks")
+	client := mock.client(t)
+	fetch := func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationNetworks, *resty.Response, error) {
+		if startingAfter != "" {
+			return nil, nil, nil
+		}
+		return client.Organizations.GetOrganizationNetworks("2930418", nil)
+	}
+	response, _, pages, err := paginateList("", 0, fetch)
+	if err == nil || err.Error() != "empty page after 1 pages" {
+		t.Errorf("paginateList() error = %v, want the empty page error", err)
+	}
+	if response != nil || pages != 1 {
+		t.Errorf("paginateList() = %v after %d pages, want no response after 1 page", response, pages)
+	}
+}
+
+// TestPaginatedOrganizationDataSources checks that the list data sources whose SDK response
+// is not the list of another data source also follow the rel=next Link header.
+func TestPaginatedOrganizationDataSources(t *testing.T) {
+	for _, test := range []struct {
+		typeName, path string
+	}{
+		{"meraki_organizations_policy_objects", "/api/v1/organizations/2930418/policyObjects"},
+		{"meraki_organizations_policy_objects_groups", "/api/v1/organizations/2930418/policyObjects/groups"},
+		{"meraki_organizations_sm_admins_roles", "/api/v1/organizations/2930418/sm/admins/roles"},
+	} {
+		t.Run(test.typeName, func(t *testing.T) {
+			mock := newMerakiMock(t, "organizations_policy_objects")
+			server := testConfiguredServer(t, mock)
+			ctx := context.Background()
+			schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			s := schemas.DataSourceSchemas[test.typeName]
+
+			for _, c := range []struct {
+				maxItems  int64
+				wantPages int64
+			}{{0, 2}, {1, 1}} {
+				attributes := map[string]tftypes.Value{
+					"organization_id": tftypes.NewValue(tftypes.String, "2930418"),
+					"per_page":        tftypes.NewValue(tftypes.Number, 1),
+				}
+				if c.maxItems > 0 {
+					attributes["max_items"] = tftypes.NewValue(tftypes.Number, c.maxItems)
+				}
+				resp, err := server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+					TypeName: test.typeName,
+					Config:   testDynamicValue(t, s, attributes),
+				})
+				if err != nil || testDiagnosticsError(resp.Diagnostics) != "" {
+					t.Fatalf("reading %s: %v %s", test.typeName, err, testDiagnosticsError(resp.Diagnostics))
+				}
+				state, err := resp.State.Unmarshal(s.ValueType())
+				if err != nil {
+					t.Fatal(err)
+				}
+				var values map[string]tftypes.Value
+				var pages big.Float
+				if err := state.As(&values); err != nil {
+					t.Fatal(err)
+				}
+				values["total_pages"].As(&pages)
+				if got, _ := pages.Int64(); got != c.wantPages {
+					t.Errorf("max_items %d: total_pages = %d, want %d", c.maxItems, got, c.wantPages)
+				}
+			}
+			if requests := mock.requestsFor("GET", test.path); len(requests) != 3 || !strings.Contains(requests[1].Query, "startingAfter=page-1") {
+				t.Errorf("requests = %+v, want the second page read after the first one", requests)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// pollInterval is the time before the second read of an asynchronous Meraki job, like an
+// action batch or a live tool. The time doubles after every read, up to pollMaxInterval.
+var pollInterval = 2 * time.Second
+
+// pollMaxInterval is the longest time between two reads of an asynchronous Meraki job.
+var pollMaxInterval = 30 * time.Second
+
+// poll calls check until it reports that the job is done or returns an error, waiting longer
+// after every call. It stops with an error when ctx is done, which is how the timeouts of a
+// resource bound the wait.
+func poll(ctx context.Context, check func() (bool, error)) error {
+	interval := pollInterval
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("timed out waiting for the job to finish: %w", ctx.Err())
+		case <-timer.C:
+		}
+		interval *= 2
+		if interval > pollMaxInterval {
+			interval = pollMaxInterval
+		}
+	}
+}
@@ -22,21 +22,30 @@ package provider
 import (
 	"context"
 	"fmt"
-	"log"
 	"os"
 	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
@@ -46,11 +55,18 @@ const (
 	DEFAULT_MAX_RETRY_DELAY  = 1000
 	DEFAULT_MAX_RETRY_JITTER = 3000
 	DEFAULT_USE_RETRY_HEADER = false
+	DEFAULT_REQUEST_TIMEOUT  = 60
 )
 
+// DEFAULT_RETRY_ON_STATUS are the statuses returned by the Meraki API during maintenance.
+var DEFAULT_RETRY_ON_STATUS = []int64{502, 503, 504}
+
 // terraform-provider-meraki
 // Ensure MerakiProvider satisfies various provider interfaces.
 var _ provider.Provider = &MerakiProvider{}
+var _ provider.ProviderWithEphemeralResources = &MerakiProvider{}
+var _ provider.ProviderWithFunctions = &MerakiProvider{}
+var _ provider.ProviderWithActions = &MerakiProvider{}
 
 // MerakiProvider defines the provider implementation.
 type MerakiProvider struct {
@@ -58,23 +74,56 @@ type MerakiProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// limiter is set by Configure, it limits the requests of every client by organization.
+	limiter atomic.Pointer[merakiRateLimiter]
 }
 
 // MerakiProviderModel describes the provider data model.
 type MerakiProviderModel struct {
-	BaseURL               types.String `tfsdk:"meraki_base_url"`
-	MerakiDashboardApiKey types.String `tfsdk:"meraki_dashboard_api_key"`
-	Debug                 types.String `tfsdk:"meraki_debug"`
-	RequestPerSecond      types.Int64  `tfsdk:"meraki_requests_per_second"`
-	UserAgent             types.String `tfsdk:"meraki_user_agent"`
-	Retries               types.Int64  `tfsdk:"meraki_retries"`
-	RetriesDelay          types.Int64  `tfsdk:"meraki_retries_delay"`
-	RetriesJitter         types.Int64  `tfsdk:"meraki_retries_jitter"`
-	UseRetryHeader        types.Bool   `tfsdk:"meraki_use_retry_header"`
+	BaseURL               types.String                     `tfsdk:"meraki_base_url"`
+	Region                types.String                     `tfsdk:"meraki_region"`
+	MerakiDashboardApiKey types.String                     `tfsdk:"meraki_dashboard_api_key"`
+	Debug                 types.String                     `tfsdk:"meraki_debug"`
+	RequestPerSecond      types.Int64                      `tfsdk:"meraki_requests_per_second"`
+	UserAgent             types.String                     `tfsdk:"meraki_user_agent"`
+	Retries               types.Int64                      `tfsdk:"meraki_retries"`
+	RetriesDelay          types.Int64                      `tfsdk:"meraki_retries_delay"`
+	RetriesJitter         types.Int64                      `tfsdk:"meraki_retries_jitter"`
+	UseRetryHeader        types.Bool                       `tfsdk:"meraki_use_retry_header"`
+	ResetOnDestroy        types.Bool                       `tfsdk:"meraki_reset_on_destroy"`
+	BatchWrites           types.Bool                       `tfsdk:"meraki_batch_writes"`
+	AllowDestructive      types.Bool                       `tfsdk:"meraki_allow_destructive_operations"`
+	RetryOnStatus         types.List                       `tfsdk:"meraki_retry_on_status"`
+	RequestTimeout        types.Int64                      `tfsdk:"meraki_request_timeout"`
+	ProxyURL              types.String                     `tfsdk:"meraki_proxy_url"`
+	CaCertFile            types.String                     `tfsdk:"meraki_ca_cert_file"`
+	CaCertPem             types.String                     `tfsdk:"meraki_ca_cert_pem"`
+	InsecureSkipVerify    types.Bool                       `tfsdk:"meraki_insecure_skip_verify"`
+	Credentials           []MerakiProviderCredentialsModel `tfsdk:"credentials"`
+}
+
+// MerakiProviderCredentialsModel describes the API key of one organization.
+type MerakiProviderCredentialsModel struct {
+	OrganizationID types.String `tfsdk:"organization_id"`
+	ApiKey         types.String `tfsdk:"api_key"`
 }
 
 type MerakiProviderData struct {
-	Client *merakigosdk.Client
+	Client         *merakigosdk.Client
+	Clients        *merakiClientPool
+	ResetOnDestroy bool
+	// AllowDestructiveOperations is set when meraki_allow_destructive_operations is enabled.
+	AllowDestructiveOperations bool
+	// Batcher is set when meraki_batch_writes is enabled.
+	Batcher *actionBatcher
+}
+
+// ClientFor returns the client for the organization, falling back to the default client.
+func (d MerakiProviderData) ClientFor(organizationID string) *merakigosdk.Client {
+	if d.Clients == nil {
+		return d.Client
+	}
+	return d.Clients.Client(organizationID)
 }
 
 func (p *MerakiProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -89,6 +138,14 @@ func (p *MerakiProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 				MarkdownDescription: "Cisco Meraki base URL, FQDN or IP. If not set, it uses the MERAKI_BASE_URL environment variable. Default is (https://api.meraki.com/)",
 			},
+			"meraki_region": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Region of the Meraki dashboard of the organizations, sets the base URL of its API: `global` (https://api.meraki.com/), `canada` (https://api.meraki.ca/), `china` (https://api.meraki.cn/), `india` (https://api.meraki.in/) or `fedramp` (https://api.gov-meraki.com/). The requests to an organization hosted in another region fail with an error naming its region. If not set, it uses the MERAKI_REGION environment variable, then the region of `meraki_base_url`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(merakiRegionNames()...),
+					stringvalidator.ConflictsWith(path.MatchRoot("meraki_base_url")),
+				},
+			},
 			"meraki_dashboard_api_key": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
@@ -96,11 +153,11 @@ func (p *MerakiProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 			},
 			"meraki_debug": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Flag for Cisco Meraki to enable debugging. If not set, it uses the MERAKI_DEBUG environment variable defaults to `false`.",
+				MarkdownDescription: "Flag for Cisco Meraki to enable debugging. When `true`, every API call is logged at the DEBUG level of the `meraki_http` log subsystem with its method, path, status, latency, rate limit headers and retry count. API keys and secrets are masked. If not set, it uses the MERAKI_DEBUG environment variable defaults to `false`.",
 			},
 			"meraki_requests_per_second": schema.Int64Attribute{
 				Optional:            true,
-				MarkdownDescription: "Flag requests per second allowed for client. Default is (10)",
+				MarkdownDescription: "Requests per second allowed for each organization. The requests of all the organizations are also limited to the 100 requests per second of a source IP. The rate of an organization is lowered when the API answers with 429 and raised back on success. Default is (10)",
 			},
 			"meraki_user_agent": schema.StringAttribute{
 				Optional:            true,
@@ -114,7 +171,7 @@ func (p *MerakiProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 			},
 			"meraki_retries": schema.Int64Attribute{
 				Optional:            true,
-				MarkdownDescription: "Maximum number of retries after receiving a 429 (Too Many Requests) error response. Default is 3.",
+				MarkdownDescription: "Maximum number of retries after receiving a 429 (Too Many Requests) error response, a status of `meraki_retry_on_status` or a network error. Default is 3.",
 			},
 			"meraki_retries_delay": schema.Int64Attribute{
 				Optional:            true,
@@ -124,10 +181,74 @@ func (p *MerakiProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 				MarkdownDescription: "Maximum jitter between retries after receiving a 429 (Too Many Requests) error response. Default is 3000 milliseconds.",
 			},
+			"meraki_retry_on_status": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "HTTP statuses after which a request is retried, up to `meraki_retries` times with an exponential backoff that starts at `meraki_retries_delay`. Requests that time out or whose connection is reset are retried as well. Only GET, PUT and DELETE requests are retried, and the POST requests that can be repeated, like blinking the LEDs of a device. Default is `[502, 503, 504]`.",
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+				},
+			},
+			"meraki_request_timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Timeout of each attempt of a request, in seconds. If not set, it uses the MERAKI_REQUEST_TIMEOUT environment variable. Default is 60.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"meraki_proxy_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "URL of the HTTP, HTTPS or SOCKS5 proxy of the API requests, like `http://proxy.example.com:3128`. If not set, it uses the MERAKI_PROXY_URL environment variable, then the HTTPS_PROXY and NO_PROXY environment variables.",
+			},
+			"meraki_ca_cert_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path of a PEM file with CA certificates to trust in addition to the system ones, like the CA of an inspecting proxy. If not set, it uses the MERAKI_CA_CERT_FILE environment variable.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("meraki_ca_cert_pem")),
+				},
+			},
+			"meraki_ca_cert_pem": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "PEM encoded CA certificates to trust in addition to the system ones, like the CA of an inspecting proxy. If not set, it uses the MERAKI_CA_CERT_PEM environment variable.",
+			},
+			"meraki_insecure_skip_verify": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Flag to skip the verification of the TLS certificate of the API. Anyone between Terraform and the API can then read the API key, only use it to troubleshoot. If not set, it uses the MERAKI_INSECURE_SKIP_VERIFY environment variable. Default is `false`.",
+			},
 			"meraki_use_retry_header": schema.BoolAttribute{
 				Optional:            true,
 				MarkdownDescription: "Flag for Cisco Meraki to enable the use of the Retry-After header in the response. Default is `false`.",
 			},
+			"meraki_reset_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Flag to restore the Meraki default settings when a settings resource that has no delete method is destroyed. It can be overridden per resource with `reset_on_destroy`. Settings that have no Meraki default, like the name of a device, are left as they are with a warning. Resources that run an action are not affected. Default is `false`.",
+			},
+			"meraki_allow_destructive_operations": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Flag to allow the resources that run an irreversible operation when they are created, like `meraki_networks_sm_devices_wipe`, `meraki_networks_devices_remove`, `meraki_networks_split`, `meraki_networks_sm_devices_unenroll`, `meraki_organizations_inventory_release` and `meraki_organizations_licenses_move`. Their plans fail unless it is set. If not set, it uses the MERAKI_ALLOW_DESTRUCTIVE_OPERATIONS environment variable. Default is `false`.",
+			},
+			"meraki_batch_writes": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Flag to send the writes of `meraki_devices_switch_ports`, `meraki_devices_switch_port_ranges`, `meraki_networks_appliance_vlans` and `meraki_networks_wireless_ssids` in action batches of their organization instead of one API call each. The writes are queued until no new write comes for 2 seconds or 100 actions are queued. Batches of up to 20 actions run synchronously, larger batches are polled until they finish. Raise `-parallelism` to put more writes in each batch. Default is `false`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credentials": schema.ListNestedBlock{
+				MarkdownDescription: "API key of an organization. The requests to the organization, and to its networks and devices, use the key of the organization, everything else uses `meraki_dashboard_api_key`, or the first credentials block when it is not set.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"organization_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Organization ID that uses this API key.",
+						},
+						"api_key": schema.StringAttribute{
+							Required:            true,
+							Sensitive:           true,
+							MarkdownDescription: "Cisco Meraki API key of the organization.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -140,30 +261,13 @@ func (p *MerakiProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	if data.BaseURL.IsUnknown() || data.BaseURL.IsNull() {
-		// resp.Diagnostics.AddAttributeError(
-		// 	path.Root("base_url"),
-		// 	"Unknown Meraki API base_url",
-		// 	"The provider cannot create the Meraki API client as there is an unknown configuration value for the Meraki API BaseURL. "+
-		// 		"Either target apply the source of the value first, set the value statically in the configuration, or use the MERAKI_BASE_URL environment variable.",
-		// )
-		data.BaseURL = types.StringValue("https://api.meraki.com/")
-		// return
-	}
-
-	var requestPerSecond int
-	if data.RequestPerSecond.IsUnknown() || data.RequestPerSecond.IsNull() {
-		// resp.Diagnostics.AddAttributeError(
-		// 	path.Root("base_url"),
-		// 	"Unknown Meraki API base_url",
-		// 	"The provider cannot create the Meraki API client as there is an unknown configuration value for the Meraki API BaseURL. "+
-		// 		"Either target apply the source of the value first, set the value statically in the configuration, or use the MERAKI_BASE_URL environment variable.",
-		// )
-		requestPerSecond = 10
-		// return
-	} else {
-		requestPerSecondTf := int(data.RequestPerSecond.ValueInt64())
-		requestPerSecond = requestPerSecondTf
+	if data.BaseURL.IsUnknown() || data.Region.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown Meraki API base_url or region",
+			"The provider cannot create the Meraki API client as there is an unknown configuration value for the Meraki API BaseURL or Region. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the MERAKI_BASE_URL or MERAKI_REGION environment variables.",
+		)
+		return
 	}
 
 	if data.MerakiDashboardApiKey.IsUnknown() {
@@ -189,15 +293,87 @@ func (p *MerakiProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	clients, limiter, diags := newMerakiClients(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.limiter.Store(limiter)
+	client := clients.defaultClient
+
+	// client.SetUserAgent(customUserAgent)
+	resetOnDestroy := false
+	if !data.ResetOnDestroy.IsNull() && !data.ResetOnDestroy.IsUnknown() {
+		resetOnDestroy = data.ResetOnDestroy.ValueBool()
+	}
+	dataClient := MerakiProviderData{Client: client, Clients: clients, ResetOnDestroy: resetOnDestroy}
+	dataClient.AllowDestructiveOperations = os.Getenv("MERAKI_ALLOW_DESTRUCTIVE_OPERATIONS") == "true"
+	if !data.AllowDestructive.IsNull() && !data.AllowDestructive.IsUnknown() {
+		dataClient.AllowDestructiveOperations = data.AllowDestructive.ValueBool()
+	}
+	if !data.BatchWrites.IsNull() && !data.BatchWrites.IsUnknown() && data.BatchWrites.ValueBool() {
+		dataClient.Batcher = newActionBatcher(clients, limiter.resolver)
+	}
+
+	resp.DataSourceData = dataClient
+	resp.ResourceData = dataClient
+	resp.EphemeralResourceData = dataClient
+	resp.ActionData = dataClient
+
+}
+
+// newMerakiClients creates the clients of the provider from its configuration, the
+// environment variables are used for the values that are not set. The export command
+// uses it with an empty configuration.
+func newMerakiClients(ctx context.Context, data MerakiProviderModel) (*merakiClientPool, *merakiRateLimiter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var requestPerSecond int
+	if data.RequestPerSecond.IsUnknown() || data.RequestPerSecond.IsNull() {
+		// resp.Diagnostics.AddAttributeError(
+		// 	path.Root("base_url"),
+		// 	"Unknown Meraki API base_url",
+		// 	"The provider cannot create the Meraki API client as there is an unknown configuration value for the Meraki API BaseURL. "+
+		// 		"Either target apply the source of the value first, set the value statically in the configuration, or use the MERAKI_BASE_URL environment variable.",
+		// )
+		requestPerSecond = 10
+		// return
+	} else {
+		requestPerSecondTf := int(data.RequestPerSecond.ValueInt64())
+		requestPerSecond = requestPerSecondTf
+	}
+
 	// Default values to enviroment variables, but override
 	// with Terraform configuration value if set.
 	baseURL := os.Getenv("MERAKI_BASE_URL")
 	merakiDashboardApiKey := os.Getenv("MERAKI_DASHBOARD_API_KEY")
 	debug := os.Getenv("MERAKI_DEBUG")
 	userAgent := CUSTOM_USER_AGENT
-	if !data.BaseURL.IsNull() && !data.BaseURL.IsUnknown() {
+	region := os.Getenv("MERAKI_REGION")
+	if region != "" {
+		if _, ok := merakiRegions[region]; !ok {
+			diags.AddError(
+				"Invalid MERAKI_REGION environment variable",
+				fmt.Sprintf("Expected one of %s. Got: %q.", strings.Join(merakiRegionNames(), ", "), region),
+			)
+			return nil, nil, diags
+		}
+	}
+	if !data.Region.IsNull() {
+		region = data.Region.ValueString()
+	}
+	// meraki_base_url overrides MERAKI_REGION, meraki_region overrides both.
+	switch {
+	case !data.Region.IsNull():
+		baseURL = merakiRegions[region]
+	case !data.BaseURL.IsNull():
 		baseURL = data.BaseURL.ValueString()
+	case region != "":
+		baseURL = merakiRegions[region]
+	case baseURL == "":
+		baseURL = merakiRegions["global"]
 	}
+	// The region of a proxy or a mock of the API is unknown, its organizations are not checked.
+	region = regionOfBaseURL(baseURL)
 	if !data.MerakiDashboardApiKey.IsNull() && !data.MerakiDashboardApiKey.IsUnknown() {
 		merakiDashboardApiKey = data.MerakiDashboardApiKey.ValueString()
 	}
@@ -210,36 +386,143 @@ func (p *MerakiProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	} else {
 		userAgent = fmt.Sprintf("%s %s", CUSTOM_USER_AGENT, DEFAULT_USER_AGENT)
 	}
-	log.Printf("[DEBUG] Meraki userAgent: %s", userAgent)
-	// if !data.SSLVerify.IsNull() {
-	// 	sslVerify = data.SSLVerify.ValueString()
-	// }
-
-	// Create a new Meraki client using the configuration values
-	client, err := merakigosdk.NewClientWithOptionsAndRequests(baseURL,
-		merakiDashboardApiKey, debug, userAgent, requestPerSecond,
-	)
-	maxRetries, maxRetryDelay, maxRetryJitter, useRetryHeader := GetBackoffValues(data)
+	tflog.Debug(ctx, "Meraki user agent", map[string]interface{}{"user_agent": userAgent})
 
+	proxyURL := os.Getenv("MERAKI_PROXY_URL")
+	caCertFile := os.Getenv("MERAKI_CA_CERT_FILE")
+	caCertPEM := os.Getenv("MERAKI_CA_CERT_PEM")
+	insecureSkipVerify := os.Getenv("MERAKI_INSECURE_SKIP_VERIFY") == "true"
+	if !data.ProxyURL.IsNull() && !data.ProxyURL.IsUnknown() {
+		proxyURL = data.ProxyURL.ValueString()
+	}
+	if !data.CaCertFile.IsNull() && !data.CaCertFile.IsUnknown() {
+		caCertFile, caCertPEM = data.CaCertFile.ValueString(), ""
+	}
+	if !data.CaCertPem.IsNull() && !data.CaCertPem.IsUnknown() {
+		caCertFile, caCertPEM = "", data.CaCertPem.ValueString()
+	}
+	if !data.InsecureSkipVerify.IsNull() && !data.InsecureSkipVerify.IsUnknown() {
+		insecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+	}
+	transport, err := newTransportOptions(proxyURL, caCertFile, caCertPEM, insecureSkipVerify)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Uneable to Create Meraki API Client",
+		diags.AddError(
+			"Invalid Meraki API proxy or TLS options",
 			"Error: "+err.Error(),
 		)
-		return
+		return nil, nil, diags
+	}
+	if insecureSkipVerify {
+		tflog.Warn(ctx, "The TLS certificate of the Meraki API is not verified")
 	}
-	client.SetBackoff(&maxRetries, &maxRetryDelay, &maxRetryJitter, &useRetryHeader)
 
-	// client.RestyClient().SetLogger(createLogger())
-	// client.SetUserAgent(customUserAgent)
-	dataClient := MerakiProviderData{Client: client}
+	maxRetries, maxRetryDelay, maxRetryJitter, useRetryHeader := GetBackoffValues(ctx, data)
+	retryOnStatus := DEFAULT_RETRY_ON_STATUS
+	if !data.RetryOnStatus.IsNull() && !data.RetryOnStatus.IsUnknown() {
+		retryOnStatus = nil
+		diags.Append(data.RetryOnStatus.ElementsAs(ctx, &retryOnStatus, false)...)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+	}
+	retries := newRetryPolicy(retryOnStatus)
+	requestTimeout := time.Duration(DEFAULT_REQUEST_TIMEOUT) * time.Second
+	if value := os.Getenv("MERAKI_REQUEST_TIMEOUT"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds < 1 {
+			diags.AddError(
+				"Invalid MERAKI_REQUEST_TIMEOUT environment variable",
+				fmt.Sprintf("Expected a number of seconds of 1 or more. Got: %q.", value),
+			)
+			return nil, nil, diags
+		}
+		requestTimeout = time.Duration(seconds) * time.Second
+	}
+	if !data.RequestTimeout.IsNull() && !data.RequestTimeout.IsUnknown() {
+		requestTimeout = time.Duration(data.RequestTimeout.ValueInt64()) * time.Second
+	}
+	// The RESTY debug output prints the API key, meraki_debug logs the calls
+	// to the meraki_http subsystem instead.
+	httpLogCtx := newHTTPLogContext(ctx)
+	// The clients share the limits of the organizations and of the source IP, so the SDK
+	// limit of each client is the one of the source IP.
+	limiter := newMerakiRateLimiter(httpLogCtx, requestPerSecond)
+	guard := newRegionGuard(httpLogCtx, region, limiter)
+	// Each organization in the credentials blocks gets a client of its own.
+	clients := newMerakiClientPool()
+	newClient := func(apiKey string) (*merakigosdk.Client, error) {
+		client, err := merakigosdk.NewClientWithOptionsAndRequests(baseURL,
+			apiKey, "false", userAgent, sourceIPRequestsPerSecond,
+		)
+		if err != nil {
+			return nil, err
+		}
+		client.SetBackoff(&maxRetries, &maxRetryDelay, &maxRetryJitter, &useRetryHeader)
+		transport.install(client)
+		retries.install(client, maxRetries, maxRetryDelay, requestTimeout)
+		limiter.install(client)
+		clients.install(client, limiter)
+		guard.install(client)
+		if debug == "true" {
+			enableHTTPLogging(httpLogCtx, client)
+		}
+		return client, nil
+	}
 
-	resp.DataSourceData = dataClient
-	resp.ResourceData = dataClient
+	var firstClient *merakigosdk.Client
+	for i, credentials := range data.Credentials {
+		if credentials.OrganizationID.IsUnknown() || credentials.ApiKey.IsUnknown() {
+			diags.AddAttributeError(
+				path.Root("credentials").AtListIndex(i),
+				"Unknown Meraki API credentials",
+				"The provider cannot create the Meraki API client as there is an unknown configuration value for the organization ID or the API key of the credentials. "+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+			return nil, nil, diags
+		}
+		organizationID := credentials.OrganizationID.ValueString()
+		if clients.clients[organizationID] != nil {
+			diags.AddAttributeError(
+				path.Root("credentials").AtListIndex(i).AtName("organization_id"),
+				"Duplicate Meraki API credentials",
+				"The organization "+organizationID+" is set in more than one credentials block.",
+			)
+			return nil, nil, diags
+		}
+		client, err := newClient(credentials.ApiKey.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Uneable to Create Meraki API Client",
+				"Error: "+err.Error(),
+			)
+			return nil, nil, diags
+		}
+		clients.Add(organizationID, client)
+		if firstClient == nil {
+			firstClient = client
+		}
+	}
 
+	// Create a new Meraki client using the configuration values
+	var client *merakigosdk.Client
+	if merakiDashboardApiKey == "" && firstClient != nil {
+		client = firstClient
+	} else {
+		var err error
+		client, err = newClient(merakiDashboardApiKey)
+		if err != nil {
+			diags.AddError(
+				"Uneable to Create Meraki API Client",
+				"Error: "+err.Error(),
+			)
+			return nil, nil, diags
+		}
+	}
+	clients.defaultClient = client
+	return clients, limiter, diags
 }
 
-func GetBackoffValues(data MerakiProviderModel) (maxRetries int, maxRetryDelay time.Duration, maxRetryJitter time.Duration, useRetryHeader bool) {
+func GetBackoffValues(ctx context.Context, data MerakiProviderModel) (maxRetries int, maxRetryDelay time.Duration, maxRetryJitter time.Duration, useRetryHeader bool) {
 
 	if data.Retries.IsUnknown() || data.Retries.IsNull() {
 		maxRetries = DEFAULT_MAX_RETRIES
@@ -264,10 +547,12 @@ func GetBackoffValues(data MerakiProviderModel) (maxRetries int, maxRetryDelay t
 	} else {
 		useRetryHeader = data.UseRetryHeader.ValueBool()
 	}
-	log.Printf("[DEBUG] Meraki maxRetries: %d", maxRetries)
-	log.Printf("[DEBUG] Meraki maxRetryDelay: %d", maxRetryDelay)
-	log.Printf("[DEBUG] Meraki maxRetryJitter: %d", maxRetryJitter)
-	log.Printf("[DEBUG] Meraki useRetryHeader: %t", useRetryHeader)
+	tflog.Debug(ctx, "Meraki retry settings", map[string]interface{}{
+		"max_retries":      maxRetries,
+		"max_retry_delay":  maxRetryDelay.String(),
+		"max_retry_jitter": maxRetryJitter.String(),
+		"use_retry_header": useRetryHeader,
+	})
 	return maxRetries, maxRetryDelay, maxRetryJitter, useRetryHeader
 }
 
@@ -295,6 +580,7 @@ func (p *MerakiProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewDevicesManagementInterfaceResource,
 		NewDevicesSensorRelationshipsResource,
 		NewDevicesSwitchPortsResource,
+		NewDevicesSwitchPortRangesResource,
 		NewDevicesSwitchRoutingInterfacesResource,
 		NewDevicesSwitchRoutingInterfacesDhcpResource,
 		NewDevicesSwitchRoutingStaticRoutesResource,
@@ -306,10 +592,14 @@ func (p *MerakiProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewNetworksApplianceConnectivityMonitoringDestinationsResource,
 		NewNetworksApplianceContentFilteringResource,
 		NewNetworksApplianceStaticRoutesResource,
+		NewNetworksApplianceFirewallCellularFirewallRuleResource,
 		NewNetworksApplianceFirewallCellularFirewallRulesResource,
 		NewNetworksApplianceFirewallFirewalledServicesResource,
+		NewNetworksApplianceFirewallInboundFirewallRuleResource,
 		NewNetworksApplianceFirewallInboundFirewallRulesResource,
+		NewNetworksApplianceFirewallL3FirewallRuleResource,
 		NewNetworksApplianceFirewallL3FirewallRulesResource,
+		NewNetworksApplianceFirewallL7FirewallRuleResource,
 		NewNetworksApplianceFirewallL7FirewallRulesResource,
 		NewNetworksApplianceFirewallOneToManyNatRulesResource,
 		NewNetworksApplianceFirewallOneToOneNatRulesResource,
@@ -343,6 +633,7 @@ func (p *MerakiProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewNetworksFirmwareUpgradesStagedEventsResource,
 		NewNetworksFirmwareUpgradesStagedGroupsResource,
 		NewNetworksFirmwareUpgradesStagedStagesResource,
+		NewFirmwareRolloutResource,
 		NewNetworksFloorPlansResource,
 		NewNetworksGroupPoliciesResource,
 		NewNetworksMerakiAuthUsersResource,
@@ -386,7 +677,9 @@ func (p *MerakiProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewNetworksWirelessSSIDsBonjourForwardingResource,
 		NewNetworksWirelessSSIDsDeviceTypeGroupPoliciesResource,
 		NewNetworksWirelessSSIDsEapOverrideResource,
+		NewNetworksWirelessSSIDsFirewallL3FirewallRuleResource,
 		NewNetworksWirelessSSIDsFirewallL3FirewallRulesResource,
+		NewNetworksWirelessSSIDsFirewallL7FirewallRuleResource,
 		NewNetworksWirelessSSIDsFirewallL7FirewallRulesResource,
 		NewNetworksWirelessSSIDsHotspot20Resource,
 		NewNetworksWirelessSSIDsIDentityPsksResource,
@@ -522,6 +815,11 @@ func (p *MerakiProvider) Resources(ctx context.Context) []func() resource.Resour
 func (p *MerakiProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewOrganizationsDataSource,
+		NewNetworkDataSource,
+		NewDeviceDataSource,
+		NewSSIDDataSource,
+		NewVLANDataSource,
+		NewGroupPolicyDataSource,
 		NewOrganizationsAdminsDataSource,
 		NewAdministeredIDentitiesMeDataSource,
 		NewDevicesDataSource,
@@ -859,3 +1157,29 @@ func (p *MerakiProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewOrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesDataSource,
 	}
 }
+
+func (p *MerakiProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAPIKeyEphemeralResource,
+		NewVmxAuthenticationTokenEphemeralResource,
+	}
+}
+
+func (p *MerakiProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewDeviceBlinkLedsAction,
+		NewDeviceSwitchPortsCycleAction,
+		NewNetworkApplianceWarmSpareSwapAction,
+		NewNetworkFirmwareRollbackAction,
+		NewNetworkSmDevicesRebootAction,
+	}
+}
+
+func (p *MerakiProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewFirewallRuleFunction,
+		NewNormalizePortRangeFunction,
+		NewParseSerialFunction,
+		NewVLANSubnetFunction,
+	}
+}
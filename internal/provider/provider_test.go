@@ -0,0 +1,110 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testAccProtoV6ProviderFactories instantiates the provider for the acceptance tests.
+// Every test points the provider at a merakiMock, so no live organization is needed.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"meraki": func() (tfprotov6.ProviderServer, error) {
+		return NewServer("test")(), nil
+	},
+}
+
+// testAccProviderConfig returns the provider block that targets the mock.
+func testAccProviderConfig(mock *merakiMock) string {
+	return fmt.Sprintf(`
+provider "meraki" {
+  meraki_base_url          = %q
+  meraki_dashboard_api_key = %q
+}
+`, mock.Server.URL, testAccApiKey)
+}
+
+// testRunFunction runs a provider function with the given arguments and returns its result.
+func testRunFunction(t *testing.T, f function.Function, result attr.Value, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	resp := &function.RunResponse{
+		Result: function.NewResultData(result),
+	}
+	f.Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData(arguments),
+	}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+// testConfiguredServer returns a provider server configured against the mock, to call the
+// protocol directly where resource.Test cannot, like the ephemeral resources without a
+// Terraform binary.
+func testConfiguredServer(t *testing.T, mock *merakiMock) tfprotov6.ProviderServer {
+	t.Helper()
+	ctx := context.Background()
+	server := NewServer("test")()
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil || testDiagnosticsError(schemas.Diagnostics) != "" {
+		t.Fatalf("getting provider schema: %v %s", err, testDiagnosticsError(schemas.Diagnostics))
+	}
+	config := testDynamicValue(t, schemas.Provider, map[string]tftypes.Value{
+		"meraki_base_url":          tftypes.NewValue(tftypes.String, mock.Server.URL),
+		"meraki_dashboard_api_key": tftypes.NewValue(tftypes.String, testAccApiKey),
+	})
+	resp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: config})
+	if err != nil || testDiagnosticsError(resp.Diagnostics) != "" {
+		t.Fatalf("configuring provider: %v %s", err, testDiagnosticsError(resp.Diagnostics))
+	}
+	return server
+}
+
+// testDynamicValue encodes an object of the schema with the given attributes, the others null.
+func testDynamicValue(t *testing.T, s *tfprotov6.Schema, attributes map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+	typ := s.ValueType().(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range typ.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+	value, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, values))
+	if err != nil {
+		t.Fatalf("encoding value: %s", err)
+	}
+	return &value
+}
+
+// testDiagnosticsError returns the summaries of the error diagnostics, or "" without errors.
+func testDiagnosticsError(diagnostics []*tfprotov6.Diagnostic) string {
+	var summaries []string
+	for _, d := range diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			summaries = append(summaries, d.Summary)
+		}
+	}
+	return strings.Join(summaries, "; ")
+}
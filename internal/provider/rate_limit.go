@@ -0,0 +1,273 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// sourceIPRequestsPerSecond is the budget of the Meraki API for each source IP, shared by
+	// all the organizations.
+	sourceIPRequestsPerSecond = 100
+	// rateLimitDefaultRetryAfter is the pause of an organization after a 429 response without
+	// a Retry-After header.
+	rateLimitDefaultRetryAfter = time.Second
+	// rateLimitSummaryThreshold is the throttling time after which a summary is added to the
+	// diagnostics of an apply.
+	rateLimitSummaryThreshold = 10 * time.Second
+)
+
+// rateLimitPath matches the organization, network or device of an API path.
+var rateLimitPath = regexp.MustCompile(`^/api/v1/(organizations|networks|devices)/([^/?]+)`)
+
+// rateLimitLookup marks the requests made by the limiter to find the organization of a
+// network or a device, which are not limited by organization.
+type rateLimitLookup struct{}
+
+// tokenBucket is a token bucket whose rate is lowered when the API answers with 429 and
+// raised back on every successful response.
+type tokenBucket struct {
+	maxRate     float64
+	rate        float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	return &tokenBucket{maxRate: rate, rate: rate, tokens: rate}
+}
+
+// reserve takes a token and returns how long the request has to wait for it.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.maxRate {
+			b.tokens = b.maxRate
+		}
+	}
+	b.last = now
+	b.tokens--
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	if pause := b.pausedUntil.Sub(now); pause > wait {
+		wait = pause
+	}
+	return wait
+}
+
+// throttle pauses the bucket for retryAfter and halves its rate.
+func (b *tokenBucket) throttle(now time.Time, retryAfter time.Duration) {
+	if until := now.Add(retryAfter); until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+	b.rate /= 2
+	if b.rate < 1 {
+		b.rate = 1
+	}
+	if b.tokens > 0 {
+		b.tokens = 0
+	}
+}
+
+// recover raises the rate of the bucket by a tenth of its maximum rate.
+func (b *tokenBucket) recover() {
+	b.rate += b.maxRate / 10
+	if b.rate > b.maxRate {
+		b.rate = b.maxRate
+	}
+}
+
+// rateLimitStats is the throttling of an organization.
+type rateLimitStats struct {
+	waited          time.Duration
+	requests        int
+	tooManyRequests int
+}
+
+// merakiRateLimiter limits the requests of every client of the provider by organization, and
+// all of them together by the budget of the source IP. The organization of a request is taken
+// from its path, networks and devices are looked up until a lookup succeeds.
+type merakiRateLimiter struct {
+	ctx  context.Context
+	rate float64
+
+	mu          sync.Mutex
+	sourceIP    *tokenBucket
+	buckets     map[string]*tokenBucket
+	total       map[string]*rateLimitStats
+	unreported  map[string]*rateLimitStats
+	unreportedT time.Duration
+
+	// resolver finds the organization of the networks and devices, it is shared with the
+	// routing of the credentials and the action batcher.
+	resolver *organizationResolver
+}
+
+func newMerakiRateLimiter(ctx context.Context, requestsPerSecond int) *merakiRateLimiter {
+	return &merakiRateLimiter{
+		ctx:        ctx,
+		rate:       float64(requestsPerSecond),
+		sourceIP:   newTokenBucket(sourceIPRequestsPerSecond),
+		buckets:    map[string]*tokenBucket{},
+		total:      map[string]*rateLimitStats{},
+		unreported: map[string]*rateLimitStats{},
+		resolver:   newOrganizationResolver(ctx),
+	}
+}
+
+// install adds the limiter to the resty client of the SDK.
+func (l *merakiRateLimiter) install(client *merakigosdk.Client) {
+	client.RestyClient().OnBeforeRequest(l.beforeRequest)
+	client.RestyClient().OnAfterResponse(l.afterResponse)
+	l.resolver.addClient(client.RestyClient())
+}
+
+func (l *merakiRateLimiter) beforeRequest(c *resty.Client, r *resty.Request) error {
+	if r.Context().Value(rateLimitLookup{}) != nil {
+		return nil
+	}
+	organizationID := l.organizationOf(c, r.URL)
+
+	l.mu.Lock()
+	now := time.Now()
+	bucket, ok := l.buckets[organizationID]
+	if !ok {
+		bucket = newTokenBucket(l.rate)
+		l.buckets[organizationID] = bucket
+	}
+	wait := bucket.reserve(now)
+	if sourceIPWait := l.sourceIP.reserve(now); sourceIPWait > wait {
+		wait = sourceIPWait
+	}
+	if wait > 0 {
+		l.record(organizationID, func(stats *rateLimitStats) {
+			stats.waited += wait
+			stats.requests++
+		})
+		l.unreportedT += wait
+	}
+	l.mu.Unlock()
+
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-r.Context().Done():
+			return r.Context().Err()
+		}
+	}
+	return nil
+}
+
+func (l *merakiRateLimiter) afterResponse(c *resty.Client, r *resty.Response) error {
+	if r.Request == nil || r.Request.Context().Value(rateLimitLookup{}) != nil {
+		return nil
+	}
+	organizationID := l.organizationOf(c, r.Request.URL)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	bucket, ok := l.buckets[organizationID]
+	if !ok {
+		return nil
+	}
+	if r.StatusCode() != http.StatusTooManyRequests {
+		if !r.IsError() {
+			bucket.recover()
+		}
+		return nil
+	}
+	retryAfter := rateLimitDefaultRetryAfter
+	if seconds, err := strconv.Atoi(r.Header().Get("Retry-After")); err == nil && seconds > 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+	bucket.throttle(time.Now(), retryAfter)
+	l.record(organizationID, func(stats *rateLimitStats) {
+		stats.tooManyRequests++
+	})
+	tflog.SubsystemWarn(l.ctx, httpLogSubsystem, "Meraki API rate limit reached", map[string]interface{}{
+		"organization_id": organizationID,
+		"retry_after":     retryAfter.String(),
+		"request_id":      r.Header().Get("X-Request-Id"),
+		"rate":            bucket.rate,
+	})
+	return nil
+}
+
+// record updates the total and unreported stats of the organization. l.mu must be held.
+func (l *merakiRateLimiter) record(organizationID string, update func(stats *rateLimitStats)) {
+	for _, stats := range []map[string]*rateLimitStats{l.total, l.unreported} {
+		if stats[organizationID] == nil {
+			stats[organizationID] = &rateLimitStats{}
+		}
+		update(stats[organizationID])
+	}
+}
+
+// organizationOf returns the organization of the path of a request, or an empty string when
+// the path has none or the lookup failed.
+func (l *merakiRateLimiter) organizationOf(c *resty.Client, url string) string {
+	return l.resolver.organizationOf(c, url)
+}
+
+// summary returns the throttling since the last summary when it is longer than
+// rateLimitSummaryThreshold, and starts a new period. It returns an empty string otherwise.
+func (l *merakiRateLimiter) summary() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.unreportedT < rateLimitSummaryThreshold {
+		return ""
+	}
+	organizationIDs := make([]string, 0, len(l.unreported))
+	for organizationID := range l.unreported {
+		organizationIDs = append(organizationIDs, organizationID)
+	}
+	sort.Strings(organizationIDs)
+	var lines []string
+	for _, organizationID := range organizationIDs {
+		stats := l.unreported[organizationID]
+		total := l.total[organizationID]
+		name := "Organization " + organizationID
+		if organizationID == "" {
+			name = "Requests without an organization"
+		}
+		lines = append(lines, fmt.Sprintf("%s: waited %s over %d requests, %d rate limit responses (%s over %d requests since the provider started).",
+			name, stats.waited.Round(time.Millisecond), stats.requests, stats.tooManyRequests,
+			total.waited.Round(time.Millisecond), total.requests))
+	}
+	l.unreported = map[string]*rateLimitStats{}
+	l.unreportedT = 0
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,219 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(10)
+	for i := 0; i < 10; i++ {
+		if wait := bucket.reserve(now); wait != 0 {
+			t.Fatalf("request %d waits %s, want no wait within the burst", i, wait)
+		}
+	}
+	if wait := bucket.reserve(now); wait != 100*time.Millisecond {
+		t.Errorf("request over the burst waits %s, want 100ms", wait)
+	}
+
+	bucket.throttle(now, 3*time.Second)
+	if bucket.rate != 5 {
+		t.Errorf("rate after a 429 = %v, want 5", bucket.rate)
+	}
+	if wait := bucket.reserve(now.Add(time.Second)); wait != 2*time.Second {
+		t.Errorf("request during the pause waits %s, want the 2s left of Retry-After", wait)
+	}
+	for i := 0; i < 10; i++ {
+		bucket.recover()
+	}
+	if bucket.rate != 10 {
+		t.Errorf("rate after recovering = %v, want 10", bucket.rate)
+	}
+}
+
+func TestMerakiRateLimiterOrganizations(t *testing.T) {
+	mock := newMerakiMock(t, "action_batcher")
+	client := mock.client(t)
+	limiter := newMerakiRateLimiter(context.Background(), 100)
+	limiter.install(client)
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Switch.GetDeviceSwitchPort("Q234-ABCD-0001", "1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := client.Appliance.GetNetworkApplianceVLAN("N_24329156", "10"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := limiter.buckets["2930418"]; !ok || len(limiter.buckets) != 1 {
+		t.Errorf("buckets = %v, want one bucket for organization 2930418", limiter.buckets)
+	}
+	if n := len(mock.requestsFor("GET", "/api/v1/devices/Q234-ABCD-0001")); n != 1 {
+		t.Errorf("device looked up %d times, want 1", n)
+	}
+	if n := len(mock.requestsFor("GET", "/api/v1/networks/N_24329156")); n != 1 {
+		t.Errorf("network looked up %d times, want 1", n)
+	}
+}
+
+func TestMerakiRateLimiterFailedLookup(t *testing.T) {
+	mock := newMerakiMock(t, "action_batcher")
+	client := mock.client(t)
+	limiter := newMerakiRateLimiter(context.Background(), 100)
+	limiter.install(client)
+	mock.failNext("GET", "/api/v1/networks/N_24329156", http.StatusServiceUnavailable)
+	mock.failNext("GET", "/api/v1/devices/Q234-ABCD-0001", http.StatusTooManyRequests)
+
+	for _, path := range []string{"/api/v1/networks/N_24329156/appliance/vlans/10", "/api/v1/devices/Q234-ABCD-0001/switch/ports/1"} {
+		if organizationID := limiter.organizationOf(client.RestyClient(), path); organizationID != "" {
+			t.Errorf("organizationOf(%s) after a failed lookup = %q, want no organization", path, organizationID)
+		}
+		if organizationID := limiter.organizationOf(client.RestyClient(), path); organizationID != "2930418" {
+			t.Errorf("organizationOf(%s) after a successful lookup = %q, want 2930418", path, organizationID)
+		}
+	}
+	if n := len(mock.requestsFor("GET", "/api/v1/networks/N_24329156")); n != 2 {
+		t.Errorf("network looked up %d times, want 2", n)
+	}
+	if n := len(mock.requestsFor("GET", "/api/v1/devices/Q234-ABCD-0001")); n != 2 {
+		t.Errorf("device looked up %d times, want 2", n)
+	}
+}
+
+func TestMerakiRateLimiterTooManyRequests(t *testing.T) {
+	limiter := newMerakiRateLimiter(context.Background(), 10)
+	client := resty.New()
+	request := client.R()
+	request.URL = "https://api.meraki.com/api/v1/organizations/2930418/networks"
+	if err := limiter.beforeRequest(client, request); err != nil {
+		t.Fatal(err)
+	}
+
+	header := http.Header{}
+	header.Set("Retry-After", "2")
+	header.Set("X-Request-Id", "b1a2")
+	response := &resty.Response{
+		Request:     request,
+		RawResponse: &http.Response{StatusCode: http.StatusTooManyRequests, Header: header},
+	}
+	if err := limiter.afterResponse(client, response); err != nil {
+		t.Fatal(err)
+	}
+
+	bucket := limiter.buckets["2930418"]
+	if bucket.rate != 5 {
+		t.Errorf("rate after a 429 = %v, want 5", bucket.rate)
+	}
+	if pause := time.Until(bucket.pausedUntil); pause < time.Second || pause > 2*time.Second {
+		t.Errorf("organization paused for %s, want the 2s of Retry-After", pause)
+	}
+	if stats := limiter.total["2930418"]; stats == nil || stats.tooManyRequests != 1 {
+		t.Errorf("stats = %+v, want one rate limit response", stats)
+	}
+	if _, ok := limiter.buckets[""]; ok {
+		t.Errorf("request limited without its organization")
+	}
+}
+
+func TestMerakiRateLimiterCancel(t *testing.T) {
+	limiter := newMerakiRateLimiter(context.Background(), 10)
+	limiter.buckets["2930418"] = newTokenBucket(10)
+	limiter.buckets["2930418"].throttle(time.Now(), time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := resty.New()
+	request := client.R().SetContext(ctx)
+	request.URL = "https://api.meraki.com/api/v1/organizations/2930418/networks"
+	errs := make(chan error, 1)
+	go func() { errs <- limiter.beforeRequest(client, request) }()
+	cancel()
+	select {
+	case err := <-errs:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("beforeRequest() = %v, want the error of the cancelled context", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("beforeRequest() waited out the Retry-After pause of a cancelled request")
+	}
+}
+
+func TestMerakiRateLimiterParallelLookups(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/networks/N_slow" {
+			<-release
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"organizationId": "2930418"}`))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	limiter := newMerakiRateLimiter(context.Background(), 10)
+	client := resty.New().SetBaseURL(server.URL)
+	go limiter.organizationOf(client, "/api/v1/networks/N_slow/devices")
+	time.Sleep(20 * time.Millisecond)
+
+	found := make(chan string, 1)
+	go func() { found <- limiter.organizationOf(client, "/api/v1/networks/N_fast/devices") }()
+	select {
+	case organizationID := <-found:
+		if organizationID != "2930418" {
+			t.Errorf("organizationOf() = %q, want 2930418", organizationID)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the lookup of a network waited for the lookup of another network")
+	}
+}
+
+func TestMerakiRateLimiterSummary(t *testing.T) {
+	limiter := newMerakiRateLimiter(context.Background(), 10)
+	limiter.record("2930418", func(stats *rateLimitStats) {
+		stats.waited += 4 * time.Second
+		stats.requests += 40
+	})
+	limiter.unreportedT = 4 * time.Second
+	if summary := limiter.summary(); summary != "" {
+		t.Errorf("summary() = %q under the threshold, want none", summary)
+	}
+
+	limiter.record("2930418", func(stats *rateLimitStats) {
+		stats.waited += 8 * time.Second
+		stats.requests += 60
+		stats.tooManyRequests++
+	})
+	limiter.unreportedT += 8 * time.Second
+	summary := limiter.summary()
+	want := "Organization 2930418: waited 12s over 100 requests, 1 rate limit responses"
+	if !strings.Contains(summary, want) {
+		t.Errorf("summary() = %q, want %q", summary, want)
+	}
+	if summary := limiter.summary(); summary != "" {
+		t.Errorf("summary() = %q right after a summary, want none", summary)
+	}
+}
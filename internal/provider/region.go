@@ -0,0 +1,225 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// merakiRegions are the base URLs of the dashboard API of each value of meraki_region.
+var merakiRegions = map[string]string{
+	"global":  "https://api.meraki.com/",
+	"canada":  "https://api.meraki.ca/",
+	"china":   "https://api.meraki.cn/",
+	"india":   "https://api.meraki.in/",
+	"fedramp": "https://api.gov-meraki.com/",
+}
+
+// merakiRegionNames returns the values of meraki_region, sorted.
+func merakiRegionNames() []string {
+	names := make([]string, 0, len(merakiRegions))
+	for name := range merakiRegions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// regionOfBaseURL returns the region of a base URL, or an empty string when it is not the
+// dashboard API of a region, like a proxy or a mock of the API.
+func regionOfBaseURL(baseURL string) string {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return ""
+	}
+	for name, regionURL := range merakiRegions {
+		if region, _ := url.Parse(regionURL); strings.EqualFold(parsed.Hostname(), region.Hostname()) {
+			return name
+		}
+	}
+	return ""
+}
+
+// regionOfCloud returns the region of an organization from the names of the region and of
+// the host of its cloud, as returned in organizations.cloud.region. It returns an empty
+// string when both names are empty.
+func regionOfCloud(regionName, hostName string) string {
+	names := strings.ToLower(regionName + " " + hostName)
+	switch {
+	case strings.TrimSpace(names) == "":
+		return ""
+	case strings.Contains(names, "fedramp"), strings.Contains(names, "government"):
+		return "fedramp"
+	case strings.Contains(names, "canada"):
+		return "canada"
+	case strings.Contains(names, "china"):
+		return "china"
+	case strings.Contains(names, "india"):
+		return "india"
+	}
+	return "global"
+}
+
+// regionGuard rejects the requests to an organization that is hosted in another region than
+// the one of the base URL, before they fail with a confusing 404. The region of each
+// organization is looked up once, a failed lookup lets the requests through.
+type regionGuard struct {
+	ctx     context.Context
+	region  string
+	limiter *merakiRateLimiter
+
+	// lookups keeps one lookup of an organization at a time, so that the requests to other
+	// organizations are not held by it. The regions found are stored under mu.
+	lookups lookupLocks
+	mu      sync.Mutex
+	regions map[string]string
+}
+
+func newRegionGuard(ctx context.Context, region string, limiter *merakiRateLimiter) *regionGuard {
+	return &regionGuard{ctx: ctx, region: region, limiter: limiter, regions: map[string]string{}}
+}
+
+// install wraps the transport of the resty client of the SDK. The SDK does not handle the
+// errors of the resty hooks, so a rejected request gets a 421 Misdirected Request response
+// instead. It has to be installed after the proxy and TLS options, which need the
+// *http.Transport of the client.
+func (g *regionGuard) install(client *merakigosdk.Client) {
+	next := client.RestyClient().GetClient().Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	client.RestyClient().SetTransport(&regionGuardTransport{guard: g, client: client.RestyClient(), next: next})
+}
+
+// check returns an error when the organization of a request is hosted in another region.
+func (g *regionGuard) check(c *resty.Client, url string) error {
+	organizationID := g.limiter.organizationOf(c, url)
+	if organizationID == "" {
+		return nil
+	}
+	region := g.regionOf(c, organizationID)
+	if region == "" || region == g.region {
+		return nil
+	}
+	return fmt.Errorf("organization %s is hosted in the %s region of the Meraki dashboard (%s), but the provider is configured for the %s region. Set meraki_region to `%s` in the provider configuration, or use a provider alias for the organizations of this region",
+		organizationID, region, merakiRegions[region], g.region, region)
+}
+
+// regionOf returns the region of an organization. The organization is read first, then
+// looked up in the organizations of the API key, since an endpoint may not serve the
+// organizations of other regions.
+func (g *regionGuard) regionOf(c *resty.Client, organizationID string) string {
+	defer g.lookups.lock(organizationID)()
+	g.mu.Lock()
+	region, ok := g.regions[organizationID]
+	g.mu.Unlock()
+	if ok {
+		return region
+	}
+
+	var organization merakigosdk.ResponseOrganizationsGetOrganization
+	if g.lookup(c, "/api/v1/organizations/"+organizationID, &organization) {
+		if organization.Cloud != nil && organization.Cloud.Region != nil {
+			host := ""
+			if organization.Cloud.Region.Host != nil {
+				host = organization.Cloud.Region.Host.Name
+			}
+			region = regionOfCloud(organization.Cloud.Region.Name, host)
+		}
+		g.mu.Lock()
+		g.regions[organizationID] = region
+		g.mu.Unlock()
+		return region
+	}
+
+	regions := map[string]string{organizationID: ""}
+	var organizations merakigosdk.ResponseOrganizationsGetOrganizations
+	if g.lookup(c, "/api/v1/organizations", &organizations) {
+		for _, item := range organizations {
+			if item.Cloud == nil || item.Cloud.Region == nil {
+				continue
+			}
+			host := ""
+			if item.Cloud.Region.Host != nil {
+				host = item.Cloud.Region.Host.Name
+			}
+			regions[item.ID] = regionOfCloud(item.Cloud.Region.Name, host)
+		}
+	}
+	g.mu.Lock()
+	for id, itemRegion := range regions {
+		g.regions[id] = itemRegion
+	}
+	g.mu.Unlock()
+	return regions[organizationID]
+}
+
+// lookup reads a path outside of the limits of the organizations and of the guard.
+func (g *regionGuard) lookup(c *resty.Client, path string, result interface{}) bool {
+	ctx := context.WithValue(context.Background(), rateLimitLookup{}, true)
+	response, err := c.R().SetContext(ctx).SetResult(result).Get(path)
+	if err != nil || response.IsError() {
+		tflog.SubsystemDebug(g.ctx, httpLogSubsystem, "Unable to find the region of a Meraki organization", map[string]interface{}{
+			"path": path,
+		})
+		return false
+	}
+	return true
+}
+
+// regionGuardTransport checks the region of the organization of each request before sending it.
+type regionGuardTransport struct {
+	guard  *regionGuard
+	client *resty.Client
+	next   http.RoundTripper
+}
+
+func (t *regionGuardTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.guard.region == "" || req.Context().Value(rateLimitLookup{}) != nil {
+		return t.next.RoundTrip(req)
+	}
+	err := t.guard.check(t.client, req.URL.Path)
+	if err == nil {
+		return t.next.RoundTrip(req)
+	}
+	body, _ := json.Marshal(map[string][]string{"errors": {err.Error()}})
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", http.StatusMisdirectedRequest, http.StatusText(http.StatusMisdirectedRequest)),
+		StatusCode:    http.StatusMisdirectedRequest,
+		Proto:         req.Proto,
+		ProtoMajor:    req.ProtoMajor,
+		ProtoMinor:    req.ProtoMinor,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
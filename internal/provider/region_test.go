@@ -0,0 +1,187 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRegionOfBaseURL(t *testing.T) {
+	for baseURL, want := range map[string]string{
+		"https://api.meraki.com/":           "global",
+		"https://api.meraki.ca/api/v1":      "canada",
+		"https://API.MERAKI.CN/":            "china",
+		"https://api.meraki.in/":            "india",
+		"https://api.gov-meraki.com/":       "fedramp",
+		"http://127.0.0.1:8080/":            "",
+		"https://meraki-proxy.example.com/": "",
+	} {
+		if got := regionOfBaseURL(baseURL); got != want {
+			t.Errorf("regionOfBaseURL(%q) = %q, want %q", baseURL, got, want)
+		}
+	}
+}
+
+func TestRegionOfCloud(t *testing.T) {
+	for _, test := range []struct {
+		region, host, want string
+	}{
+		{"North America", "United States", "global"},
+		{"Europe", "Germany", "global"},
+		{"Canada", "Canada", "canada"},
+		{"China", "China", "china"},
+		{"Asia", "India", "india"},
+		{"North America", "US FedRAMP", "fedramp"},
+		{"", "", ""},
+	} {
+		if got := regionOfCloud(test.region, test.host); got != test.want {
+			t.Errorf("regionOfCloud(%q, %q) = %q, want %q", test.region, test.host, got, test.want)
+		}
+	}
+}
+
+func TestRegionGuard(t *testing.T) {
+	mock := newMerakiMock(t, "region")
+	mock.failNext("GET", "/api/v1/organizations/7730001", 404)
+	client := mock.client(t)
+	limiter := newMerakiRateLimiter(context.Background(), 100)
+	limiter.install(client)
+	newRegionGuard(context.Background(), "global", limiter).install(client)
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Appliance.GetNetworkApplianceVLAN("N_24329156", "10"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := len(mock.requestsFor("GET", "/api/v1/organizations/2930418")); n != 1 {
+		t.Errorf("organization looked up %d times, want 1", n)
+	}
+
+	_, _, err := client.Appliance.GetNetworkApplianceVLAN("N_77300001", "10")
+	if err == nil || !strings.Contains(err.Error(), "meraki_region to `canada`") {
+		t.Errorf("request to a Canadian organization returned %v, want an error suggesting the canada region", err)
+	}
+	if n := len(mock.requestsFor("GET", "/api/v1/networks/N_77300001/appliance/vlans/10")); n != 0 {
+		t.Errorf("request to a Canadian organization was sent %d times, want 0", n)
+	}
+}
+
+func TestRegionGuardUnknownRegion(t *testing.T) {
+	mock := newMerakiMock(t, "region")
+	client := mock.client(t)
+	limiter := newMerakiRateLimiter(context.Background(), 100)
+	limiter.install(client)
+	newRegionGuard(context.Background(), "", limiter).install(client)
+
+	if _, _, err := client.Appliance.GetNetworkApplianceVLAN("N_77300001", "10"); err != nil {
+		t.Errorf("request through a base URL of unknown region returned %v, want no error", err)
+	}
+	if n := len(mock.requestsFor("GET", "/api/v1/organizations/7730001")); n != 0 {
+		t.Errorf("organization looked up %d times, want 0", n)
+	}
+}
+
+func TestRegionGuardParallelLookups(t *testing.T) {
+	// The lookup of organization 1 hangs until the end of the test.
+	arrived, release := make(chan struct{}), make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/organizations/1" {
+			close(arrived)
+			<-release
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"cloud":{"region":{"name":"North America","host":{"name":"United States"}}}}`)
+	}))
+	defer server.Close()
+	defer close(release)
+	client := resty.New().SetBaseURL(server.URL)
+	guard := newRegionGuard(context.Background(), "global", newMerakiRateLimiter(context.Background(), 100))
+
+	go guard.regionOf(client, "1")
+	<-arrived
+	done := make(chan string)
+	go func() { done <- guard.regionOf(client, "2") }()
+	select {
+	case region := <-done:
+		if region != "global" {
+			t.Errorf("regionOf(2) = %q, want global", region)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the lookup of organization 2 waited for the lookup of organization 1")
+	}
+}
+
+func TestAccProviderRegion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "meraki" {
+  meraki_region = "mars"
+}
+
+data "meraki_organizations" "all" {}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config: `
+provider "meraki" {
+  meraki_region   = "canada"
+  meraki_base_url = "https://api.meraki.ca/"
+}
+
+data "meraki_organizations" "all" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccMerakiOrganizationsDataSourceRegion(t *testing.T) {
+	mock := newMerakiMock(t, "region")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(mock) + `
+data "meraki_organizations" "all" {}
+
+data "meraki_organizations" "global" {
+  organization_id = "2930418"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meraki_organizations.all", "items.1.cloud.region.meraki_region", "canada"),
+					resource.TestCheckResourceAttr("data.meraki_organizations.global", "item.cloud.region.meraki_region", "global"),
+				),
+			},
+		},
+	})
+}
@@ -58,6 +58,7 @@ func (r *AdministeredIDentitiesMeAPIKeysGenerateResource) Metadata(_ context.Con
 // resourceAction
 func (r *AdministeredIDentitiesMeAPIKeysGenerateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource stores the generated key in the state. Use the `meraki_api_key` ephemeral resource to keep it out of the plan and the state.",
 		Attributes: map[string]schema.Attribute{
 			"item": schema.SingleNestedAttribute{
 				Computed: true,
@@ -65,6 +66,7 @@ func (r *AdministeredIDentitiesMeAPIKeysGenerateResource) Schema(_ context.Conte
 
 					"key": schema.StringAttribute{
 						MarkdownDescription: `API key in plaintext. This value will not be accessible outside of key generation`,
+						Sensitive:           true,
 						Computed:            true,
 					},
 				},
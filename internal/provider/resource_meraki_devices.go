@@ -24,7 +24,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -38,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesResource{}
-	_ resource.ResourceWithConfigure = &DevicesResource{}
+	_ resource.Resource                = &DevicesResource{}
+	_ resource.ResourceWithConfigure   = &DevicesResource{}
+	_ resource.ResourceWithImportState = &DevicesResource{}
+	_ resource.ResourceWithIdentity    = &DevicesResource{}
 )
 
 func NewDevicesResource() resource.Resource {
@@ -47,7 +48,8 @@ func NewDevicesResource() resource.Resource {
 }
 
 type DevicesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,6 +58,7 @@ func (r *DevicesResource) Configure(ctx context.Context, req resource.ConfigureR
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -209,6 +212,7 @@ func (r *DevicesResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -251,9 +255,14 @@ func (r *DevicesResource) Read(ctx context.Context, req resource.ReadRequest, re
 	data = ResponseDevicesGetDeviceItemToBodyRs(data, responseGet, true)
 	fmt.Printf("SEE HEEREEEEEE: %+v\n", data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -282,9 +291,15 @@ func (r *DevicesResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of Devices not reset", "meraki_reset_on_destroy is set, but the name, tags, notes and address of a device have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting Devices", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
@@ -384,6 +399,16 @@ func (r *DevicesRs) toSdkApiRequestUpdate(ctx context.Context) *merakigosdk.Requ
 
 // From gosdk to TF Structs Schema
 func ResponseDevicesGetDeviceItemToBodyRs(state DevicesRs, response *merakigosdk.ResponseDevicesGetDevice, is_read bool) DevicesRs {
+	itemState := ResponseDevicesGetDeviceItemToRs(state, response)
+	if is_read {
+		return mergeInterfacesOnlyPath(state, itemState).(DevicesRs)
+	}
+	return mergeInterfaces(state, itemState, true).(DevicesRs)
+}
+
+// ResponseDevicesGetDeviceItemToRs converts the response of the API to the state of the resource,
+// without merging it with the current state.
+func ResponseDevicesGetDeviceItemToRs(state DevicesRs, response *merakigosdk.ResponseDevicesGetDevice) DevicesRs {
 	fmt.Printf("Aqui llego: %+v\n", response)
 	itemState := DevicesRs{
 		Address: func() types.String {
@@ -465,8 +490,5 @@ func ResponseDevicesGetDeviceItemToBodyRs(state DevicesRs, response *merakigosdk
 			return state.Tags
 		}(),
 	}
-	if is_read {
-		return mergeInterfacesOnlyPath(state, itemState).(DevicesRs)
-	}
-	return mergeInterfaces(state, itemState, true).(DevicesRs)
+	return itemState
 }
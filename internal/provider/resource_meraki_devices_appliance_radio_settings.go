@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -35,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesApplianceRadioSettingsResource{}
-	_ resource.ResourceWithConfigure = &DevicesApplianceRadioSettingsResource{}
+	_ resource.Resource                = &DevicesApplianceRadioSettingsResource{}
+	_ resource.ResourceWithConfigure   = &DevicesApplianceRadioSettingsResource{}
+	_ resource.ResourceWithImportState = &DevicesApplianceRadioSettingsResource{}
+	_ resource.ResourceWithIdentity    = &DevicesApplianceRadioSettingsResource{}
 )
 
 func NewDevicesApplianceRadioSettingsResource() resource.Resource {
@@ -44,7 +45,8 @@ func NewDevicesApplianceRadioSettingsResource() resource.Resource {
 }
 
 type DevicesApplianceRadioSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesApplianceRadioSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +55,7 @@ func (r *DevicesApplianceRadioSettingsResource) Configure(ctx context.Context, r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -179,6 +182,7 @@ func (r *DevicesApplianceRadioSettingsResource) Create(ctx context.Context, req
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -220,9 +224,14 @@ func (r *DevicesApplianceRadioSettingsResource) Read(ctx context.Context, req re
 	//entro aqui 2
 	data = ResponseApplianceGetDeviceApplianceRadioSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesApplianceRadioSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesApplianceRadioSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesApplianceRadioSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -251,9 +260,15 @@ func (r *DevicesApplianceRadioSettingsResource) Update(ctx context.Context, req
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesApplianceRadioSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of DevicesApplianceRadioSettings not reset", "meraki_reset_on_destroy is set, but the automatic channels and power of the appliance radios have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting DevicesApplianceRadioSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
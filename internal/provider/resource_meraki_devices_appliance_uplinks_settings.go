@@ -25,7 +25,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +39,11 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesApplianceUplinksSettingsResource{}
-	_ resource.ResourceWithConfigure = &DevicesApplianceUplinksSettingsResource{}
+	_ resource.Resource                 = &DevicesApplianceUplinksSettingsResource{}
+	_ resource.ResourceWithConfigure    = &DevicesApplianceUplinksSettingsResource{}
+	_ resource.ResourceWithImportState  = &DevicesApplianceUplinksSettingsResource{}
+	_ resource.ResourceWithIdentity     = &DevicesApplianceUplinksSettingsResource{}
+	_ resource.ResourceWithUpgradeState = &DevicesApplianceUplinksSettingsResource{}
 )
 
 func NewDevicesApplianceUplinksSettingsResource() resource.Resource {
@@ -49,7 +51,8 @@ func NewDevicesApplianceUplinksSettingsResource() resource.Resource {
 }
 
 type DevicesApplianceUplinksSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesApplianceUplinksSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -58,6 +61,7 @@ func (r *DevicesApplianceUplinksSettingsResource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -65,8 +69,14 @@ func (r *DevicesApplianceUplinksSettingsResource) Metadata(_ context.Context, re
 	resp.TypeName = req.ProviderTypeName + "_devices_appliance_uplinks_settings"
 }
 
+// UpgradeState upgrades the states written before the VLAN IDs were numbers.
+func (r *DevicesApplianceUplinksSettingsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(1)
+}
+
 func (r *DevicesApplianceUplinksSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"interfaces": schema.SingleNestedAttribute{
 				MarkdownDescription: `Interface settings.`,
@@ -507,6 +517,10 @@ func (r *DevicesApplianceUplinksSettingsResource) Schema(_ context.Context, _ re
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `serial path parameter.`,
 				Required:            true,
@@ -557,6 +571,7 @@ func (r *DevicesApplianceUplinksSettingsResource) Create(ctx context.Context, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -599,9 +614,14 @@ func (r *DevicesApplianceUplinksSettingsResource) Read(ctx context.Context, req
 	data = ResponseApplianceGetDeviceApplianceUplinksSettingsItemToBodyRs(data, responseGet, true)
 	log.Printf("data: %+v", data.Interfaces)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesApplianceUplinksSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesApplianceUplinksSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesApplianceUplinksSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -630,18 +650,80 @@ func (r *DevicesApplianceUplinksSettingsResource) Update(ctx context.Context, re
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesApplianceUplinksSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesApplianceUplinksSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesApplianceUplinksSettingsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesApplianceUplinksSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	// Meraki defaults
+	defaults := DevicesApplianceUplinksSettingsRs{
+		Interfaces: &ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesRs{
+			Wan1: &ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesWan1Rs{
+				Enabled: types.BoolValue(true),
+				Pppoe: &ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesWan1PppoeRs{
+					Enabled: types.BoolValue(false),
+				},
+				Svis: &ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesWan1SvisRs{
+					IPv4: &ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesWan1SvisIpv4Rs{
+						AssignmentMode: types.StringValue("dynamic"),
+					},
+				},
+				VLANTagging: &ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesWan1VlanTaggingRs{
+					Enabled: types.BoolValue(false),
+				},
+			},
+			Wan2: &ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesWan2Rs{
+				Enabled: types.BoolValue(true),
+				Pppoe: &ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesWan2PppoeRs{
+					Enabled: types.BoolValue(false),
+				},
+				Svis: &ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesWan2SvisRs{
+					IPv4: &ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesWan2SvisIpv4Rs{
+						AssignmentMode: types.StringValue("dynamic"),
+					},
+				},
+				VLANTagging: &ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesWan2VlanTaggingRs{
+					Enabled: types.BoolValue(false),
+				},
+			},
+		},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateDeviceApplianceUplinksSettings(vvSerial, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceApplianceUplinksSettings",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceApplianceUplinksSettings",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type DevicesApplianceUplinksSettingsRs struct {
-	Serial     types.String                                                    `tfsdk:"serial"`
-	Interfaces *ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesRs `tfsdk:"interfaces"`
+	Serial         types.String                                                    `tfsdk:"serial"`
+	Interfaces     *ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesRs `tfsdk:"interfaces"`
+	ResetOnDestroy types.Bool                                                      `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetDeviceApplianceUplinksSettingsInterfacesRs struct {
@@ -34,6 +34,7 @@ import (
 var (
 	_ resource.Resource              = &DevicesApplianceVmxAuthenticationTokenResource{}
 	_ resource.ResourceWithConfigure = &DevicesApplianceVmxAuthenticationTokenResource{}
+	_ resource.ResourceWithMoveState = &DevicesApplianceVmxAuthenticationTokenResource{}
 )
 
 func NewDevicesApplianceVmxAuthenticationTokenResource() resource.Resource {
@@ -57,9 +58,15 @@ func (r *DevicesApplianceVmxAuthenticationTokenResource) Metadata(_ context.Cont
 	resp.TypeName = req.ProviderTypeName + "_devices_appliance_vmx_authentication_token"
 }
 
+// MoveState moves the states of the former meraki_devices_appliance_vmx_authentication resource.
+func (r *DevicesApplianceVmxAuthenticationTokenResource) MoveState(ctx context.Context) []resource.StateMover {
+	return moveStateFrom("meraki_devices_appliance_vmx_authentication_token")
+}
+
 // resourceAction
 func (r *DevicesApplianceVmxAuthenticationTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource stores the generated token in the state. Use the `meraki_vmx_authentication_token` ephemeral resource to keep it out of the plan and the state.",
 		Attributes: map[string]schema.Attribute{
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `serial path parameter.`,
@@ -78,6 +85,7 @@ func (r *DevicesApplianceVmxAuthenticationTokenResource) Schema(_ context.Contex
 					},
 					"token": schema.StringAttribute{
 						MarkdownDescription: `The newly generated authentication token for the vMX instance`,
+						Sensitive:           true,
 						Computed:            true,
 					},
 				},
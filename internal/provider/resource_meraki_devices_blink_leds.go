@@ -61,6 +61,7 @@ func (r *DevicesBlinkLedsResource) Metadata(_ context.Context, req resource.Meta
 // resourceAction
 func (r *DevicesBlinkLedsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		DeprecationMessage: "Use the `meraki_device_blink_leds` action instead, which runs the operation on a lifecycle event or with `terraform apply -invoke` and keeps no state. This resource will be removed in the next major release.",
 		Attributes: map[string]schema.Attribute{
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `serial path parameter.`,
@@ -24,7 +24,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesCameraCustomAnalyticsResource{}
-	_ resource.ResourceWithConfigure = &DevicesCameraCustomAnalyticsResource{}
+	_ resource.Resource                = &DevicesCameraCustomAnalyticsResource{}
+	_ resource.ResourceWithConfigure   = &DevicesCameraCustomAnalyticsResource{}
+	_ resource.ResourceWithImportState = &DevicesCameraCustomAnalyticsResource{}
+	_ resource.ResourceWithIdentity    = &DevicesCameraCustomAnalyticsResource{}
 )
 
 func NewDevicesCameraCustomAnalyticsResource() resource.Resource {
@@ -46,7 +47,8 @@ func NewDevicesCameraCustomAnalyticsResource() resource.Resource {
 }
 
 type DevicesCameraCustomAnalyticsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesCameraCustomAnalyticsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +57,7 @@ func (r *DevicesCameraCustomAnalyticsResource) Configure(ctx context.Context, re
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -106,6 +109,10 @@ func (r *DevicesCameraCustomAnalyticsResource) Schema(_ context.Context, _ resou
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `serial path parameter.`,
 				Required:            true,
@@ -157,6 +164,7 @@ func (r *DevicesCameraCustomAnalyticsResource) Create(ctx context.Context, req r
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -198,9 +206,14 @@ func (r *DevicesCameraCustomAnalyticsResource) Read(ctx context.Context, req res
 	//entro aqui 2
 	data = ResponseCameraGetDeviceCameraCustomAnalyticsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesCameraCustomAnalyticsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesCameraCustomAnalyticsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesCameraCustomAnalyticsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -229,20 +242,53 @@ func (r *DevicesCameraCustomAnalyticsResource) Update(ctx context.Context, req r
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesCameraCustomAnalyticsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesCameraCustomAnalytics", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesCameraCustomAnalyticsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesCameraCustomAnalytics", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	// Meraki defaults
+	defaults := DevicesCameraCustomAnalyticsRs{
+		Enabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Camera.UpdateDeviceCameraCustomAnalytics(vvSerial, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceCameraCustomAnalytics",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceCameraCustomAnalytics",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type DevicesCameraCustomAnalyticsRs struct {
-	Serial     types.String                                                `tfsdk:"serial"`
-	ArtifactID types.String                                                `tfsdk:"artifact_id"`
-	Enabled    types.Bool                                                  `tfsdk:"enabled"`
-	Parameters *[]ResponseCameraGetDeviceCameraCustomAnalyticsParametersRs `tfsdk:"parameters"`
+	Serial         types.String                                                `tfsdk:"serial"`
+	ArtifactID     types.String                                                `tfsdk:"artifact_id"`
+	Enabled        types.Bool                                                  `tfsdk:"enabled"`
+	Parameters     *[]ResponseCameraGetDeviceCameraCustomAnalyticsParametersRs `tfsdk:"parameters"`
+	ResetOnDestroy types.Bool                                                  `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseCameraGetDeviceCameraCustomAnalyticsParametersRs struct {
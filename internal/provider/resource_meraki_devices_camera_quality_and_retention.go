@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesCameraQualityAndRetentionResource{}
-	_ resource.ResourceWithConfigure = &DevicesCameraQualityAndRetentionResource{}
+	_ resource.Resource                = &DevicesCameraQualityAndRetentionResource{}
+	_ resource.ResourceWithConfigure   = &DevicesCameraQualityAndRetentionResource{}
+	_ resource.ResourceWithImportState = &DevicesCameraQualityAndRetentionResource{}
+	_ resource.ResourceWithIdentity    = &DevicesCameraQualityAndRetentionResource{}
 )
 
 func NewDevicesCameraQualityAndRetentionResource() resource.Resource {
@@ -46,7 +47,8 @@ func NewDevicesCameraQualityAndRetentionResource() resource.Resource {
 }
 
 type DevicesCameraQualityAndRetentionResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesCameraQualityAndRetentionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +57,7 @@ func (r *DevicesCameraQualityAndRetentionResource) Configure(ctx context.Context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -110,6 +113,10 @@ func (r *DevicesCameraQualityAndRetentionResource) Schema(_ context.Context, _ r
 					),
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"resolution": schema.StringAttribute{
 				MarkdownDescription: `Resolution of the camera. Can be one of '1280x720', '1920x1080', '1080x1080', '2112x2112', '2880x2880', '2688x1512' or '3840x2160'.Not all resolutions are supported by every camera model.
                                   Allowed values: [1080x1080,1280x720,1920x1080,2112x2112,2688x1512,2880x2880,3840x2160]`,
@@ -187,6 +194,7 @@ func (r *DevicesCameraQualityAndRetentionResource) Create(ctx context.Context, r
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -228,9 +236,14 @@ func (r *DevicesCameraQualityAndRetentionResource) Read(ctx context.Context, req
 	//entro aqui 2
 	data = ResponseCameraGetDeviceCameraQualityAndRetentionItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesCameraQualityAndRetentionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesCameraQualityAndRetentionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesCameraQualityAndRetentionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -259,11 +272,46 @@ func (r *DevicesCameraQualityAndRetentionResource) Update(ctx context.Context, r
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesCameraQualityAndRetentionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesCameraQualityAndRetention", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesCameraQualityAndRetentionRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesCameraQualityAndRetention", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	// Meraki defaults
+	defaults := DevicesCameraQualityAndRetentionRs{
+		AudioRecordingEnabled:          types.BoolValue(false),
+		MotionBasedRetentionEnabled:    types.BoolValue(false),
+		Quality:                        types.StringValue("Standard"),
+		RestrictedBandwidthModeEnabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Camera.UpdateDeviceCameraQualityAndRetention(vvSerial, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceCameraQualityAndRetention",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceCameraQualityAndRetention",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -277,6 +325,7 @@ type DevicesCameraQualityAndRetentionRs struct {
 	Quality                        types.String `tfsdk:"quality"`
 	Resolution                     types.String `tfsdk:"resolution"`
 	RestrictedBandwidthModeEnabled types.Bool   `tfsdk:"restricted_bandwidth_mode_enabled"`
+	ResetOnDestroy                 types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
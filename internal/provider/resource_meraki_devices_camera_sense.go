@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -35,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesCameraSenseResource{}
-	_ resource.ResourceWithConfigure = &DevicesCameraSenseResource{}
+	_ resource.Resource                = &DevicesCameraSenseResource{}
+	_ resource.ResourceWithConfigure   = &DevicesCameraSenseResource{}
+	_ resource.ResourceWithImportState = &DevicesCameraSenseResource{}
+	_ resource.ResourceWithIdentity    = &DevicesCameraSenseResource{}
 )
 
 func NewDevicesCameraSenseResource() resource.Resource {
@@ -44,7 +45,8 @@ func NewDevicesCameraSenseResource() resource.Resource {
 }
 
 type DevicesCameraSenseResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesCameraSenseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +55,7 @@ func (r *DevicesCameraSenseResource) Configure(ctx context.Context, req resource
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -98,6 +101,10 @@ func (r *DevicesCameraSenseResource) Schema(_ context.Context, _ resource.Schema
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"sense_enabled": schema.BoolAttribute{
 				MarkdownDescription: `Boolean indicating if sense(license) is enabled(true) or disabled(false) on the camera`,
 				Optional:            true,
@@ -156,6 +163,7 @@ func (r *DevicesCameraSenseResource) Create(ctx context.Context, req resource.Cr
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -197,9 +205,14 @@ func (r *DevicesCameraSenseResource) Read(ctx context.Context, req resource.Read
 	//entro aqui 2
 	data = ResponseCameraGetDeviceCameraSenseItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesCameraSenseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesCameraSenseResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesCameraSenseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -228,11 +241,46 @@ func (r *DevicesCameraSenseResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesCameraSenseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesCameraSense", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesCameraSenseRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesCameraSense", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	// Meraki defaults
+	defaults := DevicesCameraSenseRs{
+		AudioDetection: &ResponseCameraGetDeviceCameraSenseAudioDetectionRs{
+			Enabled: types.BoolValue(false),
+		},
+		SenseEnabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Camera.UpdateDeviceCameraSense(vvSerial, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceCameraSense",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceCameraSense",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -244,6 +292,7 @@ type DevicesCameraSenseRs struct {
 	MqttTopics       types.List                                          `tfsdk:"mqtt_topics"`
 	SenseEnabled     types.Bool                                          `tfsdk:"sense_enabled"`
 	DetectionModelID types.String                                        `tfsdk:"detection_model_id"`
+	ResetOnDestroy   types.Bool                                          `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseCameraGetDeviceCameraSenseAudioDetectionRs struct {
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -33,8 +32,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesCameraVideoSettingsResource{}
-	_ resource.ResourceWithConfigure = &DevicesCameraVideoSettingsResource{}
+	_ resource.Resource                = &DevicesCameraVideoSettingsResource{}
+	_ resource.ResourceWithConfigure   = &DevicesCameraVideoSettingsResource{}
+	_ resource.ResourceWithImportState = &DevicesCameraVideoSettingsResource{}
+	_ resource.ResourceWithIdentity    = &DevicesCameraVideoSettingsResource{}
 )
 
 func NewDevicesCameraVideoSettingsResource() resource.Resource {
@@ -42,7 +43,8 @@ func NewDevicesCameraVideoSettingsResource() resource.Resource {
 }
 
 type DevicesCameraVideoSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesCameraVideoSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -51,6 +53,7 @@ func (r *DevicesCameraVideoSettingsResource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -68,6 +71,10 @@ func (r *DevicesCameraVideoSettingsResource) Schema(_ context.Context, _ resourc
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rtsp_url": schema.StringAttribute{
 				MarkdownDescription: `External rstp url. Will only be returned if external rtsp stream is exposed`,
 				Computed:            true,
@@ -123,6 +130,7 @@ func (r *DevicesCameraVideoSettingsResource) Create(ctx context.Context, req res
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -164,9 +172,14 @@ func (r *DevicesCameraVideoSettingsResource) Read(ctx context.Context, req resou
 	//entro aqui 2
 	data = ResponseCameraGetDeviceCameraVideoSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesCameraVideoSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesCameraVideoSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesCameraVideoSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -195,11 +208,43 @@ func (r *DevicesCameraVideoSettingsResource) Update(ctx context.Context, req res
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesCameraVideoSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesCameraVideoSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesCameraVideoSettingsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesCameraVideoSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	// Meraki defaults
+	defaults := DevicesCameraVideoSettingsRs{
+		ExternalRtspEnabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Camera.UpdateDeviceCameraVideoSettings(vvSerial, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceCameraVideoSettings",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceCameraVideoSettings",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -208,6 +253,7 @@ type DevicesCameraVideoSettingsRs struct {
 	Serial              types.String `tfsdk:"serial"`
 	ExternalRtspEnabled types.Bool   `tfsdk:"external_rtsp_enabled"`
 	RtspURL             types.String `tfsdk:"rtsp_url"`
+	ResetOnDestroy      types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesCameraWirelessProfilesResource{}
-	_ resource.ResourceWithConfigure = &DevicesCameraWirelessProfilesResource{}
+	_ resource.Resource                = &DevicesCameraWirelessProfilesResource{}
+	_ resource.ResourceWithConfigure   = &DevicesCameraWirelessProfilesResource{}
+	_ resource.ResourceWithImportState = &DevicesCameraWirelessProfilesResource{}
+	_ resource.ResourceWithIdentity    = &DevicesCameraWirelessProfilesResource{}
 )
 
 func NewDevicesCameraWirelessProfilesResource() resource.Resource {
@@ -43,7 +44,8 @@ func NewDevicesCameraWirelessProfilesResource() resource.Resource {
 }
 
 type DevicesCameraWirelessProfilesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesCameraWirelessProfilesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +54,7 @@ func (r *DevicesCameraWirelessProfilesResource) Configure(ctx context.Context, r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -144,6 +147,7 @@ func (r *DevicesCameraWirelessProfilesResource) Create(ctx context.Context, req
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -185,9 +189,14 @@ func (r *DevicesCameraWirelessProfilesResource) Read(ctx context.Context, req re
 	//entro aqui 2
 	data = ResponseCameraGetDeviceCameraWirelessProfilesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesCameraWirelessProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesCameraWirelessProfilesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesCameraWirelessProfilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -216,9 +225,15 @@ func (r *DevicesCameraWirelessProfilesResource) Update(ctx context.Context, req
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesCameraWirelessProfilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of DevicesCameraWirelessProfiles not reset", "meraki_reset_on_destroy is set, but the wireless profiles of a camera have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting DevicesCameraWirelessProfiles", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesCellularGatewayLanResource{}
-	_ resource.ResourceWithConfigure = &DevicesCellularGatewayLanResource{}
+	_ resource.Resource                = &DevicesCellularGatewayLanResource{}
+	_ resource.ResourceWithConfigure   = &DevicesCellularGatewayLanResource{}
+	_ resource.ResourceWithImportState = &DevicesCellularGatewayLanResource{}
+	_ resource.ResourceWithIdentity    = &DevicesCellularGatewayLanResource{}
 )
 
 func NewDevicesCellularGatewayLanResource() resource.Resource {
@@ -43,7 +44,8 @@ func NewDevicesCellularGatewayLanResource() resource.Resource {
 }
 
 type DevicesCellularGatewayLanResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesCellularGatewayLanResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +54,7 @@ func (r *DevicesCellularGatewayLanResource) Configure(ctx context.Context, req r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -140,6 +143,10 @@ func (r *DevicesCellularGatewayLanResource) Schema(_ context.Context, _ resource
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `serial path parameter.`,
 				Required:            true,
@@ -191,6 +198,7 @@ func (r *DevicesCellularGatewayLanResource) Create(ctx context.Context, req reso
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -232,9 +240,14 @@ func (r *DevicesCellularGatewayLanResource) Read(ctx context.Context, req resour
 	//entro aqui 2
 	data = ResponseCellularGatewayGetDeviceCellularGatewayLanItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesCellularGatewayLanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesCellularGatewayLanResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesCellularGatewayLanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -263,11 +276,44 @@ func (r *DevicesCellularGatewayLanResource) Update(ctx context.Context, req reso
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesCellularGatewayLanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesCellularGatewayLan", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesCellularGatewayLanRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesCellularGatewayLan", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	// Meraki defaults
+	defaults := DevicesCellularGatewayLanRs{
+		FixedIPAssignments: &[]ResponseCellularGatewayGetDeviceCellularGatewayLanFixedIpAssignmentsRs{},
+		ReservedIPRanges:   &[]ResponseCellularGatewayGetDeviceCellularGatewayLanReservedIpRangesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.CellularGateway.UpdateDeviceCellularGatewayLan(vvSerial, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceCellularGatewayLan",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceCellularGatewayLan",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -279,6 +325,7 @@ type DevicesCellularGatewayLanRs struct {
 	DeviceSubnet       types.String                                                              `tfsdk:"device_subnet"`
 	FixedIPAssignments *[]ResponseCellularGatewayGetDeviceCellularGatewayLanFixedIpAssignmentsRs `tfsdk:"fixed_ip_assignments"`
 	ReservedIPRanges   *[]ResponseCellularGatewayGetDeviceCellularGatewayLanReservedIpRangesRs   `tfsdk:"reserved_ip_ranges"`
+	ResetOnDestroy     types.Bool                                                                `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseCellularGatewayGetDeviceCellularGatewayLanFixedIpAssignmentsRs struct {
@@ -327,13 +374,19 @@ func (r *DevicesCellularGatewayLanRs) toSdkApiRequestUpdate(ctx context.Context)
 	}
 	out := merakigosdk.RequestCellularGatewayUpdateDeviceCellularGatewayLan{
 		FixedIPAssignments: func() *[]merakigosdk.RequestCellularGatewayUpdateDeviceCellularGatewayLanFixedIPAssignments {
-			if len(requestCellularGatewayUpdateDeviceCellularGatewayLanFixedIPAssignments) > 0 {
+			if len(requestCellularGatewayUpdateDeviceCellularGatewayLanFixedIPAssignments) > 0 || r.FixedIPAssignments != nil {
+				if len(requestCellularGatewayUpdateDeviceCellularGatewayLanFixedIPAssignments) == 0 {
+					requestCellularGatewayUpdateDeviceCellularGatewayLanFixedIPAssignments = make([]merakigosdk.RequestCellularGatewayUpdateDeviceCellularGatewayLanFixedIPAssignments, 0)
+				}
 				return &requestCellularGatewayUpdateDeviceCellularGatewayLanFixedIPAssignments
 			}
 			return nil
 		}(),
 		ReservedIPRanges: func() *[]merakigosdk.RequestCellularGatewayUpdateDeviceCellularGatewayLanReservedIPRanges {
-			if len(requestCellularGatewayUpdateDeviceCellularGatewayLanReservedIPRanges) > 0 {
+			if len(requestCellularGatewayUpdateDeviceCellularGatewayLanReservedIPRanges) > 0 || r.ReservedIPRanges != nil {
+				if len(requestCellularGatewayUpdateDeviceCellularGatewayLanReservedIPRanges) == 0 {
+					requestCellularGatewayUpdateDeviceCellularGatewayLanReservedIPRanges = make([]merakigosdk.RequestCellularGatewayUpdateDeviceCellularGatewayLanReservedIPRanges, 0)
+				}
 				return &requestCellularGatewayUpdateDeviceCellularGatewayLanReservedIPRanges
 			}
 			return nil
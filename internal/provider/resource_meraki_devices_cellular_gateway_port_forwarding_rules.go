@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesCellularGatewayPortForwardingRulesResource{}
-	_ resource.ResourceWithConfigure = &DevicesCellularGatewayPortForwardingRulesResource{}
+	_ resource.Resource                = &DevicesCellularGatewayPortForwardingRulesResource{}
+	_ resource.ResourceWithConfigure   = &DevicesCellularGatewayPortForwardingRulesResource{}
+	_ resource.ResourceWithImportState = &DevicesCellularGatewayPortForwardingRulesResource{}
+	_ resource.ResourceWithIdentity    = &DevicesCellularGatewayPortForwardingRulesResource{}
 )
 
 func NewDevicesCellularGatewayPortForwardingRulesResource() resource.Resource {
@@ -43,7 +44,8 @@ func NewDevicesCellularGatewayPortForwardingRulesResource() resource.Resource {
 }
 
 type DevicesCellularGatewayPortForwardingRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesCellularGatewayPortForwardingRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +54,7 @@ func (r *DevicesCellularGatewayPortForwardingRulesResource) Configure(ctx contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -62,6 +65,10 @@ func (r *DevicesCellularGatewayPortForwardingRulesResource) Metadata(_ context.C
 func (r *DevicesCellularGatewayPortForwardingRulesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `An array of port forwarding params`,
 				Optional:            true,
@@ -176,6 +183,7 @@ func (r *DevicesCellularGatewayPortForwardingRulesResource) Create(ctx context.C
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -217,9 +225,14 @@ func (r *DevicesCellularGatewayPortForwardingRulesResource) Read(ctx context.Con
 	//entro aqui 2
 	data = ResponseCellularGatewayGetDeviceCellularGatewayPortForwardingRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesCellularGatewayPortForwardingRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesCellularGatewayPortForwardingRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesCellularGatewayPortForwardingRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -248,18 +261,51 @@ func (r *DevicesCellularGatewayPortForwardingRulesResource) Update(ctx context.C
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesCellularGatewayPortForwardingRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesCellularGatewayPortForwardingRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesCellularGatewayPortForwardingRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesCellularGatewayPortForwardingRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	// Meraki defaults
+	defaults := DevicesCellularGatewayPortForwardingRulesRs{
+		Rules: &[]ResponseCellularGatewayGetDeviceCellularGatewayPortForwardingRulesRulesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.CellularGateway.UpdateDeviceCellularGatewayPortForwardingRules(vvSerial, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceCellularGatewayPortForwardingRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceCellularGatewayPortForwardingRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type DevicesCellularGatewayPortForwardingRulesRs struct {
-	Serial types.String                                                                 `tfsdk:"serial"`
-	Rules  *[]ResponseCellularGatewayGetDeviceCellularGatewayPortForwardingRulesRulesRs `tfsdk:"rules"`
+	Serial         types.String                                                                 `tfsdk:"serial"`
+	Rules          *[]ResponseCellularGatewayGetDeviceCellularGatewayPortForwardingRulesRulesRs `tfsdk:"rules"`
+	ResetOnDestroy types.Bool                                                                   `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseCellularGatewayGetDeviceCellularGatewayPortForwardingRulesRulesRs struct {
@@ -301,7 +347,10 @@ func (r *DevicesCellularGatewayPortForwardingRulesRs) toSdkApiRequestUpdate(ctx
 	}
 	out := merakigosdk.RequestCellularGatewayUpdateDeviceCellularGatewayPortForwardingRules{
 		Rules: func() *[]merakigosdk.RequestCellularGatewayUpdateDeviceCellularGatewayPortForwardingRulesRules {
-			if len(requestCellularGatewayUpdateDeviceCellularGatewayPortForwardingRulesRules) > 0 {
+			if len(requestCellularGatewayUpdateDeviceCellularGatewayPortForwardingRulesRules) > 0 || r.Rules != nil {
+				if len(requestCellularGatewayUpdateDeviceCellularGatewayPortForwardingRulesRules) == 0 {
+					requestCellularGatewayUpdateDeviceCellularGatewayPortForwardingRulesRules = make([]merakigosdk.RequestCellularGatewayUpdateDeviceCellularGatewayPortForwardingRulesRules, 0)
+				}
 				return &requestCellularGatewayUpdateDeviceCellularGatewayPortForwardingRulesRules
 			}
 			return nil
@@ -26,7 +26,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -42,8 +41,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesCellularSimsResource{}
-	_ resource.ResourceWithConfigure = &DevicesCellularSimsResource{}
+	_ resource.Resource                = &DevicesCellularSimsResource{}
+	_ resource.ResourceWithConfigure   = &DevicesCellularSimsResource{}
+	_ resource.ResourceWithImportState = &DevicesCellularSimsResource{}
+	_ resource.ResourceWithIdentity    = &DevicesCellularSimsResource{}
 )
 
 func NewDevicesCellularSimsResource() resource.Resource {
@@ -51,7 +52,8 @@ func NewDevicesCellularSimsResource() resource.Resource {
 }
 
 type DevicesCellularSimsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesCellularSimsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -60,6 +62,7 @@ func (r *DevicesCellularSimsResource) Configure(ctx context.Context, req resourc
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -70,6 +73,10 @@ func (r *DevicesCellularSimsResource) Metadata(_ context.Context, req resource.M
 func (r *DevicesCellularSimsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `serial path parameter.`,
 				Required:            true,
@@ -257,6 +264,7 @@ func (r *DevicesCellularSimsResource) Create(ctx context.Context, req resource.C
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -298,9 +306,14 @@ func (r *DevicesCellularSimsResource) Read(ctx context.Context, req resource.Rea
 	//entro aqui 2
 	data = ResponseDevicesGetDeviceCellularSimsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesCellularSimsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesCellularSimsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesCellularSimsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -329,20 +342,55 @@ func (r *DevicesCellularSimsResource) Update(ctx context.Context, req resource.U
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesCellularSimsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesCellularSims", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesCellularSimsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesCellularSims", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	// Meraki defaults
+	defaults := DevicesCellularSimsRs{
+		SimFailover: &ResponseDevicesGetDeviceCellularSimsSimFailoverRs{
+			Enabled: types.BoolValue(false),
+		},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Devices.UpdateDeviceCellularSims(vvSerial, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceCellularSims",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceCellularSims",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type DevicesCellularSimsRs struct {
-	Serial      types.String                                       `tfsdk:"serial"`
-	SimFailover *ResponseDevicesGetDeviceCellularSimsSimFailoverRs `tfsdk:"sim_failover"`
-	SimOrdering types.List                                         `tfsdk:"sim_ordering"`
-	Sims        *[]ResponseDevicesGetDeviceCellularSimsSimsRs      `tfsdk:"sims"`
+	Serial         types.String                                       `tfsdk:"serial"`
+	SimFailover    *ResponseDevicesGetDeviceCellularSimsSimFailoverRs `tfsdk:"sim_failover"`
+	SimOrdering    types.List                                         `tfsdk:"sim_ordering"`
+	Sims           *[]ResponseDevicesGetDeviceCellularSimsSimsRs      `tfsdk:"sims"`
+	ResetOnDestroy types.Bool                                         `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseDevicesGetDeviceCellularSimsSimFailoverRs struct {
@@ -19,13 +19,12 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -36,8 +35,11 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesLiveToolsArpTableResource{}
-	_ resource.ResourceWithConfigure = &DevicesLiveToolsArpTableResource{}
+	_ resource.Resource                 = &DevicesLiveToolsArpTableResource{}
+	_ resource.ResourceWithConfigure    = &DevicesLiveToolsArpTableResource{}
+	_ resource.ResourceWithImportState  = &DevicesLiveToolsArpTableResource{}
+	_ resource.ResourceWithIdentity     = &DevicesLiveToolsArpTableResource{}
+	_ resource.ResourceWithUpgradeState = &DevicesLiveToolsArpTableResource{}
 )
 
 func NewDevicesLiveToolsArpTableResource() resource.Resource {
@@ -61,8 +63,19 @@ func (r *DevicesLiveToolsArpTableResource) Metadata(_ context.Context, req resou
 	resp.TypeName = req.ProviderTypeName + "_devices_live_tools_arp_table"
 }
 
-func (r *DevicesLiveToolsArpTableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+// UpgradeState upgrades the states written before the VLAN IDs were numbers.
+func (r *DevicesLiveToolsArpTableResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(1)
+}
+
+func (r *DevicesLiveToolsArpTableResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
 		Attributes: map[string]schema.Attribute{
 			"arp_table_id": schema.StringAttribute{
 				MarkdownDescription: `Id of the ARP table request. Used to check the status of the request.`,
@@ -121,6 +134,7 @@ func (r *DevicesLiveToolsArpTableResource) Schema(_ context.Context, _ resource.
 					},
 					"shared_secret": schema.StringAttribute{
 						MarkdownDescription: `A shared secret that will be included in the requests sent to the callback URL. It can be used to verify that the request was sent by Meraki. If using this field, please also specify an url.`,
+						Sensitive:           true,
 						Computed:            true,
 						Optional:            true,
 						PlanModifiers: []planmodifier.String{
@@ -237,6 +251,7 @@ func (r *DevicesLiveToolsArpTableResource) Create(ctx context.Context, req resou
 			data = ResponseDevicesGetDeviceLiveToolsArpTableItemToBodyRs(data, responseVerifyItem, false)
 			//Path params in update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
@@ -259,16 +274,22 @@ func (r *DevicesLiveToolsArpTableResource) Create(ctx context.Context, req resou
 	}
 
 	//Assign Path Params required
+	vvArpTableID = response.ArpTableID
+	data.ArpTableID = types.StringValue(vvArpTableID)
 
-	responseGet, restyResp1, err := r.client.Devices.GetDeviceLiveToolsArpTable(vvSerial, vvArpTableID)
-	if err != nil || responseGet == nil {
-		if restyResp1 != nil {
-			resp.Diagnostics.AddError(
-				"Failure when executing GetDeviceLiveToolsArpTable",
-				restyResp1.String(),
-			)
-			return
-		}
+	createTimeout, diags := data.Timeouts.Create(ctx, liveToolDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	responseGet, err := waitForLiveTool(waitCtx, func() (*merakigosdk.ResponseDevicesGetDeviceLiveToolsArpTable, *resty.Response, error) {
+		return r.client.Devices.GetDeviceLiveToolsArpTable(vvSerial, vvArpTableID)
+	}, func(response *merakigosdk.ResponseDevicesGetDeviceLiveToolsArpTable) string {
+		return response.Status
+	})
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing GetDeviceLiveToolsArpTable",
 			err.Error(),
@@ -277,10 +298,18 @@ func (r *DevicesLiveToolsArpTableResource) Create(ctx context.Context, req resou
 	}
 
 	data = ResponseDevicesGetDeviceLiveToolsArpTableItemToBodyRs(data, responseGet, false)
-
-	diags := resp.State.Set(ctx, &data)
+	// The results are computed, they are taken from the last response.
+	itemState := ResponseDevicesGetDeviceLiveToolsArpTableItemToRs(data, responseGet)
+	data.Entries = itemState.Entries
+	data.Error = itemState.Error
+	data.Request = itemState.Request
+	data.Status = itemState.Status
+	data.URL = itemState.URL
+
+	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
-
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(liveToolErrors("ARP table", vvArpTableID, responseGet.Status, responseGet.Error)...)
 }
 
 func (r *DevicesLiveToolsArpTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -334,21 +363,15 @@ func (r *DevicesLiveToolsArpTableResource) Read(ctx context.Context, req resourc
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesLiveToolsArpTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "serial", "arp_table_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("arp_table_id"), idParts[1])...)
+func (r *DevicesLiveToolsArpTableResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial", "arp_table_id")
 }
 
 func (r *DevicesLiveToolsArpTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -384,6 +407,8 @@ type DevicesLiveToolsArpTableRs struct {
 	Status     types.String                                           `tfsdk:"status"`
 	URL        types.String                                           `tfsdk:"url"`
 	Callback   *RequestDevicesCreateDeviceLiveToolsArpTableCallbackRs `tfsdk:"callback"`
+	// Not in the API
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ResponseDevicesGetDeviceLiveToolsArpTableEntriesRs struct {
@@ -453,6 +478,16 @@ func (r *DevicesLiveToolsArpTableRs) toSdkApiRequestCreate(ctx context.Context)
 
 // From gosdk to TF Structs Schema
 func ResponseDevicesGetDeviceLiveToolsArpTableItemToBodyRs(state DevicesLiveToolsArpTableRs, response *merakigosdk.ResponseDevicesGetDeviceLiveToolsArpTable, is_read bool) DevicesLiveToolsArpTableRs {
+	itemState := ResponseDevicesGetDeviceLiveToolsArpTableItemToRs(state, response)
+	if is_read {
+		return mergeInterfacesOnlyPath(state, itemState).(DevicesLiveToolsArpTableRs)
+	}
+	return mergeInterfaces(state, itemState, true).(DevicesLiveToolsArpTableRs)
+}
+
+// ResponseDevicesGetDeviceLiveToolsArpTableItemToRs converts the response of the API to the state of the resource,
+// without merging it with the current state.
+func ResponseDevicesGetDeviceLiveToolsArpTableItemToRs(state DevicesLiveToolsArpTableRs, response *merakigosdk.ResponseDevicesGetDeviceLiveToolsArpTable) DevicesLiveToolsArpTableRs {
 	itemState := DevicesLiveToolsArpTableRs{
 		ArpTableID: func() types.String {
 			if response.ArpTableID != "" {
@@ -527,8 +562,5 @@ func ResponseDevicesGetDeviceLiveToolsArpTableItemToBodyRs(state DevicesLiveTool
 			return types.String{}
 		}(),
 	}
-	if is_read {
-		return mergeInterfacesOnlyPath(state, itemState).(DevicesLiveToolsArpTableRs)
-	}
-	return mergeInterfaces(state, itemState, true).(DevicesLiveToolsArpTableRs)
+	return itemState
 }
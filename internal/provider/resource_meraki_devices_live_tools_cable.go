@@ -19,13 +19,12 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -38,8 +37,11 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesLiveToolsCableResource{}
-	_ resource.ResourceWithConfigure = &DevicesLiveToolsCableResource{}
+	_ resource.Resource                = &DevicesLiveToolsCableResource{}
+	_ resource.ResourceWithConfigure   = &DevicesLiveToolsCableResource{}
+	_ resource.ResourceWithImportState = &DevicesLiveToolsCableResource{}
+	_ resource.ResourceWithIdentity    = &DevicesLiveToolsCableResource{}
+	_ resource.ResourceWithMoveState   = &DevicesLiveToolsCableResource{}
 )
 
 func NewDevicesLiveToolsCableResource() resource.Resource {
@@ -63,8 +65,18 @@ func (r *DevicesLiveToolsCableResource) Metadata(_ context.Context, req resource
 	resp.TypeName = req.ProviderTypeName + "_devices_live_tools_cable"
 }
 
-func (r *DevicesLiveToolsCableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+// MoveState moves the states of the former meraki_devices_live_tools_cable_test resource.
+func (r *DevicesLiveToolsCableResource) MoveState(ctx context.Context) []resource.StateMover {
+	return moveStateFrom("meraki_devices_live_tools_cable")
+}
+
+func (r *DevicesLiveToolsCableResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
 		Attributes: map[string]schema.Attribute{
 			"cable_test_id": schema.StringAttribute{
 				MarkdownDescription: `Id of the cable test request. Used to check the status of the request.`,
@@ -119,6 +131,7 @@ func (r *DevicesLiveToolsCableResource) Schema(_ context.Context, _ resource.Sch
 					},
 					"shared_secret": schema.StringAttribute{
 						MarkdownDescription: `A shared secret that will be included in the requests sent to the callback URL. It can be used to verify that the request was sent by Meraki. If using this field, please also specify an url.`,
+						Sensitive:           true,
 						Computed:            true,
 						Optional:            true,
 						PlanModifiers: []planmodifier.String{
@@ -282,6 +295,7 @@ func (r *DevicesLiveToolsCableResource) Create(ctx context.Context, req resource
 			data = ResponseDevicesGetDeviceLiveToolsCableTestItemToBodyRs(data, responseVerifyItem, false)
 			//Path params in update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
@@ -304,16 +318,22 @@ func (r *DevicesLiveToolsCableResource) Create(ctx context.Context, req resource
 	}
 
 	//Assign Path Params required
+	vvID = response.CableTestID
+	data.ID = types.StringValue(vvID)
 
-	responseGet, restyResp1, err := r.client.Devices.GetDeviceLiveToolsCableTest(vvSerial, vvID)
-	if err != nil || responseGet == nil {
-		if restyResp1 != nil {
-			resp.Diagnostics.AddError(
-				"Failure when executing GetDeviceLiveToolsCableTest",
-				restyResp1.String(),
-			)
-			return
-		}
+	createTimeout, diags := data.Timeouts.Create(ctx, liveToolDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	responseGet, err := waitForLiveTool(waitCtx, func() (*merakigosdk.ResponseDevicesGetDeviceLiveToolsCableTest, *resty.Response, error) {
+		return r.client.Devices.GetDeviceLiveToolsCableTest(vvSerial, vvID)
+	}, func(response *merakigosdk.ResponseDevicesGetDeviceLiveToolsCableTest) string {
+		return response.Status
+	})
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing GetDeviceLiveToolsCableTest",
 			err.Error(),
@@ -322,10 +342,19 @@ func (r *DevicesLiveToolsCableResource) Create(ctx context.Context, req resource
 	}
 
 	data = ResponseDevicesGetDeviceLiveToolsCableTestItemToBodyRs(data, responseGet, false)
-
-	diags := resp.State.Set(ctx, &data)
+	// The results are computed, they are taken from the last response.
+	itemState := ResponseDevicesGetDeviceLiveToolsCableTestItemToRs(data, responseGet)
+	data.CableTestID = itemState.CableTestID
+	data.Error = itemState.Error
+	data.Request = itemState.Request
+	data.Results = itemState.Results
+	data.Status = itemState.Status
+	data.URL = itemState.URL
+
+	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
-
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(liveToolErrors("Cable test", vvID, responseGet.Status, responseGet.Error)...)
 }
 
 func (r *DevicesLiveToolsCableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -379,21 +408,15 @@ func (r *DevicesLiveToolsCableResource) Read(ctx context.Context, req resource.R
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesLiveToolsCableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "serial", "id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+func (r *DevicesLiveToolsCableResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial", "id")
 }
 
 func (r *DevicesLiveToolsCableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -431,6 +454,8 @@ type DevicesLiveToolsCableRs struct {
 	URL         types.String                                            `tfsdk:"url"`
 	Callback    *RequestDevicesCreateDeviceLiveToolsCableTestCallbackRs `tfsdk:"callback"`
 	Ports       types.Set                                               `tfsdk:"ports"`
+	// Not in the API
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ResponseDevicesGetDeviceLiveToolsCableTestRequestRs struct {
@@ -511,6 +536,16 @@ func (r *DevicesLiveToolsCableRs) toSdkApiRequestCreate(ctx context.Context) *me
 
 // From gosdk to TF Structs Schema
 func ResponseDevicesGetDeviceLiveToolsCableTestItemToBodyRs(state DevicesLiveToolsCableRs, response *merakigosdk.ResponseDevicesGetDeviceLiveToolsCableTest, is_read bool) DevicesLiveToolsCableRs {
+	itemState := ResponseDevicesGetDeviceLiveToolsCableTestItemToRs(state, response)
+	if is_read {
+		return mergeInterfacesOnlyPath(state, itemState).(DevicesLiveToolsCableRs)
+	}
+	return mergeInterfaces(state, itemState, true).(DevicesLiveToolsCableRs)
+}
+
+// ResponseDevicesGetDeviceLiveToolsCableTestItemToRs converts the response of the API to the state of the resource,
+// without merging it with the current state.
+func ResponseDevicesGetDeviceLiveToolsCableTestItemToRs(state DevicesLiveToolsCableRs, response *merakigosdk.ResponseDevicesGetDeviceLiveToolsCableTest) DevicesLiveToolsCableRs {
 	itemState := DevicesLiveToolsCableRs{
 		CableTestID: func() types.String {
 			if response.CableTestID != "" {
@@ -615,8 +650,5 @@ func ResponseDevicesGetDeviceLiveToolsCableTestItemToBodyRs(state DevicesLiveToo
 			return types.String{}
 		}(),
 	}
-	if is_read {
-		return mergeInterfacesOnlyPath(state, itemState).(DevicesLiveToolsCableRs)
-	}
-	return mergeInterfaces(state, itemState, true).(DevicesLiveToolsCableRs)
+	return itemState
 }
@@ -23,6 +23,8 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -59,8 +61,13 @@ func (r *DevicesLiveToolsPingResource) Metadata(_ context.Context, req resource.
 }
 
 // resourceAction
-func (r *DevicesLiveToolsPingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DevicesLiveToolsPingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
 		Attributes: map[string]schema.Attribute{
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `serial path parameter.`,
@@ -115,6 +122,72 @@ func (r *DevicesLiveToolsPingResource) Schema(_ context.Context, _ resource.Sche
 							},
 						},
 					},
+					"results": schema.SingleNestedAttribute{
+						MarkdownDescription: `Results of the ping request.`,
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+
+							"latencies": schema.SingleNestedAttribute{
+								MarkdownDescription: `Packet latency stats`,
+								Computed:            true,
+								Attributes: map[string]schema.Attribute{
+
+									"average": schema.Float64Attribute{
+										MarkdownDescription: `Average latency`,
+										Computed:            true,
+									},
+									"maximum": schema.Float64Attribute{
+										MarkdownDescription: `Maximum latency`,
+										Computed:            true,
+									},
+									"minimum": schema.Float64Attribute{
+										MarkdownDescription: `Minimum latency`,
+										Computed:            true,
+									},
+								},
+							},
+							"loss": schema.SingleNestedAttribute{
+								MarkdownDescription: `Lost packets`,
+								Computed:            true,
+								Attributes: map[string]schema.Attribute{
+
+									"percentage": schema.Float64Attribute{
+										MarkdownDescription: `Percentage of packets lost`,
+										Computed:            true,
+									},
+								},
+							},
+							"received": schema.Int64Attribute{
+								MarkdownDescription: `Number of packets received`,
+								Computed:            true,
+							},
+							"replies": schema.SetNestedAttribute{
+								MarkdownDescription: `Received packets`,
+								Computed:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+
+										"latency": schema.Float64Attribute{
+											MarkdownDescription: `Latency of the packet in milliseconds`,
+											Computed:            true,
+										},
+										"sequence_id": schema.Int64Attribute{
+											MarkdownDescription: `Sequence ID of the packet`,
+											Computed:            true,
+										},
+										"size": schema.Int64Attribute{
+											MarkdownDescription: `Size of the packet in bytes`,
+											Computed:            true,
+										},
+									},
+								},
+							},
+							"sent": schema.Int64Attribute{
+								MarkdownDescription: `Number of packets sent`,
+								Computed:            true,
+							},
+						},
+					},
 					"status": schema.StringAttribute{
 						MarkdownDescription: `Status of the ping request.`,
 						Computed:            true,
@@ -168,6 +241,7 @@ func (r *DevicesLiveToolsPingResource) Schema(_ context.Context, _ resource.Sche
 							},
 							"shared_secret": schema.StringAttribute{
 								MarkdownDescription: `A shared secret that will be included in the requests sent to the callback URL. It can be used to verify that the request was sent by Meraki. If using this field, please also specify an url.`,
+								Sensitive:           true,
 								Optional:            true,
 								Computed:            true,
 								PlanModifiers: []planmodifier.String{
@@ -243,8 +317,35 @@ func (r *DevicesLiveToolsPingResource) Create(ctx context.Context, req resource.
 	}
 	//Item
 	data = ResponseDevicesCreateDeviceLiveToolsPingItemToBody(data, response)
-	diags := resp.State.Set(ctx, &data)
+
+	vvPingID := response.PingID
+	createTimeout, diags := data.Timeouts.Create(ctx, liveToolDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	responseGet, err := waitForLiveTool(waitCtx, func() (*merakigosdk.ResponseDevicesGetDeviceLiveToolsPing, *resty.Response, error) {
+		return r.client.Devices.GetDeviceLiveToolsPing(vvSerial, vvPingID)
+	}, func(response *merakigosdk.ResponseDevicesGetDeviceLiveToolsPing) string {
+		return response.Status
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing GetDeviceLiveToolsPing",
+			err.Error(),
+		)
+		return
+	}
+	// The results are read like in the devices_live_tools_ping_info data source.
+	info := ResponseDevicesGetDeviceLiveToolsPingItemToBody(DevicesLiveToolsPingInfo{}, responseGet)
+	data.Item.Status = info.Item.Status
+	data.Item.Results = info.Item.Results
+
+	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(liveToolErrors("Ping", vvPingID, responseGet.Status, "")...)
 }
 
 func (r *DevicesLiveToolsPingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -265,12 +366,14 @@ type DevicesLiveToolsPing struct {
 	Serial     types.String                               `tfsdk:"serial"`
 	Item       *ResponseDevicesCreateDeviceLiveToolsPing  `tfsdk:"item"`
 	Parameters *RequestDevicesCreateDeviceLiveToolsPingRs `tfsdk:"parameters"`
+	Timeouts   timeouts.Value                             `tfsdk:"timeouts"`
 }
 
 type ResponseDevicesCreateDeviceLiveToolsPing struct {
 	Callback *ResponseDevicesCreateDeviceLiveToolsPingCallback `tfsdk:"callback"`
 	PingID   types.String                                      `tfsdk:"ping_id"`
 	Request  *ResponseDevicesCreateDeviceLiveToolsPingRequest  `tfsdk:"request"`
+	Results  *ResponseDevicesGetDeviceLiveToolsPingResults     `tfsdk:"results"`
 	Status   types.String                                      `tfsdk:"status"`
 	URL      types.String                                      `tfsdk:"url"`
 }
@@ -164,6 +164,7 @@ func (r *DevicesLiveToolsPingDeviceResource) Schema(_ context.Context, _ resourc
 							},
 							"shared_secret": schema.StringAttribute{
 								MarkdownDescription: `A shared secret that will be included in the requests sent to the callback URL. It can be used to verify that the request was sent by Meraki. If using this field, please also specify an url.`,
+								Sensitive:           true,
 								Optional:            true,
 								Computed:            true,
 								PlanModifiers: []planmodifier.String{
@@ -19,13 +19,12 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -36,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesLiveToolsThroughputTestResource{}
-	_ resource.ResourceWithConfigure = &DevicesLiveToolsThroughputTestResource{}
+	_ resource.Resource                = &DevicesLiveToolsThroughputTestResource{}
+	_ resource.ResourceWithConfigure   = &DevicesLiveToolsThroughputTestResource{}
+	_ resource.ResourceWithImportState = &DevicesLiveToolsThroughputTestResource{}
+	_ resource.ResourceWithIdentity    = &DevicesLiveToolsThroughputTestResource{}
 )
 
 func NewDevicesLiveToolsThroughputTestResource() resource.Resource {
@@ -61,8 +62,13 @@ func (r *DevicesLiveToolsThroughputTestResource) Metadata(_ context.Context, req
 	resp.TypeName = req.ProviderTypeName + "_devices_live_tools_throughput_test"
 }
 
-func (r *DevicesLiveToolsThroughputTestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DevicesLiveToolsThroughputTestResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
 		Attributes: map[string]schema.Attribute{
 			"callback": schema.SingleNestedAttribute{
 				MarkdownDescription: `Details for the callback. Please include either an httpServerId OR url and sharedSecret`,
@@ -113,6 +119,7 @@ func (r *DevicesLiveToolsThroughputTestResource) Schema(_ context.Context, _ res
 					},
 					"shared_secret": schema.StringAttribute{
 						MarkdownDescription: `A shared secret that will be included in the requests sent to the callback URL. It can be used to verify that the request was sent by Meraki. If using this field, please also specify an url.`,
+						Sensitive:           true,
 						Computed:            true,
 						Optional:            true,
 						PlanModifiers: []planmodifier.String{
@@ -230,6 +237,7 @@ func (r *DevicesLiveToolsThroughputTestResource) Create(ctx context.Context, req
 			data = ResponseDevicesGetDeviceLiveToolsThroughputTestItemToBodyRs(data, responseVerifyItem, false)
 			//Path params in update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
@@ -252,16 +260,22 @@ func (r *DevicesLiveToolsThroughputTestResource) Create(ctx context.Context, req
 	}
 
 	//Assign Path Params required
+	vvThroughputTestID = response.ThroughputTestID
+	data.ThroughputTestID = types.StringValue(vvThroughputTestID)
 
-	responseGet, restyResp1, err := r.client.Devices.GetDeviceLiveToolsThroughputTest(vvSerial, vvThroughputTestID)
-	if err != nil || responseGet == nil {
-		if restyResp1 != nil {
-			resp.Diagnostics.AddError(
-				"Failure when executing GetDeviceLiveToolsThroughputTest",
-				restyResp1.String(),
-			)
-			return
-		}
+	createTimeout, diags := data.Timeouts.Create(ctx, liveToolDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	responseGet, err := waitForLiveTool(waitCtx, func() (*merakigosdk.ResponseDevicesGetDeviceLiveToolsThroughputTest, *resty.Response, error) {
+		return r.client.Devices.GetDeviceLiveToolsThroughputTest(vvSerial, vvThroughputTestID)
+	}, func(response *merakigosdk.ResponseDevicesGetDeviceLiveToolsThroughputTest) string {
+		return response.Status
+	})
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing GetDeviceLiveToolsThroughputTest",
 			err.Error(),
@@ -270,10 +284,18 @@ func (r *DevicesLiveToolsThroughputTestResource) Create(ctx context.Context, req
 	}
 
 	data = ResponseDevicesGetDeviceLiveToolsThroughputTestItemToBodyRs(data, responseGet, false)
-
-	diags := resp.State.Set(ctx, &data)
+	// The results are computed, they are taken from the last response.
+	itemState := ResponseDevicesGetDeviceLiveToolsThroughputTestItemToRs(data, responseGet)
+	data.Error = itemState.Error
+	data.Request = itemState.Request
+	data.Result = itemState.Result
+	data.Status = itemState.Status
+	data.URL = itemState.URL
+
+	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
-
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(liveToolErrors("Throughput test", vvThroughputTestID, responseGet.Status, responseGet.Error)...)
 }
 
 func (r *DevicesLiveToolsThroughputTestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -327,21 +349,15 @@ func (r *DevicesLiveToolsThroughputTestResource) Read(ctx context.Context, req r
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesLiveToolsThroughputTestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "serial", "throughput_test_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("throughput_test_id"), idParts[1])...)
+func (r *DevicesLiveToolsThroughputTestResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial", "throughput_test_id")
 }
 
 func (r *DevicesLiveToolsThroughputTestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -377,6 +393,8 @@ type DevicesLiveToolsThroughputTestRs struct {
 	Status           types.String                                                 `tfsdk:"status"`
 	URL              types.String                                                 `tfsdk:"url"`
 	Callback         *RequestDevicesCreateDeviceLiveToolsThroughputTestCallbackRs `tfsdk:"callback"`
+	// Not in the API
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ResponseDevicesGetDeviceLiveToolsThroughputTestRequestRs struct {
@@ -447,6 +465,16 @@ func (r *DevicesLiveToolsThroughputTestRs) toSdkApiRequestCreate(ctx context.Con
 
 // From gosdk to TF Structs Schema
 func ResponseDevicesGetDeviceLiveToolsThroughputTestItemToBodyRs(state DevicesLiveToolsThroughputTestRs, response *merakigosdk.ResponseDevicesGetDeviceLiveToolsThroughputTest, is_read bool) DevicesLiveToolsThroughputTestRs {
+	itemState := ResponseDevicesGetDeviceLiveToolsThroughputTestItemToRs(state, response)
+	if is_read {
+		return mergeInterfacesOnlyPath(state, itemState).(DevicesLiveToolsThroughputTestRs)
+	}
+	return mergeInterfaces(state, itemState, true).(DevicesLiveToolsThroughputTestRs)
+}
+
+// ResponseDevicesGetDeviceLiveToolsThroughputTestItemToRs converts the response of the API to the state of the resource,
+// without merging it with the current state.
+func ResponseDevicesGetDeviceLiveToolsThroughputTestItemToRs(state DevicesLiveToolsThroughputTestRs, response *merakigosdk.ResponseDevicesGetDeviceLiveToolsThroughputTest) DevicesLiveToolsThroughputTestRs {
 	itemState := DevicesLiveToolsThroughputTestRs{
 		Error: func() types.String {
 			if response.Error != "" {
@@ -506,8 +534,5 @@ func ResponseDevicesGetDeviceLiveToolsThroughputTestItemToBodyRs(state DevicesLi
 			return types.String{}
 		}(),
 	}
-	if is_read {
-		return mergeInterfacesOnlyPath(state, itemState).(DevicesLiveToolsThroughputTestRs)
-	}
-	return mergeInterfaces(state, itemState, true).(DevicesLiveToolsThroughputTestRs)
+	return itemState
 }
@@ -19,13 +19,12 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -37,8 +36,11 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesLiveToolsWakeOnLanResource{}
-	_ resource.ResourceWithConfigure = &DevicesLiveToolsWakeOnLanResource{}
+	_ resource.Resource                 = &DevicesLiveToolsWakeOnLanResource{}
+	_ resource.ResourceWithConfigure    = &DevicesLiveToolsWakeOnLanResource{}
+	_ resource.ResourceWithImportState  = &DevicesLiveToolsWakeOnLanResource{}
+	_ resource.ResourceWithIdentity     = &DevicesLiveToolsWakeOnLanResource{}
+	_ resource.ResourceWithUpgradeState = &DevicesLiveToolsWakeOnLanResource{}
 )
 
 func NewDevicesLiveToolsWakeOnLanResource() resource.Resource {
@@ -62,8 +64,19 @@ func (r *DevicesLiveToolsWakeOnLanResource) Metadata(_ context.Context, req reso
 	resp.TypeName = req.ProviderTypeName + "_devices_live_tools_wake_on_lan"
 }
 
-func (r *DevicesLiveToolsWakeOnLanResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+// UpgradeState upgrades the states written before the VLAN IDs were numbers.
+func (r *DevicesLiveToolsWakeOnLanResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(1)
+}
+
+func (r *DevicesLiveToolsWakeOnLanResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
 		Attributes: map[string]schema.Attribute{
 			"callback": schema.SingleNestedAttribute{
 				MarkdownDescription: `Details for the callback. Please include either an httpServerId OR url and sharedSecret`,
@@ -114,6 +127,7 @@ func (r *DevicesLiveToolsWakeOnLanResource) Schema(_ context.Context, _ resource
 					},
 					"shared_secret": schema.StringAttribute{
 						MarkdownDescription: `A shared secret that will be included in the requests sent to the callback URL. It can be used to verify that the request was sent by Meraki. If using this field, please also specify an url.`,
+						Sensitive:           true,
 						Computed:            true,
 						Optional:            true,
 						PlanModifiers: []planmodifier.String{
@@ -237,6 +251,7 @@ func (r *DevicesLiveToolsWakeOnLanResource) Create(ctx context.Context, req reso
 			data = ResponseDevicesGetDeviceLiveToolsWakeOnLanItemToBodyRs(data, responseVerifyItem, false)
 			//Path params in update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
@@ -259,16 +274,22 @@ func (r *DevicesLiveToolsWakeOnLanResource) Create(ctx context.Context, req reso
 	}
 
 	//Assign Path Params required
+	vvWakeOnLanID = response.WakeOnLanID
+	data.WakeOnLanID = types.StringValue(vvWakeOnLanID)
 
-	responseGet, restyResp1, err := r.client.Devices.GetDeviceLiveToolsWakeOnLan(vvSerial, vvWakeOnLanID)
-	if err != nil || responseGet == nil {
-		if restyResp1 != nil {
-			resp.Diagnostics.AddError(
-				"Failure when executing GetDeviceLiveToolsWakeOnLan",
-				restyResp1.String(),
-			)
-			return
-		}
+	createTimeout, diags := data.Timeouts.Create(ctx, liveToolDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	responseGet, err := waitForLiveTool(waitCtx, func() (*merakigosdk.ResponseDevicesGetDeviceLiveToolsWakeOnLan, *resty.Response, error) {
+		return r.client.Devices.GetDeviceLiveToolsWakeOnLan(vvSerial, vvWakeOnLanID)
+	}, func(response *merakigosdk.ResponseDevicesGetDeviceLiveToolsWakeOnLan) string {
+		return response.Status
+	})
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing GetDeviceLiveToolsWakeOnLan",
 			err.Error(),
@@ -277,10 +298,17 @@ func (r *DevicesLiveToolsWakeOnLanResource) Create(ctx context.Context, req reso
 	}
 
 	data = ResponseDevicesGetDeviceLiveToolsWakeOnLanItemToBodyRs(data, responseGet, false)
-
-	diags := resp.State.Set(ctx, &data)
+	// The results are computed, they are taken from the last response.
+	itemState := ResponseDevicesGetDeviceLiveToolsWakeOnLanItemToRs(data, responseGet)
+	data.Error = itemState.Error
+	data.Request = itemState.Request
+	data.Status = itemState.Status
+	data.URL = itemState.URL
+
+	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
-
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(liveToolErrors("Wake-on-LAN", vvWakeOnLanID, responseGet.Status, responseGet.Error)...)
 }
 
 func (r *DevicesLiveToolsWakeOnLanResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -334,21 +362,15 @@ func (r *DevicesLiveToolsWakeOnLanResource) Read(ctx context.Context, req resour
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesLiveToolsWakeOnLanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "serial", "wake_on_lan_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wake_on_lan_id"), idParts[1])...)
+func (r *DevicesLiveToolsWakeOnLanResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial", "wake_on_lan_id")
 }
 
 func (r *DevicesLiveToolsWakeOnLanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -385,6 +407,8 @@ type DevicesLiveToolsWakeOnLanRs struct {
 	Callback    *RequestDevicesCreateDeviceLiveToolsWakeOnLanCallbackRs `tfsdk:"callback"`
 	Mac         types.String                                            `tfsdk:"mac"`
 	VLANID      types.Int64                                             `tfsdk:"vlan_id"`
+	// Not in the API
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ResponseDevicesGetDeviceLiveToolsWakeOnLanRequestRs struct {
@@ -464,6 +488,16 @@ func (r *DevicesLiveToolsWakeOnLanRs) toSdkApiRequestCreate(ctx context.Context)
 
 // From gosdk to TF Structs Schema
 func ResponseDevicesGetDeviceLiveToolsWakeOnLanItemToBodyRs(state DevicesLiveToolsWakeOnLanRs, response *merakigosdk.ResponseDevicesGetDeviceLiveToolsWakeOnLan, is_read bool) DevicesLiveToolsWakeOnLanRs {
+	itemState := ResponseDevicesGetDeviceLiveToolsWakeOnLanItemToRs(state, response)
+	if is_read {
+		return mergeInterfacesOnlyPath(state, itemState).(DevicesLiveToolsWakeOnLanRs)
+	}
+	return mergeInterfaces(state, itemState, true).(DevicesLiveToolsWakeOnLanRs)
+}
+
+// ResponseDevicesGetDeviceLiveToolsWakeOnLanItemToRs converts the response of the API to the state of the resource,
+// without merging it with the current state.
+func ResponseDevicesGetDeviceLiveToolsWakeOnLanItemToRs(state DevicesLiveToolsWakeOnLanRs, response *merakigosdk.ResponseDevicesGetDeviceLiveToolsWakeOnLan) DevicesLiveToolsWakeOnLanRs {
 	itemState := DevicesLiveToolsWakeOnLanRs{
 		Error: func() types.String {
 			if response.Error != "" {
@@ -515,8 +549,5 @@ func ResponseDevicesGetDeviceLiveToolsWakeOnLanItemToBodyRs(state DevicesLiveToo
 			return types.String{}
 		}(),
 	}
-	if is_read {
-		return mergeInterfacesOnlyPath(state, itemState).(DevicesLiveToolsWakeOnLanRs)
-	}
-	return mergeInterfaces(state, itemState, true).(DevicesLiveToolsWakeOnLanRs)
+	return itemState
 }
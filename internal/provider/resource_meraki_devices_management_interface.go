@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -39,8 +38,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesManagementInterfaceResource{}
-	_ resource.ResourceWithConfigure = &DevicesManagementInterfaceResource{}
+	_ resource.Resource                = &DevicesManagementInterfaceResource{}
+	_ resource.ResourceWithConfigure   = &DevicesManagementInterfaceResource{}
+	_ resource.ResourceWithImportState = &DevicesManagementInterfaceResource{}
+	_ resource.ResourceWithIdentity    = &DevicesManagementInterfaceResource{}
 )
 
 func NewDevicesManagementInterfaceResource() resource.Resource {
@@ -48,7 +49,8 @@ func NewDevicesManagementInterfaceResource() resource.Resource {
 }
 
 type DevicesManagementInterfaceResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesManagementInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,6 +59,7 @@ func (r *DevicesManagementInterfaceResource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -86,6 +89,10 @@ func (r *DevicesManagementInterfaceResource) Schema(_ context.Context, _ resourc
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `serial path parameter.`,
 				Required:            true,
@@ -275,6 +282,7 @@ func (r *DevicesManagementInterfaceResource) Create(ctx context.Context, req res
 			data = ResponseDevicesGetDeviceManagementInterfaceItemToBodyRs(data, responseVerifyItem, false)
 			//Path params in update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
@@ -317,6 +325,7 @@ func (r *DevicesManagementInterfaceResource) Create(ctx context.Context, req res
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -370,9 +379,14 @@ func (r *DevicesManagementInterfaceResource) Read(ctx context.Context, req resou
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesManagementInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesManagementInterfaceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesManagementInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -406,20 +420,58 @@ func (r *DevicesManagementInterfaceResource) Update(ctx context.Context, req res
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesManagementInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesManagementInterface", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesManagementInterfaceRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesManagementInterface", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	// Meraki defaults
+	defaults := DevicesManagementInterfaceRs{
+		Wan1: &ResponseDevicesGetDeviceManagementInterfaceWan1Rs{
+			UsingStaticIP: types.BoolValue(false),
+		},
+		Wan2: &ResponseDevicesGetDeviceManagementInterfaceWan2Rs{
+			UsingStaticIP: types.BoolValue(false),
+		},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Devices.UpdateDeviceManagementInterface(vvSerial, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceManagementInterface",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceManagementInterface",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type DevicesManagementInterfaceRs struct {
-	Serial        types.String                                                `tfsdk:"serial"`
-	DdnsHostnames *ResponseDevicesGetDeviceManagementInterfaceDdnsHostnamesRs `tfsdk:"ddns_hostnames"`
-	Wan1          *ResponseDevicesGetDeviceManagementInterfaceWan1Rs          `tfsdk:"wan1"`
-	Wan2          *ResponseDevicesGetDeviceManagementInterfaceWan2Rs          `tfsdk:"wan2"`
+	Serial         types.String                                                `tfsdk:"serial"`
+	DdnsHostnames  *ResponseDevicesGetDeviceManagementInterfaceDdnsHostnamesRs `tfsdk:"ddns_hostnames"`
+	Wan1           *ResponseDevicesGetDeviceManagementInterfaceWan1Rs          `tfsdk:"wan1"`
+	Wan2           *ResponseDevicesGetDeviceManagementInterfaceWan2Rs          `tfsdk:"wan2"`
+	ResetOnDestroy types.Bool                                                  `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseDevicesGetDeviceManagementInterfaceDdnsHostnamesRs struct {
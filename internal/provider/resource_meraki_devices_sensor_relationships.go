@@ -25,7 +25,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesSensorRelationshipsResource{}
-	_ resource.ResourceWithConfigure = &DevicesSensorRelationshipsResource{}
+	_ resource.Resource                = &DevicesSensorRelationshipsResource{}
+	_ resource.ResourceWithConfigure   = &DevicesSensorRelationshipsResource{}
+	_ resource.ResourceWithImportState = &DevicesSensorRelationshipsResource{}
+	_ resource.ResourceWithIdentity    = &DevicesSensorRelationshipsResource{}
 )
 
 func NewDevicesSensorRelationshipsResource() resource.Resource {
@@ -46,7 +47,8 @@ func NewDevicesSensorRelationshipsResource() resource.Resource {
 }
 
 type DevicesSensorRelationshipsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesSensorRelationshipsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +57,7 @@ func (r *DevicesSensorRelationshipsResource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -99,6 +102,10 @@ func (r *DevicesSensorRelationshipsResource) Schema(_ context.Context, _ resourc
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `serial path parameter.`,
 				Required:            true,
@@ -150,6 +157,7 @@ func (r *DevicesSensorRelationshipsResource) Create(ctx context.Context, req res
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -191,9 +199,14 @@ func (r *DevicesSensorRelationshipsResource) Read(ctx context.Context, req resou
 	//entro aqui 2
 	data = ResponseSensorGetDeviceSensorRelationshipsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesSensorRelationshipsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesSensorRelationshipsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesSensorRelationshipsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -222,18 +235,53 @@ func (r *DevicesSensorRelationshipsResource) Update(ctx context.Context, req res
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesSensorRelationshipsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesSensorRelationships", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesSensorRelationshipsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesSensorRelationships", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	// Meraki defaults
+	defaults := DevicesSensorRelationshipsRs{
+		Livestream: &ResponseSensorGetDeviceSensorRelationshipsLivestreamRs{
+			RelatedDevices: &[]ResponseSensorGetDeviceSensorRelationshipsLivestreamRelatedDevicesRs{},
+		},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Sensor.UpdateDeviceSensorRelationships(vvSerial, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceSensorRelationships",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceSensorRelationships",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type DevicesSensorRelationshipsRs struct {
-	Serial     types.String                                            `tfsdk:"serial"`
-	Livestream *ResponseSensorGetDeviceSensorRelationshipsLivestreamRs `tfsdk:"livestream"`
+	Serial         types.String                                            `tfsdk:"serial"`
+	Livestream     *ResponseSensorGetDeviceSensorRelationshipsLivestreamRs `tfsdk:"livestream"`
+	ResetOnDestroy types.Bool                                              `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSensorGetDeviceSensorRelationshipsLivestreamRs struct {
@@ -265,7 +313,10 @@ func (r *DevicesSensorRelationshipsRs) toSdkApiRequestUpdate(ctx context.Context
 		}
 		requestSensorUpdateDeviceSensorRelationshipsLivestream = &merakigosdk.RequestSensorUpdateDeviceSensorRelationshipsLivestream{
 			RelatedDevices: func() *[]merakigosdk.RequestSensorUpdateDeviceSensorRelationshipsLivestreamRelatedDevices {
-				if len(requestSensorUpdateDeviceSensorRelationshipsLivestreamRelatedDevices) > 0 {
+				if len(requestSensorUpdateDeviceSensorRelationshipsLivestreamRelatedDevices) > 0 || r.Livestream.RelatedDevices != nil {
+					if len(requestSensorUpdateDeviceSensorRelationshipsLivestreamRelatedDevices) == 0 {
+						requestSensorUpdateDeviceSensorRelationshipsLivestreamRelatedDevices = make([]merakigosdk.RequestSensorUpdateDeviceSensorRelationshipsLivestreamRelatedDevices, 0)
+					}
 					return &requestSensorUpdateDeviceSensorRelationshipsLivestreamRelatedDevices
 				}
 				return nil
@@ -0,0 +1,745 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// RESOURCE NORMAL
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &DevicesSwitchPortRangesResource{}
+	_ resource.ResourceWithConfigure      = &DevicesSwitchPortRangesResource{}
+	_ resource.ResourceWithImportState    = &DevicesSwitchPortRangesResource{}
+	_ resource.ResourceWithIdentity       = &DevicesSwitchPortRangesResource{}
+	_ resource.ResourceWithModifyPlan     = &DevicesSwitchPortRangesResource{}
+	_ resource.ResourceWithValidateConfig = &DevicesSwitchPortRangesResource{}
+)
+
+func NewDevicesSwitchPortRangesResource() resource.Resource {
+	return &DevicesSwitchPortRangesResource{}
+}
+
+type DevicesSwitchPortRangesResource struct {
+	client  *merakigosdk.Client
+	clients *merakiClientPool
+	batcher *actionBatcher
+}
+
+func (r *DevicesSwitchPortRangesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.clients = providerData.Clients
+	r.batcher = providerData.Batcher
+}
+
+// Metadata returns the data source type name.
+func (r *DevicesSwitchPortRangesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices_switch_port_ranges"
+}
+
+func (r *DevicesSwitchPortRangesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Applies the same settings to a range of ports of a switch, like `1-24,49`. The ports are read in one request, and only the ports whose settings differ from the configuration are updated, in action batches when `meraki_batch_writes` is set. Only the configured settings are managed: the other settings of the ports, and the settings removed from the configuration, are left as they are. Destroying the resource does not change the ports, and importing it does not import their settings, they are applied from the configuration. Do not manage the same port with `meraki_devices_switch_ports` or another range.",
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: `ID of the organization of the switch`,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serial": schema.StringAttribute{
+				MarkdownDescription: `serial path parameter.`,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port_ids": schema.StringAttribute{
+				MarkdownDescription: `Comma-separated list of port IDs and ranges of port numbers, like ` + "`1-24,49`" + ` or ` + "`1-8,1_MA-MOD-4X10G_1`",
+				Required:            true,
+			},
+			"ports": schema.ListAttribute{
+				MarkdownDescription: `The port IDs of ` + "`port_ids`",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"out_of_sync_port_ids": schema.ListAttribute{
+				MarkdownDescription: `The ports whose settings differed from the configuration at the last refresh. They are updated by the next apply.`,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"access_policy_number": schema.Int64Attribute{
+				MarkdownDescription: `The number of a custom access policy to configure on the switch port. Only applicable when 'accessPolicyType' is 'Custom access policy'.`,
+				Optional:            true,
+			},
+			"access_policy_type": schema.StringAttribute{
+				MarkdownDescription: `The type of the access policy of the switch port. Only applicable to access ports. Can be one of 'Open', 'Custom access policy', 'MAC allow list' or 'Sticky MAC allow list'.
+                                  Allowed values: [Custom access policy,MAC allow list,Open,Sticky MAC allow list]`,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"Custom access policy",
+						"MAC allow list",
+						"Open",
+						"Sticky MAC allow list",
+					),
+				},
+			},
+			"allowed_vlans": schema.StringAttribute{
+				MarkdownDescription: `The VLANs allowed on the switch port. Only applicable to trunk ports.`,
+				Optional:            true,
+			},
+			"dai_trusted": schema.BoolAttribute{
+				MarkdownDescription: `If true, ARP packets for this port will be considered trusted, and Dynamic ARP Inspection will allow the traffic.`,
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: `The status of the switch port.`,
+				Optional:            true,
+			},
+			"isolation_enabled": schema.BoolAttribute{
+				MarkdownDescription: `The isolation status of the switch port.`,
+				Optional:            true,
+			},
+			"link_negotiation": schema.StringAttribute{
+				MarkdownDescription: `The link speed for the switch port.`,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: `The name of the switch ports.`,
+				Optional:            true,
+			},
+			"poe_enabled": schema.BoolAttribute{
+				MarkdownDescription: `The PoE status of the switch port.`,
+				Optional:            true,
+			},
+			"port_schedule_id": schema.StringAttribute{
+				MarkdownDescription: `The ID of the port schedule.`,
+				Optional:            true,
+			},
+			"profile": schema.SingleNestedAttribute{
+				MarkdownDescription: `Profile attributes`,
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: `When enabled, override this port's configuration with a port profile.`,
+						Optional:            true,
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: `When enabled, the ID of the port profile used to override the port's configuration.`,
+						Optional:            true,
+					},
+					"iname": schema.StringAttribute{
+						MarkdownDescription: `When enabled, the IName of the profile.`,
+						Optional:            true,
+					},
+				},
+			},
+			"rstp_enabled": schema.BoolAttribute{
+				MarkdownDescription: `The rapid spanning tree protocol status.`,
+				Optional:            true,
+			},
+			"sticky_mac_allow_list": schema.ListAttribute{
+				MarkdownDescription: `The initial list of MAC addresses for sticky Mac allow list. Only applicable when 'accessPolicyType' is 'Sticky MAC allow list'.`,
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"sticky_mac_allow_list_limit": schema.Int64Attribute{
+				MarkdownDescription: `The maximum number of MAC addresses for sticky MAC allow list. Only applicable when 'accessPolicyType' is 'Sticky MAC allow list'.`,
+				Optional:            true,
+			},
+			"storm_control_enabled": schema.BoolAttribute{
+				MarkdownDescription: `The storm control status of the switch port.`,
+				Optional:            true,
+			},
+			"stp_guard": schema.StringAttribute{
+				MarkdownDescription: `The state of the STP guard ('disabled', 'root guard', 'bpdu guard' or 'loop guard').
+                                  Allowed values: [bpdu guard,disabled,loop guard,root guard]`,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"bpdu guard",
+						"disabled",
+						"loop guard",
+						"root guard",
+					),
+				},
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: `The list of tags of the switch port.`,
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: `The type of the switch port ('trunk', 'access', 'stack' or 'routed').
+                                  Allowed values: [access,routed,stack,trunk]`,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"access",
+						"routed",
+						"stack",
+						"trunk",
+					),
+				},
+			},
+			"udld": schema.StringAttribute{
+				MarkdownDescription: `The action to take when Unidirectional Link is detected (Alert only, Enforce). Default configuration is Alert only.
+                                  Allowed values: [Alert only,Enforce]`,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"Alert only",
+						"Enforce",
+					),
+				},
+			},
+			"vlan": schema.Int64Attribute{
+				MarkdownDescription: `The VLAN of the switch port. For a trunk port, this is the native VLAN.`,
+				Optional:            true,
+			},
+			"voice_vlan": schema.Int64Attribute{
+				MarkdownDescription: `The voice VLAN of the switch port. Only applicable to access ports.`,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *DevicesSwitchPortRangesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var portIDs types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("port_ids"), &portIDs)...)
+	if resp.Diagnostics.HasError() || portIDs.IsNull() || portIDs.IsUnknown() {
+		return
+	}
+	if _, err := expandSwitchPortRange(portIDs.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("port_ids"), "Invalid port range", err.Error())
+	}
+}
+
+// ModifyPlan expands port_ids and plans no out of sync port, so that the ports found out of
+// sync by the last refresh are a change that the next apply fixes.
+func (r *DevicesSwitchPortRangesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan DevicesSwitchPortRangesRs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Ports = types.ListUnknown(types.StringType)
+	if !plan.PortIDs.IsUnknown() {
+		ports, err := expandSwitchPortRange(plan.PortIDs.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("port_ids"), "Invalid port range", err.Error())
+			return
+		}
+		plan.Ports = switchPortList(ports)
+	}
+	plan.OutOfSyncPortIDs = switchPortList(nil)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *DevicesSwitchPortRangesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DevicesSwitchPortRangesRs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.apply(ctx, &data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *DevicesSwitchPortRangesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DevicesSwitchPortRangesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	vvOrganizationID := data.OrganizationID.ValueString()
+	vvSerial := data.Serial.ValueString()
+	current, found, err := r.switchPorts(vvOrganizationID, vvSerial)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing GetOrganizationSwitchPortsBySwitch",
+			err.Error(),
+		)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddWarning(
+			"Resource not found",
+			"Deleting resource",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	settings, err := switchPortSettings(data.toSdkApiRequestUpdate(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("Failure when reading the switch port settings", err.Error())
+		return
+	}
+	// An imported range has no ports yet.
+	if data.Ports.IsNull() {
+		ports, err := expandSwitchPortRange(data.PortIDs.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("port_ids"), "Invalid port range", err.Error())
+			return
+		}
+		data.Ports = switchPortList(ports)
+	}
+	// The settings that the read does not return are the ones of the last apply.
+	outOfSync := []string{}
+	for _, port := range elementsToStrings(ctx, data.Ports) {
+		if values, ok := current[port]; !ok || !switchPortInSync(values, settings, settings) {
+			outOfSync = append(outOfSync, port)
+		}
+	}
+	data.OutOfSyncPortIDs = switchPortList(outOfSync)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+// ImportState imports a range from "serial,port_ids", like "Q234-ABCD-5678,1-24,49". The
+// organization is the one of the network of the switch. The settings are not imported, they
+// are taken from the configuration by the next apply.
+func (r *DevicesSwitchPortRangesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePartsList(ctx, req, resp, "serial", "port_ids")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var serial types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("serial"), &serial)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	device, restyResp, err := r.client.Devices.GetDevice(serial.ValueString())
+	if err != nil || device == nil {
+		if restyResp != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing GetDevice",
+				restyResp.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing GetDevice",
+			err.Error(),
+		)
+		return
+	}
+	if device.NetworkID == "" {
+		resp.Diagnostics.AddError(
+			"Switch not in a network",
+			fmt.Sprintf("The switch %s is not in a network, its organization is not known.", serial.ValueString()),
+		)
+		return
+	}
+	network, restyResp, err := r.client.Networks.GetNetwork(device.NetworkID)
+	if err != nil || network == nil {
+		if restyResp != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing GetNetwork",
+				restyResp.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing GetNetwork",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), network.OrganizationID)...)
+}
+
+func (r *DevicesSwitchPortRangesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial", "port_ids")
+}
+
+func (r *DevicesSwitchPortRangesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DevicesSwitchPortRangesRs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *DevicesSwitchPortRangesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The ports keep their settings, the range is only removed from the state.
+	resp.State.RemoveResource(ctx)
+}
+
+// apply reads the ports of the switch and updates the ports of the range whose settings
+// differ from data. previous is the state before an update, the settings that the read does
+// not return are compared to it.
+func (r *DevicesSwitchPortRangesResource) apply(ctx context.Context, data *DevicesSwitchPortRangesRs, previous *DevicesSwitchPortRangesRs) diag.Diagnostics {
+	var diags diag.Diagnostics
+	vvOrganizationID := data.OrganizationID.ValueString()
+	vvSerial := data.Serial.ValueString()
+	ports, err := expandSwitchPortRange(data.PortIDs.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("port_ids"), "Invalid port range", err.Error())
+		return diags
+	}
+	request := data.toSdkApiRequestUpdate(ctx)
+	settings, err := switchPortSettings(request)
+	if err != nil {
+		diags.AddError("Failure when reading the switch port settings", err.Error())
+		return diags
+	}
+	var previousSettings map[string]interface{}
+	previousPorts := map[string]bool{}
+	if previous != nil {
+		previousSettings, err = switchPortSettings(previous.toSdkApiRequestUpdate(ctx))
+		if err != nil {
+			diags.AddError("Failure when reading the switch port settings", err.Error())
+			return diags
+		}
+		for _, port := range elementsToStrings(ctx, previous.Ports) {
+			previousPorts[port] = true
+		}
+	}
+
+	current, found, err := r.switchPorts(vvOrganizationID, vvSerial)
+	if err == nil && !found {
+		err = fmt.Errorf("the organization %s has no switch %s", vvOrganizationID, vvSerial)
+	}
+	if err != nil {
+		diags.AddError(
+			"Failure when executing GetOrganizationSwitchPortsBySwitch",
+			err.Error(),
+		)
+		return diags
+	}
+	var changed []string
+	for _, port := range ports {
+		values, ok := current[port]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("port_ids"),
+				"Switch port not found",
+				fmt.Sprintf("The switch %s has no port %s.", vvSerial, port),
+			)
+			continue
+		}
+		portPrevious := previousSettings
+		if !previousPorts[port] {
+			portPrevious = nil
+		}
+		if !switchPortInSync(values, settings, portPrevious) {
+			changed = append(changed, port)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+	log.Printf("[DEBUG] Updating the ports %v of the %d ports of the range of switch %s", changed, len(ports), vvSerial)
+	diags.Append(r.updateSwitchPorts(ctx, vvOrganizationID, vvSerial, changed, request)...)
+	if diags.HasError() {
+		return diags
+	}
+	data.Ports = switchPortList(ports)
+	data.OutOfSyncPortIDs = switchPortList(nil)
+	return diags
+}
+
+// updateSwitchPorts updates the ports with the same request, in action batches of the
+// organization when meraki_batch_writes is set.
+func (r *DevicesSwitchPortRangesResource) updateSwitchPorts(ctx context.Context, organizationID string, serial string, ports []string, request *merakigosdk.RequestSwitchUpdateDeviceSwitchPort) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.batcher != nil {
+		var actions []actionBatchAction
+		for _, port := range ports {
+			actions = append(actions, actionBatchAction{
+				Resource:  "/devices/" + serial + "/switch/ports/" + port,
+				Operation: "update",
+				Body:      request,
+			})
+		}
+		for len(actions) > 0 {
+			n := min(len(actions), actionBatchMaxActions)
+			if err := r.batcher.Run(ctx, organizationID, actions[:n]...); err != nil {
+				diags.AddError(
+					"Failure when executing UpdateDeviceSwitchPort in an action batch",
+					err.Error(),
+				)
+				return diags
+			}
+			actions = actions[n:]
+		}
+		return diags
+	}
+	for _, port := range ports {
+		response, restyResp, err := r.clients.Client(organizationID).Switch.UpdateDeviceSwitchPort(serial, port, request)
+		if err != nil || restyResp == nil || response == nil {
+			if restyResp != nil {
+				diags.AddError(
+					"Failure when executing UpdateDeviceSwitchPort",
+					"Port "+port+": Status: "+strconv.Itoa(restyResp.StatusCode())+"\n"+restyResp.String(),
+				)
+				continue
+			}
+			diags.AddError(
+				"Failure when executing UpdateDeviceSwitchPort",
+				"Port "+port+": "+err.Error(),
+			)
+		}
+	}
+	return diags
+}
+
+// switchPortsBySwitch is an item of GetOrganizationSwitchPortsBySwitch. The API returns a list
+// of switches while the SDK decodes one switch, so the ports are read with resty. The settings
+// of the ports are kept as decoded JSON, to be compared with switchPortSettings.
+type switchPortsBySwitch struct {
+	Serial string                   `json:"serial"`
+	Ports  []map[string]interface{} `json:"ports"`
+}
+
+// switchPorts returns the settings of the ports of the switch by port ID, and false when the
+// organization has no such switch.
+func (r *DevicesSwitchPortRangesResource) switchPorts(organizationID string, serial string) (map[string]map[string]interface{}, bool, error) {
+	var switches []switchPortsBySwitch
+	restyResp, err := r.clients.Client(organizationID).RestyClient().R().
+		SetQueryParamsFromValues(url.Values{"serials[]": {serial}}).
+		SetResult(&switches).
+		Get("/api/v1/organizations/" + url.PathEscape(organizationID) + "/switch/ports/bySwitch")
+	if err == nil && restyResp.IsError() {
+		err = fmt.Errorf("error with operation: %s Error:\n %s", restyResp.Request.URL, restyResp)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	for _, item := range switches {
+		if item.Serial != serial {
+			continue
+		}
+		ports := make(map[string]map[string]interface{}, len(item.Ports))
+		for _, port := range item.Ports {
+			portID, _ := port["portId"].(string)
+			ports[portID] = port
+		}
+		return ports, true, nil
+	}
+	return nil, false, nil
+}
+
+// switchPortSettings returns the settings set by the request, as decoded JSON.
+func switchPortSettings(request *merakigosdk.RequestSwitchUpdateDeviceSwitchPort) (map[string]interface{}, error) {
+	content, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	settings := map[string]interface{}{}
+	err = json.Unmarshal(content, &settings)
+	return settings, err
+}
+
+// switchPortInSync reports whether the settings of a port have the values of settings. A
+// setting that the port does not have is compared to previous, the settings of the last
+// apply, and is out of sync when there is none.
+func switchPortInSync(port, settings, previous map[string]interface{}) bool {
+	for key, value := range settings {
+		if object, ok := value.(map[string]interface{}); ok {
+			portObject, _ := port[key].(map[string]interface{})
+			var previousObject map[string]interface{}
+			if previous != nil {
+				previousObject, _ = previous[key].(map[string]interface{})
+				if previousObject == nil {
+					previousObject = map[string]interface{}{}
+				}
+			}
+			if !switchPortInSync(portObject, object, previousObject) {
+				return false
+			}
+			continue
+		}
+		current, ok := port[key]
+		if !ok && previous != nil {
+			current, ok = previous[key]
+		}
+		if !ok || !reflect.DeepEqual(current, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// switchPortList returns the port IDs as a list, which is empty rather than null when there
+// is no port.
+func switchPortList(ports []string) types.List {
+	elements := make([]attr.Value, 0, len(ports))
+	for _, port := range ports {
+		elements = append(elements, types.StringValue(port))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+var switchPortRangeRegexp = regexp.MustCompile(`^([0-9]+)-([0-9]+)$`)
+
+// expandSwitchPortRange returns the port IDs of a range expression like "1-24,49". The items
+// that are not a range of numbers, like the port of a module 1_MA-MOD-4X10G_1, are port IDs.
+func expandSwitchPortRange(expression string) ([]string, error) {
+	var ports []string
+	seen := map[string]bool{}
+	add := func(port string) error {
+		if seen[port] {
+			return fmt.Errorf("the port %s is listed twice", port)
+		}
+		seen[port] = true
+		ports = append(ports, port)
+		return nil
+	}
+	for _, item := range strings.Split(expression, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			return nil, fmt.Errorf("%q has an empty item", expression)
+		}
+		match := switchPortRangeRegexp.FindStringSubmatch(item)
+		if match == nil {
+			if err := add(item); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		first, _ := strconv.Atoi(match[1])
+		last, _ := strconv.Atoi(match[2])
+		if first > last {
+			return nil, fmt.Errorf("the range %s ends before it starts", item)
+		}
+		for port := first; port <= last; port++ {
+			if err := add(strconv.Itoa(port)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ports, nil
+}
+
+// TF Structs Schema
+type DevicesSwitchPortRangesRs struct {
+	OrganizationID          types.String                      `tfsdk:"organization_id"`
+	Serial                  types.String                      `tfsdk:"serial"`
+	PortIDs                 types.String                      `tfsdk:"port_ids"`
+	Ports                   types.List                        `tfsdk:"ports"`
+	OutOfSyncPortIDs        types.List                        `tfsdk:"out_of_sync_port_ids"`
+	AccessPolicyNumber      types.Int64                       `tfsdk:"access_policy_number"`
+	AccessPolicyType        types.String                      `tfsdk:"access_policy_type"`
+	AllowedVLANs            types.String                      `tfsdk:"allowed_vlans"`
+	DaiTrusted              types.Bool                        `tfsdk:"dai_trusted"`
+	Enabled                 types.Bool                        `tfsdk:"enabled"`
+	IsolationEnabled        types.Bool                        `tfsdk:"isolation_enabled"`
+	LinkNegotiation         types.String                      `tfsdk:"link_negotiation"`
+	Name                    types.String                      `tfsdk:"name"`
+	PoeEnabled              types.Bool                        `tfsdk:"poe_enabled"`
+	PortScheduleID          types.String                      `tfsdk:"port_schedule_id"`
+	Profile                 *DevicesSwitchPortRangesProfileRs `tfsdk:"profile"`
+	RstpEnabled             types.Bool                        `tfsdk:"rstp_enabled"`
+	StickyMacAllowList      types.List                        `tfsdk:"sticky_mac_allow_list"`
+	StickyMacAllowListLimit types.Int64                       `tfsdk:"sticky_mac_allow_list_limit"`
+	StormControlEnabled     types.Bool                        `tfsdk:"storm_control_enabled"`
+	StpGuard                types.String                      `tfsdk:"stp_guard"`
+	Tags                    types.List                        `tfsdk:"tags"`
+	Type                    types.String                      `tfsdk:"type"`
+	Udld                    types.String                      `tfsdk:"udld"`
+	VLAN                    types.Int64                       `tfsdk:"vlan"`
+	VoiceVLAN               types.Int64                       `tfsdk:"voice_vlan"`
+}
+
+type DevicesSwitchPortRangesProfileRs struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	ID      types.String `tfsdk:"id"`
+	Iname   types.String `tfsdk:"iname"`
+}
+
+// toSdkApiRequestUpdate returns the request that sets the configured settings, the settings
+// that are not configured are left out.
+func (r *DevicesSwitchPortRangesRs) toSdkApiRequestUpdate(ctx context.Context) *merakigosdk.RequestSwitchUpdateDeviceSwitchPort {
+	request := &merakigosdk.RequestSwitchUpdateDeviceSwitchPort{
+		AccessPolicyNumber:      int64ToIntPointer(r.AccessPolicyNumber.ValueInt64Pointer()),
+		AccessPolicyType:        r.AccessPolicyType.ValueString(),
+		AllowedVLANs:            r.AllowedVLANs.ValueString(),
+		DaiTrusted:              r.DaiTrusted.ValueBoolPointer(),
+		Enabled:                 r.Enabled.ValueBoolPointer(),
+		IsolationEnabled:        r.IsolationEnabled.ValueBoolPointer(),
+		LinkNegotiation:         r.LinkNegotiation.ValueString(),
+		Name:                    r.Name.ValueString(),
+		PoeEnabled:              r.PoeEnabled.ValueBoolPointer(),
+		PortScheduleID:          r.PortScheduleID.ValueString(),
+		RstpEnabled:             r.RstpEnabled.ValueBoolPointer(),
+		StickyMacAllowListLimit: int64ToIntPointer(r.StickyMacAllowListLimit.ValueInt64Pointer()),
+		StormControlEnabled:     r.StormControlEnabled.ValueBoolPointer(),
+		StpGuard:                r.StpGuard.ValueString(),
+		Type:                    r.Type.ValueString(),
+		Udld:                    r.Udld.ValueString(),
+		VLAN:                    int64ToIntPointer(r.VLAN.ValueInt64Pointer()),
+		VoiceVLAN:               int64ToIntPointer(r.VoiceVLAN.ValueInt64Pointer()),
+	}
+	if !r.StickyMacAllowList.IsNull() {
+		request.StickyMacAllowList = elementsToStrings(ctx, r.StickyMacAllowList)
+	}
+	if !r.Tags.IsNull() {
+		request.Tags = elementsToStrings(ctx, r.Tags)
+	}
+	if r.Profile != nil {
+		request.Profile = &merakigosdk.RequestSwitchUpdateDeviceSwitchPortProfile{
+			Enabled: r.Profile.Enabled.ValueBoolPointer(),
+			ID:      r.Profile.ID.ValueString(),
+			Iname:   r.Profile.Iname.ValueString(),
+		}
+	}
+	return request
+}
@@ -0,0 +1,238 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testSwitchPortRangesBySwitchPath = "/api/v1/organizations/O_1/switch/ports/bySwitch"
+
+func TestExpandSwitchPortRange(t *testing.T) {
+	cases := []struct {
+		expression string
+		want       []string
+		wantErr    bool
+	}{
+		{expression: "1-4,49", want: []string{"1", "2", "3", "4", "49"}},
+		{expression: " 7 , 1_MA-MOD-4X10G_1", want: []string{"7", "1_MA-MOD-4X10G_1"}},
+		{expression: "3-3", want: []string{"3"}},
+		{expression: "4-1", wantErr: true},
+		{expression: "1-4,2", wantErr: true},
+		{expression: "1,,2", wantErr: true},
+	}
+	for _, c := range cases {
+		got, err := expandSwitchPortRange(c.expression)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("expandSwitchPortRange(%q) = %v, want an error", c.expression, got)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, c.want) {
+			t.Errorf("expandSwitchPortRange(%q) = %v, %v, want %v", c.expression, got, err, c.want)
+		}
+	}
+}
+
+func TestSwitchPortInSync(t *testing.T) {
+	port := map[string]interface{}{"portId": "1", "vlan": float64(10), "profile": map[string]interface{}{"enabled": true}}
+	settings := map[string]interface{}{"vlan": float64(10), "accessPolicyNumber": float64(2), "profile": map[string]interface{}{"enabled": true, "id": "P_1"}}
+	if switchPortInSync(port, settings, nil) {
+		t.Error("a port without the settings of the read is in sync without a previous apply")
+	}
+	if !switchPortInSync(port, settings, settings) {
+		t.Error("a port with the settings of the previous apply is out of sync")
+	}
+	previous := map[string]interface{}{"vlan": float64(10), "accessPolicyNumber": float64(1), "profile": map[string]interface{}{"enabled": true, "id": "P_1"}}
+	if switchPortInSync(port, settings, previous) {
+		t.Error("a setting changed since the previous apply is in sync")
+	}
+	port["vlan"] = float64(20)
+	if switchPortInSync(port, settings, settings) {
+		t.Error("a port with another VLAN is in sync")
+	}
+}
+
+func testSwitchPortRanges(portIDs string, vlan int64) DevicesSwitchPortRangesRs {
+	return DevicesSwitchPortRangesRs{
+		OrganizationID:     types.StringValue("O_1"),
+		Serial:             types.StringValue("Q2SW-0001-0001"),
+		PortIDs:            types.StringValue(portIDs),
+		Ports:              types.ListUnknown(types.StringType),
+		OutOfSyncPortIDs:   types.ListUnknown(types.StringType),
+		PoeEnabled:         types.BoolValue(true),
+		StickyMacAllowList: types.ListNull(types.StringType),
+		Tags:               types.ListNull(types.StringType),
+		VLAN:               types.Int64Value(vlan),
+	}
+}
+
+func newTestSwitchPortRangesResource(t *testing.T, mock *merakiMock) *DevicesSwitchPortRangesResource {
+	t.Helper()
+	clients := newMerakiClientPool()
+	clients.defaultClient = mock.client(t)
+	return &DevicesSwitchPortRangesResource{clients: clients}
+}
+
+// createSwitchPortRanges runs Create with data as the plan and returns the new state.
+func createSwitchPortRanges(t *testing.T, r *DevicesSwitchPortRangesResource, data DevicesSwitchPortRangesRs) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	return resp.State
+}
+
+func TestDevicesSwitchPortRangesResource(t *testing.T) {
+	ctx := context.Background()
+	mock := newMerakiMock(t, "switch_port_ranges")
+	r := newTestSwitchPortRangesResource(t, mock)
+
+	// Only the port with another VLAN is updated.
+	state := createSwitchPortRanges(t, r, testSwitchPortRanges("1-4", 10))
+	var data DevicesSwitchPortRangesRs
+	if diags := state.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if ports := elementsToStrings(ctx, data.Ports); !reflect.DeepEqual(ports, []string{"1", "2", "3", "4"}) {
+		t.Errorf("ports = %v, want 1 to 4", ports)
+	}
+	for _, port := range []string{"1", "2", "3", "4"} {
+		requests := len(mock.requestsFor("PUT", "/api/v1/devices/Q2SW-0001-0001/switch/ports/"+port))
+		if want := map[bool]int{true: 1, false: 0}[port == "2"]; requests != want {
+			t.Errorf("PUT requests of port %s = %d, want %d", port, requests, want)
+		}
+	}
+	request, _ := mock.lastRequest("PUT", "/api/v1/devices/Q2SW-0001-0001/switch/ports/2")
+	if want := map[string]interface{}{"poeEnabled": true, "vlan": float64(10)}; !reflect.DeepEqual(request.Body, want) {
+		t.Errorf("PUT body = %v, want %v", request.Body, want)
+	}
+
+	// A port changed outside of Terraform is reported by the refresh.
+	mock.setBody(testSwitchPortRangesBySwitchPath, []interface{}{
+		map[string]interface{}{"serial": "Q2SW-0001-0001", "ports": []interface{}{
+			map[string]interface{}{"portId": "1", "poeEnabled": true, "vlan": 10},
+			map[string]interface{}{"portId": "2", "poeEnabled": true, "vlan": 10},
+			map[string]interface{}{"portId": "3", "poeEnabled": false, "vlan": 10},
+			map[string]interface{}{"portId": "4", "poeEnabled": true, "vlan": 10},
+		}},
+	})
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if outOfSync := elementsToStrings(ctx, data.OutOfSyncPortIDs); !reflect.DeepEqual(outOfSync, []string{"3"}) {
+		t.Errorf("out of sync ports = %v, want [3]", outOfSync)
+	}
+}
+
+func TestDevicesSwitchPortRangesUnknownPort(t *testing.T) {
+	ctx := context.Background()
+	mock := newMerakiMock(t, "switch_port_ranges")
+	r := newTestSwitchPortRangesResource(t, mock)
+	data := testSwitchPortRanges("1-5", 10)
+	if diags := r.apply(ctx, &data, nil); !diags.HasError() {
+		t.Fatal("apply succeeded with a port that the switch does not have")
+	}
+	if requests := len(mock.requestsFor("PUT", "/api/v1/devices/Q2SW-0001-0001/switch/ports/2")); requests != 0 {
+		t.Errorf("PUT requests = %d, want none", requests)
+	}
+}
+
+func TestDevicesSwitchPortRangesBatchWrites(t *testing.T) {
+	defer func(delay time.Duration) { actionBatchFlushDelay = delay }(actionBatchFlushDelay)
+	actionBatchFlushDelay = 10 * time.Millisecond
+
+	ctx := context.Background()
+	mock := newMerakiMock(t, "switch_port_ranges")
+	r := newTestSwitchPortRangesResource(t, mock)
+	r.batcher = newActionBatcher(r.clients, newTestOrganizationResolver(r.clients))
+	previous := testSwitchPortRanges("1-4", 10)
+	previous.Ports = switchPortList([]string{"1", "2", "3", "4"})
+	data := testSwitchPortRanges("1-4", 20)
+	if diags := r.apply(ctx, &data, &previous); diags.HasError() {
+		t.Fatal(diags)
+	}
+	batches := mock.requestsFor("POST", "/api/v1/organizations/O_1/actionBatches")
+	if len(batches) != 1 {
+		t.Fatalf("action batches = %d, want 1", len(batches))
+	}
+	body, _ := batches[0].Body.(map[string]interface{})
+	if actions, _ := body["actions"].([]interface{}); len(actions) != 3 {
+		t.Errorf("actions = %d, want the 3 ports without VLAN 20", len(actions))
+	}
+	if len(mock.requestsFor("PUT", "/api/v1/devices/Q2SW-0001-0001/switch/ports/1")) != 0 {
+		t.Error("a port was updated outside of the action batch")
+	}
+}
+
+func TestDevicesSwitchPortRangesImport(t *testing.T) {
+	ctx := context.Background()
+	mock := newMerakiMock(t, "switch_port_ranges")
+	r := newTestSwitchPortRangesResource(t, mock)
+	r.client = mock.client(t)
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "Q2SW-0001-0001,1-2,4"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: resp.State}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatal(readResp.Diagnostics)
+	}
+	var data DevicesSwitchPortRangesRs
+	if diags := readResp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if data.OrganizationID.ValueString() != "O_1" || data.Serial.ValueString() != "Q2SW-0001-0001" || data.PortIDs.ValueString() != "1-2,4" {
+		t.Errorf("imported %s, %s, %s, want O_1, Q2SW-0001-0001, 1-2,4", data.OrganizationID, data.Serial, data.PortIDs)
+	}
+	if ports := elementsToStrings(ctx, data.Ports); !reflect.DeepEqual(ports, []string{"1", "2", "4"}) {
+		t.Errorf("ports = %v, want [1 2 4]", ports)
+	}
+	if outOfSync := elementsToStrings(ctx, data.OutOfSyncPortIDs); len(outOfSync) != 0 {
+		t.Errorf("out of sync ports = %v, want none", outOfSync)
+	}
+}
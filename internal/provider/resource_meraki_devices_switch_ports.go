@@ -19,14 +19,12 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -42,8 +40,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesSwitchPortsResource{}
-	_ resource.ResourceWithConfigure = &DevicesSwitchPortsResource{}
+	_ resource.Resource                = &DevicesSwitchPortsResource{}
+	_ resource.ResourceWithConfigure   = &DevicesSwitchPortsResource{}
+	_ resource.ResourceWithImportState = &DevicesSwitchPortsResource{}
+	_ resource.ResourceWithIdentity    = &DevicesSwitchPortsResource{}
 )
 
 func NewDevicesSwitchPortsResource() resource.Resource {
@@ -51,15 +51,19 @@ func NewDevicesSwitchPortsResource() resource.Resource {
 }
 
 type DevicesSwitchPortsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	batcher        *actionBatcher
+	resetOnDestroy bool
 }
 
 func (r *DevicesSwitchPortsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.batcher = providerData.Batcher
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -276,6 +280,10 @@ func (r *DevicesSwitchPortsResource) Schema(_ context.Context, _ resource.Schema
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rstp_enabled": schema.BoolAttribute{
 				MarkdownDescription: `The rapid spanning tree protocol status.`,
 				Optional:            true,
@@ -444,25 +452,14 @@ func (r *DevicesSwitchPortsResource) Create(ctx context.Context, req resource.Cr
 
 	// UPDATE NO CREATE
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Switch.UpdateDeviceSwitchPort(vvSerial, vvPortID, dataRequest)
-	//Update
-	if err != nil || restyResp2 == nil || response == nil {
-		if restyResp2 != nil {
-			resp.Diagnostics.AddError(
-				"Failure when executing UpdateDeviceSwitchPort",
-				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
-			)
-			return
-		}
-		resp.Diagnostics.AddError(
-			"Failure when executing UpdateDeviceSwitchPort",
-			err.Error(),
-		)
+	resp.Diagnostics.Append(r.updateSwitchPort(ctx, vvSerial, vvPortID, dataRequest)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -505,19 +502,14 @@ func (r *DevicesSwitchPortsResource) Read(ctx context.Context, req resource.Read
 	//entro aqui 2
 	data = ResponseSwitchGetDeviceSwitchPortItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesSwitchPortsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "serial", "port_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: serial,portId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port_id"), idParts[1])...)
+func (r *DevicesSwitchPortsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial", "port_id")
 }
 
 func (r *DevicesSwitchPortsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -531,27 +523,84 @@ func (r *DevicesSwitchPortsResource) Update(ctx context.Context, req resource.Up
 	vvSerial := plan.Serial.ValueString()
 	vvPortID := plan.PortID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Switch.UpdateDeviceSwitchPort(vvSerial, vvPortID, dataRequest)
+	resp.Diagnostics.Append(r.updateSwitchPort(ctx, vvSerial, vvPortID, dataRequest)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+// updateSwitchPort updates the port, in an action batch of the organization of the switch when
+// meraki_batch_writes is set.
+func (r *DevicesSwitchPortsResource) updateSwitchPort(ctx context.Context, serial string, portID string, request *merakigosdk.RequestSwitchUpdateDeviceSwitchPort) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.batcher != nil {
+		err := r.batcher.RunForDevice(ctx, serial, actionBatchAction{
+			Resource:  "/devices/" + serial + "/switch/ports/" + portID,
+			Operation: "update",
+			Body:      request,
+		})
+		if err != nil {
+			diags.AddError(
+				"Failure when executing UpdateDeviceSwitchPort in an action batch",
+				err.Error(),
+			)
+		}
+		return diags
+	}
+	response, restyResp2, err := r.client.Switch.UpdateDeviceSwitchPort(serial, portID, request)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Failure when executing UpdateDeviceSwitchPort",
 				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
 			)
-			return
+			return diags
 		}
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Failure when executing UpdateDeviceSwitchPort",
 			err.Error(),
 		)
-		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	return diags
 }
 
 func (r *DevicesSwitchPortsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesSwitchPorts", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesSwitchPortsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesSwitchPorts", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	vvPortID := state.PortID.ValueString()
+	// Meraki defaults
+	defaults := DevicesSwitchPortsRs{
+		AccessPolicyType: types.StringValue("Open"),
+		AllowedVLANs:     types.StringValue("all"),
+		DaiTrusted:       types.BoolValue(false),
+		Enabled:          types.BoolValue(true),
+		IsolationEnabled: types.BoolValue(false),
+		LinkNegotiation:  types.StringValue("Auto negotiate"),
+		PoeEnabled:       types.BoolValue(true),
+		RstpEnabled:      types.BoolValue(true),
+		StpGuard:         types.StringValue("disabled"),
+		Type:             types.StringValue("trunk"),
+		Udld:             types.StringValue("Alert only"),
+		VLAN:             types.Int64Value(1),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	resp.Diagnostics.Append(r.updateSwitchPort(ctx, vvSerial, vvPortID, dataRequest)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -591,6 +640,7 @@ type DevicesSwitchPortsRs struct {
 	Udld                        types.String                                            `tfsdk:"udld"`
 	VLAN                        types.Int64                                             `tfsdk:"vlan"`
 	VoiceVLAN                   types.Int64                                             `tfsdk:"voice_vlan"`
+	ResetOnDestroy              types.Bool                                              `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetDeviceSwitchPortAdaptivePolicyGroupRs struct {
@@ -826,6 +876,16 @@ func (r *DevicesSwitchPortsRs) toSdkApiRequestUpdate(ctx context.Context) *merak
 
 // From gosdk to TF Structs Schema
 func ResponseSwitchGetDeviceSwitchPortItemToBodyRs(state DevicesSwitchPortsRs, response *merakigosdk.ResponseSwitchGetDeviceSwitchPort, is_read bool) DevicesSwitchPortsRs {
+	itemState := ResponseSwitchGetDeviceSwitchPortItemToRs(state, response)
+	if is_read {
+		return mergeInterfacesOnlyPath(state, itemState).(DevicesSwitchPortsRs)
+	}
+	return mergeInterfaces(state, itemState, true).(DevicesSwitchPortsRs)
+}
+
+// ResponseSwitchGetDeviceSwitchPortItemToRs converts the response of the API to the state of the resource,
+// without merging it with the current state.
+func ResponseSwitchGetDeviceSwitchPortItemToRs(state DevicesSwitchPortsRs, response *merakigosdk.ResponseSwitchGetDeviceSwitchPort) DevicesSwitchPortsRs {
 	itemState := DevicesSwitchPortsRs{
 		AccessPolicyNumber: func() types.Int64 {
 			if response.AccessPolicyNumber != nil {
@@ -1085,8 +1145,5 @@ func ResponseSwitchGetDeviceSwitchPortItemToBodyRs(state DevicesSwitchPortsRs, r
 			return types.Int64{}
 		}(),
 	}
-	if is_read {
-		return mergeInterfacesOnlyPath(state, itemState).(DevicesSwitchPortsRs)
-	}
-	return mergeInterfaces(state, itemState, true).(DevicesSwitchPortsRs)
+	return itemState
 }
@@ -60,6 +60,7 @@ func (r *DevicesSwitchPortsCycleResource) Metadata(_ context.Context, req resour
 // resourceAction
 func (r *DevicesSwitchPortsCycleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		DeprecationMessage: "Use the `meraki_device_switch_ports_cycle` action instead, which runs the operation on a lifecycle event or with `terraform apply -invoke` and keeps no state. This resource will be removed in the next major release.",
 		Attributes: map[string]schema.Attribute{
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `serial path parameter.`,
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +37,11 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesSwitchRoutingInterfacesResource{}
-	_ resource.ResourceWithConfigure = &DevicesSwitchRoutingInterfacesResource{}
+	_ resource.Resource                 = &DevicesSwitchRoutingInterfacesResource{}
+	_ resource.ResourceWithConfigure    = &DevicesSwitchRoutingInterfacesResource{}
+	_ resource.ResourceWithImportState  = &DevicesSwitchRoutingInterfacesResource{}
+	_ resource.ResourceWithIdentity     = &DevicesSwitchRoutingInterfacesResource{}
+	_ resource.ResourceWithUpgradeState = &DevicesSwitchRoutingInterfacesResource{}
 )
 
 func NewDevicesSwitchRoutingInterfacesResource() resource.Resource {
@@ -65,8 +65,14 @@ func (r *DevicesSwitchRoutingInterfacesResource) Metadata(_ context.Context, req
 	resp.TypeName = req.ProviderTypeName + "_devices_switch_routing_interfaces"
 }
 
+// UpgradeState upgrades the states written before the VLAN IDs were numbers.
+func (r *DevicesSwitchRoutingInterfacesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(1)
+}
+
 func (r *DevicesSwitchRoutingInterfacesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"default_gateway": schema.StringAttribute{
 				MarkdownDescription: `IPv4 default gateway`,
@@ -289,6 +295,7 @@ func (r *DevicesSwitchRoutingInterfacesResource) Create(ctx context.Context, req
 				data = ResponseSwitchGetDeviceSwitchRoutingInterfaceItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -344,6 +351,7 @@ func (r *DevicesSwitchRoutingInterfacesResource) Create(ctx context.Context, req
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseSwitchGetDeviceSwitchRoutingInterfaceItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -408,19 +416,14 @@ func (r *DevicesSwitchRoutingInterfacesResource) Read(ctx context.Context, req r
 	//entro aqui 2
 	data = ResponseSwitchGetDeviceSwitchRoutingInterfaceItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesSwitchRoutingInterfacesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "serial", "interface_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: serial,interfaceId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface_id"), idParts[1])...)
+func (r *DevicesSwitchRoutingInterfacesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial", "interface_id")
 }
 
 func (r *DevicesSwitchRoutingInterfacesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -450,6 +453,7 @@ func (r *DevicesSwitchRoutingInterfacesResource) Update(ctx context.Context, req
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesSwitchRoutingInterfacesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
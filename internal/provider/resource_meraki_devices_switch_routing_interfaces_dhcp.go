@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesSwitchRoutingInterfacesDhcpResource{}
-	_ resource.ResourceWithConfigure = &DevicesSwitchRoutingInterfacesDhcpResource{}
+	_ resource.Resource                = &DevicesSwitchRoutingInterfacesDhcpResource{}
+	_ resource.ResourceWithConfigure   = &DevicesSwitchRoutingInterfacesDhcpResource{}
+	_ resource.ResourceWithImportState = &DevicesSwitchRoutingInterfacesDhcpResource{}
+	_ resource.ResourceWithIdentity    = &DevicesSwitchRoutingInterfacesDhcpResource{}
 )
 
 func NewDevicesSwitchRoutingInterfacesDhcpResource() resource.Resource {
@@ -49,7 +48,8 @@ func NewDevicesSwitchRoutingInterfacesDhcpResource() resource.Resource {
 }
 
 type DevicesSwitchRoutingInterfacesDhcpResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesSwitchRoutingInterfacesDhcpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -58,6 +58,7 @@ func (r *DevicesSwitchRoutingInterfacesDhcpResource) Configure(ctx context.Conte
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -271,6 +272,10 @@ func (r *DevicesSwitchRoutingInterfacesDhcpResource) Schema(_ context.Context, _
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `serial path parameter.`,
 				Required:            true,
@@ -323,6 +328,7 @@ func (r *DevicesSwitchRoutingInterfacesDhcpResource) Create(ctx context.Context,
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -365,19 +371,14 @@ func (r *DevicesSwitchRoutingInterfacesDhcpResource) Read(ctx context.Context, r
 	//entro aqui 2
 	data = ResponseSwitchGetDeviceSwitchRoutingInterfaceDhcpItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesSwitchRoutingInterfacesDhcpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "serial", "interface_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: serial,interfaceId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface_id"), idParts[1])...)
+func (r *DevicesSwitchRoutingInterfacesDhcpResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial", "interface_id")
 }
 
 func (r *DevicesSwitchRoutingInterfacesDhcpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -407,11 +408,44 @@ func (r *DevicesSwitchRoutingInterfacesDhcpResource) Update(ctx context.Context,
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesSwitchRoutingInterfacesDhcpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesSwitchRoutingInterfacesDhcp", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesSwitchRoutingInterfacesDhcpRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesSwitchRoutingInterfacesDhcp", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	vvInterfaceID := state.InterfaceID.ValueString()
+	// Meraki defaults
+	defaults := DevicesSwitchRoutingInterfacesDhcpRs{
+		DhcpMode: types.StringValue("dhcpDisabled"),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Switch.UpdateDeviceSwitchRoutingInterfaceDhcp(vvSerial, vvInterfaceID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceSwitchRoutingInterfaceDhcp",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceSwitchRoutingInterfaceDhcp",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -430,6 +464,7 @@ type DevicesSwitchRoutingInterfacesDhcpRs struct {
 	DNSNameserversOption types.String                                                             `tfsdk:"dns_nameservers_option"`
 	FixedIPAssignments   *[]ResponseSwitchGetDeviceSwitchRoutingInterfaceDhcpFixedIpAssignmentsRs `tfsdk:"fixed_ip_assignments"`
 	ReservedIPRanges     *[]ResponseSwitchGetDeviceSwitchRoutingInterfaceDhcpReservedIpRangesRs   `tfsdk:"reserved_ip_ranges"`
+	ResetOnDestroy       types.Bool                                                               `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetDeviceSwitchRoutingInterfaceDhcpDhcpOptionsRs struct {
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -36,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesSwitchRoutingStaticRoutesResource{}
-	_ resource.ResourceWithConfigure = &DevicesSwitchRoutingStaticRoutesResource{}
+	_ resource.Resource                = &DevicesSwitchRoutingStaticRoutesResource{}
+	_ resource.ResourceWithConfigure   = &DevicesSwitchRoutingStaticRoutesResource{}
+	_ resource.ResourceWithImportState = &DevicesSwitchRoutingStaticRoutesResource{}
+	_ resource.ResourceWithIdentity    = &DevicesSwitchRoutingStaticRoutesResource{}
 )
 
 func NewDevicesSwitchRoutingStaticRoutesResource() resource.Resource {
@@ -181,6 +180,7 @@ func (r *DevicesSwitchRoutingStaticRoutesResource) Create(ctx context.Context, r
 				data = ResponseSwitchGetDeviceSwitchRoutingStaticRouteItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -236,6 +236,7 @@ func (r *DevicesSwitchRoutingStaticRoutesResource) Create(ctx context.Context, r
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseSwitchGetDeviceSwitchRoutingStaticRouteItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -300,19 +301,14 @@ func (r *DevicesSwitchRoutingStaticRoutesResource) Read(ctx context.Context, req
 	//entro aqui 2
 	data = ResponseSwitchGetDeviceSwitchRoutingStaticRouteItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesSwitchRoutingStaticRoutesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "serial", "static_route_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: serial,staticRouteId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("static_route_id"), idParts[1])...)
+func (r *DevicesSwitchRoutingStaticRoutesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial", "static_route_id")
 }
 
 func (r *DevicesSwitchRoutingStaticRoutesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -342,6 +338,7 @@ func (r *DevicesSwitchRoutingStaticRoutesResource) Update(ctx context.Context, r
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesSwitchRoutingStaticRoutesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
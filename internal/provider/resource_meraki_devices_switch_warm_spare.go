@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesSwitchWarmSpareResource{}
-	_ resource.ResourceWithConfigure = &DevicesSwitchWarmSpareResource{}
+	_ resource.Resource                = &DevicesSwitchWarmSpareResource{}
+	_ resource.ResourceWithConfigure   = &DevicesSwitchWarmSpareResource{}
+	_ resource.ResourceWithImportState = &DevicesSwitchWarmSpareResource{}
+	_ resource.ResourceWithIdentity    = &DevicesSwitchWarmSpareResource{}
 )
 
 func NewDevicesSwitchWarmSpareResource() resource.Resource {
@@ -43,7 +44,8 @@ func NewDevicesSwitchWarmSpareResource() resource.Resource {
 }
 
 type DevicesSwitchWarmSpareResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesSwitchWarmSpareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +54,7 @@ func (r *DevicesSwitchWarmSpareResource) Configure(ctx context.Context, req reso
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -73,6 +76,10 @@ func (r *DevicesSwitchWarmSpareResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: `Serial number of the primary switch`,
 				Computed:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `serial path parameter.`,
 				Required:            true,
@@ -131,6 +138,7 @@ func (r *DevicesSwitchWarmSpareResource) Create(ctx context.Context, req resourc
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -172,9 +180,14 @@ func (r *DevicesSwitchWarmSpareResource) Read(ctx context.Context, req resource.
 	//entro aqui 2
 	data = ResponseSwitchGetDeviceSwitchWarmSpareItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesSwitchWarmSpareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesSwitchWarmSpareResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesSwitchWarmSpareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -203,20 +216,53 @@ func (r *DevicesSwitchWarmSpareResource) Update(ctx context.Context, req resourc
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesSwitchWarmSpareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesSwitchWarmSpare", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesSwitchWarmSpareRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesSwitchWarmSpare", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	// Meraki defaults
+	defaults := DevicesSwitchWarmSpareRs{
+		Enabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Switch.UpdateDeviceSwitchWarmSpare(vvSerial, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceSwitchWarmSpare",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceSwitchWarmSpare",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type DevicesSwitchWarmSpareRs struct {
-	Serial        types.String `tfsdk:"serial"`
-	Enabled       types.Bool   `tfsdk:"enabled"`
-	PrimarySerial types.String `tfsdk:"primary_serial"`
-	SpareSerial   types.String `tfsdk:"spare_serial"`
+	Serial         types.String `tfsdk:"serial"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	PrimarySerial  types.String `tfsdk:"primary_serial"`
+	SpareSerial    types.String `tfsdk:"spare_serial"`
+	ResetOnDestroy types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
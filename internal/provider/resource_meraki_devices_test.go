@@ -0,0 +1,60 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDevicesResetOnDestroyWarns(t *testing.T) {
+	ctx := context.Background()
+	mock := newMerakiMock(t)
+	r := &DevicesResource{client: mock.client(t), resetOnDestroy: true}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &DevicesRs{
+		Serial: types.StringValue("Q234-ABCD-5678"),
+		Name:   types.StringValue("switch"),
+		Tags:   types.ListNull(types.StringType),
+	}); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "have no Meraki default to restore") {
+		t.Errorf("warnings = %v, want the settings without default", warnings)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("the device was not removed from the state")
+	}
+	if requests := len(mock.requestsFor("PUT", "/api/v1/devices/Q234-ABCD-5678")); requests != 0 {
+		t.Errorf("got %d updates of the device, want none", requests)
+	}
+}
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesWirelessBluetoothSettingsResource{}
-	_ resource.ResourceWithConfigure = &DevicesWirelessBluetoothSettingsResource{}
+	_ resource.Resource                = &DevicesWirelessBluetoothSettingsResource{}
+	_ resource.ResourceWithConfigure   = &DevicesWirelessBluetoothSettingsResource{}
+	_ resource.ResourceWithImportState = &DevicesWirelessBluetoothSettingsResource{}
+	_ resource.ResourceWithIdentity    = &DevicesWirelessBluetoothSettingsResource{}
 )
 
 func NewDevicesWirelessBluetoothSettingsResource() resource.Resource {
@@ -43,7 +44,8 @@ func NewDevicesWirelessBluetoothSettingsResource() resource.Resource {
 }
 
 type DevicesWirelessBluetoothSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesWirelessBluetoothSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +54,7 @@ func (r *DevicesWirelessBluetoothSettingsResource) Configure(ctx context.Context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -137,6 +140,7 @@ func (r *DevicesWirelessBluetoothSettingsResource) Create(ctx context.Context, r
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -178,9 +182,14 @@ func (r *DevicesWirelessBluetoothSettingsResource) Read(ctx context.Context, req
 	//entro aqui 2
 	data = ResponseWirelessGetDeviceWirelessBluetoothSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesWirelessBluetoothSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesWirelessBluetoothSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesWirelessBluetoothSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -209,9 +218,15 @@ func (r *DevicesWirelessBluetoothSettingsResource) Update(ctx context.Context, r
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesWirelessBluetoothSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of DevicesWirelessBluetoothSettings not reset", "meraki_reset_on_destroy is set, but the iBeacon identifiers of a wireless device have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting DevicesWirelessBluetoothSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
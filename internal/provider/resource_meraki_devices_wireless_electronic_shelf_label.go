@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesWirelessElectronicShelfLabelResource{}
-	_ resource.ResourceWithConfigure = &DevicesWirelessElectronicShelfLabelResource{}
+	_ resource.Resource                = &DevicesWirelessElectronicShelfLabelResource{}
+	_ resource.ResourceWithConfigure   = &DevicesWirelessElectronicShelfLabelResource{}
+	_ resource.ResourceWithImportState = &DevicesWirelessElectronicShelfLabelResource{}
+	_ resource.ResourceWithIdentity    = &DevicesWirelessElectronicShelfLabelResource{}
 )
 
 func NewDevicesWirelessElectronicShelfLabelResource() resource.Resource {
@@ -43,7 +44,8 @@ func NewDevicesWirelessElectronicShelfLabelResource() resource.Resource {
 }
 
 type DevicesWirelessElectronicShelfLabelResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesWirelessElectronicShelfLabelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +54,7 @@ func (r *DevicesWirelessElectronicShelfLabelResource) Configure(ctx context.Cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -92,6 +95,10 @@ func (r *DevicesWirelessElectronicShelfLabelResource) Schema(_ context.Context,
 				MarkdownDescription: `The service providing ESL functionality`,
 				Computed:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"serial": schema.StringAttribute{
 				MarkdownDescription: `The serial number of the device`,
 				Required:            true,
@@ -143,6 +150,7 @@ func (r *DevicesWirelessElectronicShelfLabelResource) Create(ctx context.Context
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -184,9 +192,14 @@ func (r *DevicesWirelessElectronicShelfLabelResource) Read(ctx context.Context,
 	//entro aqui 2
 	data = ResponseWirelessGetDeviceWirelessElectronicShelfLabelItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesWirelessElectronicShelfLabelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesWirelessElectronicShelfLabelResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesWirelessElectronicShelfLabelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -215,23 +228,56 @@ func (r *DevicesWirelessElectronicShelfLabelResource) Update(ctx context.Context
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesWirelessElectronicShelfLabelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting DevicesWirelessElectronicShelfLabel", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state DevicesWirelessElectronicShelfLabelRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting DevicesWirelessElectronicShelfLabel", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvSerial := state.Serial.ValueString()
+	// Meraki defaults
+	defaults := DevicesWirelessElectronicShelfLabelRs{
+		Enabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Wireless.UpdateDeviceWirelessElectronicShelfLabel(vvSerial, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateDeviceWirelessElectronicShelfLabel",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateDeviceWirelessElectronicShelfLabel",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type DevicesWirelessElectronicShelfLabelRs struct {
-	Serial    types.String `tfsdk:"serial"`
-	ApEslID   types.Int64  `tfsdk:"ap_esl_id"`
-	Channel   types.String `tfsdk:"channel"`
-	Enabled   types.Bool   `tfsdk:"enabled"`
-	Hostname  types.String `tfsdk:"hostname"`
-	NetworkID types.String `tfsdk:"network_id"`
-	Provider  types.String `tfsdk:"provider_r"`
+	Serial         types.String `tfsdk:"serial"`
+	ApEslID        types.Int64  `tfsdk:"ap_esl_id"`
+	Channel        types.String `tfsdk:"channel"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Hostname       types.String `tfsdk:"hostname"`
+	NetworkID      types.String `tfsdk:"network_id"`
+	Provider       types.String `tfsdk:"provider_r"`
+	ResetOnDestroy types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
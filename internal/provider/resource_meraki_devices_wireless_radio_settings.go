@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -35,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &DevicesWirelessRadioSettingsResource{}
-	_ resource.ResourceWithConfigure = &DevicesWirelessRadioSettingsResource{}
+	_ resource.Resource                = &DevicesWirelessRadioSettingsResource{}
+	_ resource.ResourceWithConfigure   = &DevicesWirelessRadioSettingsResource{}
+	_ resource.ResourceWithImportState = &DevicesWirelessRadioSettingsResource{}
+	_ resource.ResourceWithIdentity    = &DevicesWirelessRadioSettingsResource{}
 )
 
 func NewDevicesWirelessRadioSettingsResource() resource.Resource {
@@ -44,7 +45,8 @@ func NewDevicesWirelessRadioSettingsResource() resource.Resource {
 }
 
 type DevicesWirelessRadioSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *DevicesWirelessRadioSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +55,7 @@ func (r *DevicesWirelessRadioSettingsResource) Configure(ctx context.Context, re
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -179,6 +182,7 @@ func (r *DevicesWirelessRadioSettingsResource) Create(ctx context.Context, req r
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -220,9 +224,14 @@ func (r *DevicesWirelessRadioSettingsResource) Read(ctx context.Context, req res
 	//entro aqui 2
 	data = ResponseWirelessGetDeviceWirelessRadioSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *DevicesWirelessRadioSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+	importStateParts(ctx, req, resp, "serial")
+}
+
+func (r *DevicesWirelessRadioSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("serial")
 }
 
 func (r *DevicesWirelessRadioSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -251,9 +260,15 @@ func (r *DevicesWirelessRadioSettingsResource) Update(ctx context.Context, req r
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DevicesWirelessRadioSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of DevicesWirelessRadioSettings not reset", "meraki_reset_on_destroy is set, but the automatic channels and power of the wireless radios have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting DevicesWirelessRadioSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
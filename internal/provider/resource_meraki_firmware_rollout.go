@@ -0,0 +1,696 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// RESOURCE NORMAL
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &FirmwareRolloutResource{}
+	_ resource.ResourceWithConfigure = &FirmwareRolloutResource{}
+)
+
+func NewFirmwareRolloutResource() resource.Resource {
+	return &FirmwareRolloutResource{}
+}
+
+type FirmwareRolloutResource struct {
+	client  *merakigosdk.Client
+	clients *merakiClientPool
+}
+
+func (r *FirmwareRolloutResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(MerakiProviderData).Client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
+}
+
+// Metadata returns the data source type name.
+func (r *FirmwareRolloutResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firmware_rollout"
+}
+
+func (r *FirmwareRolloutResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rolls out a switch firmware upgrade to a network stage by stage, through a staged upgrade event. After every stage, the devices of the stage must have finished their upgrade and be online before the next stage starts. The devices of a stage are the devices assigned to its group and the devices that report an upgrade in the group. When devices of the stage are still offline or not reported after `offline_threshold`, or no device of the stage is reported at all, the rollout is paused or rolled back, according to `on_failure`, and the apply fails. The rollout runs during the apply and is bounded by the `create` timeout, 6 hours by default. Destroying the resource does not cancel or revert the upgrade.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: `ID of the network of the rollout`,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: `ID of the organization of the network, used to read the upgrade and the status of its devices`,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: `networkId path parameter. Network ID`,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product": schema.StringAttribute{
+				MarkdownDescription: `Product of the devices to upgrade
+                                        Allowed values: [switch,switchCatalyst]`,
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("switch"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"switch",
+						"switchCatalyst",
+					),
+				},
+			},
+			"to_version_id": schema.StringAttribute{
+				MarkdownDescription: `ID of the firmware version to upgrade to`,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"stages": schema.ListNestedAttribute{
+				MarkdownDescription: `The ordered stages of the rollout`,
+				Required:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							MarkdownDescription: `ID of the staged upgrade group of the stage`,
+							Required:            true,
+						},
+						"scheduled_for": schema.StringAttribute{
+							MarkdownDescription: `Start time of the stage (in ISO-8601 format). A stage with a start time starts then, whatever the health of the previous stages. When not set, the first stage starts at once and the other stages start when the previous stage has passed its health gate.`,
+							Optional:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: `Upgrade status of the stage`,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"started_at": schema.StringAttribute{
+							MarkdownDescription: `Start time of the stage`,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"completed_at": schema.StringAttribute{
+							MarkdownDescription: `Finish time of the stage`,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"offline_threshold": schema.StringAttribute{
+				MarkdownDescription: `How long the devices of a stage can stay offline or not upgraded after the stage has completed, as a duration like ` + "`15m`" + ` or ` + "`1h30m`" + `. Defaults to ` + "`15m`" + `.`,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("15m"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(firmwareRolloutDurationRegexp, "must be a duration like 15m or 1h30m"),
+				},
+			},
+			"on_failure": schema.StringAttribute{
+				MarkdownDescription: `What to do when a stage fails its health gate: ` + "`pause`" + ` postpones the pending stages by a week, ` + "`rollback`" + ` rolls back the stages already upgraded. Defaults to ` + "`pause`" + `.
+                                        Allowed values: [pause,rollback]`,
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("pause"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"pause",
+						"rollback",
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: `Status of the rollout: ` + "`completed`" + `, ` + "`in_progress`" + ` (the apply timed out), ` + "`paused`" + ` or ` + "`rolled_back`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"offline_devices": schema.ListAttribute{
+				MarkdownDescription: `Serials of the devices that failed the health gate of the last stage`,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FirmwareRolloutResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirmwareRolloutRs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := data.Timeouts.Create(ctx, firmwareRolloutDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	runCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	vvOrganizationID := data.OrganizationID.ValueString()
+	resp.Diagnostics.Append(runFirmwareRollout(runCtx, r.clients.Client(vvOrganizationID), &data)...)
+	// The state is saved even when the rollout failed, so that the status of the stages and
+	// the offline devices are shown. Terraform then replaces the resource on the next apply.
+	if data.ID.IsUnknown() {
+		return
+	}
+	data.nullUnknowns()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirmwareRolloutResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirmwareRolloutRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	vvNetworkID := data.NetworkID.ValueString()
+	response, restyResp, err := r.clients.Client(data.OrganizationID.ValueString()).Networks.GetNetworkFirmwareUpgradesStagedEvents(vvNetworkID)
+	// The staged event of a finished rollout may be gone, the last known status is kept.
+	if restyResp != nil && restyResp.StatusCode() == http.StatusNotFound {
+		return
+	}
+	if err != nil || response == nil {
+		if restyResp != nil {
+			err = errors.New(restyResp.String())
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing GetNetworkFirmwareUpgradesStagedEvents",
+			err.Error(),
+		)
+		return
+	}
+	data.refresh(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only changes the settings that are used by the next rollout, like on_failure.
+func (r *FirmwareRolloutResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FirmwareRolloutRs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.OfflineThreshold = plan.OfflineThreshold
+	state.OnFailure = plan.OnFailure
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FirmwareRolloutResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The firmware of the devices is not reverted, the rollout is only removed from the state.
+	resp.State.RemoveResource(ctx)
+}
+
+// TF Structs
+type FirmwareRolloutRs struct {
+	ID               types.String               `tfsdk:"id"`
+	OrganizationID   types.String               `tfsdk:"organization_id"`
+	NetworkID        types.String               `tfsdk:"network_id"`
+	Product          types.String               `tfsdk:"product"`
+	ToVersionID      types.String               `tfsdk:"to_version_id"`
+	Stages           *[]FirmwareRolloutStagesRs `tfsdk:"stages"`
+	OfflineThreshold types.String               `tfsdk:"offline_threshold"`
+	OnFailure        types.String               `tfsdk:"on_failure"`
+	Status           types.String               `tfsdk:"status"`
+	OfflineDevices   types.List                 `tfsdk:"offline_devices"`
+	Timeouts         timeouts.Value             `tfsdk:"timeouts"`
+}
+
+type FirmwareRolloutStagesRs struct {
+	GroupID      types.String `tfsdk:"group_id"`
+	ScheduledFor types.String `tfsdk:"scheduled_for"`
+	Status       types.String `tfsdk:"status"`
+	StartedAt    types.String `tfsdk:"started_at"`
+	CompletedAt  types.String `tfsdk:"completed_at"`
+}
+
+// firmwareRolloutDefaultTimeout bounds the whole rollout when the timeouts block does not
+// set one.
+const firmwareRolloutDefaultTimeout = 6 * time.Hour
+
+// firmwareRolloutGatedDelay is how far in the future a gated stage is scheduled until the
+// previous stage has passed its health gate. It only has to be later than the rollout.
+const firmwareRolloutGatedDelay = 7 * 24 * time.Hour
+
+var firmwareRolloutDurationRegexp = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
+// rolloutNow returns the current time, it is replaced by the tests.
+var rolloutNow = time.Now
+
+// Rollout statuses.
+const (
+	firmwareRolloutCompleted  = "completed"
+	firmwareRolloutInProgress = "in_progress"
+	firmwareRolloutPaused     = "paused"
+	firmwareRolloutRolledBack = "rolled_back"
+)
+
+// runFirmwareRollout creates the staged event of the rollout and waits for its stages one
+// after the other. After every stage, the devices of the stage must be upgraded and online
+// within the offline threshold, otherwise the rollout is paused or rolled back. The status
+// of the rollout and of its stages is set in data, also when an error is returned.
+func runFirmwareRollout(ctx context.Context, client *merakigosdk.Client, data *FirmwareRolloutRs) diag.Diagnostics {
+	var diags diag.Diagnostics
+	vvOrganizationID := data.OrganizationID.ValueString()
+	vvNetworkID := data.NetworkID.ValueString()
+	threshold, err := time.ParseDuration(data.OfflineThreshold.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("offline_threshold"), "Invalid offline threshold", err.Error())
+		return diags
+	}
+	stages := *data.Stages
+	schedule := data.schedule(rolloutNow())
+	response, restyResp, err := client.Networks.CreateNetworkFirmwareUpgradesStagedEvent(vvNetworkID, data.toSdkApiRequestCreate(schedule))
+	if err != nil || response == nil {
+		if restyResp != nil {
+			err = errors.New(restyResp.String())
+		}
+		diags.AddError(
+			"Failure when executing CreateNetworkFirmwareUpgradesStagedEvent",
+			err.Error(),
+		)
+		return diags
+	}
+	data.ID = types.StringValue(vvNetworkID)
+	data.Status = types.StringValue(firmwareRolloutInProgress)
+	data.OfflineDevices = types.ListValueMust(types.StringType, nil)
+	for i := range stages {
+		if data.Status.ValueString() != firmwareRolloutInProgress {
+			break
+		}
+		groupID := stages[i].GroupID.ValueString()
+		if i > 0 && stages[i].ScheduledFor.IsNull() {
+			schedule[i] = rolloutNow().UTC().Format(time.RFC3339)
+			_, restyResp, err := client.Networks.UpdateNetworkFirmwareUpgradesStagedEvents(vvNetworkID, firmwareRolloutUpdateRequest(stages, schedule))
+			if err != nil {
+				if restyResp != nil {
+					err = errors.New(restyResp.String())
+				}
+				diags.AddError(
+					"Failure when executing UpdateNetworkFirmwareUpgradesStagedEvents",
+					fmt.Sprintf("Starting stage %d (group %s): %s", i+1, groupID, err.Error()),
+				)
+				return diags
+			}
+		}
+		err := poll(ctx, func() (bool, error) {
+			response, restyResp, err := client.Networks.GetNetworkFirmwareUpgradesStagedEvents(vvNetworkID)
+			if err != nil || response == nil {
+				if restyResp != nil {
+					return false, fmt.Errorf("failure when executing GetNetworkFirmwareUpgradesStagedEvents: %s", restyResp.String())
+				}
+				return false, fmt.Errorf("failure when executing GetNetworkFirmwareUpgradesStagedEvents: %v", err)
+			}
+			data.refresh(response)
+			switch strings.ToLower((*data.Stages)[i].Status.ValueString()) {
+			case "completed":
+				return true, nil
+			case "canceled":
+				return false, fmt.Errorf("the stage was canceled")
+			}
+			return false, nil
+		})
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Failure when waiting for stage %d (group %s) of the firmware rollout", i+1, groupID),
+				err.Error(),
+			)
+			return diags
+		}
+		offline, err := waitForFirmwareRolloutGate(ctx, client, vvOrganizationID, vvNetworkID, groupID, threshold)
+		if err != nil && !errors.Is(err, errFirmwareRolloutNoDevices) {
+			diags.AddError(
+				fmt.Sprintf("Failure when checking the devices of stage %d (group %s) of the firmware rollout", i+1, groupID),
+				err.Error(),
+			)
+			return diags
+		}
+		if err == nil && len(offline) == 0 {
+			continue
+		}
+		reason := "Devices not upgraded or offline after the stage: " + strings.Join(offline, ", ")
+		detail := fmt.Sprintf("The devices %s of stage %d (group %s) are not upgraded and online after %s.",
+			strings.Join(offline, ", "), i+1, groupID, threshold)
+		if err != nil {
+			reason = "No device of the stage reported its upgrade"
+			detail = fmt.Sprintf("No device of stage %d (group %s) reported its upgrade within %s.", i+1, groupID, threshold)
+		}
+		offlineDevices, listDiags := types.ListValueFrom(ctx, types.StringType, offline)
+		diags.Append(listDiags...)
+		data.OfflineDevices = offlineDevices
+		diags.Append(stopFirmwareRollout(client, data, i, reason)...)
+		if diags.HasError() {
+			return diags
+		}
+		diags.AddError(
+			"Firmware rollout stopped",
+			fmt.Sprintf("%s The rollout is %s.", detail, strings.ReplaceAll(data.Status.ValueString(), "_", " ")),
+		)
+		return diags
+	}
+	data.Status = types.StringValue(firmwareRolloutCompleted)
+	return diags
+}
+
+// errFirmwareRolloutNoDevices is returned by waitForFirmwareRolloutGate when no device of the
+// group was reported within the threshold, so the health of the stage is unknown.
+var errFirmwareRolloutNoDevices = errors.New("no device of the staged upgrade group was reported")
+
+// waitForFirmwareRolloutGate waits for the devices of a staged upgrade group to be upgraded
+// and online, and returns the serials of the devices that are not after threshold. The devices
+// assigned to the group are pending until they are reported, since the upgrades of the
+// organization may not list them yet when the stage completes. A device whose upgrade failed
+// is returned at once.
+func waitForFirmwareRolloutGate(ctx context.Context, client *merakigosdk.Client, organizationID, networkID, groupID string, threshold time.Duration) ([]string, error) {
+	group, restyResp, err := client.Networks.GetNetworkFirmwareUpgradesStagedGroup(networkID, groupID)
+	if err != nil || group == nil {
+		if restyResp != nil {
+			return nil, fmt.Errorf("failure when executing GetNetworkFirmwareUpgradesStagedGroup: %s", restyResp.String())
+		}
+		return nil, fmt.Errorf("failure when executing GetNetworkFirmwareUpgradesStagedGroup: %v", err)
+	}
+	var assigned []string
+	if group.AssignedDevices != nil && group.AssignedDevices.Devices != nil {
+		for _, device := range *group.AssignedDevices.Devices {
+			assigned = append(assigned, device.Serial)
+		}
+	}
+
+	gateCtx, cancel := context.WithTimeout(ctx, threshold)
+	defer cancel()
+	var pending []string
+	err = poll(gateCtx, func() (bool, error) {
+		upgrades, restyResp, _, err := paginateList("", 0, func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationFirmwareUpgradesByDevice, *resty.Response, error) {
+			return client.Organizations.GetOrganizationFirmwareUpgradesByDevice(organizationID, &merakigosdk.GetOrganizationFirmwareUpgradesByDeviceQueryParams{
+				PerPage:       100,
+				StartingAfter: startingAfter,
+				NetworkIDs:    []string{networkID},
+			})
+		})
+		if err != nil || upgrades == nil {
+			if restyResp != nil {
+				return false, fmt.Errorf("failure when executing GetOrganizationFirmwareUpgradesByDevice: %s", restyResp.String())
+			}
+			return false, fmt.Errorf("failure when executing GetOrganizationFirmwareUpgradesByDevice: %v", err)
+		}
+		upgraded := map[string]bool{}
+		for _, serial := range assigned {
+			upgraded[serial] = false
+		}
+		var failed []string
+		for _, item := range *upgrades {
+			if item.Upgrade == nil || item.Upgrade.Staged == nil || item.Upgrade.Staged.Group == nil || item.Upgrade.Staged.Group.ID != groupID {
+				continue
+			}
+			switch strings.ToLower(item.Upgrade.Status) {
+			case "completed":
+				upgraded[item.Serial] = true
+			case "failed":
+				failed = append(failed, item.Serial)
+			default:
+				upgraded[item.Serial] = false
+			}
+		}
+		if len(failed) > 0 {
+			sort.Strings(failed)
+			pending = failed
+			return true, nil
+		}
+		if len(upgraded) == 0 {
+			pending = nil
+			return false, nil
+		}
+		serials := make([]string, 0, len(upgraded))
+		for serial := range upgraded {
+			serials = append(serials, serial)
+		}
+		sort.Strings(serials)
+		statuses, restyResp, _, err := paginateList("", 0, func(startingAfter string) (*merakigosdk.ResponseOrganizationsGetOrganizationDevicesStatuses, *resty.Response, error) {
+			return client.Organizations.GetOrganizationDevicesStatuses(organizationID, &merakigosdk.GetOrganizationDevicesStatusesQueryParams{
+				PerPage:       1000,
+				StartingAfter: startingAfter,
+				Serials:       serials,
+			})
+		})
+		if err != nil || statuses == nil {
+			if restyResp != nil {
+				return false, fmt.Errorf("failure when executing GetOrganizationDevicesStatuses: %s", restyResp.String())
+			}
+			return false, fmt.Errorf("failure when executing GetOrganizationDevicesStatuses: %v", err)
+		}
+		// A device that reports alerts is up, offline and dormant devices are not.
+		online := map[string]bool{}
+		for _, item := range *statuses {
+			online[item.Serial] = item.Status == "online" || item.Status == "alerting"
+		}
+		pending = pending[:0]
+		for _, serial := range serials {
+			if !upgraded[serial] || !online[serial] {
+				pending = append(pending, serial)
+			}
+		}
+		return len(pending) == 0, nil
+	})
+	if err != nil && gateCtx.Err() != nil && ctx.Err() == nil {
+		if pending == nil {
+			return nil, errFirmwareRolloutNoDevices
+		}
+		return pending, nil
+	}
+	return pending, err
+}
+
+// stopFirmwareRollout pauses or rolls back the rollout after the stage at index failed its
+// health gate for reason.
+func stopFirmwareRollout(client *merakigosdk.Client, data *FirmwareRolloutRs, index int, reason string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	vvNetworkID := data.NetworkID.ValueString()
+	if data.OnFailure.ValueString() == "rollback" {
+		request := &merakigosdk.RequestNetworksRollbacksNetworkFirmwareUpgradesStagedEvents{
+			Reasons: &[]merakigosdk.RequestNetworksRollbacksNetworkFirmwareUpgradesStagedEventsReasons{
+				{
+					Category: "stability",
+					Comment:  reason,
+				},
+			},
+		}
+		now := rolloutNow().UTC().Format(time.RFC3339)
+		var stages []merakigosdk.RequestNetworksRollbacksNetworkFirmwareUpgradesStagedEventsStages
+		for _, stage := range (*data.Stages)[:index+1] {
+			stages = append(stages, merakigosdk.RequestNetworksRollbacksNetworkFirmwareUpgradesStagedEventsStages{
+				Group:      &merakigosdk.RequestNetworksRollbacksNetworkFirmwareUpgradesStagedEventsStagesGroup{ID: stage.GroupID.ValueString()},
+				Milestones: &merakigosdk.RequestNetworksRollbacksNetworkFirmwareUpgradesStagedEventsStagesMilestones{ScheduledFor: now},
+			})
+		}
+		request.Stages = &stages
+		_, restyResp, err := client.Networks.RollbacksNetworkFirmwareUpgradesStagedEvents(vvNetworkID, request)
+		if err != nil {
+			if restyResp != nil {
+				err = errors.New(restyResp.String())
+			}
+			diags.AddError(
+				"Failure when executing RollbacksNetworkFirmwareUpgradesStagedEvents",
+				err.Error(),
+			)
+			return diags
+		}
+		data.Status = types.StringValue(firmwareRolloutRolledBack)
+		return diags
+	}
+	_, restyResp, err := client.Networks.DeferNetworkFirmwareUpgradesStagedEvents(vvNetworkID)
+	if err != nil {
+		if restyResp != nil {
+			err = errors.New(restyResp.String())
+		}
+		diags.AddError(
+			"Failure when executing DeferNetworkFirmwareUpgradesStagedEvents",
+			err.Error(),
+		)
+		return diags
+	}
+	data.Status = types.StringValue(firmwareRolloutPaused)
+	return diags
+}
+
+// schedule returns the start times of the stages: the configured time, now for the first
+// stage, and a time after the end of the rollout for the gated stages.
+func (r *FirmwareRolloutRs) schedule(now time.Time) []string {
+	schedule := make([]string, len(*r.Stages))
+	for i, stage := range *r.Stages {
+		switch {
+		case !stage.ScheduledFor.IsNull():
+			schedule[i] = stage.ScheduledFor.ValueString()
+		case i == 0:
+			schedule[i] = now.UTC().Format(time.RFC3339)
+		default:
+			schedule[i] = now.Add(firmwareRolloutGatedDelay).UTC().Format(time.RFC3339)
+		}
+	}
+	return schedule
+}
+
+func (r *FirmwareRolloutRs) toSdkApiRequestCreate(schedule []string) *merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEvent {
+	products := &merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEventProducts{}
+	if r.Product.ValueString() == "switchCatalyst" {
+		products.SwitchCatalyst = &merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEventProductsSwitchCatalyst{
+			NextUpgrade: &merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEventProductsSwitchCatalystNextUpgrade{
+				ToVersion: &merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEventProductsSwitchCatalystNextUpgradeToVersion{
+					ID: r.ToVersionID.ValueString(),
+				},
+			},
+		}
+	} else {
+		products.Switch = &merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEventProductsSwitch{
+			NextUpgrade: &merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEventProductsSwitchNextUpgrade{
+				ToVersion: &merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEventProductsSwitchNextUpgradeToVersion{
+					ID: r.ToVersionID.ValueString(),
+				},
+			},
+		}
+	}
+	var stages []merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEventStages
+	for i, stage := range *r.Stages {
+		stages = append(stages, merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEventStages{
+			Group:      &merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEventStagesGroup{ID: stage.GroupID.ValueString()},
+			Milestones: &merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEventStagesMilestones{ScheduledFor: schedule[i]},
+		})
+	}
+	return &merakigosdk.RequestNetworksCreateNetworkFirmwareUpgradesStagedEvent{
+		Products: products,
+		Stages:   &stages,
+	}
+}
+
+func firmwareRolloutUpdateRequest(stages []FirmwareRolloutStagesRs, schedule []string) *merakigosdk.RequestNetworksUpdateNetworkFirmwareUpgradesStagedEvents {
+	var items []merakigosdk.RequestNetworksUpdateNetworkFirmwareUpgradesStagedEventsStages
+	for i, stage := range stages {
+		items = append(items, merakigosdk.RequestNetworksUpdateNetworkFirmwareUpgradesStagedEventsStages{
+			Group:      &merakigosdk.RequestNetworksUpdateNetworkFirmwareUpgradesStagedEventsStagesGroup{ID: stage.GroupID.ValueString()},
+			Milestones: &merakigosdk.RequestNetworksUpdateNetworkFirmwareUpgradesStagedEventsStagesMilestones{ScheduledFor: schedule[i]},
+		})
+	}
+	return &merakigosdk.RequestNetworksUpdateNetworkFirmwareUpgradesStagedEvents{
+		Stages: &items,
+	}
+}
+
+// refresh sets the status and the milestones of the stages from the staged event of the
+// network. The stages are matched by group.
+func (r *FirmwareRolloutRs) refresh(response *merakigosdk.ResponseNetworksGetNetworkFirmwareUpgradesStagedEvents) {
+	if r.Stages == nil || response.Stages == nil {
+		return
+	}
+	for i := range *r.Stages {
+		stage := &(*r.Stages)[i]
+		for _, item := range *response.Stages {
+			if item.Group == nil || item.Group.ID != stage.GroupID.ValueString() {
+				continue
+			}
+			stage.Status = types.StringValue(item.Status)
+			if item.Milestones != nil {
+				stage.StartedAt = types.StringValue(item.Milestones.StartedAt)
+				stage.CompletedAt = types.StringValue(item.Milestones.CompletedAt)
+			}
+		}
+	}
+}
+
+// nullUnknowns sets the computed values that the rollout did not read, like the status of
+// the stages it did not reach, to null.
+func (r *FirmwareRolloutRs) nullUnknowns() {
+	if r.Status.IsUnknown() {
+		r.Status = types.StringNull()
+	}
+	if r.OfflineDevices.IsUnknown() {
+		r.OfflineDevices = types.ListNull(types.StringType)
+	}
+	for i := range *r.Stages {
+		stage := &(*r.Stages)[i]
+		if stage.Status.IsUnknown() {
+			stage.Status = types.StringNull()
+		}
+		if stage.StartedAt.IsUnknown() {
+			stage.StartedAt = types.StringNull()
+		}
+		if stage.CompletedAt.IsUnknown() {
+			stage.CompletedAt = types.StringNull()
+		}
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testStagedEventsPath = "/api/v1/networks/N_1/firmwareUpgrades/staged/events"
+
+// simulateStagedEvent completes the stages of the staged event of the mock once their start
+// time has passed, like the Meraki API does when the devices of a stage are upgraded.
+func simulateStagedEvent(t *testing.T, mock *merakiMock) {
+	t.Helper()
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+	go func() {
+		ticker := time.NewTicker(5 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			stored, ok := mock.body(testStagedEventsPath)
+			if !ok {
+				continue
+			}
+			// The stored body is copied, the mock serves it concurrently.
+			content, _ := json.Marshal(stored)
+			var event map[string]interface{}
+			if err := json.Unmarshal(content, &event); err != nil {
+				continue
+			}
+			stages, _ := event["stages"].([]interface{})
+			for _, item := range stages {
+				stage, _ := item.(map[string]interface{})
+				milestones, _ := stage["milestones"].(map[string]interface{})
+				scheduledFor, _ := milestones["scheduledFor"].(string)
+				start, err := time.Parse(time.RFC3339, scheduledFor)
+				if err != nil || start.After(time.Now()) || stage["status"] == "completed" {
+					continue
+				}
+				stage["status"] = "completed"
+				milestones["startedAt"] = scheduledFor
+				milestones["completedAt"] = time.Now().UTC().Format(time.RFC3339)
+			}
+			mock.setBody(testStagedEventsPath, event)
+		}
+	}()
+}
+
+func testFirmwareRollout(onFailure string) *FirmwareRolloutRs {
+	return &FirmwareRolloutRs{
+		ID:             types.StringUnknown(),
+		OrganizationID: types.StringValue("O_1"),
+		NetworkID:      types.StringValue("N_1"),
+		Product:        types.StringValue("switch"),
+		ToVersionID:    types.StringValue("2001"),
+		Stages: &[]FirmwareRolloutStagesRs{
+			{GroupID: types.StringValue("G_1"), ScheduledFor: types.StringNull(), Status: types.StringUnknown(), StartedAt: types.StringUnknown(), CompletedAt: types.StringUnknown()},
+			{GroupID: types.StringValue("G_2"), ScheduledFor: types.StringNull(), Status: types.StringUnknown(), StartedAt: types.StringUnknown(), CompletedAt: types.StringUnknown()},
+		},
+		OfflineThreshold: types.StringValue("100ms"),
+		OnFailure:        types.StringValue(onFailure),
+		Status:           types.StringUnknown(),
+		OfflineDevices:   types.ListUnknown(types.StringType),
+	}
+}
+
+func TestFirmwareRollout(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	mock := newMerakiMock(t, "firmware_rollout")
+	simulateStagedEvent(t, mock)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	data := testFirmwareRollout("pause")
+	if diags := runFirmwareRollout(ctx, mock.client(t), data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if data.Status.ValueString() != firmwareRolloutCompleted {
+		t.Errorf("status = %s, want %s", data.Status, firmwareRolloutCompleted)
+	}
+	for i, stage := range *data.Stages {
+		if stage.Status.ValueString() != "completed" || stage.CompletedAt.ValueString() == "" {
+			t.Errorf("stage %d = %+v, want completed", i+1, stage)
+		}
+	}
+	if len(data.OfflineDevices.Elements()) != 0 {
+		t.Errorf("offline devices = %s, want none", data.OfflineDevices)
+	}
+
+	// The second stage is gated: it is scheduled after the rollout, then started once the
+	// first stage has passed its health gate.
+	request, _ := mock.requestsFor("POST", testStagedEventsPath)[0].Body.(map[string]interface{})
+	stages, _ := request["stages"].([]interface{})
+	scheduledFor, _ := stages[1].(map[string]interface{})["milestones"].(map[string]interface{})["scheduledFor"].(string)
+	if start, err := time.Parse(time.RFC3339, scheduledFor); err != nil || start.Before(time.Now().Add(24*time.Hour)) {
+		t.Errorf("gated stage scheduled for %q, want a time after the rollout", scheduledFor)
+	}
+	if requests := len(mock.requestsFor("PUT", testStagedEventsPath)); requests != 1 {
+		t.Errorf("PUT requests = %d, want 1", requests)
+	}
+}
+
+func TestFirmwareRolloutPause(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	mock := newMerakiMock(t, "firmware_rollout")
+	mock.setBody("/api/v1/organizations/O_1/devices/statuses", []interface{}{
+		map[string]interface{}{"serial": "Q2AA-0001-0001", "status": "offline"},
+		map[string]interface{}{"serial": "Q2AA-0002-0002", "status": "online"},
+	})
+	simulateStagedEvent(t, mock)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	data := testFirmwareRollout("pause")
+	diags := runFirmwareRollout(ctx, mock.client(t), data)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "Q2AA-0001-0001") {
+		t.Fatalf("diagnostics = %v, want the offline device", diags)
+	}
+	if data.Status.ValueString() != firmwareRolloutPaused {
+		t.Errorf("status = %s, want %s", data.Status, firmwareRolloutPaused)
+	}
+	if devices := data.OfflineDevices.String(); devices != `["Q2AA-0001-0001"]` {
+		t.Errorf("offline devices = %s, want [\"Q2AA-0001-0001\"]", devices)
+	}
+	if requests := len(mock.requestsFor("POST", testStagedEventsPath+"/defer")); requests != 1 {
+		t.Errorf("defer requests = %d, want 1", requests)
+	}
+	if requests := len(mock.requestsFor("PUT", testStagedEventsPath)); requests != 0 {
+		t.Errorf("PUT requests = %d, the second stage was started", requests)
+	}
+}
+
+func TestFirmwareRolloutRollback(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	mock := newMerakiMock(t, "firmware_rollout")
+	mock.setBody("/api/v1/organizations/O_1/firmware/upgrades/byDevice", []interface{}{
+		map[string]interface{}{"serial": "Q2AA-0001-0001", "upgrade": map[string]interface{}{"status": "Completed", "staged": map[string]interface{}{"group": map[string]interface{}{"id": "G_1"}}}},
+		map[string]interface{}{"serial": "Q2AA-0002-0002", "upgrade": map[string]interface{}{"status": "Failed", "staged": map[string]interface{}{"group": map[string]interface{}{"id": "G_2"}}}},
+	})
+	simulateStagedEvent(t, mock)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	data := testFirmwareRollout("rollback")
+	if diags := runFirmwareRollout(ctx, mock.client(t), data); !diags.HasError() {
+		t.Fatal("the rollout succeeded with a failed upgrade")
+	}
+	if data.Status.ValueString() != firmwareRolloutRolledBack {
+		t.Errorf("status = %s, want %s", data.Status, firmwareRolloutRolledBack)
+	}
+	request, ok := mock.lastRequest("POST", testStagedEventsPath+"/rollbacks")
+	if !ok {
+		t.Fatal("the rollout was not rolled back")
+	}
+	body, _ := request.Body.(map[string]interface{})
+	if stages, _ := body["stages"].([]interface{}); len(stages) != 2 {
+		t.Errorf("rolled back stages = %v, want both stages", body["stages"])
+	}
+	reasons, _ := body["reasons"].([]interface{})
+	if len(reasons) != 1 || !strings.Contains(reasons[0].(map[string]interface{})["comment"].(string), "Q2AA-0002-0002") {
+		t.Errorf("reasons = %v, want the failed device", reasons)
+	}
+}
+
+func TestFirmwareRolloutDeviceNotReported(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	mock := newMerakiMock(t, "firmware_rollout")
+	// The upgrades of the organization do not list the device of the second stage yet.
+	mock.setBody("/api/v1/organizations/O_1/firmware/upgrades/byDevice", []interface{}{
+		map[string]interface{}{"serial": "Q2AA-0001-0001", "upgrade": map[string]interface{}{"status": "Completed", "staged": map[string]interface{}{"group": map[string]interface{}{"id": "G_1"}}}},
+	})
+	simulateStagedEvent(t, mock)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	data := testFirmwareRollout("pause")
+	diags := runFirmwareRollout(ctx, mock.client(t), data)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "Q2AA-0002-0002") {
+		t.Fatalf("diagnostics = %v, want the device that was not reported", diags)
+	}
+	if data.Status.ValueString() != firmwareRolloutPaused {
+		t.Errorf("status = %s, want %s", data.Status, firmwareRolloutPaused)
+	}
+	if devices := data.OfflineDevices.String(); devices != `["Q2AA-0002-0002"]` {
+		t.Errorf("offline devices = %s, want [\"Q2AA-0002-0002\"]", devices)
+	}
+}
+
+func TestFirmwareRolloutNoDevicesReported(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	mock := newMerakiMock(t, "firmware_rollout")
+	mock.setBody("/api/v1/networks/N_1/firmwareUpgrades/staged/groups/G_1", map[string]interface{}{"groupId": "G_1"})
+	mock.setBody("/api/v1/organizations/O_1/firmware/upgrades/byDevice", []interface{}{})
+	simulateStagedEvent(t, mock)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	data := testFirmwareRollout("pause")
+	diags := runFirmwareRollout(ctx, mock.client(t), data)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "No device of stage 1") {
+		t.Fatalf("diagnostics = %v, want the stage without devices", diags)
+	}
+	if data.Status.ValueString() != firmwareRolloutPaused {
+		t.Errorf("status = %s, want %s", data.Status, firmwareRolloutPaused)
+	}
+	if requests := len(mock.requestsFor("PUT", testStagedEventsPath)); requests != 0 {
+		t.Errorf("PUT requests = %d, the second stage was started", requests)
+	}
+}
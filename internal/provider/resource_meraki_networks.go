@@ -24,7 +24,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -36,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksResource{}
-	_ resource.ResourceWithConfigure = &NetworksResource{}
+	_ resource.Resource                = &NetworksResource{}
+	_ resource.ResourceWithConfigure   = &NetworksResource{}
+	_ resource.ResourceWithImportState = &NetworksResource{}
+	_ resource.ResourceWithIdentity    = &NetworksResource{}
 )
 
 func NewNetworksResource() resource.Resource {
@@ -45,7 +46,8 @@ func NewNetworksResource() resource.Resource {
 }
 
 type NetworksResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *NetworksResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -54,6 +56,7 @@ func (r *NetworksResource) Configure(ctx context.Context, req resource.Configure
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -177,7 +180,7 @@ func (r *NetworksResource) Create(ctx context.Context, req resource.CreateReques
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvName := data.Name.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Organizations.GetOrganizationNetworks(vvOrganizationID, &merakigosdk.GetOrganizationNetworksQueryParams{
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationNetworks(vvOrganizationID, &merakigosdk.GetOrganizationNetworksQueryParams{
 		PerPage: -1,
 	})
 	//Has Post
@@ -206,20 +209,21 @@ func (r *NetworksResource) Create(ctx context.Context, req resource.CreateReques
 				)
 				return
 			}
-			r.client.Networks.UpdateNetwork(vvNetworkID, data.toSdkApiRequestUpdate(ctx))
+			r.clients.Client(vvOrganizationID).Networks.UpdateNetwork(vvNetworkID, data.toSdkApiRequestUpdate(ctx))
 
-			responseVerifyItem2, _, _ := r.client.Networks.GetNetwork(vvNetworkID)
+			responseVerifyItem2, _, _ := r.clients.Client(vvOrganizationID).Networks.GetNetwork(vvNetworkID)
 			if responseVerifyItem2 != nil {
 				data = ResponseNetworksGetNetworkItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Organizations.CreateOrganizationNetwork(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationNetwork(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -247,6 +251,7 @@ func (r *NetworksResource) Create(ctx context.Context, req resource.CreateReques
 
 	data = ResponseNetworksGetNetworkItemToBodyRs(data, responseGet, false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -272,7 +277,8 @@ func (r *NetworksResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// Has Item2
 
 	vvNetworkID := data.ID.ValueString()
-	responseGet, restyRespGet, err := r.client.Networks.GetNetwork(vvNetworkID)
+	vvOrganizationID := data.OrganizationID.ValueString()
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Networks.GetNetwork(vvNetworkID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -300,10 +306,15 @@ func (r *NetworksResource) Read(ctx context.Context, req resource.ReadRequest, r
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	importStateParts(ctx, req, resp, "id")
+}
+
+func (r *NetworksResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("id")
 }
 
 func (r *NetworksResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -318,8 +329,9 @@ func (r *NetworksResource) Update(ctx context.Context, req resource.UpdateReques
 
 	//Path Params
 	vvNetworkID := data.ID.ValueString()
+	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Networks.UpdateNetwork(vvNetworkID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Networks.UpdateNetwork(vvNetworkID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -337,6 +349,7 @@ func (r *NetworksResource) Update(ctx context.Context, req resource.UpdateReques
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -357,7 +370,8 @@ func (r *NetworksResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	vvNetworkID := state.ID.ValueString()
-	_, err := r.client.Networks.DeleteNetwork(vvNetworkID)
+	vvOrganizationID := state.OrganizationID.ValueString()
+	_, err := r.clients.Client(vvOrganizationID).Networks.DeleteNetwork(vvNetworkID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteNetwork", err.Error())
@@ -465,6 +479,16 @@ func (r *NetworksRs) toSdkApiRequestUpdate(ctx context.Context) *merakigosdk.Req
 
 // From gosdk to TF Structs Schema
 func ResponseNetworksGetNetworkItemToBodyRs(state NetworksRs, response *merakigosdk.ResponseNetworksGetNetwork, is_read bool) NetworksRs {
+	itemState := ResponseNetworksGetNetworkItemToRs(state, response)
+	if is_read {
+		return mergeInterfacesOnlyPath(state, itemState).(NetworksRs)
+	}
+	return mergeInterfaces(state, itemState, true).(NetworksRs)
+}
+
+// ResponseNetworksGetNetworkItemToRs converts the response of the API to the state of the resource,
+// without merging it with the current state.
+func ResponseNetworksGetNetworkItemToRs(state NetworksRs, response *merakigosdk.ResponseNetworksGetNetwork) NetworksRs {
 	itemState := NetworksRs{
 		EnrollmentString: func() types.String {
 			if response.EnrollmentString != "" {
@@ -519,8 +543,5 @@ func ResponseNetworksGetNetworkItemToBodyRs(state NetworksRs, response *merakigo
 		CopyFromNetworkID: state.CopyFromNetworkID,
 	}
 	itemState.NetworkID = types.StringValue(response.ID)
-	if is_read {
-		return mergeInterfacesOnlyPath(state, itemState).(NetworksRs)
-	}
-	return mergeInterfaces(state, itemState, true).(NetworksRs)
+	return itemState
 }
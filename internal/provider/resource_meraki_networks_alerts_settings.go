@@ -26,7 +26,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -42,8 +41,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksAlertsSettingsResource{}
-	_ resource.ResourceWithConfigure = &NetworksAlertsSettingsResource{}
+	_ resource.Resource                = &NetworksAlertsSettingsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksAlertsSettingsResource{}
+	_ resource.ResourceWithImportState = &NetworksAlertsSettingsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksAlertsSettingsResource{}
 )
 
 func NewNetworksAlertsSettingsResource() resource.Resource {
@@ -51,7 +52,8 @@ func NewNetworksAlertsSettingsResource() resource.Resource {
 }
 
 type NetworksAlertsSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksAlertsSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -60,6 +62,7 @@ func (r *NetworksAlertsSettingsResource) Configure(ctx context.Context, req reso
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -377,6 +380,10 @@ func (r *NetworksAlertsSettingsResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -424,6 +431,7 @@ func (r *NetworksAlertsSettingsResource) Create(ctx context.Context, req resourc
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -465,9 +473,14 @@ func (r *NetworksAlertsSettingsResource) Read(ctx context.Context, req resource.
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkAlertsSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksAlertsSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksAlertsSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksAlertsSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -496,11 +509,51 @@ func (r *NetworksAlertsSettingsResource) Update(ctx context.Context, req resourc
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksAlertsSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksAlertsSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksAlertsSettingsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksAlertsSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksAlertsSettingsRs{
+		DefaultDestinations: &ResponseNetworksGetNetworkAlertsSettingsDefaultDestinationsRs{
+			AllAdmins: types.BoolValue(false),
+			SNMP:      types.BoolValue(false),
+		},
+		Muting: &ResponseNetworksGetNetworkAlertsSettingsMutingRs{
+			ByPortSchedules: &ResponseNetworksGetNetworkAlertsSettingsMutingByPortSchedulesRs{
+				Enabled: types.BoolValue(false),
+			},
+		},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Networks.UpdateNetworkAlertsSettings(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkAlertsSettings",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkAlertsSettings",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -510,6 +563,7 @@ type NetworksAlertsSettingsRs struct {
 	Alerts              *[]ResponseNetworksGetNetworkAlertsSettingsAlertsRs            `tfsdk:"alerts"`
 	DefaultDestinations *ResponseNetworksGetNetworkAlertsSettingsDefaultDestinationsRs `tfsdk:"default_destinations"`
 	Muting              *ResponseNetworksGetNetworkAlertsSettingsMutingRs              `tfsdk:"muting"`
+	ResetOnDestroy      types.Bool                                                     `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseNetworksGetNetworkAlertsSettingsAlertsRs struct {
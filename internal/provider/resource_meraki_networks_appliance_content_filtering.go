@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceContentFilteringResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceContentFilteringResource{}
+	_ resource.Resource                = &NetworksApplianceContentFilteringResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceContentFilteringResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceContentFilteringResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceContentFilteringResource{}
 )
 
 func NewNetworksApplianceContentFilteringResource() resource.Resource {
@@ -46,7 +47,8 @@ func NewNetworksApplianceContentFilteringResource() resource.Resource {
 }
 
 type NetworksApplianceContentFilteringResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceContentFilteringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +57,7 @@ func (r *NetworksApplianceContentFilteringResource) Configure(ctx context.Contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -128,6 +131,10 @@ func (r *NetworksApplianceContentFilteringResource) Schema(_ context.Context, _
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"url_category_list_size": schema.StringAttribute{
 				MarkdownDescription: `URL category list size which is either 'topSites' or 'fullList'
                                   Allowed values: [fullList,topSites]`,
@@ -231,6 +238,7 @@ func (r *NetworksApplianceContentFilteringResource) Create(ctx context.Context,
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -284,9 +292,14 @@ func (r *NetworksApplianceContentFilteringResource) Read(ctx context.Context, re
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceContentFilteringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceContentFilteringResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceContentFilteringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -320,11 +333,43 @@ func (r *NetworksApplianceContentFilteringResource) Update(ctx context.Context,
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceContentFilteringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceContentFiltering", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceContentFilteringRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceContentFiltering", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceContentFilteringRs{
+		URLCategoryListSize: types.StringValue("topSites"),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Appliance.UpdateNetworkApplianceContentFiltering(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceContentFiltering",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceContentFiltering",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -336,6 +381,7 @@ type NetworksApplianceContentFilteringRs struct {
 	BlockedURLCategoriesRs types.Set                                                                     `tfsdk:"blocked_url_categories"`
 	BlockedURLPatterns     types.Set                                                                     `tfsdk:"blocked_url_patterns"`
 	URLCategoryListSize    types.String                                                                  `tfsdk:"url_category_list_size"`
+	ResetOnDestroy         types.Bool                                                                    `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceContentFilteringBlockedUrlCategoriesRs struct {
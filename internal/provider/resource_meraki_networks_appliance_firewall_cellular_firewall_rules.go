@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceFirewallCellularFirewallRulesResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceFirewallCellularFirewallRulesResource{}
+	_ resource.Resource                = &NetworksApplianceFirewallCellularFirewallRulesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceFirewallCellularFirewallRulesResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceFirewallCellularFirewallRulesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceFirewallCellularFirewallRulesResource{}
 )
 
 func NewNetworksApplianceFirewallCellularFirewallRulesResource() resource.Resource {
@@ -46,15 +47,17 @@ func NewNetworksApplianceFirewallCellularFirewallRulesResource() resource.Resour
 }
 
 type NetworksApplianceFirewallCellularFirewallRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceFirewallCellularFirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -69,6 +72,10 @@ func (r *NetworksApplianceFirewallCellularFirewallRulesResource) Schema(_ contex
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `An ordered array of the firewall rules (not including the default rule)`,
 				Optional:            true,
@@ -201,6 +208,7 @@ func (r *NetworksApplianceFirewallCellularFirewallRulesResource) Create(ctx cont
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -242,9 +250,14 @@ func (r *NetworksApplianceFirewallCellularFirewallRulesResource) Read(ctx contex
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceFirewallCellularFirewallRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceFirewallCellularFirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceFirewallCellularFirewallRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceFirewallCellularFirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -273,18 +286,51 @@ func (r *NetworksApplianceFirewallCellularFirewallRulesResource) Update(ctx cont
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceFirewallCellularFirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallCellularFirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceFirewallCellularFirewallRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallCellularFirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceFirewallCellularFirewallRulesRs{
+		Rules: &[]ResponseApplianceGetNetworkApplianceFirewallCellularFirewallRulesRulesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Appliance.UpdateNetworkApplianceFirewallCellularFirewallRules(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceFirewallCellularFirewallRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceFirewallCellularFirewallRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksApplianceFirewallCellularFirewallRulesRs struct {
-	NetworkID types.String                                                                `tfsdk:"network_id"`
-	Rules     *[]ResponseApplianceGetNetworkApplianceFirewallCellularFirewallRulesRulesRs `tfsdk:"rules"`
+	NetworkID      types.String                                                                `tfsdk:"network_id"`
+	Rules          *[]ResponseApplianceGetNetworkApplianceFirewallCellularFirewallRulesRulesRs `tfsdk:"rules"`
+	ResetOnDestroy types.Bool                                                                  `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceFirewallCellularFirewallRulesRulesRs struct {
@@ -333,6 +379,9 @@ func (r *NetworksApplianceFirewallCellularFirewallRulesRs) toSdkApiRequestUpdate
 	out := merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallCellularFirewallRules{
 		Rules: func() *[]merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallCellularFirewallRulesRules {
 			if len(requestApplianceUpdateNetworkApplianceFirewallCellularFirewallRulesRules) > 0 || r.Rules != nil {
+				if len(requestApplianceUpdateNetworkApplianceFirewallCellularFirewallRulesRules) == 0 {
+					requestApplianceUpdateNetworkApplianceFirewallCellularFirewallRulesRules = make([]merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallCellularFirewallRulesRules, 0)
+				}
 				return &requestApplianceUpdateNetworkApplianceFirewallCellularFirewallRulesRules
 			}
 			return nil
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
@@ -39,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceFirewallFirewalledServicesResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceFirewallFirewalledServicesResource{}
+	_ resource.Resource                = &NetworksApplianceFirewallFirewalledServicesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceFirewallFirewalledServicesResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceFirewallFirewalledServicesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceFirewallFirewalledServicesResource{}
 )
 
 func NewNetworksApplianceFirewallFirewalledServicesResource() resource.Resource {
@@ -48,7 +47,8 @@ func NewNetworksApplianceFirewallFirewalledServicesResource() resource.Resource
 }
 
 type NetworksApplianceFirewallFirewalledServicesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceFirewallFirewalledServicesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,6 +57,7 @@ func (r *NetworksApplianceFirewallFirewalledServicesResource) Configure(ctx cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -97,6 +98,10 @@ func (r *NetworksApplianceFirewallFirewalledServicesResource) Schema(_ context.C
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"service": schema.StringAttribute{
 				MarkdownDescription: `Appliance service name`,
 				Required:            true,
@@ -149,6 +154,7 @@ func (r *NetworksApplianceFirewallFirewalledServicesResource) Create(ctx context
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -191,19 +197,14 @@ func (r *NetworksApplianceFirewallFirewalledServicesResource) Read(ctx context.C
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceFirewallFirewalledServiceItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceFirewallFirewalledServicesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "service")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,service. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), idParts[1])...)
+func (r *NetworksApplianceFirewallFirewalledServicesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "service")
 }
 
 func (r *NetworksApplianceFirewallFirewalledServicesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -233,20 +234,58 @@ func (r *NetworksApplianceFirewallFirewalledServicesResource) Update(ctx context
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceFirewallFirewalledServicesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallFirewalledServices", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceFirewallFirewalledServicesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallFirewalledServices", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvService := state.Service.ValueString()
+	access := "blocked"
+	if vvService == "ICMP" {
+		access = "unrestricted"
+	}
+	// Meraki defaults
+	defaults := NetworksApplianceFirewallFirewalledServicesRs{
+		Access: types.StringValue(access),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceFirewallFirewalledService(vvNetworkID, vvService, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceFirewallFirewalledService",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceFirewallFirewalledService",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksApplianceFirewallFirewalledServicesRs struct {
-	NetworkID  types.String `tfsdk:"network_id"`
-	Service    types.String `tfsdk:"service"`
-	Access     types.String `tfsdk:"access"`
-	AllowedIPs types.List   `tfsdk:"allowed_ips"`
+	NetworkID      types.String `tfsdk:"network_id"`
+	Service        types.String `tfsdk:"service"`
+	Access         types.String `tfsdk:"access"`
+	AllowedIPs     types.List   `tfsdk:"allowed_ips"`
+	ResetOnDestroy types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
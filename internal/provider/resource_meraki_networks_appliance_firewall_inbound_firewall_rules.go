@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceFirewallInboundFirewallRulesResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceFirewallInboundFirewallRulesResource{}
+	_ resource.Resource                = &NetworksApplianceFirewallInboundFirewallRulesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceFirewallInboundFirewallRulesResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceFirewallInboundFirewallRulesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceFirewallInboundFirewallRulesResource{}
 )
 
 func NewNetworksApplianceFirewallInboundFirewallRulesResource() resource.Resource {
@@ -46,15 +47,17 @@ func NewNetworksApplianceFirewallInboundFirewallRulesResource() resource.Resourc
 }
 
 type NetworksApplianceFirewallInboundFirewallRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceFirewallInboundFirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -69,6 +72,10 @@ func (r *NetworksApplianceFirewallInboundFirewallRulesResource) Schema(_ context
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `An ordered array of the firewall rules (not including the default rule)`,
 				Optional:            true,
@@ -208,6 +215,7 @@ func (r *NetworksApplianceFirewallInboundFirewallRulesResource) Create(ctx conte
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -249,9 +257,14 @@ func (r *NetworksApplianceFirewallInboundFirewallRulesResource) Read(ctx context
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceFirewallInboundFirewallRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceFirewallInboundFirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceFirewallInboundFirewallRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceFirewallInboundFirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -280,11 +293,44 @@ func (r *NetworksApplianceFirewallInboundFirewallRulesResource) Update(ctx conte
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceFirewallInboundFirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallInboundFirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceFirewallInboundFirewallRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallInboundFirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceFirewallInboundFirewallRulesRs{
+		Rules:             &[]ResponseApplianceGetNetworkApplianceFirewallInboundFirewallRulesRulesRs{},
+		SyslogDefaultRule: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceFirewallInboundFirewallRules(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceFirewallInboundFirewallRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceFirewallInboundFirewallRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -293,6 +339,7 @@ type NetworksApplianceFirewallInboundFirewallRulesRs struct {
 	NetworkID         types.String                                                               `tfsdk:"network_id"`
 	Rules             *[]ResponseApplianceGetNetworkApplianceFirewallInboundFirewallRulesRulesRs `tfsdk:"rules"`
 	SyslogDefaultRule types.Bool                                                                 `tfsdk:"syslog_default_rule"`
+	ResetOnDestroy    types.Bool                                                                 `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceFirewallInboundFirewallRulesRulesRs struct {
@@ -346,7 +393,10 @@ func (r *NetworksApplianceFirewallInboundFirewallRulesRs) toSdkApiRequestUpdate(
 	}
 	out := merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallInboundFirewallRules{
 		Rules: func() *[]merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallInboundFirewallRulesRules {
-			if len(requestApplianceUpdateNetworkApplianceFirewallInboundFirewallRulesRules) > 0 {
+			if len(requestApplianceUpdateNetworkApplianceFirewallInboundFirewallRulesRules) > 0 || r.Rules != nil {
+				if len(requestApplianceUpdateNetworkApplianceFirewallInboundFirewallRulesRules) == 0 {
+					requestApplianceUpdateNetworkApplianceFirewallInboundFirewallRulesRules = make([]merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallInboundFirewallRulesRules, 0)
+				}
 				return &requestApplianceUpdateNetworkApplianceFirewallInboundFirewallRulesRules
 			}
 			return nil
@@ -0,0 +1,345 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// RESOURCE NORMAL
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &NetworksApplianceFirewallL3FirewallRuleResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceFirewallL3FirewallRuleResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceFirewallL3FirewallRuleResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceFirewallL3FirewallRuleResource{}
+)
+
+func NewNetworksApplianceFirewallL3FirewallRuleResource() resource.Resource {
+	return &NetworksApplianceFirewallL3FirewallRuleResource{}
+}
+
+type NetworksApplianceFirewallL3FirewallRuleResource struct {
+	client *merakigosdk.Client
+}
+
+func (r *NetworksApplianceFirewallL3FirewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+}
+
+// Metadata returns the data source type name.
+func (r *NetworksApplianceFirewallL3FirewallRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks_appliance_firewall_l3_firewall_rule"
+}
+
+func (r *NetworksApplianceFirewallL3FirewallRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages one rule of the L3 firewall of an MX network. The other rules of the list, like the ones of `meraki_networks_appliance_firewall_l3_firewall_rule` resources of other configurations, are kept. Do not use it with `meraki_networks_appliance_firewall_l3_firewall_rules`, which owns the whole list.",
+		Attributes: map[string]schema.Attribute{
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: `networkId path parameter. Network ID`,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: `Position of the rule in the list, starting at 1. The rule is inserted before the rule that has this position, and the rules owned elsewhere keep their order. When the list has less rules, the rule is the last one (not including the default rule).`,
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: `Description of the rule (optional)`,
+				Optional:            true,
+			},
+			"dest_cidr": schema.StringAttribute{
+				MarkdownDescription: `Comma-separated list of destination IP address(es) (in IP or CIDR notation), fully-qualified domain names (FQDN) or 'any'`,
+				Required:            true,
+			},
+			"dest_port": schema.StringAttribute{
+				MarkdownDescription: `Comma-separated list of destination port(s) (integer in the range 1-65535), or 'any'`,
+				Optional:            true,
+			},
+			"policy": schema.StringAttribute{
+				MarkdownDescription: `'allow' or 'deny' traffic specified by this rule
+                                        Allowed values: [allow,deny]`,
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"allow",
+						"deny",
+					),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: `The type of protocol (must be 'tcp', 'udp', 'icmp', 'icmp6' or 'any')
+                                        Allowed values: [any,icmp,icmp6,tcp,udp]`,
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"any",
+						"icmp",
+						"icmp6",
+						"tcp",
+						"udp",
+					),
+				},
+			},
+			"src_cidr": schema.StringAttribute{
+				MarkdownDescription: `Comma-separated list of source IP address(es) (in IP or CIDR notation), or 'any' (note: FQDN not supported for source addresses)`,
+				Required:            true,
+			},
+			"src_port": schema.StringAttribute{
+				MarkdownDescription: `Comma-separated list of source port(s) (integer in the range 1-65535), or 'any'`,
+				Optional:            true,
+			},
+			"syslog_enabled": schema.BoolAttribute{
+				MarkdownDescription: `Log this rule to syslog (true or false, boolean value) - only applicable if a syslog has been configured (optional)`,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *NetworksApplianceFirewallL3FirewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworksApplianceFirewallL3FirewallRuleRs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := networksApplianceFirewallL3FirewallRules(r.client, data.NetworkID.ValueString())
+	err := rules.Insert(data.toSdkApiRequestRule(), data.Priority.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceFirewallL3FirewallRules",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *NetworksApplianceFirewallL3FirewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworksApplianceFirewallL3FirewallRuleRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := networksApplianceFirewallL3FirewallRules(r.client, data.NetworkID.ValueString())
+	// Imported
+	if data.Policy.IsNull() {
+		rule, found, err := rules.At(data.Priority.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing GetNetworkApplianceFirewallL3FirewallRules",
+				err.Error(),
+			)
+			return
+		}
+		if !found {
+			resp.Diagnostics.AddError(
+				"Rule not found",
+				fmt.Sprintf("The L3 firewall of network %s has no rule with priority %d.", data.NetworkID.ValueString(), data.Priority.ValueInt64()),
+			)
+			return
+		}
+		data = RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRulesToRs(data, rule)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+		return
+	}
+	priority, found, err := rules.Read(data.toSdkApiRequestRule(), data.Priority.ValueInt64())
+	if errors.Is(err, errFirewallRulesNotFound) {
+		found, err = false, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing GetNetworkApplianceFirewallL3FirewallRules",
+			err.Error(),
+		)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddWarning(
+			"Resource not found",
+			"Deleting resource",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.Priority = types.Int64Value(priority)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *NetworksApplianceFirewallL3FirewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importFirewallRule(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceFirewallL3FirewallRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "priority")
+}
+
+func (r *NetworksApplianceFirewallL3FirewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NetworksApplianceFirewallL3FirewallRuleRs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := networksApplianceFirewallL3FirewallRules(r.client, plan.NetworkID.ValueString())
+	err := rules.Replace(state.toSdkApiRequestRule(), state.Priority.ValueInt64(), plan.toSdkApiRequestRule(), plan.Priority.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceFirewallL3FirewallRules",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *NetworksApplianceFirewallL3FirewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NetworksApplianceFirewallL3FirewallRuleRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := networksApplianceFirewallL3FirewallRules(r.client, state.NetworkID.ValueString())
+	err := rules.Remove(state.toSdkApiRequestRule(), state.Priority.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceFirewallL3FirewallRules",
+			err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// TF Structs Schema
+type NetworksApplianceFirewallL3FirewallRuleRs struct {
+	NetworkID     types.String `tfsdk:"network_id"`
+	Priority      types.Int64  `tfsdk:"priority"`
+	Comment       types.String `tfsdk:"comment"`
+	DestCidr      types.String `tfsdk:"dest_cidr"`
+	DestPort      types.String `tfsdk:"dest_port"`
+	Policy        types.String `tfsdk:"policy"`
+	Protocol      types.String `tfsdk:"protocol"`
+	SrcCidr       types.String `tfsdk:"src_cidr"`
+	SrcPort       types.String `tfsdk:"src_port"`
+	SyslogEnabled types.Bool   `tfsdk:"syslog_enabled"`
+}
+
+// networksApplianceFirewallL3FirewallRules returns the L3 firewall rules of the network, without
+// the default rule.
+func networksApplianceFirewallL3FirewallRules(client *merakigosdk.Client, networkID string) firewallRules[merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules] {
+	return firewallRules[merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules]{
+		key:          "networks/" + networkID + "/appliance/firewall/l3FirewallRules",
+		getOperation: "GetNetworkApplianceFirewallL3FirewallRules",
+		putOperation: "UpdateNetworkApplianceFirewallL3FirewallRules",
+		get: func() ([]merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules, *resty.Response, error) {
+			response, restyResp, err := client.Appliance.GetNetworkApplianceFirewallL3FirewallRules(networkID)
+			if err != nil || response == nil || response.Rules == nil {
+				return nil, restyResp, err
+			}
+			rules := []merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules{}
+			for i, rule := range *response.Rules {
+				if i == len(*response.Rules)-1 && rule.Comment == "Default rule" {
+					break
+				}
+				rules = append(rules, merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules(rule))
+			}
+			return rules, restyResp, nil
+		},
+		put: func(rules []merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules) (*resty.Response, error) {
+			if rules == nil {
+				rules = []merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules{}
+			}
+			return client.Appliance.UpdateNetworkApplianceFirewallL3FirewallRules(networkID, &merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRules{
+				Rules: rules,
+			})
+		},
+		equal: func(a, b merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules) bool {
+			return a.Comment == b.Comment &&
+				firewallRuleFieldEqual(a.DestCidr, b.DestCidr) &&
+				firewallRuleFieldEqual(a.DestPort, b.DestPort) &&
+				firewallRuleFieldEqual(a.Policy, b.Policy) &&
+				firewallRuleFieldEqual(a.Protocol, b.Protocol) &&
+				firewallRuleFieldEqual(a.SrcCidr, b.SrcCidr) &&
+				firewallRuleFieldEqual(a.SrcPort, b.SrcPort) &&
+				firewallRuleBoolEqual(a.SyslogEnabled, b.SyslogEnabled)
+		},
+	}
+}
+
+// FromBody
+func (r *NetworksApplianceFirewallL3FirewallRuleRs) toSdkApiRequestRule() merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules {
+	syslogEnabled := func() *bool {
+		if !r.SyslogEnabled.IsUnknown() && !r.SyslogEnabled.IsNull() {
+			return r.SyslogEnabled.ValueBoolPointer()
+		}
+		return nil
+	}()
+	return merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules{
+		Comment:       r.Comment.ValueString(),
+		DestCidr:      r.DestCidr.ValueString(),
+		DestPort:      r.DestPort.ValueString(),
+		Policy:        r.Policy.ValueString(),
+		Protocol:      r.Protocol.ValueString(),
+		SrcCidr:       r.SrcCidr.ValueString(),
+		SrcPort:       r.SrcPort.ValueString(),
+		SyslogEnabled: syslogEnabled,
+	}
+}
+
+// From gosdk to TF Structs Schema
+func RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRulesToRs(state NetworksApplianceFirewallL3FirewallRuleRs, rule merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL3FirewallRulesRules) NetworksApplianceFirewallL3FirewallRuleRs {
+	return NetworksApplianceFirewallL3FirewallRuleRs{
+		NetworkID:     state.NetworkID,
+		Priority:      state.Priority,
+		Comment:       firewallRuleStringToRs(rule.Comment),
+		DestCidr:      firewallRuleStringToRs(rule.DestCidr),
+		DestPort:      firewallRuleStringToRs(rule.DestPort),
+		Policy:        firewallRuleStringToRs(rule.Policy),
+		Protocol:      firewallRuleStringToRs(rule.Protocol),
+		SrcCidr:       firewallRuleStringToRs(rule.SrcCidr),
+		SrcPort:       firewallRuleStringToRs(rule.SrcPort),
+		SyslogEnabled: types.BoolPointerValue(rule.SyslogEnabled),
+	}
+}
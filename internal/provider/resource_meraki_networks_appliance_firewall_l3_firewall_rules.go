@@ -27,7 +27,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +39,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceFirewallL3FirewallRulesResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceFirewallL3FirewallRulesResource{}
+	_ resource.Resource                = &NetworksApplianceFirewallL3FirewallRulesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceFirewallL3FirewallRulesResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceFirewallL3FirewallRulesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceFirewallL3FirewallRulesResource{}
 )
 
 func NewNetworksApplianceFirewallL3FirewallRulesResource() resource.Resource {
@@ -49,15 +50,17 @@ func NewNetworksApplianceFirewallL3FirewallRulesResource() resource.Resource {
 }
 
 type NetworksApplianceFirewallL3FirewallRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceFirewallL3FirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -72,6 +75,10 @@ func (r *NetworksApplianceFirewallL3FirewallRulesResource) Schema(_ context.Cont
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `An ordered array of the firewall rules (not including the default rule)`,
 				Optional:            true,
@@ -226,6 +233,7 @@ func (r *NetworksApplianceFirewallL3FirewallRulesResource) Create(ctx context.Co
 	data = ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesItemToBodyRs(data, responseGet, true)
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -267,9 +275,14 @@ func (r *NetworksApplianceFirewallL3FirewallRulesResource) Read(ctx context.Cont
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceFirewallL3FirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceFirewallL3FirewallRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceFirewallL3FirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -298,11 +311,44 @@ func (r *NetworksApplianceFirewallL3FirewallRulesResource) Update(ctx context.Co
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceFirewallL3FirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallL3FirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceFirewallL3FirewallRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallL3FirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceFirewallL3FirewallRulesRs{
+		Rules:             &[]ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesRulesRs{},
+		SyslogDefaultRule: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Appliance.UpdateNetworkApplianceFirewallL3FirewallRules(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceFirewallL3FirewallRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceFirewallL3FirewallRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -311,6 +357,7 @@ type NetworksApplianceFirewallL3FirewallRulesRs struct {
 	NetworkID         types.String                                                          `tfsdk:"network_id"`
 	Rules             *[]ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesRulesRs `tfsdk:"rules"`
 	SyslogDefaultRule types.Bool                                                            `tfsdk:"syslog_default_rule"`
+	ResetOnDestroy    types.Bool                                                            `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesRulesRs struct {
@@ -383,6 +430,16 @@ func (r *NetworksApplianceFirewallL3FirewallRulesRs) toSdkApiRequestUpdate(ctx c
 
 // From gosdk to TF Structs Schema
 func ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesItemToBodyRs(state NetworksApplianceFirewallL3FirewallRulesRs, response *merakigosdk.ResponseApplianceGetNetworkApplianceFirewallL3FirewallRules, is_read bool) NetworksApplianceFirewallL3FirewallRulesRs {
+	itemState := ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesItemToRs(state, response)
+	if is_read {
+		return mergeInterfacesOnlyPath(state, itemState).(NetworksApplianceFirewallL3FirewallRulesRs)
+	}
+	return mergeInterfaces(state, itemState, true).(NetworksApplianceFirewallL3FirewallRulesRs)
+}
+
+// ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesItemToRs converts the response of the API to the state of the resource,
+// without merging it with the current state.
+func ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesItemToRs(state NetworksApplianceFirewallL3FirewallRulesRs, response *merakigosdk.ResponseApplianceGetNetworkApplianceFirewallL3FirewallRules) NetworksApplianceFirewallL3FirewallRulesRs {
 	if response.Rules != nil {
 		var filteredRules []merakigosdk.ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesRules
 		for _, rule := range *response.Rules {
@@ -455,8 +512,5 @@ func ResponseApplianceGetNetworkApplianceFirewallL3FirewallRulesItemToBodyRs(sta
 			return nil
 		}(),
 	}
-	if is_read {
-		return mergeInterfacesOnlyPath(state, itemState).(NetworksApplianceFirewallL3FirewallRulesRs)
-	}
-	return mergeInterfaces(state, itemState, true).(NetworksApplianceFirewallL3FirewallRulesRs)
+	return itemState
 }
@@ -25,7 +25,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -40,8 +39,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceFirewallL7FirewallRulesResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceFirewallL7FirewallRulesResource{}
+	_ resource.Resource                = &NetworksApplianceFirewallL7FirewallRulesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceFirewallL7FirewallRulesResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceFirewallL7FirewallRulesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceFirewallL7FirewallRulesResource{}
 )
 
 func NewNetworksApplianceFirewallL7FirewallRulesResource() resource.Resource {
@@ -49,15 +50,17 @@ func NewNetworksApplianceFirewallL7FirewallRulesResource() resource.Resource {
 }
 
 type NetworksApplianceFirewallL7FirewallRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceFirewallL7FirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -72,6 +75,10 @@ func (r *NetworksApplianceFirewallL7FirewallRulesResource) Schema(_ context.Cont
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `An ordered array of the MX L7 firewall rules`,
 				Optional:            true,
@@ -201,6 +208,7 @@ func (r *NetworksApplianceFirewallL7FirewallRulesResource) Create(ctx context.Co
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -242,9 +250,14 @@ func (r *NetworksApplianceFirewallL7FirewallRulesResource) Read(ctx context.Cont
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceFirewallL7FirewallRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceFirewallL7FirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceFirewallL7FirewallRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceFirewallL7FirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -273,18 +286,51 @@ func (r *NetworksApplianceFirewallL7FirewallRulesResource) Update(ctx context.Co
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceFirewallL7FirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallL7FirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceFirewallL7FirewallRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallL7FirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceFirewallL7FirewallRulesRs{
+		Rules: &[]ResponseApplianceGetNetworkApplianceFirewallL7FirewallRulesRulesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Appliance.UpdateNetworkApplianceFirewallL7FirewallRules(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceFirewallL7FirewallRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceFirewallL7FirewallRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksApplianceFirewallL7FirewallRulesRs struct {
-	NetworkID types.String                                                          `tfsdk:"network_id"`
-	Rules     *[]ResponseApplianceGetNetworkApplianceFirewallL7FirewallRulesRulesRs `tfsdk:"rules"`
+	NetworkID      types.String                                                          `tfsdk:"network_id"`
+	Rules          *[]ResponseApplianceGetNetworkApplianceFirewallL7FirewallRulesRulesRs `tfsdk:"rules"`
+	ResetOnDestroy types.Bool                                                            `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceFirewallL7FirewallRulesRulesRs struct {
@@ -336,6 +382,9 @@ func (r *NetworksApplianceFirewallL7FirewallRulesRs) toSdkApiRequestUpdate(ctx c
 	out := merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL7FirewallRules{
 		Rules: func() *[]merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL7FirewallRulesRules {
 			if len(requestApplianceUpdateNetworkApplianceFirewallL7FirewallRulesRules) > 0 || r.Rules != nil {
+				if len(requestApplianceUpdateNetworkApplianceFirewallL7FirewallRulesRules) == 0 {
+					requestApplianceUpdateNetworkApplianceFirewallL7FirewallRulesRules = make([]merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL7FirewallRulesRules, 0)
+				}
 				return &requestApplianceUpdateNetworkApplianceFirewallL7FirewallRulesRules
 			} else {
 				rules := make([]merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallL7FirewallRulesRules, 0)
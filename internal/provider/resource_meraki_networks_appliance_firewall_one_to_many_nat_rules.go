@@ -26,7 +26,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -38,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceFirewallOneToManyNatRulesResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceFirewallOneToManyNatRulesResource{}
+	_ resource.Resource                = &NetworksApplianceFirewallOneToManyNatRulesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceFirewallOneToManyNatRulesResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceFirewallOneToManyNatRulesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceFirewallOneToManyNatRulesResource{}
 )
 
 func NewNetworksApplianceFirewallOneToManyNatRulesResource() resource.Resource {
@@ -47,15 +48,17 @@ func NewNetworksApplianceFirewallOneToManyNatRulesResource() resource.Resource {
 }
 
 type NetworksApplianceFirewallOneToManyNatRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceFirewallOneToManyNatRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -70,6 +73,10 @@ func (r *NetworksApplianceFirewallOneToManyNatRulesResource) Schema(_ context.Co
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `An array of 1:Many nat rules`,
 				Optional:            true,
@@ -212,6 +219,7 @@ func (r *NetworksApplianceFirewallOneToManyNatRulesResource) Create(ctx context.
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -253,9 +261,14 @@ func (r *NetworksApplianceFirewallOneToManyNatRulesResource) Read(ctx context.Co
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceFirewallOneToManyNatRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceFirewallOneToManyNatRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceFirewallOneToManyNatRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceFirewallOneToManyNatRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -284,18 +297,51 @@ func (r *NetworksApplianceFirewallOneToManyNatRulesResource) Update(ctx context.
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceFirewallOneToManyNatRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallOneToManyNatRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceFirewallOneToManyNatRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallOneToManyNatRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceFirewallOneToManyNatRulesRs{
+		Rules: &[]ResponseApplianceGetNetworkApplianceFirewallOneToManyNatRulesRulesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Appliance.UpdateNetworkApplianceFirewallOneToManyNatRules(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceFirewallOneToManyNatRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceFirewallOneToManyNatRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksApplianceFirewallOneToManyNatRulesRs struct {
-	NetworkID types.String                                                            `tfsdk:"network_id"`
-	Rules     *[]ResponseApplianceGetNetworkApplianceFirewallOneToManyNatRulesRulesRs `tfsdk:"rules"`
+	NetworkID      types.String                                                            `tfsdk:"network_id"`
+	Rules          *[]ResponseApplianceGetNetworkApplianceFirewallOneToManyNatRulesRulesRs `tfsdk:"rules"`
+	ResetOnDestroy types.Bool                                                              `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceFirewallOneToManyNatRulesRulesRs struct {
@@ -362,6 +408,9 @@ func (r *NetworksApplianceFirewallOneToManyNatRulesRs) toSdkApiRequestUpdate(ctx
 	out := merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallOneToManyNatRules{
 		Rules: func() *[]merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallOneToManyNatRulesRules {
 			// Always return the rules array, even if empty, to avoid sending null
+			if len(requestApplianceUpdateNetworkApplianceFirewallOneToManyNatRulesRules) == 0 {
+				requestApplianceUpdateNetworkApplianceFirewallOneToManyNatRulesRules = make([]merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallOneToManyNatRulesRules, 0)
+			}
 			return &requestApplianceUpdateNetworkApplianceFirewallOneToManyNatRulesRules
 		}(),
 	}
@@ -26,7 +26,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -38,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceFirewallOneToOneNatRulesResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceFirewallOneToOneNatRulesResource{}
+	_ resource.Resource                = &NetworksApplianceFirewallOneToOneNatRulesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceFirewallOneToOneNatRulesResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceFirewallOneToOneNatRulesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceFirewallOneToOneNatRulesResource{}
 )
 
 func NewNetworksApplianceFirewallOneToOneNatRulesResource() resource.Resource {
@@ -47,15 +48,17 @@ func NewNetworksApplianceFirewallOneToOneNatRulesResource() resource.Resource {
 }
 
 type NetworksApplianceFirewallOneToOneNatRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceFirewallOneToOneNatRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -70,6 +73,10 @@ func (r *NetworksApplianceFirewallOneToOneNatRulesResource) Schema(_ context.Con
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `An array of 1:1 nat rules`,
 				Optional:            true,
@@ -209,6 +216,7 @@ func (r *NetworksApplianceFirewallOneToOneNatRulesResource) Create(ctx context.C
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -250,9 +258,14 @@ func (r *NetworksApplianceFirewallOneToOneNatRulesResource) Read(ctx context.Con
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceFirewallOneToOneNatRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceFirewallOneToOneNatRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceFirewallOneToOneNatRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceFirewallOneToOneNatRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -281,18 +294,51 @@ func (r *NetworksApplianceFirewallOneToOneNatRulesResource) Update(ctx context.C
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceFirewallOneToOneNatRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallOneToOneNatRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceFirewallOneToOneNatRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallOneToOneNatRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceFirewallOneToOneNatRulesRs{
+		Rules: &[]ResponseApplianceGetNetworkApplianceFirewallOneToOneNatRulesRulesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Appliance.UpdateNetworkApplianceFirewallOneToOneNatRules(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceFirewallOneToOneNatRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceFirewallOneToOneNatRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksApplianceFirewallOneToOneNatRulesRs struct {
-	NetworkID types.String                                                           `tfsdk:"network_id"`
-	Rules     *[]ResponseApplianceGetNetworkApplianceFirewallOneToOneNatRulesRulesRs `tfsdk:"rules"`
+	NetworkID      types.String                                                           `tfsdk:"network_id"`
+	Rules          *[]ResponseApplianceGetNetworkApplianceFirewallOneToOneNatRulesRulesRs `tfsdk:"rules"`
+	ResetOnDestroy types.Bool                                                             `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceFirewallOneToOneNatRulesRulesRs struct {
@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -36,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceFirewallPortForwardingRulesResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceFirewallPortForwardingRulesResource{}
+	_ resource.Resource                = &NetworksApplianceFirewallPortForwardingRulesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceFirewallPortForwardingRulesResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceFirewallPortForwardingRulesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceFirewallPortForwardingRulesResource{}
 )
 
 func NewNetworksApplianceFirewallPortForwardingRulesResource() resource.Resource {
@@ -45,15 +46,17 @@ func NewNetworksApplianceFirewallPortForwardingRulesResource() resource.Resource
 }
 
 type NetworksApplianceFirewallPortForwardingRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceFirewallPortForwardingRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -68,6 +71,10 @@ func (r *NetworksApplianceFirewallPortForwardingRulesResource) Schema(_ context.
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `An array of port forwarding rules`,
 				Optional:            true,
@@ -194,6 +201,7 @@ func (r *NetworksApplianceFirewallPortForwardingRulesResource) Create(ctx contex
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -235,9 +243,14 @@ func (r *NetworksApplianceFirewallPortForwardingRulesResource) Read(ctx context.
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceFirewallPortForwardingRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceFirewallPortForwardingRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceFirewallPortForwardingRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceFirewallPortForwardingRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -266,18 +279,51 @@ func (r *NetworksApplianceFirewallPortForwardingRulesResource) Update(ctx contex
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceFirewallPortForwardingRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallPortForwardingRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceFirewallPortForwardingRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallPortForwardingRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceFirewallPortForwardingRulesRs{
+		Rules: &[]ResponseApplianceGetNetworkApplianceFirewallPortForwardingRulesRulesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceFirewallPortForwardingRules(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceFirewallPortForwardingRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceFirewallPortForwardingRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksApplianceFirewallPortForwardingRulesRs struct {
-	NetworkID types.String                                                              `tfsdk:"network_id"`
-	Rules     *[]ResponseApplianceGetNetworkApplianceFirewallPortForwardingRulesRulesRs `tfsdk:"rules"`
+	NetworkID      types.String                                                              `tfsdk:"network_id"`
+	Rules          *[]ResponseApplianceGetNetworkApplianceFirewallPortForwardingRulesRulesRs `tfsdk:"rules"`
+	ResetOnDestroy types.Bool                                                                `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceFirewallPortForwardingRulesRulesRs struct {
@@ -320,6 +366,9 @@ func (r *NetworksApplianceFirewallPortForwardingRulesRs) toSdkApiRequestUpdate(c
 	out := merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallPortForwardingRules{
 		Rules: func() *[]merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallPortForwardingRulesRules {
 			if len(requestApplianceUpdateNetworkApplianceFirewallPortForwardingRulesRules) > 0 || r.Rules != nil {
+				if len(requestApplianceUpdateNetworkApplianceFirewallPortForwardingRulesRules) == 0 {
+					requestApplianceUpdateNetworkApplianceFirewallPortForwardingRulesRules = make([]merakigosdk.RequestApplianceUpdateNetworkApplianceFirewallPortForwardingRulesRules, 0)
+				}
 				return &requestApplianceUpdateNetworkApplianceFirewallPortForwardingRulesRules
 			}
 			return nil
@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -36,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceFirewallSettingsResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceFirewallSettingsResource{}
+	_ resource.Resource                = &NetworksApplianceFirewallSettingsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceFirewallSettingsResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceFirewallSettingsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceFirewallSettingsResource{}
 )
 
 func NewNetworksApplianceFirewallSettingsResource() resource.Resource {
@@ -45,7 +46,8 @@ func NewNetworksApplianceFirewallSettingsResource() resource.Resource {
 }
 
 type NetworksApplianceFirewallSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceFirewallSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -54,6 +56,7 @@ func (r *NetworksApplianceFirewallSettingsResource) Configure(ctx context.Contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -68,6 +71,10 @@ func (r *NetworksApplianceFirewallSettingsResource) Schema(_ context.Context, _
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"spoofing_protection": schema.SingleNestedAttribute{
 				MarkdownDescription: `Spoofing protection settings`,
 				Optional:            true,
@@ -149,6 +156,7 @@ func (r *NetworksApplianceFirewallSettingsResource) Create(ctx context.Context,
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -190,9 +198,14 @@ func (r *NetworksApplianceFirewallSettingsResource) Read(ctx context.Context, re
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceFirewallSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceFirewallSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceFirewallSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceFirewallSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -221,11 +234,47 @@ func (r *NetworksApplianceFirewallSettingsResource) Update(ctx context.Context,
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceFirewallSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceFirewallSettingsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceFirewallSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceFirewallSettingsRs{
+		SpoofingProtection: &ResponseApplianceGetNetworkApplianceFirewallSettingsSpoofingProtectionRs{
+			IPSourceGuard: &ResponseApplianceGetNetworkApplianceFirewallSettingsSpoofingProtectionIpSourceGuardRs{
+				Mode: types.StringValue("log"),
+			},
+		},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Appliance.UpdateNetworkApplianceFirewallSettings(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceFirewallSettings",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceFirewallSettings",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -233,6 +282,7 @@ func (r *NetworksApplianceFirewallSettingsResource) Delete(ctx context.Context,
 type NetworksApplianceFirewallSettingsRs struct {
 	NetworkID          types.String                                                              `tfsdk:"network_id"`
 	SpoofingProtection *ResponseApplianceGetNetworkApplianceFirewallSettingsSpoofingProtectionRs `tfsdk:"spoofing_protection"`
+	ResetOnDestroy     types.Bool                                                                `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceFirewallSettingsSpoofingProtectionRs struct {
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksAppliancePortsResource{}
-	_ resource.ResourceWithConfigure = &NetworksAppliancePortsResource{}
+	_ resource.Resource                = &NetworksAppliancePortsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksAppliancePortsResource{}
+	_ resource.ResourceWithImportState = &NetworksAppliancePortsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksAppliancePortsResource{}
 )
 
 func NewNetworksAppliancePortsResource() resource.Resource {
@@ -46,7 +45,8 @@ func NewNetworksAppliancePortsResource() resource.Resource {
 }
 
 type NetworksAppliancePortsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksAppliancePortsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +55,7 @@ func (r *NetworksAppliancePortsResource) Configure(ctx context.Context, req reso
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -105,6 +106,10 @@ func (r *NetworksAppliancePortsResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: `portId path parameter. Port ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: `The type of the port: 'access' or 'trunk'.`,
 				Optional:            true,
@@ -169,6 +174,7 @@ func (r *NetworksAppliancePortsResource) Create(ctx context.Context, req resourc
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -211,19 +217,14 @@ func (r *NetworksAppliancePortsResource) Read(ctx context.Context, req resource.
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkAppliancePortItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksAppliancePortsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "port_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,portId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port_id"), idParts[1])...)
+func (r *NetworksAppliancePortsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "port_id")
 }
 
 func (r *NetworksAppliancePortsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -253,11 +254,49 @@ func (r *NetworksAppliancePortsResource) Update(ctx context.Context, req resourc
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksAppliancePortsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksAppliancePorts", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksAppliancePortsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksAppliancePorts", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvPortID := state.PortID.ValueString()
+	// Meraki defaults
+	defaults := NetworksAppliancePortsRs{
+		AccessPolicy:        types.StringValue("open"),
+		AllowedVLANs:        types.StringValue("all"),
+		DropUntaggedTraffic: types.BoolValue(false),
+		Enabled:             types.BoolValue(true),
+		Type:                types.StringValue("trunk"),
+		VLAN:                types.Int64Value(1),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkAppliancePort(vvNetworkID, vvPortID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkAppliancePort",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkAppliancePort",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -272,6 +311,7 @@ type NetworksAppliancePortsRs struct {
 	Number              types.Int64  `tfsdk:"number"`
 	Type                types.String `tfsdk:"type"`
 	VLAN                types.Int64  `tfsdk:"vlan"`
+	ResetOnDestroy      types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -39,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksAppliancePrefixesDelegatedStaticsResource{}
-	_ resource.ResourceWithConfigure = &NetworksAppliancePrefixesDelegatedStaticsResource{}
+	_ resource.Resource                = &NetworksAppliancePrefixesDelegatedStaticsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksAppliancePrefixesDelegatedStaticsResource{}
+	_ resource.ResourceWithImportState = &NetworksAppliancePrefixesDelegatedStaticsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksAppliancePrefixesDelegatedStaticsResource{}
 )
 
 func NewNetworksAppliancePrefixesDelegatedStaticsResource() resource.Resource {
@@ -197,6 +196,7 @@ func (r *NetworksAppliancePrefixesDelegatedStaticsResource) Create(ctx context.C
 				data = ResponseApplianceGetNetworkAppliancePrefixesDelegatedStaticItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -252,6 +252,7 @@ func (r *NetworksAppliancePrefixesDelegatedStaticsResource) Create(ctx context.C
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseApplianceGetNetworkAppliancePrefixesDelegatedStaticItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -316,19 +317,14 @@ func (r *NetworksAppliancePrefixesDelegatedStaticsResource) Read(ctx context.Con
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkAppliancePrefixesDelegatedStaticItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksAppliancePrefixesDelegatedStaticsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "static_delegated_prefix_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,staticDelegatedPrefixId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("static_delegated_prefix_id"), idParts[1])...)
+func (r *NetworksAppliancePrefixesDelegatedStaticsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "static_delegated_prefix_id")
 }
 
 func (r *NetworksAppliancePrefixesDelegatedStaticsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -358,6 +354,7 @@ func (r *NetworksAppliancePrefixesDelegatedStaticsResource) Update(ctx context.C
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksAppliancePrefixesDelegatedStaticsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
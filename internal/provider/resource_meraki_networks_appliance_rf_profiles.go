@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -41,8 +38,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceRfProfilesResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceRfProfilesResource{}
+	_ resource.Resource                = &NetworksApplianceRfProfilesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceRfProfilesResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceRfProfilesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceRfProfilesResource{}
 )
 
 func NewNetworksApplianceRfProfilesResource() resource.Resource {
@@ -362,6 +361,7 @@ func (r *NetworksApplianceRfProfilesResource) Create(ctx context.Context, req re
 				data = ResponseApplianceGetNetworkApplianceRfProfileItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -403,6 +403,7 @@ func (r *NetworksApplianceRfProfilesResource) Create(ctx context.Context, req re
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseApplianceGetNetworkApplianceRfProfileItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -478,20 +479,14 @@ func (r *NetworksApplianceRfProfilesResource) Read(ctx context.Context, req reso
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceRfProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "network_id", "id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+func (r *NetworksApplianceRfProfilesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "id")
 }
 
 func (r *NetworksApplianceRfProfilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -526,6 +521,7 @@ func (r *NetworksApplianceRfProfilesResource) Update(ctx context.Context, req re
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceRfProfilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
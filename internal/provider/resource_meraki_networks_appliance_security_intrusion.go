@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -38,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceSecurityIntrusionResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceSecurityIntrusionResource{}
+	_ resource.Resource                = &NetworksApplianceSecurityIntrusionResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceSecurityIntrusionResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceSecurityIntrusionResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceSecurityIntrusionResource{}
 )
 
 func NewNetworksApplianceSecurityIntrusionResource() resource.Resource {
@@ -47,7 +48,8 @@ func NewNetworksApplianceSecurityIntrusionResource() resource.Resource {
 }
 
 type NetworksApplianceSecurityIntrusionResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceSecurityIntrusionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,6 +58,7 @@ func (r *NetworksApplianceSecurityIntrusionResource) Configure(ctx context.Conte
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -135,6 +138,10 @@ func (r *NetworksApplianceSecurityIntrusionResource) Schema(_ context.Context, _
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -182,6 +189,7 @@ func (r *NetworksApplianceSecurityIntrusionResource) Create(ctx context.Context,
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -223,9 +231,14 @@ func (r *NetworksApplianceSecurityIntrusionResource) Read(ctx context.Context, r
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceSecurityIntrusionItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceSecurityIntrusionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceSecurityIntrusionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceSecurityIntrusionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -254,11 +267,47 @@ func (r *NetworksApplianceSecurityIntrusionResource) Update(ctx context.Context,
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceSecurityIntrusionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceSecurityIntrusion", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceSecurityIntrusionRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceSecurityIntrusion", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceSecurityIntrusionRs{
+		IDsRulesets: types.StringValue("balanced"),
+		Mode:        types.StringValue("disabled"),
+		ProtectedNetworks: &ResponseApplianceGetNetworkApplianceSecurityIntrusionProtectedNetworksRs{
+			UseDefault: types.BoolValue(true),
+		},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceSecurityIntrusion(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceSecurityIntrusion",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceSecurityIntrusion",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -268,6 +317,7 @@ type NetworksApplianceSecurityIntrusionRs struct {
 	IDsRulesets       types.String                                                              `tfsdk:"ids_rulesets"`
 	Mode              types.String                                                              `tfsdk:"mode"`
 	ProtectedNetworks *ResponseApplianceGetNetworkApplianceSecurityIntrusionProtectedNetworksRs `tfsdk:"protected_networks"`
+	ResetOnDestroy    types.Bool                                                                `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceSecurityIntrusionProtectedNetworksRs struct {
@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -36,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceSecurityMalwareResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceSecurityMalwareResource{}
+	_ resource.Resource                = &NetworksApplianceSecurityMalwareResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceSecurityMalwareResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceSecurityMalwareResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceSecurityMalwareResource{}
 )
 
 func NewNetworksApplianceSecurityMalwareResource() resource.Resource {
@@ -45,7 +46,8 @@ func NewNetworksApplianceSecurityMalwareResource() resource.Resource {
 }
 
 type NetworksApplianceSecurityMalwareResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceSecurityMalwareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -54,6 +56,7 @@ func (r *NetworksApplianceSecurityMalwareResource) Configure(ctx context.Context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -134,6 +137,10 @@ func (r *NetworksApplianceSecurityMalwareResource) Schema(_ context.Context, _ r
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -181,6 +188,7 @@ func (r *NetworksApplianceSecurityMalwareResource) Create(ctx context.Context, r
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -222,9 +230,14 @@ func (r *NetworksApplianceSecurityMalwareResource) Read(ctx context.Context, req
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceSecurityMalwareItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceSecurityMalwareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceSecurityMalwareResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceSecurityMalwareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -253,20 +266,55 @@ func (r *NetworksApplianceSecurityMalwareResource) Update(ctx context.Context, r
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceSecurityMalwareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceSecurityMalware", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceSecurityMalwareRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceSecurityMalware", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceSecurityMalwareRs{
+		AllowedFiles: &[]ResponseApplianceGetNetworkApplianceSecurityMalwareAllowedFilesRs{},
+		AllowedURLs:  &[]ResponseApplianceGetNetworkApplianceSecurityMalwareAllowedUrlsRs{},
+		Mode:         types.StringValue("disabled"),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceSecurityMalware(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceSecurityMalware",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceSecurityMalware",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksApplianceSecurityMalwareRs struct {
-	NetworkID    types.String                                                         `tfsdk:"network_id"`
-	AllowedFiles *[]ResponseApplianceGetNetworkApplianceSecurityMalwareAllowedFilesRs `tfsdk:"allowed_files"`
-	AllowedURLs  *[]ResponseApplianceGetNetworkApplianceSecurityMalwareAllowedUrlsRs  `tfsdk:"allowed_urls"`
-	Mode         types.String                                                         `tfsdk:"mode"`
+	NetworkID      types.String                                                         `tfsdk:"network_id"`
+	AllowedFiles   *[]ResponseApplianceGetNetworkApplianceSecurityMalwareAllowedFilesRs `tfsdk:"allowed_files"`
+	AllowedURLs    *[]ResponseApplianceGetNetworkApplianceSecurityMalwareAllowedUrlsRs  `tfsdk:"allowed_urls"`
+	Mode           types.String                                                         `tfsdk:"mode"`
+	ResetOnDestroy types.Bool                                                           `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceSecurityMalwareAllowedFilesRs struct {
@@ -316,13 +364,19 @@ func (r *NetworksApplianceSecurityMalwareRs) toSdkApiRequestUpdate(ctx context.C
 	}
 	out := merakigosdk.RequestApplianceUpdateNetworkApplianceSecurityMalware{
 		AllowedFiles: func() *[]merakigosdk.RequestApplianceUpdateNetworkApplianceSecurityMalwareAllowedFiles {
-			if len(requestApplianceUpdateNetworkApplianceSecurityMalwareAllowedFiles) > 0 {
+			if len(requestApplianceUpdateNetworkApplianceSecurityMalwareAllowedFiles) > 0 || r.AllowedFiles != nil {
+				if len(requestApplianceUpdateNetworkApplianceSecurityMalwareAllowedFiles) == 0 {
+					requestApplianceUpdateNetworkApplianceSecurityMalwareAllowedFiles = make([]merakigosdk.RequestApplianceUpdateNetworkApplianceSecurityMalwareAllowedFiles, 0)
+				}
 				return &requestApplianceUpdateNetworkApplianceSecurityMalwareAllowedFiles
 			}
 			return nil
 		}(),
 		AllowedURLs: func() *[]merakigosdk.RequestApplianceUpdateNetworkApplianceSecurityMalwareAllowedURLs {
-			if len(requestApplianceUpdateNetworkApplianceSecurityMalwareAllowedURLs) > 0 {
+			if len(requestApplianceUpdateNetworkApplianceSecurityMalwareAllowedURLs) > 0 || r.AllowedURLs != nil {
+				if len(requestApplianceUpdateNetworkApplianceSecurityMalwareAllowedURLs) == 0 {
+					requestApplianceUpdateNetworkApplianceSecurityMalwareAllowedURLs = make([]merakigosdk.RequestApplianceUpdateNetworkApplianceSecurityMalwareAllowedURLs, 0)
+				}
 				return &requestApplianceUpdateNetworkApplianceSecurityMalwareAllowedURLs
 			}
 			return nil
@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceSettingsResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceSettingsResource{}
+	_ resource.Resource                = &NetworksApplianceSettingsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceSettingsResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceSettingsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceSettingsResource{}
 )
 
 func NewNetworksApplianceSettingsResource() resource.Resource {
@@ -46,7 +47,8 @@ func NewNetworksApplianceSettingsResource() resource.Resource {
 }
 
 type NetworksApplianceSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +57,7 @@ func (r *NetworksApplianceSettingsResource) Configure(ctx context.Context, req r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -126,6 +129,10 @@ func (r *NetworksApplianceSettingsResource) Schema(_ context.Context, _ resource
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -173,6 +180,7 @@ func (r *NetworksApplianceSettingsResource) Create(ctx context.Context, req reso
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -214,9 +222,14 @@ func (r *NetworksApplianceSettingsResource) Read(ctx context.Context, req resour
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -245,11 +258,47 @@ func (r *NetworksApplianceSettingsResource) Update(ctx context.Context, req reso
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceSettingsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceSettingsRs{
+		ClientTrackingMethod: types.StringValue("MAC address"),
+		DeploymentMode:       types.StringValue("routed"),
+		DynamicDNS: &ResponseApplianceGetNetworkApplianceSettingsDynamicDnsRs{
+			Enabled: types.BoolValue(true),
+		},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceSettings(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceSettings",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceSettings",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -259,6 +308,7 @@ type NetworksApplianceSettingsRs struct {
 	ClientTrackingMethod types.String                                              `tfsdk:"client_tracking_method"`
 	DeploymentMode       types.String                                              `tfsdk:"deployment_mode"`
 	DynamicDNS           *ResponseApplianceGetNetworkApplianceSettingsDynamicDnsRs `tfsdk:"dynamic_dns"`
+	ResetOnDestroy       types.Bool                                                `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceSettingsDynamicDnsRs struct {
@@ -26,7 +26,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +39,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceSingleLanResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceSingleLanResource{}
+	_ resource.Resource                = &NetworksApplianceSingleLanResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceSingleLanResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceSingleLanResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceSingleLanResource{}
 )
 
 func NewNetworksApplianceSingleLanResource() resource.Resource {
@@ -49,7 +50,8 @@ func NewNetworksApplianceSingleLanResource() resource.Resource {
 }
 
 type NetworksApplianceSingleLanResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceSingleLanResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -58,6 +60,7 @@ func (r *NetworksApplianceSingleLanResource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -179,6 +182,10 @@ func (r *NetworksApplianceSingleLanResource) Schema(_ context.Context, _ resourc
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"subnet": schema.StringAttribute{
 				MarkdownDescription: `The subnet of the single LAN`,
 				Optional:            true,
@@ -233,6 +240,7 @@ func (r *NetworksApplianceSingleLanResource) Create(ctx context.Context, req res
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -274,9 +282,14 @@ func (r *NetworksApplianceSingleLanResource) Read(ctx context.Context, req resou
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceSingleLanItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceSingleLanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceSingleLanResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceSingleLanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -305,21 +318,59 @@ func (r *NetworksApplianceSingleLanResource) Update(ctx context.Context, req res
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceSingleLanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceSingleLan", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceSingleLanRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceSingleLan", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceSingleLanRs{
+		IPv6: &ResponseApplianceGetNetworkApplianceSingleLanIpv6Rs{
+			Enabled: types.BoolValue(false),
+		},
+		MandatoryDhcp: &ResponseApplianceGetNetworkApplianceSingleLanMandatoryDhcpRs{
+			Enabled: types.BoolValue(false),
+		},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceSingleLan(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceSingleLan",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceSingleLan",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksApplianceSingleLanRs struct {
-	NetworkID     types.String                                                  `tfsdk:"network_id"`
-	ApplianceIP   types.String                                                  `tfsdk:"appliance_ip"`
-	IPv6          *ResponseApplianceGetNetworkApplianceSingleLanIpv6Rs          `tfsdk:"ipv6"`
-	MandatoryDhcp *ResponseApplianceGetNetworkApplianceSingleLanMandatoryDhcpRs `tfsdk:"mandatory_dhcp"`
-	Subnet        types.String                                                  `tfsdk:"subnet"`
+	NetworkID      types.String                                                  `tfsdk:"network_id"`
+	ApplianceIP    types.String                                                  `tfsdk:"appliance_ip"`
+	IPv6           *ResponseApplianceGetNetworkApplianceSingleLanIpv6Rs          `tfsdk:"ipv6"`
+	MandatoryDhcp  *ResponseApplianceGetNetworkApplianceSingleLanMandatoryDhcpRs `tfsdk:"mandatory_dhcp"`
+	Subnet         types.String                                                  `tfsdk:"subnet"`
+	ResetOnDestroy types.Bool                                                    `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceSingleLanIpv6Rs struct {
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -41,8 +38,11 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceSSIDsResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceSSIDsResource{}
+	_ resource.Resource                 = &NetworksApplianceSSIDsResource{}
+	_ resource.ResourceWithConfigure    = &NetworksApplianceSSIDsResource{}
+	_ resource.ResourceWithImportState  = &NetworksApplianceSSIDsResource{}
+	_ resource.ResourceWithIdentity     = &NetworksApplianceSSIDsResource{}
+	_ resource.ResourceWithUpgradeState = &NetworksApplianceSSIDsResource{}
 )
 
 func NewNetworksApplianceSSIDsResource() resource.Resource {
@@ -50,7 +50,8 @@ func NewNetworksApplianceSSIDsResource() resource.Resource {
 }
 
 type NetworksApplianceSSIDsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceSSIDsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -59,6 +60,7 @@ func (r *NetworksApplianceSSIDsResource) Configure(ctx context.Context, req reso
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -66,8 +68,14 @@ func (r *NetworksApplianceSSIDsResource) Metadata(_ context.Context, req resourc
 	resp.TypeName = req.ProviderTypeName + "_networks_appliance_ssids"
 }
 
+// UpgradeState upgrades the states written before the VLAN IDs were numbers.
+func (r *NetworksApplianceSSIDsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(1)
+}
+
 func (r *NetworksApplianceSSIDsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"auth_mode": schema.StringAttribute{
 				MarkdownDescription: `The association control method for the SSID.
@@ -172,6 +180,7 @@ func (r *NetworksApplianceSSIDsResource) Schema(_ context.Context, _ resource.Sc
 			},
 			"psk": schema.StringAttribute{
 				MarkdownDescription: `The passkey for the SSID. This param is only valid if the authMode is 'psk'.`,
+				Sensitive:           true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -202,6 +211,7 @@ func (r *NetworksApplianceSSIDsResource) Schema(_ context.Context, _ resource.Sc
 						},
 						"secret": schema.StringAttribute{
 							MarkdownDescription: `The RADIUS client shared secret.`,
+							Sensitive:           true,
 							Optional:            true,
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
@@ -210,6 +220,10 @@ func (r *NetworksApplianceSSIDsResource) Schema(_ context.Context, _ resource.Sc
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"visible": schema.BoolAttribute{
 				MarkdownDescription: `Boolean indicating whether the MX should advertise or hide this SSID.`,
 				Optional:            true,
@@ -283,6 +297,7 @@ func (r *NetworksApplianceSSIDsResource) Create(ctx context.Context, req resourc
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -325,19 +340,14 @@ func (r *NetworksApplianceSSIDsResource) Read(ctx context.Context, req resource.
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceSSIDItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceSSIDsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "number")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,number. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), idParts[1])...)
+func (r *NetworksApplianceSSIDsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number")
 }
 
 func (r *NetworksApplianceSSIDsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -367,11 +377,46 @@ func (r *NetworksApplianceSSIDsResource) Update(ctx context.Context, req resourc
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceSSIDsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceSSIDs", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceSSIDsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceSSIDs", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvNumber := state.Number.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceSSIDsRs{
+		AuthMode: types.StringValue("open"),
+		Enabled:  types.BoolValue(false),
+		Visible:  types.BoolValue(true),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceSSID(vvNetworkID, vvNumber, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceSSID",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceSSID",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -390,6 +435,7 @@ type NetworksApplianceSSIDsRs struct {
 	DhcpEnforcedDeauthentication *RequestApplianceUpdateNetworkApplianceSsidDhcpEnforcedDeauthenticationRs `tfsdk:"dhcp_enforced_deauthentication"`
 	Dot11W                       *RequestApplianceUpdateNetworkApplianceSsidDot11WRs                       `tfsdk:"dot11w"`
 	Psk                          types.String                                                              `tfsdk:"psk"`
+	ResetOnDestroy               types.Bool                                                                `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceSsidRadiusServersRs struct {
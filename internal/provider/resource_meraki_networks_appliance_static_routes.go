@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -38,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceStaticRoutesResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceStaticRoutesResource{}
+	_ resource.Resource                = &NetworksApplianceStaticRoutesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceStaticRoutesResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceStaticRoutesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceStaticRoutesResource{}
 )
 
 func NewNetworksApplianceStaticRoutesResource() resource.Resource {
@@ -223,6 +222,7 @@ func (r *NetworksApplianceStaticRoutesResource) Create(ctx context.Context, req
 				data = ResponseApplianceGetNetworkApplianceStaticRouteItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -278,6 +278,7 @@ func (r *NetworksApplianceStaticRoutesResource) Create(ctx context.Context, req
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseApplianceGetNetworkApplianceStaticRouteItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -342,19 +343,14 @@ func (r *NetworksApplianceStaticRoutesResource) Read(ctx context.Context, req re
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceStaticRouteItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceStaticRoutesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "static_route_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,staticRouteId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("static_route_id"), idParts[1])...)
+func (r *NetworksApplianceStaticRoutesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "static_route_id")
 }
 
 func (r *NetworksApplianceStaticRoutesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -384,6 +380,7 @@ func (r *NetworksApplianceStaticRoutesResource) Update(ctx context.Context, req
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceStaticRoutesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
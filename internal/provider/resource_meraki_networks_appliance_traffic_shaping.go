@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceTrafficShapingResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceTrafficShapingResource{}
+	_ resource.Resource                = &NetworksApplianceTrafficShapingResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceTrafficShapingResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceTrafficShapingResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceTrafficShapingResource{}
 )
 
 func NewNetworksApplianceTrafficShapingResource() resource.Resource {
@@ -43,7 +44,8 @@ func NewNetworksApplianceTrafficShapingResource() resource.Resource {
 }
 
 type NetworksApplianceTrafficShapingResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceTrafficShapingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +54,7 @@ func (r *NetworksApplianceTrafficShapingResource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -137,6 +140,7 @@ func (r *NetworksApplianceTrafficShapingResource) Create(ctx context.Context, re
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -178,9 +182,14 @@ func (r *NetworksApplianceTrafficShapingResource) Read(ctx context.Context, req
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceTrafficShapingItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceTrafficShapingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceTrafficShapingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceTrafficShapingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -209,9 +218,15 @@ func (r *NetworksApplianceTrafficShapingResource) Update(ctx context.Context, re
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceTrafficShapingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of NetworksApplianceTrafficShaping not reset", "meraki_reset_on_destroy is set, but the unlimited global bandwidth limits have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceTrafficShaping", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
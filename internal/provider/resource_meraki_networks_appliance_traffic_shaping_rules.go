@@ -26,7 +26,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -43,8 +42,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceTrafficShapingRulesResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceTrafficShapingRulesResource{}
+	_ resource.Resource                = &NetworksApplianceTrafficShapingRulesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceTrafficShapingRulesResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceTrafficShapingRulesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceTrafficShapingRulesResource{}
 )
 
 func NewNetworksApplianceTrafficShapingRulesResource() resource.Resource {
@@ -52,15 +53,17 @@ func NewNetworksApplianceTrafficShapingRulesResource() resource.Resource {
 }
 
 type NetworksApplianceTrafficShapingRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceTrafficShapingRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -82,6 +85,10 @@ func (r *NetworksApplianceTrafficShapingRulesResource) Schema(_ context.Context,
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `    An array of traffic shaping rules. Rules are applied in the order that
     they are specified in. An empty list (or null) means no rules. Note that
@@ -289,6 +296,7 @@ func (r *NetworksApplianceTrafficShapingRulesResource) Create(ctx context.Contex
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -330,9 +338,14 @@ func (r *NetworksApplianceTrafficShapingRulesResource) Read(ctx context.Context,
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceTrafficShapingRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceTrafficShapingRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceTrafficShapingRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceTrafficShapingRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -361,11 +374,44 @@ func (r *NetworksApplianceTrafficShapingRulesResource) Update(ctx context.Contex
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceTrafficShapingRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceTrafficShapingRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceTrafficShapingRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceTrafficShapingRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceTrafficShapingRulesRs{
+		DefaultRulesEnabled: types.BoolValue(true),
+		Rules:               &[]ResponseApplianceGetNetworkApplianceTrafficShapingRulesRulesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Appliance.UpdateNetworkApplianceTrafficShapingRules(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceTrafficShapingRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceTrafficShapingRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -374,6 +420,7 @@ type NetworksApplianceTrafficShapingRulesRs struct {
 	NetworkID           types.String                                                      `tfsdk:"network_id"`
 	DefaultRulesEnabled types.Bool                                                        `tfsdk:"default_rules_enabled"`
 	Rules               *[]ResponseApplianceGetNetworkApplianceTrafficShapingRulesRulesRs `tfsdk:"rules"`
+	ResetOnDestroy      types.Bool                                                        `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceTrafficShapingRulesRulesRs struct {
@@ -504,6 +551,9 @@ func (r *NetworksApplianceTrafficShapingRulesRs) toSdkApiRequestUpdate(ctx conte
 		DefaultRulesEnabled: defaultRulesEnabled,
 		Rules: func() *[]merakigosdk.RequestApplianceUpdateNetworkApplianceTrafficShapingRulesRules {
 			if len(requestApplianceUpdateNetworkApplianceTrafficShapingRulesRules) > 0 || r.Rules != nil {
+				if len(requestApplianceUpdateNetworkApplianceTrafficShapingRulesRules) == 0 {
+					requestApplianceUpdateNetworkApplianceTrafficShapingRulesRules = make([]merakigosdk.RequestApplianceUpdateNetworkApplianceTrafficShapingRulesRules, 0)
+				}
 				return &requestApplianceUpdateNetworkApplianceTrafficShapingRulesRules
 			}
 			return nil
@@ -0,0 +1,58 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNetworksApplianceTrafficShapingRulesResetOnDestroy(t *testing.T) {
+	ctx := context.Background()
+	mock := newMerakiMock(t, "traffic_shaping_rules")
+	r := &NetworksApplianceTrafficShapingRulesResource{client: mock.client(t)}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &NetworksApplianceTrafficShapingRulesRs{
+		NetworkID:           types.StringValue("N_1"),
+		DefaultRulesEnabled: types.BoolValue(false),
+		ResetOnDestroy:      types.BoolValue(true),
+	}); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	request, ok := mock.lastRequest("PUT", "/api/v1/networks/N_1/appliance/trafficShaping/rules")
+	if !ok {
+		t.Fatal("the traffic shaping rules were not reset")
+	}
+	want := map[string]interface{}{"defaultRulesEnabled": true, "rules": []interface{}{}}
+	if !reflect.DeepEqual(request.Body, want) {
+		t.Errorf("reset request = %v, want %v", request.Body, want)
+	}
+}
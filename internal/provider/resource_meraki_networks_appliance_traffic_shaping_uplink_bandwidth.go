@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceTrafficShapingUplinkBandwidthResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceTrafficShapingUplinkBandwidthResource{}
+	_ resource.Resource                = &NetworksApplianceTrafficShapingUplinkBandwidthResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceTrafficShapingUplinkBandwidthResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceTrafficShapingUplinkBandwidthResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceTrafficShapingUplinkBandwidthResource{}
 )
 
 func NewNetworksApplianceTrafficShapingUplinkBandwidthResource() resource.Resource {
@@ -43,7 +44,8 @@ func NewNetworksApplianceTrafficShapingUplinkBandwidthResource() resource.Resour
 }
 
 type NetworksApplianceTrafficShapingUplinkBandwidthResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceTrafficShapingUplinkBandwidthResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +54,7 @@ func (r *NetworksApplianceTrafficShapingUplinkBandwidthResource) Configure(ctx c
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -195,6 +198,7 @@ func (r *NetworksApplianceTrafficShapingUplinkBandwidthResource) Create(ctx cont
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -236,9 +240,14 @@ func (r *NetworksApplianceTrafficShapingUplinkBandwidthResource) Read(ctx contex
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceTrafficShapingUplinkBandwidthItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceTrafficShapingUplinkBandwidthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceTrafficShapingUplinkBandwidthResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceTrafficShapingUplinkBandwidthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -267,9 +276,15 @@ func (r *NetworksApplianceTrafficShapingUplinkBandwidthResource) Update(ctx cont
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceTrafficShapingUplinkBandwidthResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of NetworksApplianceTrafficShapingUplinkBandwidth not reset", "meraki_reset_on_destroy is set, but the uplink bandwidth limits have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceTrafficShapingUplinkBandwidth", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
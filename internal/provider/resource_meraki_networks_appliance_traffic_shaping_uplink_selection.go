@@ -26,7 +26,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -41,8 +40,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceTrafficShapingUplinkSelectionResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceTrafficShapingUplinkSelectionResource{}
+	_ resource.Resource                = &NetworksApplianceTrafficShapingUplinkSelectionResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceTrafficShapingUplinkSelectionResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceTrafficShapingUplinkSelectionResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceTrafficShapingUplinkSelectionResource{}
 )
 
 func NewNetworksApplianceTrafficShapingUplinkSelectionResource() resource.Resource {
@@ -50,7 +51,8 @@ func NewNetworksApplianceTrafficShapingUplinkSelectionResource() resource.Resour
 }
 
 type NetworksApplianceTrafficShapingUplinkSelectionResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceTrafficShapingUplinkSelectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -59,6 +61,7 @@ func (r *NetworksApplianceTrafficShapingUplinkSelectionResource) Configure(ctx c
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -128,6 +131,10 @@ func (r *NetworksApplianceTrafficShapingUplinkSelectionResource) Schema(_ contex
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"vpn_traffic_uplink_preferences": schema.ListNestedAttribute{
 				MarkdownDescription: `Uplink preference rules for VPN traffic`,
 				Optional:            true,
@@ -580,6 +587,7 @@ func (r *NetworksApplianceTrafficShapingUplinkSelectionResource) Create(ctx cont
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -621,9 +629,14 @@ func (r *NetworksApplianceTrafficShapingUplinkSelectionResource) Read(ctx contex
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceTrafficShapingUplinkSelectionItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceTrafficShapingUplinkSelectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceTrafficShapingUplinkSelectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceTrafficShapingUplinkSelectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -652,11 +665,52 @@ func (r *NetworksApplianceTrafficShapingUplinkSelectionResource) Update(ctx cont
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceTrafficShapingUplinkSelectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceTrafficShapingUplinkSelection", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceTrafficShapingUplinkSelectionRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceTrafficShapingUplinkSelection", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceTrafficShapingUplinkSelectionRs{
+		ActiveActiveAutoVpnEnabled: types.BoolValue(false),
+		DefaultUplink:              types.StringValue("wan1"),
+		FailoverAndFailback: &ResponseApplianceGetNetworkApplianceTrafficShapingUplinkSelectionFailoverAndFailbackRs{
+			Immediate: &ResponseApplianceGetNetworkApplianceTrafficShapingUplinkSelectionFailoverAndFailbackImmediateRs{
+				Enabled: types.BoolValue(true),
+			},
+		},
+		LoadBalancingEnabled:        types.BoolValue(false),
+		VpnTrafficUplinkPreferences: &[]ResponseApplianceGetNetworkApplianceTrafficShapingUplinkSelectionVpnTrafficUplinkPreferencesRs{},
+		WanTrafficUplinkPreferences: &[]ResponseApplianceGetNetworkApplianceTrafficShapingUplinkSelectionWanTrafficUplinkPreferencesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceTrafficShapingUplinkSelection(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceTrafficShapingUplinkSelection",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceTrafficShapingUplinkSelection",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -669,6 +723,7 @@ type NetworksApplianceTrafficShapingUplinkSelectionRs struct {
 	LoadBalancingEnabled        types.Bool                                                                                        `tfsdk:"load_balancing_enabled"`
 	VpnTrafficUplinkPreferences *[]ResponseApplianceGetNetworkApplianceTrafficShapingUplinkSelectionVpnTrafficUplinkPreferencesRs `tfsdk:"vpn_traffic_uplink_preferences"`
 	WanTrafficUplinkPreferences *[]ResponseApplianceGetNetworkApplianceTrafficShapingUplinkSelectionWanTrafficUplinkPreferencesRs `tfsdk:"wan_traffic_uplink_preferences"`
+	ResetOnDestroy              types.Bool                                                                                        `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceTrafficShapingUplinkSelectionFailoverAndFailbackRs struct {
@@ -999,13 +1054,19 @@ func (r *NetworksApplianceTrafficShapingUplinkSelectionRs) toSdkApiRequestUpdate
 		FailoverAndFailback:        requestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionFailoverAndFailback,
 		LoadBalancingEnabled:       loadBalancingEnabled,
 		VpnTrafficUplinkPreferences: func() *[]merakigosdk.RequestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionVpnTrafficUplinkPreferences {
-			if len(requestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionVpnTrafficUplinkPreferences) > 0 {
+			if len(requestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionVpnTrafficUplinkPreferences) > 0 || r.VpnTrafficUplinkPreferences != nil {
+				if len(requestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionVpnTrafficUplinkPreferences) == 0 {
+					requestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionVpnTrafficUplinkPreferences = make([]merakigosdk.RequestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionVpnTrafficUplinkPreferences, 0)
+				}
 				return &requestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionVpnTrafficUplinkPreferences
 			}
 			return nil
 		}(),
 		WanTrafficUplinkPreferences: func() *[]merakigosdk.RequestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionWanTrafficUplinkPreferences {
-			if len(requestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionWanTrafficUplinkPreferences) > 0 {
+			if len(requestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionWanTrafficUplinkPreferences) > 0 || r.WanTrafficUplinkPreferences != nil {
+				if len(requestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionWanTrafficUplinkPreferences) == 0 {
+					requestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionWanTrafficUplinkPreferences = make([]merakigosdk.RequestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionWanTrafficUplinkPreferences, 0)
+				}
 				return &requestApplianceUpdateNetworkApplianceTrafficShapingUplinkSelectionWanTrafficUplinkPreferences
 			}
 			return nil
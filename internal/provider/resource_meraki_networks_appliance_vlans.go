@@ -19,9 +19,7 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	"log"
 
@@ -44,8 +42,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceVLANsResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceVLANsResource{}
+	_ resource.Resource                = &NetworksApplianceVLANsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceVLANsResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceVLANsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceVLANsResource{}
 )
 
 func NewNetworksApplianceVLANsResource() resource.Resource {
@@ -53,15 +53,17 @@ func NewNetworksApplianceVLANsResource() resource.Resource {
 }
 
 type NetworksApplianceVLANsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	batcher *actionBatcher
 }
 
 func (r *NetworksApplianceVLANsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.batcher = providerData.Batcher
 }
 
 // Metadata returns the data source type name.
@@ -496,44 +498,74 @@ func (r *NetworksApplianceVLANsResource) Create(ctx context.Context, req resourc
 	if responseVerifyItem != nil {
 		data = ResponseApplianceGetNetworkApplianceVLANItemToBodyRs(data, responseVerifyItem, false)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Appliance.CreateNetworkApplianceVLAN(vvNetworkID, dataRequest)
+	dataRequestUp := data.toSdkApiRequestUpdate(ctx)
+	if r.batcher != nil {
+		if vvID == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Missing VLAN ID",
+				"The id of the VLAN must be set when meraki_batch_writes is enabled.",
+			)
+			return
+		}
+		// The VLAN is created and updated in the same action batch.
+		err := r.batcher.RunForNetwork(ctx, vvNetworkID, actionBatchAction{
+			Resource:  "/networks/" + vvNetworkID + "/appliance/vlans",
+			Operation: "create",
+			Body:      dataRequest,
+		}, actionBatchAction{
+			Resource:  "/networks/" + vvNetworkID + "/appliance/vlans/" + vvID,
+			Operation: "update",
+			Body:      dataRequestUp,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing CreateNetworkApplianceVLAN in an action batch",
+				err.Error(),
+			)
+			return
+		}
+	} else {
+		response, restyResp2, err := r.client.Appliance.CreateNetworkApplianceVLAN(vvNetworkID, dataRequest)
 
-	if err != nil || restyResp2 == nil || response == nil {
-		if restyResp2 != nil {
+		if err != nil || restyResp2 == nil || response == nil {
+			if restyResp2 != nil {
+				resp.Diagnostics.AddError(
+					"Failure when executing CreateNetworkApplianceVLAN",
+					"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+				)
+				return
+			}
 			resp.Diagnostics.AddError(
 				"Failure when executing CreateNetworkApplianceVLAN",
-				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+				err.Error(),
 			)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Failure when executing CreateNetworkApplianceVLAN",
-			err.Error(),
-		)
-		return
-	}
-	dataRequestUp := data.toSdkApiRequestUpdate(ctx)
-	responseU, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceVLAN(vvNetworkID, strconv.Itoa(*response.ID), dataRequestUp)
-	if err != nil || restyResp2 == nil || responseU == nil {
-		if restyResp2 != nil {
+		responseU, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceVLAN(vvNetworkID, strconv.Itoa(*response.ID), dataRequestUp)
+		if err != nil || restyResp2 == nil || responseU == nil {
+			if restyResp2 != nil {
+				resp.Diagnostics.AddError(
+					"Failure when executing CreateNetworkApplianceVLAN 2",
+					"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+				)
+				return
+			}
 			resp.Diagnostics.AddError(
 				"Failure when executing CreateNetworkApplianceVLAN 2",
-				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+				err.Error(),
 			)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Failure when executing CreateNetworkApplianceVLAN 2",
-			err.Error(),
-		)
-		return
+		vvID = strconv.Itoa(*response.ID)
 	}
 	//Items
-	responseGet, restyResp1, err := r.client.Appliance.GetNetworkApplianceVLAN(vvNetworkID, strconv.Itoa(*response.ID))
+	responseGet, restyResp1, err := r.client.Appliance.GetNetworkApplianceVLAN(vvNetworkID, vvID)
 	// Has item and has items
 
 	if err != nil || responseGet == nil {
@@ -553,6 +585,7 @@ func (r *NetworksApplianceVLANsResource) Create(ctx context.Context, req resourc
 	if responseGet != nil {
 		data = ResponseApplianceGetNetworkApplianceVLANItemToBodyRs(data, responseGet, false)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		if restyResp1 != nil {
@@ -618,20 +651,14 @@ func (r *NetworksApplianceVLANsResource) Read(ctx context.Context, req resource.
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceVLANsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "network_id", "id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+func (r *NetworksApplianceVLANsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "id")
 }
 
 func (r *NetworksApplianceVLANsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -649,6 +676,24 @@ func (r *NetworksApplianceVLANsResource) Update(ctx context.Context, req resourc
 	// network_id
 	vvVLANID := data.ID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
+	if r.batcher != nil {
+		err := r.batcher.RunForNetwork(ctx, vvNetworkID, actionBatchAction{
+			Resource:  "/networks/" + vvNetworkID + "/appliance/vlans/" + vvVLANID,
+			Operation: "update",
+			Body:      dataRequest,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceVLAN in an action batch",
+				err.Error(),
+			)
+			return
+		}
+		resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+		return
+	}
 	response, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceVLAN(vvNetworkID, vvVLANID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
@@ -667,6 +712,7 @@ func (r *NetworksApplianceVLANsResource) Update(ctx context.Context, req resourc
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceVLANsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -688,6 +734,19 @@ func (r *NetworksApplianceVLANsResource) Delete(ctx context.Context, req resourc
 
 	vvNetworkID := state.NetworkID.ValueString()
 	vvVLANID := state.ID.ValueString()
+	if r.batcher != nil {
+		err := r.batcher.RunForNetwork(ctx, vvNetworkID, actionBatchAction{
+			Resource:  "/networks/" + vvNetworkID + "/appliance/vlans/" + vvVLANID,
+			Operation: "destroy",
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing DeleteNetworkApplianceVLAN in an action batch", err.Error())
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	_, err := r.client.Appliance.DeleteNetworkApplianceVLAN(vvNetworkID, vvVLANID)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -1170,6 +1229,16 @@ func (r *NetworksApplianceVLANsRs) toSdkApiRequestUpdate(ctx context.Context) *m
 
 // From gosdk to TF Structs Schema
 func ResponseApplianceGetNetworkApplianceVLANItemToBodyRs(state NetworksApplianceVLANsRs, response *merakigosdk.ResponseApplianceGetNetworkApplianceVLAN, is_read bool) NetworksApplianceVLANsRs {
+	itemState := ResponseApplianceGetNetworkApplianceVLANItemToRs(state, response)
+	if is_read {
+		return mergeInterfacesOnlyPath(state, itemState).(NetworksApplianceVLANsRs)
+	}
+	return mergeInterfaces(state, itemState, true).(NetworksApplianceVLANsRs)
+}
+
+// ResponseApplianceGetNetworkApplianceVLANItemToRs converts the response of the API to the state of the resource,
+// without merging it with the current state.
+func ResponseApplianceGetNetworkApplianceVLANItemToRs(state NetworksApplianceVLANsRs, response *merakigosdk.ResponseApplianceGetNetworkApplianceVLAN) NetworksApplianceVLANsRs {
 	itemState := NetworksApplianceVLANsRs{
 		ApplianceIP: func() types.String {
 			if response.ApplianceIP != "" {
@@ -1396,8 +1465,5 @@ func ResponseApplianceGetNetworkApplianceVLANItemToBodyRs(state NetworksApplianc
 			return types.String{}
 		}(),
 	}
-	if is_read {
-		return mergeInterfacesOnlyPath(state, itemState).(NetworksApplianceVLANsRs)
-	}
-	return mergeInterfaces(state, itemState, true).(NetworksApplianceVLANsRs)
+	return itemState
 }
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -33,8 +32,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceVLANsSettingsResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceVLANsSettingsResource{}
+	_ resource.Resource                = &NetworksApplianceVLANsSettingsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceVLANsSettingsResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceVLANsSettingsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceVLANsSettingsResource{}
 )
 
 func NewNetworksApplianceVLANsSettingsResource() resource.Resource {
@@ -42,7 +43,8 @@ func NewNetworksApplianceVLANsSettingsResource() resource.Resource {
 }
 
 type NetworksApplianceVLANsSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceVLANsSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -51,6 +53,7 @@ func (r *NetworksApplianceVLANsSettingsResource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -65,6 +68,10 @@ func (r *NetworksApplianceVLANsSettingsResource) Schema(_ context.Context, _ res
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"vlans_enabled": schema.BoolAttribute{
 				MarkdownDescription: `Boolean indicating whether VLANs are enabled (true) or disabled (false) for the network`,
 				Optional:            true,
@@ -119,6 +126,7 @@ func (r *NetworksApplianceVLANsSettingsResource) Create(ctx context.Context, req
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -160,9 +168,14 @@ func (r *NetworksApplianceVLANsSettingsResource) Read(ctx context.Context, req r
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceVLANsSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceVLANsSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceVLANsSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceVLANsSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -191,18 +204,51 @@ func (r *NetworksApplianceVLANsSettingsResource) Update(ctx context.Context, req
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceVLANsSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceVLANsSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceVLANsSettingsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceVLANsSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceVLANsSettingsRs{
+		VLANsEnabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceVLANsSettings(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceVLANsSettings",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceVLANsSettings",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksApplianceVLANsSettingsRs struct {
-	NetworkID    types.String `tfsdk:"network_id"`
-	VLANsEnabled types.Bool   `tfsdk:"vlans_enabled"`
+	NetworkID      types.String `tfsdk:"network_id"`
+	VLANsEnabled   types.Bool   `tfsdk:"vlans_enabled"`
+	ResetOnDestroy types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceVpnBgpResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceVpnBgpResource{}
+	_ resource.Resource                = &NetworksApplianceVpnBgpResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceVpnBgpResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceVpnBgpResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceVpnBgpResource{}
 )
 
 func NewNetworksApplianceVpnBgpResource() resource.Resource {
@@ -46,7 +47,8 @@ func NewNetworksApplianceVpnBgpResource() resource.Resource {
 }
 
 type NetworksApplianceVpnBgpResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceVpnBgpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +57,7 @@ func (r *NetworksApplianceVpnBgpResource) Configure(ctx context.Context, req res
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -210,6 +213,10 @@ func (r *NetworksApplianceVpnBgpResource) Schema(_ context.Context, _ resource.S
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -257,6 +264,7 @@ func (r *NetworksApplianceVpnBgpResource) Create(ctx context.Context, req resour
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -298,9 +306,14 @@ func (r *NetworksApplianceVpnBgpResource) Read(ctx context.Context, req resource
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceVpnBgpItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceVpnBgpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceVpnBgpResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceVpnBgpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -329,21 +342,56 @@ func (r *NetworksApplianceVpnBgpResource) Update(ctx context.Context, req resour
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceVpnBgpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceVpnBgp", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceVpnBgpRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceVpnBgp", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceVpnBgpRs{
+		AsNumber:      types.Int64Value(64512),
+		Enabled:       types.BoolValue(false),
+		IbgpHoldTimer: types.Int64Value(240),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceVpnBgp(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceVpnBgp",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceVpnBgp",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksApplianceVpnBgpRs struct {
-	NetworkID     types.String                                             `tfsdk:"network_id"`
-	AsNumber      types.Int64                                              `tfsdk:"as_number"`
-	Enabled       types.Bool                                               `tfsdk:"enabled"`
-	IbgpHoldTimer types.Int64                                              `tfsdk:"ibgp_hold_timer"`
-	Neighbors     *[]ResponseApplianceGetNetworkApplianceVpnBgpNeighborsRs `tfsdk:"neighbors"`
+	NetworkID      types.String                                             `tfsdk:"network_id"`
+	AsNumber       types.Int64                                              `tfsdk:"as_number"`
+	Enabled        types.Bool                                               `tfsdk:"enabled"`
+	IbgpHoldTimer  types.Int64                                              `tfsdk:"ibgp_hold_timer"`
+	Neighbors      *[]ResponseApplianceGetNetworkApplianceVpnBgpNeighborsRs `tfsdk:"neighbors"`
+	ResetOnDestroy types.Bool                                               `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceVpnBgpNeighborsRs struct {
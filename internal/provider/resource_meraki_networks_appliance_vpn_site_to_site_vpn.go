@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -38,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceVpnSiteToSiteVpnResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceVpnSiteToSiteVpnResource{}
+	_ resource.Resource                = &NetworksApplianceVpnSiteToSiteVpnResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceVpnSiteToSiteVpnResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceVpnSiteToSiteVpnResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceVpnSiteToSiteVpnResource{}
 )
 
 func NewNetworksApplianceVpnSiteToSiteVpnResource() resource.Resource {
@@ -47,7 +48,8 @@ func NewNetworksApplianceVpnSiteToSiteVpnResource() resource.Resource {
 }
 
 type NetworksApplianceVpnSiteToSiteVpnResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceVpnSiteToSiteVpnResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,6 +58,7 @@ func (r *NetworksApplianceVpnSiteToSiteVpnResource) Configure(ctx context.Contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -111,6 +114,10 @@ func (r *NetworksApplianceVpnSiteToSiteVpnResource) Schema(_ context.Context, _
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"subnet": schema.SingleNestedAttribute{
 				MarkdownDescription: `Configuration of subnet features`,
 				Optional:            true,
@@ -235,6 +242,7 @@ func (r *NetworksApplianceVpnSiteToSiteVpnResource) Create(ctx context.Context,
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -276,9 +284,14 @@ func (r *NetworksApplianceVpnSiteToSiteVpnResource) Read(ctx context.Context, re
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceVpnSiteToSiteVpnItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceVpnSiteToSiteVpnResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceVpnSiteToSiteVpnResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceVpnSiteToSiteVpnResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -307,21 +320,54 @@ func (r *NetworksApplianceVpnSiteToSiteVpnResource) Update(ctx context.Context,
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceVpnSiteToSiteVpnResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceVpnSiteToSiteVpn", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceVpnSiteToSiteVpnRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceVpnSiteToSiteVpn", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceVpnSiteToSiteVpnRs{
+		Mode: types.StringValue("none"),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceVpnSiteToSiteVpn(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceVpnSiteToSiteVpn",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceVpnSiteToSiteVpn",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksApplianceVpnSiteToSiteVpnRs struct {
-	NetworkID types.String                                                     `tfsdk:"network_id"`
-	Hubs      *[]ResponseApplianceGetNetworkApplianceVpnSiteToSiteVpnHubsRs    `tfsdk:"hubs"`
-	Mode      types.String                                                     `tfsdk:"mode"`
-	Subnet    *ResponseApplianceGetNetworkApplianceVpnSiteToSiteVpnSubnetRs    `tfsdk:"subnet"`
-	Subnets   *[]ResponseApplianceGetNetworkApplianceVpnSiteToSiteVpnSubnetsRs `tfsdk:"subnets"`
+	NetworkID      types.String                                                     `tfsdk:"network_id"`
+	Hubs           *[]ResponseApplianceGetNetworkApplianceVpnSiteToSiteVpnHubsRs    `tfsdk:"hubs"`
+	Mode           types.String                                                     `tfsdk:"mode"`
+	Subnet         *ResponseApplianceGetNetworkApplianceVpnSiteToSiteVpnSubnetRs    `tfsdk:"subnet"`
+	Subnets        *[]ResponseApplianceGetNetworkApplianceVpnSiteToSiteVpnSubnetsRs `tfsdk:"subnets"`
+	ResetOnDestroy types.Bool                                                       `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceVpnSiteToSiteVpnHubsRs struct {
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksApplianceWarmSpareResource{}
-	_ resource.ResourceWithConfigure = &NetworksApplianceWarmSpareResource{}
+	_ resource.Resource                = &NetworksApplianceWarmSpareResource{}
+	_ resource.ResourceWithConfigure   = &NetworksApplianceWarmSpareResource{}
+	_ resource.ResourceWithImportState = &NetworksApplianceWarmSpareResource{}
+	_ resource.ResourceWithIdentity    = &NetworksApplianceWarmSpareResource{}
 )
 
 func NewNetworksApplianceWarmSpareResource() resource.Resource {
@@ -43,7 +44,8 @@ func NewNetworksApplianceWarmSpareResource() resource.Resource {
 }
 
 type NetworksApplianceWarmSpareResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksApplianceWarmSpareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +54,7 @@ func (r *NetworksApplianceWarmSpareResource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -77,6 +80,10 @@ func (r *NetworksApplianceWarmSpareResource) Schema(_ context.Context, _ resourc
 				MarkdownDescription: `Serial number of the primary appliance`,
 				Computed:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"spare_serial": schema.StringAttribute{
 				MarkdownDescription: `Serial number of the warm spare appliance`,
 				Optional:            true,
@@ -182,6 +189,7 @@ func (r *NetworksApplianceWarmSpareResource) Create(ctx context.Context, req res
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -223,9 +231,14 @@ func (r *NetworksApplianceWarmSpareResource) Read(ctx context.Context, req resou
 	//entro aqui 2
 	data = ResponseApplianceGetNetworkApplianceWarmSpareItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksApplianceWarmSpareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksApplianceWarmSpareResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksApplianceWarmSpareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -254,25 +267,58 @@ func (r *NetworksApplianceWarmSpareResource) Update(ctx context.Context, req res
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksApplianceWarmSpareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksApplianceWarmSpare", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksApplianceWarmSpareRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksApplianceWarmSpare", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksApplianceWarmSpareRs{
+		Enabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Appliance.UpdateNetworkApplianceWarmSpare(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkApplianceWarmSpare",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkApplianceWarmSpare",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksApplianceWarmSpareRs struct {
-	NetworkID     types.String                                         `tfsdk:"network_id"`
-	Enabled       types.Bool                                           `tfsdk:"enabled"`
-	PrimarySerial types.String                                         `tfsdk:"primary_serial"`
-	SpareSerial   types.String                                         `tfsdk:"spare_serial"`
-	UplinkMode    types.String                                         `tfsdk:"uplink_mode"`
-	Wan1          *ResponseApplianceGetNetworkApplianceWarmSpareWan1Rs `tfsdk:"wan1"`
-	Wan2          *ResponseApplianceGetNetworkApplianceWarmSpareWan2Rs `tfsdk:"wan2"`
-	VirtualIP1    types.String                                         `tfsdk:"virtual_ip1"`
-	VirtualIP2    types.String                                         `tfsdk:"virtual_ip2"`
+	NetworkID      types.String                                         `tfsdk:"network_id"`
+	Enabled        types.Bool                                           `tfsdk:"enabled"`
+	PrimarySerial  types.String                                         `tfsdk:"primary_serial"`
+	SpareSerial    types.String                                         `tfsdk:"spare_serial"`
+	UplinkMode     types.String                                         `tfsdk:"uplink_mode"`
+	Wan1           *ResponseApplianceGetNetworkApplianceWarmSpareWan1Rs `tfsdk:"wan1"`
+	Wan2           *ResponseApplianceGetNetworkApplianceWarmSpareWan2Rs `tfsdk:"wan2"`
+	VirtualIP1     types.String                                         `tfsdk:"virtual_ip1"`
+	VirtualIP2     types.String                                         `tfsdk:"virtual_ip2"`
+	ResetOnDestroy types.Bool                                           `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetNetworkApplianceWarmSpareWan1Rs struct {
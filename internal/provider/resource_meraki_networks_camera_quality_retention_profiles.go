@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksCameraQualityRetentionProfilesResource{}
-	_ resource.ResourceWithConfigure = &NetworksCameraQualityRetentionProfilesResource{}
+	_ resource.Resource                = &NetworksCameraQualityRetentionProfilesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksCameraQualityRetentionProfilesResource{}
+	_ resource.ResourceWithImportState = &NetworksCameraQualityRetentionProfilesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksCameraQualityRetentionProfilesResource{}
 )
 
 func NewNetworksCameraQualityRetentionProfilesResource() resource.Resource {
@@ -1183,6 +1182,7 @@ func (r *NetworksCameraQualityRetentionProfilesResource) Create(ctx context.Cont
 				data = ResponseCameraGetNetworkCameraQualityRetentionProfileItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -1238,6 +1238,7 @@ func (r *NetworksCameraQualityRetentionProfilesResource) Create(ctx context.Cont
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseCameraGetNetworkCameraQualityRetentionProfileItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -1302,19 +1303,14 @@ func (r *NetworksCameraQualityRetentionProfilesResource) Read(ctx context.Contex
 	//entro aqui 2
 	data = ResponseCameraGetNetworkCameraQualityRetentionProfileItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksCameraQualityRetentionProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "quality_retention_profile_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,qualityRetentionProfileId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("quality_retention_profile_id"), idParts[1])...)
+func (r *NetworksCameraQualityRetentionProfilesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "quality_retention_profile_id")
 }
 
 func (r *NetworksCameraQualityRetentionProfilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -1344,6 +1340,7 @@ func (r *NetworksCameraQualityRetentionProfilesResource) Update(ctx context.Cont
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksCameraQualityRetentionProfilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -38,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksCameraWirelessProfilesResource{}
-	_ resource.ResourceWithConfigure = &NetworksCameraWirelessProfilesResource{}
+	_ resource.Resource                = &NetworksCameraWirelessProfilesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksCameraWirelessProfilesResource{}
+	_ resource.ResourceWithImportState = &NetworksCameraWirelessProfilesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksCameraWirelessProfilesResource{}
 )
 
 func NewNetworksCameraWirelessProfilesResource() resource.Resource {
@@ -148,6 +147,7 @@ func (r *NetworksCameraWirelessProfilesResource) Schema(_ context.Context, _ res
 					},
 					"psk": schema.StringAttribute{
 						MarkdownDescription: `The pre-shared key of the SSID, if mode is PSK`,
+						Sensitive:           true,
 						Optional:            true,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
@@ -226,6 +226,7 @@ func (r *NetworksCameraWirelessProfilesResource) Create(ctx context.Context, req
 				data = ResponseCameraGetNetworkCameraWirelessProfileItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -281,6 +282,7 @@ func (r *NetworksCameraWirelessProfilesResource) Create(ctx context.Context, req
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseCameraGetNetworkCameraWirelessProfileItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -345,19 +347,14 @@ func (r *NetworksCameraWirelessProfilesResource) Read(ctx context.Context, req r
 	//entro aqui 2
 	data = ResponseCameraGetNetworkCameraWirelessProfileItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksCameraWirelessProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "wireless_profile_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,wirelessProfileId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wireless_profile_id"), idParts[1])...)
+func (r *NetworksCameraWirelessProfilesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "wireless_profile_id")
 }
 
 func (r *NetworksCameraWirelessProfilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -387,6 +384,7 @@ func (r *NetworksCameraWirelessProfilesResource) Update(ctx context.Context, req
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksCameraWirelessProfilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -35,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksCellularGatewayConnectivityMonitoringDestinationsResource{}
-	_ resource.ResourceWithConfigure = &NetworksCellularGatewayConnectivityMonitoringDestinationsResource{}
+	_ resource.Resource                = &NetworksCellularGatewayConnectivityMonitoringDestinationsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksCellularGatewayConnectivityMonitoringDestinationsResource{}
+	_ resource.ResourceWithImportState = &NetworksCellularGatewayConnectivityMonitoringDestinationsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksCellularGatewayConnectivityMonitoringDestinationsResource{}
 )
 
 func NewNetworksCellularGatewayConnectivityMonitoringDestinationsResource() resource.Resource {
@@ -44,7 +45,8 @@ func NewNetworksCellularGatewayConnectivityMonitoringDestinationsResource() reso
 }
 
 type NetworksCellularGatewayConnectivityMonitoringDestinationsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksCellularGatewayConnectivityMonitoringDestinationsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +55,7 @@ func (r *NetworksCellularGatewayConnectivityMonitoringDestinationsResource) Conf
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -100,6 +103,10 @@ func (r *NetworksCellularGatewayConnectivityMonitoringDestinationsResource) Sche
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -147,6 +154,7 @@ func (r *NetworksCellularGatewayConnectivityMonitoringDestinationsResource) Crea
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -188,9 +196,14 @@ func (r *NetworksCellularGatewayConnectivityMonitoringDestinationsResource) Read
 	//entro aqui 2
 	data = ResponseCellularGatewayGetNetworkCellularGatewayConnectivityMonitoringDestinationsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksCellularGatewayConnectivityMonitoringDestinationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksCellularGatewayConnectivityMonitoringDestinationsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksCellularGatewayConnectivityMonitoringDestinationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -219,18 +232,57 @@ func (r *NetworksCellularGatewayConnectivityMonitoringDestinationsResource) Upda
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksCellularGatewayConnectivityMonitoringDestinationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksCellularGatewayConnectivityMonitoringDestinations", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksCellularGatewayConnectivityMonitoringDestinationsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksCellularGatewayConnectivityMonitoringDestinations", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksCellularGatewayConnectivityMonitoringDestinationsRs{
+		Destinations: &[]ResponseCellularGatewayGetNetworkCellularGatewayConnectivityMonitoringDestinationsDestinationsRs{
+			{
+				Default:     types.BoolValue(true),
+				Description: types.StringValue("Google"),
+				IP:          types.StringValue("8.8.8.8"),
+			},
+		},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.CellularGateway.UpdateNetworkCellularGatewayConnectivityMonitoringDestinations(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkCellularGatewayConnectivityMonitoringDestinations",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkCellularGatewayConnectivityMonitoringDestinations",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksCellularGatewayConnectivityMonitoringDestinationsRs struct {
-	NetworkID    types.String                                                                                        `tfsdk:"network_id"`
-	Destinations *[]ResponseCellularGatewayGetNetworkCellularGatewayConnectivityMonitoringDestinationsDestinationsRs `tfsdk:"destinations"`
+	NetworkID      types.String                                                                                        `tfsdk:"network_id"`
+	Destinations   *[]ResponseCellularGatewayGetNetworkCellularGatewayConnectivityMonitoringDestinationsDestinationsRs `tfsdk:"destinations"`
+	ResetOnDestroy types.Bool                                                                                          `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseCellularGatewayGetNetworkCellularGatewayConnectivityMonitoringDestinationsDestinationsRs struct {
@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksCellularGatewayDhcpResource{}
-	_ resource.ResourceWithConfigure = &NetworksCellularGatewayDhcpResource{}
+	_ resource.Resource                = &NetworksCellularGatewayDhcpResource{}
+	_ resource.ResourceWithConfigure   = &NetworksCellularGatewayDhcpResource{}
+	_ resource.ResourceWithImportState = &NetworksCellularGatewayDhcpResource{}
+	_ resource.ResourceWithIdentity    = &NetworksCellularGatewayDhcpResource{}
 )
 
 func NewNetworksCellularGatewayDhcpResource() resource.Resource {
@@ -46,7 +47,8 @@ func NewNetworksCellularGatewayDhcpResource() resource.Resource {
 }
 
 type NetworksCellularGatewayDhcpResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksCellularGatewayDhcpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +57,7 @@ func (r *NetworksCellularGatewayDhcpResource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -114,6 +117,10 @@ func (r *NetworksCellularGatewayDhcpResource) Schema(_ context.Context, _ resour
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -161,6 +168,7 @@ func (r *NetworksCellularGatewayDhcpResource) Create(ctx context.Context, req re
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -202,9 +210,14 @@ func (r *NetworksCellularGatewayDhcpResource) Read(ctx context.Context, req reso
 	//entro aqui 2
 	data = ResponseCellularGatewayGetNetworkCellularGatewayDhcpItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksCellularGatewayDhcpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksCellularGatewayDhcpResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksCellularGatewayDhcpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -233,11 +246,44 @@ func (r *NetworksCellularGatewayDhcpResource) Update(ctx context.Context, req re
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksCellularGatewayDhcpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksCellularGatewayDhcp", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksCellularGatewayDhcpRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksCellularGatewayDhcp", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksCellularGatewayDhcpRs{
+		DhcpLeaseTime:  types.StringValue("1 day"),
+		DNSNameservers: types.StringValue("upstream_dns"),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.CellularGateway.UpdateNetworkCellularGatewayDhcp(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkCellularGatewayDhcp",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkCellularGatewayDhcp",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -247,6 +293,7 @@ type NetworksCellularGatewayDhcpRs struct {
 	DhcpLeaseTime        types.String `tfsdk:"dhcp_lease_time"`
 	DNSCustomNameservers types.List   `tfsdk:"dns_custom_nameservers"`
 	DNSNameservers       types.String `tfsdk:"dns_nameservers"`
+	ResetOnDestroy       types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
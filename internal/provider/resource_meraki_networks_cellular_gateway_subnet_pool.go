@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksCellularGatewaySubnetPoolResource{}
-	_ resource.ResourceWithConfigure = &NetworksCellularGatewaySubnetPoolResource{}
+	_ resource.Resource                = &NetworksCellularGatewaySubnetPoolResource{}
+	_ resource.ResourceWithConfigure   = &NetworksCellularGatewaySubnetPoolResource{}
+	_ resource.ResourceWithImportState = &NetworksCellularGatewaySubnetPoolResource{}
+	_ resource.ResourceWithIdentity    = &NetworksCellularGatewaySubnetPoolResource{}
 )
 
 func NewNetworksCellularGatewaySubnetPoolResource() resource.Resource {
@@ -43,7 +44,8 @@ func NewNetworksCellularGatewaySubnetPoolResource() resource.Resource {
 }
 
 type NetworksCellularGatewaySubnetPoolResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksCellularGatewaySubnetPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +54,7 @@ func (r *NetworksCellularGatewaySubnetPoolResource) Configure(ctx context.Contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -156,6 +159,7 @@ func (r *NetworksCellularGatewaySubnetPoolResource) Create(ctx context.Context,
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -197,9 +201,14 @@ func (r *NetworksCellularGatewaySubnetPoolResource) Read(ctx context.Context, re
 	//entro aqui 2
 	data = ResponseCellularGatewayGetNetworkCellularGatewaySubnetPoolItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksCellularGatewaySubnetPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksCellularGatewaySubnetPoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksCellularGatewaySubnetPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -228,9 +237,15 @@ func (r *NetworksCellularGatewaySubnetPoolResource) Update(ctx context.Context,
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksCellularGatewaySubnetPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of NetworksCellularGatewaySubnetPool not reset", "meraki_reset_on_destroy is set, but the subnet pool of the cellular gateways have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting NetworksCellularGatewaySubnetPool", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksCellularGatewayUplinkResource{}
-	_ resource.ResourceWithConfigure = &NetworksCellularGatewayUplinkResource{}
+	_ resource.Resource                = &NetworksCellularGatewayUplinkResource{}
+	_ resource.ResourceWithConfigure   = &NetworksCellularGatewayUplinkResource{}
+	_ resource.ResourceWithImportState = &NetworksCellularGatewayUplinkResource{}
+	_ resource.ResourceWithIdentity    = &NetworksCellularGatewayUplinkResource{}
 )
 
 func NewNetworksCellularGatewayUplinkResource() resource.Resource {
@@ -43,7 +44,8 @@ func NewNetworksCellularGatewayUplinkResource() resource.Resource {
 }
 
 type NetworksCellularGatewayUplinkResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksCellularGatewayUplinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +54,7 @@ func (r *NetworksCellularGatewayUplinkResource) Configure(ctx context.Context, r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -137,6 +140,7 @@ func (r *NetworksCellularGatewayUplinkResource) Create(ctx context.Context, req
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -178,9 +182,14 @@ func (r *NetworksCellularGatewayUplinkResource) Read(ctx context.Context, req re
 	//entro aqui 2
 	data = ResponseCellularGatewayGetNetworkCellularGatewayUplinkItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksCellularGatewayUplinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksCellularGatewayUplinkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksCellularGatewayUplinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -209,9 +218,15 @@ func (r *NetworksCellularGatewayUplinkResource) Update(ctx context.Context, req
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksCellularGatewayUplinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of NetworksCellularGatewayUplink not reset", "meraki_reset_on_destroy is set, but the uplink bandwidth limits have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting NetworksCellularGatewayUplink", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -35,8 +32,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksClientsPolicyResource{}
-	_ resource.ResourceWithConfigure = &NetworksClientsPolicyResource{}
+	_ resource.Resource                = &NetworksClientsPolicyResource{}
+	_ resource.ResourceWithConfigure   = &NetworksClientsPolicyResource{}
+	_ resource.ResourceWithImportState = &NetworksClientsPolicyResource{}
+	_ resource.ResourceWithIdentity    = &NetworksClientsPolicyResource{}
 )
 
 func NewNetworksClientsPolicyResource() resource.Resource {
@@ -44,7 +43,8 @@ func NewNetworksClientsPolicyResource() resource.Resource {
 }
 
 type NetworksClientsPolicyResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksClientsPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +53,7 @@ func (r *NetworksClientsPolicyResource) Configure(ctx context.Context, req resou
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -89,6 +90,10 @@ func (r *NetworksClientsPolicyResource) Schema(_ context.Context, _ resource.Sch
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -137,6 +142,7 @@ func (r *NetworksClientsPolicyResource) Create(ctx context.Context, req resource
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -179,19 +185,14 @@ func (r *NetworksClientsPolicyResource) Read(ctx context.Context, req resource.R
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkClientPolicyItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksClientsPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "client_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,clientId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), idParts[1])...)
+func (r *NetworksClientsPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "client_id")
 }
 
 func (r *NetworksClientsPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -221,21 +222,55 @@ func (r *NetworksClientsPolicyResource) Update(ctx context.Context, req resource
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksClientsPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksClientsPolicy", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksClientsPolicyRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksClientsPolicy", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvClientID := state.ClientID.ValueString()
+	// Meraki defaults
+	defaults := NetworksClientsPolicyRs{
+		DevicePolicy: types.StringValue("Normal"),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Networks.UpdateNetworkClientPolicy(vvNetworkID, vvClientID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkClientPolicy",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkClientPolicy",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksClientsPolicyRs struct {
-	NetworkID     types.String `tfsdk:"network_id"`
-	ClientID      types.String `tfsdk:"client_id"`
-	DevicePolicy  types.String `tfsdk:"device_policy"`
-	GroupPolicyID types.String `tfsdk:"group_policy_id"`
-	Mac           types.String `tfsdk:"mac"`
+	NetworkID      types.String `tfsdk:"network_id"`
+	ClientID       types.String `tfsdk:"client_id"`
+	DevicePolicy   types.String `tfsdk:"device_policy"`
+	GroupPolicyID  types.String `tfsdk:"group_policy_id"`
+	Mac            types.String `tfsdk:"mac"`
+	ResetOnDestroy types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
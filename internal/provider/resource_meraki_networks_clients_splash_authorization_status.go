@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -36,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksClientsSplashAuthorizationStatusResource{}
-	_ resource.ResourceWithConfigure = &NetworksClientsSplashAuthorizationStatusResource{}
+	_ resource.Resource                = &NetworksClientsSplashAuthorizationStatusResource{}
+	_ resource.ResourceWithConfigure   = &NetworksClientsSplashAuthorizationStatusResource{}
+	_ resource.ResourceWithImportState = &NetworksClientsSplashAuthorizationStatusResource{}
+	_ resource.ResourceWithIdentity    = &NetworksClientsSplashAuthorizationStatusResource{}
 )
 
 func NewNetworksClientsSplashAuthorizationStatusResource() resource.Resource {
@@ -45,7 +44,8 @@ func NewNetworksClientsSplashAuthorizationStatusResource() resource.Resource {
 }
 
 type NetworksClientsSplashAuthorizationStatusResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksClientsSplashAuthorizationStatusResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -54,6 +54,7 @@ func (r *NetworksClientsSplashAuthorizationStatusResource) Configure(ctx context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -391,6 +392,7 @@ func (r *NetworksClientsSplashAuthorizationStatusResource) Create(ctx context.Co
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -433,19 +435,14 @@ func (r *NetworksClientsSplashAuthorizationStatusResource) Read(ctx context.Cont
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkClientSplashAuthorizationStatusItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksClientsSplashAuthorizationStatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "client_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,clientId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), idParts[1])...)
+func (r *NetworksClientsSplashAuthorizationStatusResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "client_id")
 }
 
 func (r *NetworksClientsSplashAuthorizationStatusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -475,9 +472,15 @@ func (r *NetworksClientsSplashAuthorizationStatusResource) Update(ctx context.Co
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksClientsSplashAuthorizationStatusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of NetworksClientsSplashAuthorizationStatus not reset", "meraki_reset_on_destroy is set, but the splash authorizations of a client have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting NetworksClientsSplashAuthorizationStatus", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
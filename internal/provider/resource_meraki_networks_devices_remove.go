@@ -20,6 +20,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
@@ -32,8 +33,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksDevicesRemoveResource{}
-	_ resource.ResourceWithConfigure = &NetworksDevicesRemoveResource{}
+	_ resource.Resource               = &NetworksDevicesRemoveResource{}
+	_ resource.ResourceWithConfigure  = &NetworksDevicesRemoveResource{}
+	_ resource.ResourceWithModifyPlan = &NetworksDevicesRemoveResource{}
 )
 
 func NewNetworksDevicesRemoveResource() resource.Resource {
@@ -41,7 +43,8 @@ func NewNetworksDevicesRemoveResource() resource.Resource {
 }
 
 type NetworksDevicesRemoveResource struct {
-	client *merakigosdk.Client
+	client                     *merakigosdk.Client
+	allowDestructiveOperations bool
 }
 
 func (r *NetworksDevicesRemoveResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +53,7 @@ func (r *NetworksDevicesRemoveResource) Configure(ctx context.Context, req resou
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.allowDestructiveOperations = req.ProviderData.(MerakiProviderData).AllowDestructiveOperations
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,7 @@ func (r *NetworksDevicesRemoveResource) Metadata(_ context.Context, req resource
 func (r *NetworksDevicesRemoveResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"confirm_target": confirmTargetAttribute("the `serial` of the removed device"),
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
@@ -84,6 +89,20 @@ func (r *NetworksDevicesRemoveResource) Schema(_ context.Context, _ resource.Sch
 		},
 	}
 }
+
+// ModifyPlan checks confirm_target and warns about the removed device when the resource is created.
+func (r *NetworksDevicesRemoveResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+	var config NetworksDevicesRemove
+	resp.Diagnostics.Append(destructiveConfig(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(planDestructiveOperation(config.ConfirmTarget, config.destructiveOperation(ctx), r.allowDestructiveOperations, r.client != nil)...)
+}
+
 func (r *NetworksDevicesRemoveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var data NetworksDevicesRemove
@@ -99,6 +118,15 @@ func (r *NetworksDevicesRemoveResource) Create(ctx context.Context, req resource
 		UnhandledUnknownAsEmpty: true,
 	})...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config NetworksDevicesRemove
+	resp.Diagnostics.Append(destructiveConfig(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkDestructiveOperation(config.ConfirmTarget, config.destructiveOperation(ctx), r.allowDestructiveOperations)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -133,7 +161,7 @@ func (r *NetworksDevicesRemoveResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *NetworksDevicesRemoveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// resp.Diagnostics.AddWarning("Error Update Resource", "This resource has no update method in the meraki lab, the resource was deleted only in terraform.")
+	updateConfirmTarget(ctx, req, resp)
 }
 
 func (r *NetworksDevicesRemoveResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -143,8 +171,9 @@ func (r *NetworksDevicesRemoveResource) Delete(ctx context.Context, req resource
 
 // TF Structs Schema
 type NetworksDevicesRemove struct {
-	NetworkID  types.String                           `tfsdk:"network_id"`
-	Parameters *RequestNetworksRemoveNetworkDevicesRs `tfsdk:"parameters"`
+	NetworkID     types.String                           `tfsdk:"network_id"`
+	ConfirmTarget types.String                           `tfsdk:"confirm_target"`
+	Parameters    *RequestNetworksRemoveNetworkDevicesRs `tfsdk:"parameters"`
 }
 
 type RequestNetworksRemoveNetworkDevicesRs struct {
@@ -166,3 +195,16 @@ func (r *NetworksDevicesRemove) toSdkApiRequestCreate(ctx context.Context) *mera
 	}
 	return &out
 }
+
+// destructiveOperation returns the removal of the device from its network.
+func (r *NetworksDevicesRemove) destructiveOperation(ctx context.Context) destructiveOperation {
+	serial := types.StringNull()
+	if r.Parameters != nil {
+		serial = r.Parameters.Serial
+	}
+	return destructiveOperation{
+		Action:     fmt.Sprintf("remove the device %s from network %s", destructiveValue(serial), destructiveValue(r.NetworkID)),
+		Target:     serial,
+		TargetKind: "the serial",
+	}
+}
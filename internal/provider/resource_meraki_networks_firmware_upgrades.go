@@ -25,7 +25,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -39,8 +38,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksFirmwareUpgradesResource{}
-	_ resource.ResourceWithConfigure = &NetworksFirmwareUpgradesResource{}
+	_ resource.Resource                = &NetworksFirmwareUpgradesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksFirmwareUpgradesResource{}
+	_ resource.ResourceWithImportState = &NetworksFirmwareUpgradesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksFirmwareUpgradesResource{}
 )
 
 func NewNetworksFirmwareUpgradesResource() resource.Resource {
@@ -48,7 +49,8 @@ func NewNetworksFirmwareUpgradesResource() resource.Resource {
 }
 
 type NetworksFirmwareUpgradesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksFirmwareUpgradesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,6 +59,7 @@ func (r *NetworksFirmwareUpgradesResource) Configure(ctx context.Context, req re
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -1174,6 +1177,7 @@ func (r *NetworksFirmwareUpgradesResource) Create(ctx context.Context, req resou
 	// Assign data
 	data = ResponseNetworksGetNetworkFirmwareUpgradesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -1215,9 +1219,14 @@ func (r *NetworksFirmwareUpgradesResource) Read(ctx context.Context, req resourc
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkFirmwareUpgradesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksFirmwareUpgradesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksFirmwareUpgradesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksFirmwareUpgradesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -1246,9 +1255,15 @@ func (r *NetworksFirmwareUpgradesResource) Update(ctx context.Context, req resou
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksFirmwareUpgradesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of NetworksFirmwareUpgrades not reset", "meraki_reset_on_destroy is set, but the firmware upgrade window and versions have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting NetworksFirmwareUpgrades", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
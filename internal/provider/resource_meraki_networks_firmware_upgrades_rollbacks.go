@@ -60,6 +60,7 @@ func (r *NetworksFirmwareUpgradesRollbacksResource) Metadata(_ context.Context,
 // resourceAction
 func (r *NetworksFirmwareUpgradesRollbacksResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		DeprecationMessage: "Use the `meraki_network_firmware_rollback` action instead, which runs the operation on a lifecycle event or with `terraform apply -invoke` and keeps no state. This resource will be removed in the next major release.",
 		Attributes: map[string]schema.Attribute{
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId path parameter. Network ID`,
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -35,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksFirmwareUpgradesStagedEventsResource{}
-	_ resource.ResourceWithConfigure = &NetworksFirmwareUpgradesStagedEventsResource{}
+	_ resource.Resource                = &NetworksFirmwareUpgradesStagedEventsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksFirmwareUpgradesStagedEventsResource{}
+	_ resource.ResourceWithImportState = &NetworksFirmwareUpgradesStagedEventsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksFirmwareUpgradesStagedEventsResource{}
 )
 
 func NewNetworksFirmwareUpgradesStagedEventsResource() resource.Resource {
@@ -44,7 +45,8 @@ func NewNetworksFirmwareUpgradesStagedEventsResource() resource.Resource {
 }
 
 type NetworksFirmwareUpgradesStagedEventsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksFirmwareUpgradesStagedEventsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +55,7 @@ func (r *NetworksFirmwareUpgradesStagedEventsResource) Configure(ctx context.Con
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -306,6 +309,7 @@ func (r *NetworksFirmwareUpgradesStagedEventsResource) Create(ctx context.Contex
 			data = ResponseNetworksGetNetworkFirmwareUpgradesStagedEventsItemToBodyRs(data, responseVerifyItem, false)
 			//Path params in update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
@@ -349,6 +353,7 @@ func (r *NetworksFirmwareUpgradesStagedEventsResource) Create(ctx context.Contex
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -402,9 +407,14 @@ func (r *NetworksFirmwareUpgradesStagedEventsResource) Read(ctx context.Context,
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksFirmwareUpgradesStagedEventsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksFirmwareUpgradesStagedEventsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksFirmwareUpgradesStagedEventsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -438,9 +448,15 @@ func (r *NetworksFirmwareUpgradesStagedEventsResource) Update(ctx context.Contex
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksFirmwareUpgradesStagedEventsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of NetworksFirmwareUpgradesStagedEvents not reset", "meraki_reset_on_destroy is set, but the staged firmware upgrade events have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting NetworksFirmwareUpgradesStagedEvents", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
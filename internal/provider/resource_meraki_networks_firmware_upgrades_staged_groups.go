@@ -19,15 +19,12 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	"log"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksFirmwareUpgradesStagedGroupsResource{}
-	_ resource.ResourceWithConfigure = &NetworksFirmwareUpgradesStagedGroupsResource{}
+	_ resource.Resource                = &NetworksFirmwareUpgradesStagedGroupsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksFirmwareUpgradesStagedGroupsResource{}
+	_ resource.ResourceWithImportState = &NetworksFirmwareUpgradesStagedGroupsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksFirmwareUpgradesStagedGroupsResource{}
 )
 
 func NewNetworksFirmwareUpgradesStagedGroupsResource() resource.Resource {
@@ -226,6 +225,7 @@ func (r *NetworksFirmwareUpgradesStagedGroupsResource) Create(ctx context.Contex
 				data = ResponseNetworksGetNetworkFirmwareUpgradesStagedGroupItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -281,6 +281,7 @@ func (r *NetworksFirmwareUpgradesStagedGroupsResource) Create(ctx context.Contex
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseNetworksGetNetworkFirmwareUpgradesStagedGroupItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -345,19 +346,14 @@ func (r *NetworksFirmwareUpgradesStagedGroupsResource) Read(ctx context.Context,
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkFirmwareUpgradesStagedGroupItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksFirmwareUpgradesStagedGroupsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "group_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,groupId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), idParts[1])...)
+func (r *NetworksFirmwareUpgradesStagedGroupsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "group_id")
 }
 
 func (r *NetworksFirmwareUpgradesStagedGroupsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -387,6 +383,7 @@ func (r *NetworksFirmwareUpgradesStagedGroupsResource) Update(ctx context.Contex
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksFirmwareUpgradesStagedGroupsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -35,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksFirmwareUpgradesStagedStagesResource{}
-	_ resource.ResourceWithConfigure = &NetworksFirmwareUpgradesStagedStagesResource{}
+	_ resource.Resource                = &NetworksFirmwareUpgradesStagedStagesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksFirmwareUpgradesStagedStagesResource{}
+	_ resource.ResourceWithImportState = &NetworksFirmwareUpgradesStagedStagesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksFirmwareUpgradesStagedStagesResource{}
 )
 
 func NewNetworksFirmwareUpgradesStagedStagesResource() resource.Resource {
@@ -44,7 +45,8 @@ func NewNetworksFirmwareUpgradesStagedStagesResource() resource.Resource {
 }
 
 type NetworksFirmwareUpgradesStagedStagesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksFirmwareUpgradesStagedStagesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +55,7 @@ func (r *NetworksFirmwareUpgradesStagedStagesResource) Configure(ctx context.Con
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -205,6 +208,7 @@ func (r *NetworksFirmwareUpgradesStagedStagesResource) Create(ctx context.Contex
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -260,10 +264,15 @@ func (r *NetworksFirmwareUpgradesStagedStagesResource) Read(ctx context.Context,
 	data = ResponseNetworksGetNetworkFirmwareUpgradesStagedStagesItemToBodyRs(data, responseGet, true)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksFirmwareUpgradesStagedStagesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksFirmwareUpgradesStagedStagesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksFirmwareUpgradesStagedStagesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -297,9 +306,15 @@ func (r *NetworksFirmwareUpgradesStagedStagesResource) Update(ctx context.Contex
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksFirmwareUpgradesStagedStagesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of NetworksFirmwareUpgradesStagedStages not reset", "meraki_reset_on_destroy is set, but the staged firmware upgrade groups have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting NetworksFirmwareUpgradesStagedStages", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
@@ -37,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksFloorPlansResource{}
-	_ resource.ResourceWithConfigure = &NetworksFloorPlansResource{}
+	_ resource.Resource                = &NetworksFloorPlansResource{}
+	_ resource.ResourceWithConfigure   = &NetworksFloorPlansResource{}
+	_ resource.ResourceWithImportState = &NetworksFloorPlansResource{}
+	_ resource.ResourceWithIdentity    = &NetworksFloorPlansResource{}
 )
 
 func NewNetworksFloorPlansResource() resource.Resource {
@@ -388,6 +387,7 @@ func (r *NetworksFloorPlansResource) Create(ctx context.Context, req resource.Cr
 				data = ResponseNetworksGetNetworkFloorPlanItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -443,6 +443,7 @@ func (r *NetworksFloorPlansResource) Create(ctx context.Context, req resource.Cr
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseNetworksGetNetworkFloorPlanItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -507,19 +508,14 @@ func (r *NetworksFloorPlansResource) Read(ctx context.Context, req resource.Read
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkFloorPlanItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksFloorPlansResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "floor_plan_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,floorPlanId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("floor_plan_id"), idParts[1])...)
+func (r *NetworksFloorPlansResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "floor_plan_id")
 }
 
 func (r *NetworksFloorPlansResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -549,6 +545,7 @@ func (r *NetworksFloorPlansResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksFloorPlansResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
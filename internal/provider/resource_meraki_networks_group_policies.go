@@ -20,7 +20,6 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"strconv"
 	"strings"
 
@@ -29,7 +28,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -44,8 +42,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksGroupPoliciesResource{}
-	_ resource.ResourceWithConfigure = &NetworksGroupPoliciesResource{}
+	_ resource.Resource                = &NetworksGroupPoliciesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksGroupPoliciesResource{}
+	_ resource.ResourceWithImportState = &NetworksGroupPoliciesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksGroupPoliciesResource{}
 )
 
 func NewNetworksGroupPoliciesResource() resource.Resource {
@@ -889,6 +889,7 @@ func (r *NetworksGroupPoliciesResource) Create(ctx context.Context, req resource
 			}
 			data = ResponseNetworksGetNetworkGroupPolicyItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
@@ -920,6 +921,7 @@ func (r *NetworksGroupPoliciesResource) Create(ctx context.Context, req resource
 	}
 	data = ResponseNetworksGetNetworkGroupPolicyItemToBodyRs(data, responseGet, false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -962,19 +964,14 @@ func (r *NetworksGroupPoliciesResource) Read(ctx context.Context, req resource.R
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkGroupPolicyItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksGroupPoliciesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "group_policy_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,groupPolicyId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_policy_id"), idParts[1])...)
+func (r *NetworksGroupPoliciesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "group_policy_id")
 }
 
 func (r *NetworksGroupPoliciesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -1004,6 +1001,7 @@ func (r *NetworksGroupPoliciesResource) Update(ctx context.Context, req resource
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksGroupPoliciesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksMerakiAuthUsersResource{}
-	_ resource.ResourceWithConfigure = &NetworksMerakiAuthUsersResource{}
+	_ resource.Resource                = &NetworksMerakiAuthUsersResource{}
+	_ resource.ResourceWithConfigure   = &NetworksMerakiAuthUsersResource{}
+	_ resource.ResourceWithImportState = &NetworksMerakiAuthUsersResource{}
+	_ resource.ResourceWithIdentity    = &NetworksMerakiAuthUsersResource{}
 )
 
 func NewNetworksMerakiAuthUsersResource() resource.Resource {
@@ -244,6 +243,7 @@ func (r *NetworksMerakiAuthUsersResource) Create(ctx context.Context, req resour
 				data = ResponseNetworksGetNetworkMerakiAuthUserItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -299,6 +299,7 @@ func (r *NetworksMerakiAuthUsersResource) Create(ctx context.Context, req resour
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseNetworksGetNetworkMerakiAuthUserItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -363,19 +364,14 @@ func (r *NetworksMerakiAuthUsersResource) Read(ctx context.Context, req resource
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkMerakiAuthUserItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksMerakiAuthUsersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "meraki_auth_user_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,merakiAuthUserId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("meraki_auth_user_id"), idParts[1])...)
+func (r *NetworksMerakiAuthUsersResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "meraki_auth_user_id")
 }
 
 func (r *NetworksMerakiAuthUsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -405,6 +401,7 @@ func (r *NetworksMerakiAuthUsersResource) Update(ctx context.Context, req resour
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksMerakiAuthUsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -35,8 +32,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSensorMqttBrokersResource{}
-	_ resource.ResourceWithConfigure = &NetworksSensorMqttBrokersResource{}
+	_ resource.Resource                = &NetworksSensorMqttBrokersResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSensorMqttBrokersResource{}
+	_ resource.ResourceWithImportState = &NetworksSensorMqttBrokersResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSensorMqttBrokersResource{}
 )
 
 func NewNetworksSensorMqttBrokersResource() resource.Resource {
@@ -44,7 +43,8 @@ func NewNetworksSensorMqttBrokersResource() resource.Resource {
 }
 
 type NetworksSensorMqttBrokersResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSensorMqttBrokersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +53,7 @@ func (r *NetworksSensorMqttBrokersResource) Configure(ctx context.Context, req r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -78,6 +79,10 @@ func (r *NetworksSensorMqttBrokersResource) Schema(_ context.Context, _ resource
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -128,6 +133,7 @@ func (r *NetworksSensorMqttBrokersResource) Create(ctx context.Context, req reso
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -170,19 +176,14 @@ func (r *NetworksSensorMqttBrokersResource) Read(ctx context.Context, req resour
 	//entro aqui 2
 	data = ResponseSensorGetNetworkSensorMqttBrokerItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSensorMqttBrokersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "mqtt_broker_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,mqttBrokerId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mqtt_broker_id"), idParts[1])...)
+func (r *NetworksSensorMqttBrokersResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "mqtt_broker_id")
 }
 
 func (r *NetworksSensorMqttBrokersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -212,19 +213,53 @@ func (r *NetworksSensorMqttBrokersResource) Update(ctx context.Context, req reso
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSensorMqttBrokersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSensorMqttBrokers", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSensorMqttBrokersRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSensorMqttBrokers", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvMqttBrokerID := state.MqttBrokerID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSensorMqttBrokersRs{
+		Enabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Sensor.UpdateNetworkSensorMqttBroker(vvNetworkID, vvMqttBrokerID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSensorMqttBroker",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSensorMqttBroker",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksSensorMqttBrokersRs struct {
-	NetworkID    types.String `tfsdk:"network_id"`
-	MqttBrokerID types.String `tfsdk:"mqtt_broker_id"`
-	Enabled      types.Bool   `tfsdk:"enabled"`
+	NetworkID      types.String `tfsdk:"network_id"`
+	MqttBrokerID   types.String `tfsdk:"mqtt_broker_id"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	ResetOnDestroy types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
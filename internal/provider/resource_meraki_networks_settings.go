@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +36,10 @@ import (
 // Custom plan modifier to ignore "null" password values
 
 var (
-	_ resource.Resource              = &NetworksSettingsResource{}
-	_ resource.ResourceWithConfigure = &NetworksSettingsResource{}
+	_ resource.Resource                = &NetworksSettingsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSettingsResource{}
+	_ resource.ResourceWithImportState = &NetworksSettingsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSettingsResource{}
 )
 
 func NewNetworksSettingsResource() resource.Resource {
@@ -46,7 +47,8 @@ func NewNetworksSettingsResource() resource.Resource {
 }
 
 type NetworksSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +57,7 @@ func (r *NetworksSettingsResource) Configure(ctx context.Context, req resource.C
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -159,6 +162,10 @@ func (r *NetworksSettingsResource) Schema(_ context.Context, _ resource.SchemaRe
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"secure_port": schema.SingleNestedAttribute{
 				MarkdownDescription: `A hash of SecureConnect options applied to the Network.`,
 				Optional:            true,
@@ -224,6 +231,7 @@ func (r *NetworksSettingsResource) Create(ctx context.Context, req resource.Crea
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -274,9 +282,14 @@ func (r *NetworksSettingsResource) Read(ctx context.Context, req resource.ReadRe
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -324,11 +337,52 @@ func (r *NetworksSettingsResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSettingsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSettingsRs{
+		LocalStatusPage: &ResponseNetworksGetNetworkSettingsLocalStatusPageRs{
+			Authentication: &ResponseNetworksGetNetworkSettingsLocalStatusPageAuthenticationRs{
+				Enabled: types.BoolValue(false),
+			},
+		},
+		LocalStatusPageEnabled:  types.BoolValue(true),
+		RemoteStatusPageEnabled: types.BoolValue(true),
+		SecurePort: &ResponseNetworksGetNetworkSettingsSecurePortRs{
+			Enabled: types.BoolValue(false),
+		},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Networks.UpdateNetworkSettings(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSettings",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSettings",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -341,6 +395,7 @@ type NetworksSettingsRs struct {
 	NamedVLANs              *ResponseNetworksGetNetworkSettingsNamedVlansRs      `tfsdk:"named_vlans"`
 	RemoteStatusPageEnabled types.Bool                                           `tfsdk:"remote_status_page_enabled"`
 	SecurePort              *ResponseNetworksGetNetworkSettingsSecurePortRs      `tfsdk:"secure_port"`
+	ResetOnDestroy          types.Bool                                           `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseNetworksGetNetworkSettingsFipsRs struct {
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -37,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSmBypassActivationLockAttemptsResource{}
-	_ resource.ResourceWithConfigure = &NetworksSmBypassActivationLockAttemptsResource{}
+	_ resource.Resource                = &NetworksSmBypassActivationLockAttemptsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSmBypassActivationLockAttemptsResource{}
+	_ resource.ResourceWithImportState = &NetworksSmBypassActivationLockAttemptsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSmBypassActivationLockAttemptsResource{}
 )
 
 func NewNetworksSmBypassActivationLockAttemptsResource() resource.Resource {
@@ -168,6 +167,7 @@ func (r *NetworksSmBypassActivationLockAttemptsResource) Create(ctx context.Cont
 			data = ResponseSmGetNetworkSmBypassActivationLockAttemptItemToBodyRs(data, responseVerifyItem, false)
 			//Path params in update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
@@ -211,6 +211,7 @@ func (r *NetworksSmBypassActivationLockAttemptsResource) Create(ctx context.Cont
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -265,21 +266,15 @@ func (r *NetworksSmBypassActivationLockAttemptsResource) Read(ctx context.Contex
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSmBypassActivationLockAttemptsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "network_id", "attempt_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attempt_id"), idParts[1])...)
+func (r *NetworksSmBypassActivationLockAttemptsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "attempt_id")
 }
 
 func (r *NetworksSmBypassActivationLockAttemptsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
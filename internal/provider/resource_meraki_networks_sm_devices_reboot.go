@@ -61,6 +61,7 @@ func (r *NetworksSmDevicesRebootResource) Metadata(_ context.Context, req resour
 // resourceAction
 func (r *NetworksSmDevicesRebootResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		DeprecationMessage: "Use the `meraki_network_sm_devices_reboot` action instead, which runs the operation on a lifecycle event or with `terraform apply -invoke` and keeps no state. This resource will be removed in the next major release.",
 		Attributes: map[string]schema.Attribute{
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId path parameter. Network ID`,
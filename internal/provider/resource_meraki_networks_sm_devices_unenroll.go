@@ -20,6 +20,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
@@ -32,8 +33,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSmDevicesUnenrollResource{}
-	_ resource.ResourceWithConfigure = &NetworksSmDevicesUnenrollResource{}
+	_ resource.Resource               = &NetworksSmDevicesUnenrollResource{}
+	_ resource.ResourceWithConfigure  = &NetworksSmDevicesUnenrollResource{}
+	_ resource.ResourceWithModifyPlan = &NetworksSmDevicesUnenrollResource{}
 )
 
 func NewNetworksSmDevicesUnenrollResource() resource.Resource {
@@ -41,7 +43,8 @@ func NewNetworksSmDevicesUnenrollResource() resource.Resource {
 }
 
 type NetworksSmDevicesUnenrollResource struct {
-	client *merakigosdk.Client
+	client                     *merakigosdk.Client
+	allowDestructiveOperations bool
 }
 
 func (r *NetworksSmDevicesUnenrollResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +53,7 @@ func (r *NetworksSmDevicesUnenrollResource) Configure(ctx context.Context, req r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.allowDestructiveOperations = req.ProviderData.(MerakiProviderData).AllowDestructiveOperations
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,7 @@ func (r *NetworksSmDevicesUnenrollResource) Metadata(_ context.Context, req reso
 func (r *NetworksSmDevicesUnenrollResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"confirm_target": confirmTargetAttribute("the `device_id` of the unenrolled device"),
 			"device_id": schema.StringAttribute{
 				MarkdownDescription: `deviceId path parameter. Device ID`,
 				Required:            true,
@@ -88,6 +93,20 @@ func (r *NetworksSmDevicesUnenrollResource) Schema(_ context.Context, _ resource
 		},
 	}
 }
+
+// ModifyPlan checks confirm_target and warns about the unenrolled device when the resource is created.
+func (r *NetworksSmDevicesUnenrollResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+	var config NetworksSmDevicesUnenroll
+	resp.Diagnostics.Append(destructiveConfig(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(planDestructiveOperation(config.ConfirmTarget, config.destructiveOperation(ctx), r.allowDestructiveOperations, r.client != nil)...)
+}
+
 func (r *NetworksSmDevicesUnenrollResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var data NetworksSmDevicesUnenroll
@@ -103,6 +122,15 @@ func (r *NetworksSmDevicesUnenrollResource) Create(ctx context.Context, req reso
 		UnhandledUnknownAsEmpty: true,
 	})...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config NetworksSmDevicesUnenroll
+	resp.Diagnostics.Append(destructiveConfig(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkDestructiveOperation(config.ConfirmTarget, config.destructiveOperation(ctx), r.allowDestructiveOperations)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -135,7 +163,7 @@ func (r *NetworksSmDevicesUnenrollResource) Read(ctx context.Context, req resour
 }
 
 func (r *NetworksSmDevicesUnenrollResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// resp.Diagnostics.AddWarning("Error Update Resource", "This resource has no update method in the meraki lab, the resource was deleted only in terraform.")
+	updateConfirmTarget(ctx, req, resp)
 }
 
 func (r *NetworksSmDevicesUnenrollResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -145,9 +173,10 @@ func (r *NetworksSmDevicesUnenrollResource) Delete(ctx context.Context, req reso
 
 // TF Structs Schema
 type NetworksSmDevicesUnenroll struct {
-	NetworkID types.String                       `tfsdk:"network_id"`
-	DeviceID  types.String                       `tfsdk:"device_id"`
-	Item      *ResponseSmUnenrollNetworkSmDevice `tfsdk:"item"`
+	NetworkID     types.String                       `tfsdk:"network_id"`
+	ConfirmTarget types.String                       `tfsdk:"confirm_target"`
+	DeviceID      types.String                       `tfsdk:"device_id"`
+	Item          *ResponseSmUnenrollNetworkSmDevice `tfsdk:"item"`
 }
 
 type ResponseSmUnenrollNetworkSmDevice struct {
@@ -170,3 +199,12 @@ func ResponseSmUnenrollNetworkSmDeviceItemToBody(state NetworksSmDevicesUnenroll
 	state.Item = &itemState
 	return state
 }
+
+// destructiveOperation returns the unenrollment of the device.
+func (r *NetworksSmDevicesUnenroll) destructiveOperation(ctx context.Context) destructiveOperation {
+	return destructiveOperation{
+		Action:     fmt.Sprintf("unenroll the Systems Manager device %s of network %s", destructiveValue(r.DeviceID), destructiveValue(r.NetworkID)),
+		Target:     r.DeviceID,
+		TargetKind: "the device ID",
+	}
+}
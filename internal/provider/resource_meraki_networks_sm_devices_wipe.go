@@ -20,6 +20,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
@@ -33,8 +34,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSmDevicesWipeResource{}
-	_ resource.ResourceWithConfigure = &NetworksSmDevicesWipeResource{}
+	_ resource.Resource               = &NetworksSmDevicesWipeResource{}
+	_ resource.ResourceWithConfigure  = &NetworksSmDevicesWipeResource{}
+	_ resource.ResourceWithModifyPlan = &NetworksSmDevicesWipeResource{}
 )
 
 func NewNetworksSmDevicesWipeResource() resource.Resource {
@@ -42,7 +44,8 @@ func NewNetworksSmDevicesWipeResource() resource.Resource {
 }
 
 type NetworksSmDevicesWipeResource struct {
-	client *merakigosdk.Client
+	client                     *merakigosdk.Client
+	allowDestructiveOperations bool
 }
 
 func (r *NetworksSmDevicesWipeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -51,6 +54,7 @@ func (r *NetworksSmDevicesWipeResource) Configure(ctx context.Context, req resou
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.allowDestructiveOperations = req.ProviderData.(MerakiProviderData).AllowDestructiveOperations
 }
 
 // Metadata returns the data source type name.
@@ -62,6 +66,7 @@ func (r *NetworksSmDevicesWipeResource) Metadata(_ context.Context, req resource
 func (r *NetworksSmDevicesWipeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"confirm_target": confirmTargetAttribute("the `serial` of the wiped device, or its `id` or `wifi_mac` when the serial is not set"),
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
@@ -119,6 +124,20 @@ func (r *NetworksSmDevicesWipeResource) Schema(_ context.Context, _ resource.Sch
 		},
 	}
 }
+
+// ModifyPlan checks confirm_target and warns about the wiped device when the resource is created.
+func (r *NetworksSmDevicesWipeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+	var config NetworksSmDevicesWipe
+	resp.Diagnostics.Append(destructiveConfig(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(planDestructiveOperation(config.ConfirmTarget, config.destructiveOperation(ctx), r.allowDestructiveOperations, r.client != nil)...)
+}
+
 func (r *NetworksSmDevicesWipeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var data NetworksSmDevicesWipe
@@ -134,6 +153,15 @@ func (r *NetworksSmDevicesWipeResource) Create(ctx context.Context, req resource
 		UnhandledUnknownAsEmpty: true,
 	})...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config NetworksSmDevicesWipe
+	resp.Diagnostics.Append(destructiveConfig(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkDestructiveOperation(config.ConfirmTarget, config.destructiveOperation(ctx), r.allowDestructiveOperations)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -166,7 +194,7 @@ func (r *NetworksSmDevicesWipeResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *NetworksSmDevicesWipeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// resp.Diagnostics.AddWarning("Error Update Resource", "This resource has no update method in the meraki lab, the resource was deleted only in terraform.")
+	updateConfirmTarget(ctx, req, resp)
 }
 
 func (r *NetworksSmDevicesWipeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -176,9 +204,10 @@ func (r *NetworksSmDevicesWipeResource) Delete(ctx context.Context, req resource
 
 // TF Structs Schema
 type NetworksSmDevicesWipe struct {
-	NetworkID  types.String                     `tfsdk:"network_id"`
-	Item       *ResponseSmWipeNetworkSmDevices  `tfsdk:"item"`
-	Parameters *RequestSmWipeNetworkSmDevicesRs `tfsdk:"parameters"`
+	NetworkID     types.String                     `tfsdk:"network_id"`
+	ConfirmTarget types.String                     `tfsdk:"confirm_target"`
+	Item          *ResponseSmWipeNetworkSmDevices  `tfsdk:"item"`
+	Parameters    *RequestSmWipeNetworkSmDevicesRs `tfsdk:"parameters"`
 }
 
 type ResponseSmWipeNetworkSmDevices struct {
@@ -242,3 +271,20 @@ func ResponseSmWipeNetworkSmDevicesItemToBody(state NetworksSmDevicesWipe, respo
 	state.Item = &itemState
 	return state
 }
+
+// destructiveOperation returns the wipe of the device, which is identified by its serial, ID or Wi-Fi MAC.
+func (r *NetworksSmDevicesWipe) destructiveOperation(ctx context.Context) destructiveOperation {
+	op := destructiveOperation{Target: types.StringNull(), TargetKind: "the serial"}
+	if r.Parameters != nil {
+		switch {
+		case !r.Parameters.Serial.IsNull():
+			op.Target = r.Parameters.Serial
+		case !r.Parameters.ID.IsNull():
+			op.Target, op.TargetKind = r.Parameters.ID, "the device ID"
+		case !r.Parameters.WifiMac.IsNull():
+			op.Target, op.TargetKind = r.Parameters.WifiMac, "the Wi-Fi MAC"
+		}
+	}
+	op.Action = fmt.Sprintf("wipe the Systems Manager device %s of network %s", destructiveValue(op.Target), destructiveValue(r.NetworkID))
+	return op
+}
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -35,8 +32,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSmTargetGroupsResource{}
-	_ resource.ResourceWithConfigure = &NetworksSmTargetGroupsResource{}
+	_ resource.Resource                = &NetworksSmTargetGroupsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSmTargetGroupsResource{}
+	_ resource.ResourceWithImportState = &NetworksSmTargetGroupsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSmTargetGroupsResource{}
 )
 
 func NewNetworksSmTargetGroupsResource() resource.Resource {
@@ -161,6 +160,7 @@ func (r *NetworksSmTargetGroupsResource) Create(ctx context.Context, req resourc
 				data = ResponseSmGetNetworkSmTargetGroupItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -216,6 +216,7 @@ func (r *NetworksSmTargetGroupsResource) Create(ctx context.Context, req resourc
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseSmGetNetworkSmTargetGroupItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -280,19 +281,14 @@ func (r *NetworksSmTargetGroupsResource) Read(ctx context.Context, req resource.
 	//entro aqui 2
 	data = ResponseSmGetNetworkSmTargetGroupItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSmTargetGroupsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "target_group_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,targetGroupId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_group_id"), idParts[1])...)
+func (r *NetworksSmTargetGroupsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "target_group_id")
 }
 
 func (r *NetworksSmTargetGroupsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -322,6 +318,7 @@ func (r *NetworksSmTargetGroupsResource) Update(ctx context.Context, req resourc
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSmTargetGroupsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -36,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSNMPResource{}
-	_ resource.ResourceWithConfigure = &NetworksSNMPResource{}
+	_ resource.Resource                = &NetworksSNMPResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSNMPResource{}
+	_ resource.ResourceWithImportState = &NetworksSNMPResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSNMPResource{}
 )
 
 func NewNetworksSNMPResource() resource.Resource {
@@ -45,15 +46,17 @@ func NewNetworksSNMPResource() resource.Resource {
 }
 
 type NetworksSNMPResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSNMPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -81,6 +84,7 @@ func (r *NetworksSNMPResource) Schema(_ context.Context, _ resource.SchemaReques
 			},
 			"community_string": schema.StringAttribute{
 				MarkdownDescription: `SNMP community string if access is 'community'.`,
+				Sensitive:           true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -90,6 +94,10 @@ func (r *NetworksSNMPResource) Schema(_ context.Context, _ resource.SchemaReques
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"users": schema.ListNestedAttribute{
 				MarkdownDescription: `SNMP settings if access is 'users'.`,
 				Optional:            true,
@@ -101,6 +109,7 @@ func (r *NetworksSNMPResource) Schema(_ context.Context, _ resource.SchemaReques
 
 						"passphrase": schema.StringAttribute{
 							MarkdownDescription: `The passphrase for the SNMP user.`,
+							Sensitive:           true,
 							Optional:            true,
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
@@ -163,6 +172,7 @@ func (r *NetworksSNMPResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -204,9 +214,14 @@ func (r *NetworksSNMPResource) Read(ctx context.Context, req resource.ReadReques
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkSNMPItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSNMPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSNMPResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSNMPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -235,11 +250,43 @@ func (r *NetworksSNMPResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSNMPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSNMP", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSNMPRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSNMP", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSNMPRs{
+		Access: types.StringValue("none"),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Networks.UpdateNetworkSNMP(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSNMP",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSNMP",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -249,6 +296,7 @@ type NetworksSNMPRs struct {
 	Access          types.String                             `tfsdk:"access"`
 	CommunityString types.String                             `tfsdk:"community_string"`
 	Users           *[]ResponseNetworksGetNetworkSnmpUsersRs `tfsdk:"users"`
+	ResetOnDestroy  types.Bool                               `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseNetworksGetNetworkSnmpUsersRs struct {
@@ -20,6 +20,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
@@ -32,8 +33,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSplitResource{}
-	_ resource.ResourceWithConfigure = &NetworksSplitResource{}
+	_ resource.Resource               = &NetworksSplitResource{}
+	_ resource.ResourceWithConfigure  = &NetworksSplitResource{}
+	_ resource.ResourceWithModifyPlan = &NetworksSplitResource{}
 )
 
 func NewNetworksSplitResource() resource.Resource {
@@ -41,7 +43,8 @@ func NewNetworksSplitResource() resource.Resource {
 }
 
 type NetworksSplitResource struct {
-	client *merakigosdk.Client
+	client                     *merakigosdk.Client
+	allowDestructiveOperations bool
 }
 
 func (r *NetworksSplitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +53,7 @@ func (r *NetworksSplitResource) Configure(ctx context.Context, req resource.Conf
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.allowDestructiveOperations = req.ProviderData.(MerakiProviderData).AllowDestructiveOperations
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +65,7 @@ func (r *NetworksSplitResource) Metadata(_ context.Context, req resource.Metadat
 func (r *NetworksSplitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"confirm_target": confirmTargetAttribute("the `network_id` of the split network"),
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
@@ -128,6 +133,20 @@ func (r *NetworksSplitResource) Schema(_ context.Context, _ resource.SchemaReque
 		},
 	}
 }
+
+// ModifyPlan checks confirm_target and warns about the split network when the resource is created.
+func (r *NetworksSplitResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+	var config NetworksSplit
+	resp.Diagnostics.Append(destructiveConfig(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(planDestructiveOperation(config.ConfirmTarget, config.destructiveOperation(ctx), r.allowDestructiveOperations, r.client != nil)...)
+}
+
 func (r *NetworksSplitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var data NetworksSplit
@@ -143,6 +162,15 @@ func (r *NetworksSplitResource) Create(ctx context.Context, req resource.CreateR
 		UnhandledUnknownAsEmpty: true,
 	})...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config NetworksSplit
+	resp.Diagnostics.Append(destructiveConfig(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkDestructiveOperation(config.ConfirmTarget, config.destructiveOperation(ctx), r.allowDestructiveOperations)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -174,7 +202,7 @@ func (r *NetworksSplitResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *NetworksSplitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// resp.Diagnostics.AddWarning("Error Update Resource", "This resource has no update method in the meraki lab, the resource was deleted only in terraform.")
+	updateConfirmTarget(ctx, req, resp)
 }
 
 func (r *NetworksSplitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -184,8 +212,9 @@ func (r *NetworksSplitResource) Delete(ctx context.Context, req resource.DeleteR
 
 // TF Structs Schema
 type NetworksSplit struct {
-	NetworkID types.String                  `tfsdk:"network_id"`
-	Item      *ResponseNetworksSplitNetwork `tfsdk:"item"`
+	NetworkID     types.String                  `tfsdk:"network_id"`
+	ConfirmTarget types.String                  `tfsdk:"confirm_target"`
+	Item          *ResponseNetworksSplitNetwork `tfsdk:"item"`
 }
 
 type ResponseNetworksSplitNetwork struct {
@@ -276,3 +305,12 @@ func ResponseNetworksSplitNetworkItemToBody(state NetworksSplit, response *merak
 	state.Item = &itemState
 	return state
 }
+
+// destructiveOperation returns the split of the network.
+func (r *NetworksSplit) destructiveOperation(ctx context.Context) destructiveOperation {
+	return destructiveOperation{
+		Action:     fmt.Sprintf("split network %s into one network per product type", destructiveValue(r.NetworkID)),
+		Target:     r.NetworkID,
+		TargetKind: "the network ID",
+	}
+}
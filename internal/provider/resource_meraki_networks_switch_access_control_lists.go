@@ -25,7 +25,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchAccessControlListsResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchAccessControlListsResource{}
+	_ resource.Resource                = &NetworksSwitchAccessControlListsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchAccessControlListsResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchAccessControlListsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchAccessControlListsResource{}
 )
 
 func NewNetworksSwitchAccessControlListsResource() resource.Resource {
@@ -46,7 +47,8 @@ func NewNetworksSwitchAccessControlListsResource() resource.Resource {
 }
 
 type NetworksSwitchAccessControlListsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSwitchAccessControlListsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +57,7 @@ func (r *NetworksSwitchAccessControlListsResource) Configure(ctx context.Context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -69,6 +72,10 @@ func (r *NetworksSwitchAccessControlListsResource) Schema(_ context.Context, _ r
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `An ordered array of the access control list rules`,
 				Optional:            true,
@@ -223,6 +230,7 @@ func (r *NetworksSwitchAccessControlListsResource) Create(ctx context.Context, r
 	data = ResponseSwitchGetNetworkSwitchAccessControlListsItemToBodyRs(data, responseGet, true)
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -264,9 +272,14 @@ func (r *NetworksSwitchAccessControlListsResource) Read(ctx context.Context, req
 	//entro aqui 2
 	data = ResponseSwitchGetNetworkSwitchAccessControlListsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchAccessControlListsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSwitchAccessControlListsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSwitchAccessControlListsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -295,18 +308,51 @@ func (r *NetworksSwitchAccessControlListsResource) Update(ctx context.Context, r
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchAccessControlListsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSwitchAccessControlLists", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSwitchAccessControlListsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSwitchAccessControlLists", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSwitchAccessControlListsRs{
+		Rules: &[]ResponseSwitchGetNetworkSwitchAccessControlListsRulesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Switch.UpdateNetworkSwitchAccessControlLists(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSwitchAccessControlLists",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSwitchAccessControlLists",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksSwitchAccessControlListsRs struct {
-	NetworkID types.String                                               `tfsdk:"network_id"`
-	Rules     *[]ResponseSwitchGetNetworkSwitchAccessControlListsRulesRs `tfsdk:"rules"`
+	NetworkID      types.String                                               `tfsdk:"network_id"`
+	Rules          *[]ResponseSwitchGetNetworkSwitchAccessControlListsRulesRs `tfsdk:"rules"`
+	ResetOnDestroy types.Bool                                                 `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetNetworkSwitchAccessControlListsRulesRs struct {
@@ -352,7 +398,10 @@ func (r *NetworksSwitchAccessControlListsRs) toSdkApiRequestUpdate(ctx context.C
 	}
 	out := merakigosdk.RequestSwitchUpdateNetworkSwitchAccessControlLists{
 		Rules: func() *[]merakigosdk.RequestSwitchUpdateNetworkSwitchAccessControlListsRules {
-			if len(requestSwitchUpdateNetworkSwitchAccessControlListsRules) > 0 {
+			if len(requestSwitchUpdateNetworkSwitchAccessControlListsRules) > 0 || r.Rules != nil {
+				if len(requestSwitchUpdateNetworkSwitchAccessControlListsRules) == 0 {
+					requestSwitchUpdateNetworkSwitchAccessControlListsRules = make([]merakigosdk.RequestSwitchUpdateNetworkSwitchAccessControlListsRules, 0)
+				}
 				return &requestSwitchUpdateNetworkSwitchAccessControlListsRules
 			}
 			return nil
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -42,8 +39,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchAccessPoliciesResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchAccessPoliciesResource{}
+	_ resource.Resource                = &NetworksSwitchAccessPoliciesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchAccessPoliciesResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchAccessPoliciesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchAccessPoliciesResource{}
 )
 
 func NewNetworksSwitchAccessPoliciesResource() resource.Resource {
@@ -304,6 +303,7 @@ func (r *NetworksSwitchAccessPoliciesResource) Schema(_ context.Context, _ resou
 						},
 						"secret": schema.StringAttribute{
 							MarkdownDescription: `RADIUS client shared secret`,
+							Sensitive:           true,
 							Optional:            true,
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
@@ -365,6 +365,7 @@ func (r *NetworksSwitchAccessPoliciesResource) Schema(_ context.Context, _ resou
 						},
 						"secret": schema.StringAttribute{
 							MarkdownDescription: `RADIUS client shared secret`,
+							Sensitive:           true,
 							Optional:            true,
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
@@ -476,6 +477,7 @@ func (r *NetworksSwitchAccessPoliciesResource) Create(ctx context.Context, req r
 				data = ResponseSwitchGetNetworkSwitchAccessPolicyItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -531,6 +533,7 @@ func (r *NetworksSwitchAccessPoliciesResource) Create(ctx context.Context, req r
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseSwitchGetNetworkSwitchAccessPolicyItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -595,19 +598,14 @@ func (r *NetworksSwitchAccessPoliciesResource) Read(ctx context.Context, req res
 	//entro aqui 2
 	data = ResponseSwitchGetNetworkSwitchAccessPolicyItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchAccessPoliciesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "access_policy_number")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,accessPolicyNumber. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("access_policy_number"), idParts[1])...)
+func (r *NetworksSwitchAccessPoliciesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "access_policy_number")
 }
 
 func (r *NetworksSwitchAccessPoliciesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -637,6 +635,7 @@ func (r *NetworksSwitchAccessPoliciesResource) Update(ctx context.Context, req r
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchAccessPoliciesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
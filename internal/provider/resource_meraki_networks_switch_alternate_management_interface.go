@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +36,11 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchAlternateManagementInterfaceResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchAlternateManagementInterfaceResource{}
+	_ resource.Resource                 = &NetworksSwitchAlternateManagementInterfaceResource{}
+	_ resource.ResourceWithConfigure    = &NetworksSwitchAlternateManagementInterfaceResource{}
+	_ resource.ResourceWithImportState  = &NetworksSwitchAlternateManagementInterfaceResource{}
+	_ resource.ResourceWithIdentity     = &NetworksSwitchAlternateManagementInterfaceResource{}
+	_ resource.ResourceWithUpgradeState = &NetworksSwitchAlternateManagementInterfaceResource{}
 )
 
 func NewNetworksSwitchAlternateManagementInterfaceResource() resource.Resource {
@@ -46,7 +48,8 @@ func NewNetworksSwitchAlternateManagementInterfaceResource() resource.Resource {
 }
 
 type NetworksSwitchAlternateManagementInterfaceResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSwitchAlternateManagementInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +58,7 @@ func (r *NetworksSwitchAlternateManagementInterfaceResource) Configure(ctx conte
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -62,8 +66,14 @@ func (r *NetworksSwitchAlternateManagementInterfaceResource) Metadata(_ context.
 	resp.TypeName = req.ProviderTypeName + "_networks_switch_alternate_management_interface"
 }
 
+// UpgradeState upgrades the states written before the VLAN IDs were numbers.
+func (r *NetworksSwitchAlternateManagementInterfaceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(1)
+}
+
 func (r *NetworksSwitchAlternateManagementInterfaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"enabled": schema.BoolAttribute{
 				MarkdownDescription: `Boolean value to enable or disable AMI configuration. If enabled, VLAN and protocols must be set`,
@@ -87,6 +97,10 @@ func (r *NetworksSwitchAlternateManagementInterfaceResource) Schema(_ context.Co
 				ElementType: types.StringType,
 				Default:     listdefault.StaticValue(types.ListNull(types.StringType)),
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"switches": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of switch serial number and IP assignment. If parameter is present, it cannot have empty body. Note: switches parameter is not applicable for template networks, in other words, do not put 'switches' in the body when updating template networks. Also, an empty 'switches' array will remove all previous assignments`,
 				Optional:            true,
@@ -181,6 +195,7 @@ func (r *NetworksSwitchAlternateManagementInterfaceResource) Create(ctx context.
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -222,9 +237,14 @@ func (r *NetworksSwitchAlternateManagementInterfaceResource) Read(ctx context.Co
 	//entro aqui 2
 	data = ResponseSwitchGetNetworkSwitchAlternateManagementInterfaceItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchAlternateManagementInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSwitchAlternateManagementInterfaceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSwitchAlternateManagementInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -253,21 +273,54 @@ func (r *NetworksSwitchAlternateManagementInterfaceResource) Update(ctx context.
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchAlternateManagementInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSwitchAlternateManagementInterface", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSwitchAlternateManagementInterfaceRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSwitchAlternateManagementInterface", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSwitchAlternateManagementInterfaceRs{
+		Enabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Switch.UpdateNetworkSwitchAlternateManagementInterface(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSwitchAlternateManagementInterface",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSwitchAlternateManagementInterface",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksSwitchAlternateManagementInterfaceRs struct {
-	NetworkID types.String                                                            `tfsdk:"network_id"`
-	Enabled   types.Bool                                                              `tfsdk:"enabled"`
-	Protocols types.List                                                              `tfsdk:"protocols"`
-	Switches  *[]ResponseSwitchGetNetworkSwitchAlternateManagementInterfaceSwitchesRs `tfsdk:"switches"`
-	VLANID    types.Int64                                                             `tfsdk:"vlan_id"`
+	NetworkID      types.String                                                            `tfsdk:"network_id"`
+	Enabled        types.Bool                                                              `tfsdk:"enabled"`
+	Protocols      types.List                                                              `tfsdk:"protocols"`
+	Switches       *[]ResponseSwitchGetNetworkSwitchAlternateManagementInterfaceSwitchesRs `tfsdk:"switches"`
+	VLANID         types.Int64                                                             `tfsdk:"vlan_id"`
+	ResetOnDestroy types.Bool                                                              `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetNetworkSwitchAlternateManagementInterfaceSwitchesRs struct {
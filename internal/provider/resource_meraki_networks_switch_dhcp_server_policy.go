@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -39,8 +38,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchDhcpServerPolicyResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchDhcpServerPolicyResource{}
+	_ resource.Resource                = &NetworksSwitchDhcpServerPolicyResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchDhcpServerPolicyResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchDhcpServerPolicyResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchDhcpServerPolicyResource{}
 )
 
 func NewNetworksSwitchDhcpServerPolicyResource() resource.Resource {
@@ -48,7 +49,8 @@ func NewNetworksSwitchDhcpServerPolicyResource() resource.Resource {
 }
 
 type NetworksSwitchDhcpServerPolicyResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSwitchDhcpServerPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,6 +59,7 @@ func (r *NetworksSwitchDhcpServerPolicyResource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -158,6 +161,10 @@ func (r *NetworksSwitchDhcpServerPolicyResource) Schema(_ context.Context, _ res
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -205,6 +212,7 @@ func (r *NetworksSwitchDhcpServerPolicyResource) Create(ctx context.Context, req
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -246,9 +254,14 @@ func (r *NetworksSwitchDhcpServerPolicyResource) Read(ctx context.Context, req r
 	//entro aqui 2
 	data = ResponseSwitchGetNetworkSwitchDhcpServerPolicyItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchDhcpServerPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSwitchDhcpServerPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSwitchDhcpServerPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -277,11 +290,51 @@ func (r *NetworksSwitchDhcpServerPolicyResource) Update(ctx context.Context, req
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchDhcpServerPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSwitchDhcpServerPolicy", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSwitchDhcpServerPolicyRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSwitchDhcpServerPolicy", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSwitchDhcpServerPolicyRs{
+		Alerts: &ResponseSwitchGetNetworkSwitchDhcpServerPolicyAlertsRs{
+			Email: &ResponseSwitchGetNetworkSwitchDhcpServerPolicyAlertsEmailRs{
+				Enabled: types.BoolValue(false),
+			},
+		},
+		ArpInspection: &ResponseSwitchGetNetworkSwitchDhcpServerPolicyArpInspectionRs{
+			Enabled: types.BoolValue(false),
+		},
+		DefaultPolicy: types.StringValue("allow"),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Switch.UpdateNetworkSwitchDhcpServerPolicy(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSwitchDhcpServerPolicy",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSwitchDhcpServerPolicy",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -293,6 +346,7 @@ type NetworksSwitchDhcpServerPolicyRs struct {
 	ArpInspection  *ResponseSwitchGetNetworkSwitchDhcpServerPolicyArpInspectionRs `tfsdk:"arp_inspection"`
 	BlockedServers types.List                                                     `tfsdk:"blocked_servers"`
 	DefaultPolicy  types.String                                                   `tfsdk:"default_policy"`
+	ResetOnDestroy types.Bool                                                     `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetNetworkSwitchDhcpServerPolicyAlertsRs struct {
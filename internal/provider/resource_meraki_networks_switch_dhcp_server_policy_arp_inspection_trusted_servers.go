@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -35,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource{}
+	_ resource.Resource                = &NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource{}
 )
 
 func NewNetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource() resource.Resource {
@@ -172,6 +173,7 @@ func (r *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource) Crea
 			data = ResponseSwitchGetNetworkSwitchDhcpServerPolicyArpInspectionTrustedServersItemToBodyRs(data, &responseVerifyItem2, false)
 			// Path params update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 
 		}
@@ -225,6 +227,7 @@ func (r *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource) Crea
 		data = ResponseSwitchGetNetworkSwitchDhcpServerPolicyArpInspectionTrustedServersItemToBodyRs(data, &responseVerifyItem2, false)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -302,6 +305,7 @@ func (r *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource) Read
 		data = ResponseSwitchGetNetworkSwitchDhcpServerPolicyArpInspectionTrustedServersItemToBodyRs(data, &responseVerifyItem2, true)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -313,7 +317,11 @@ func (r *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource) Read
 }
 
 func (r *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -348,6 +356,7 @@ func (r *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource) Upda
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchDhcpServerPolicyArpInspectionTrustedServersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -35,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchDscpToCosMappingsResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchDscpToCosMappingsResource{}
+	_ resource.Resource                = &NetworksSwitchDscpToCosMappingsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchDscpToCosMappingsResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchDscpToCosMappingsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchDscpToCosMappingsResource{}
 )
 
 func NewNetworksSwitchDscpToCosMappingsResource() resource.Resource {
@@ -44,7 +45,8 @@ func NewNetworksSwitchDscpToCosMappingsResource() resource.Resource {
 }
 
 type NetworksSwitchDscpToCosMappingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSwitchDscpToCosMappingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +55,7 @@ func (r *NetworksSwitchDscpToCosMappingsResource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -100,6 +103,10 @@ func (r *NetworksSwitchDscpToCosMappingsResource) Schema(_ context.Context, _ re
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -147,6 +154,7 @@ func (r *NetworksSwitchDscpToCosMappingsResource) Create(ctx context.Context, re
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -188,9 +196,14 @@ func (r *NetworksSwitchDscpToCosMappingsResource) Read(ctx context.Context, req
 	//entro aqui 2
 	data = ResponseSwitchGetNetworkSwitchDscpToCosMappingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchDscpToCosMappingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSwitchDscpToCosMappingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSwitchDscpToCosMappingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -219,18 +232,51 @@ func (r *NetworksSwitchDscpToCosMappingsResource) Update(ctx context.Context, re
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchDscpToCosMappingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSwitchDscpToCosMappings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSwitchDscpToCosMappingsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSwitchDscpToCosMappings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSwitchDscpToCosMappingsRs{
+		Mappings: &[]ResponseSwitchGetNetworkSwitchDscpToCosMappingsMappingsRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Switch.UpdateNetworkSwitchDscpToCosMappings(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSwitchDscpToCosMappings",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSwitchDscpToCosMappings",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksSwitchDscpToCosMappingsRs struct {
-	NetworkID types.String                                                 `tfsdk:"network_id"`
-	Mappings  *[]ResponseSwitchGetNetworkSwitchDscpToCosMappingsMappingsRs `tfsdk:"mappings"`
+	NetworkID      types.String                                                 `tfsdk:"network_id"`
+	Mappings       *[]ResponseSwitchGetNetworkSwitchDscpToCosMappingsMappingsRs `tfsdk:"mappings"`
+	ResetOnDestroy types.Bool                                                   `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetNetworkSwitchDscpToCosMappingsMappingsRs struct {
@@ -268,7 +314,10 @@ func (r *NetworksSwitchDscpToCosMappingsRs) toSdkApiRequestUpdate(ctx context.Co
 	}
 	out := merakigosdk.RequestSwitchUpdateNetworkSwitchDscpToCosMappings{
 		Mappings: func() *[]merakigosdk.RequestSwitchUpdateNetworkSwitchDscpToCosMappingsMappings {
-			if len(requestSwitchUpdateNetworkSwitchDscpToCosMappingsMappings) > 0 {
+			if len(requestSwitchUpdateNetworkSwitchDscpToCosMappingsMappings) > 0 || r.Mappings != nil {
+				if len(requestSwitchUpdateNetworkSwitchDscpToCosMappingsMappings) == 0 {
+					requestSwitchUpdateNetworkSwitchDscpToCosMappingsMappings = make([]merakigosdk.RequestSwitchUpdateNetworkSwitchDscpToCosMappingsMappings, 0)
+				}
 				return &requestSwitchUpdateNetworkSwitchDscpToCosMappingsMappings
 			}
 			return nil
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -36,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchLinkAggregationsResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchLinkAggregationsResource{}
+	_ resource.Resource                = &NetworksSwitchLinkAggregationsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchLinkAggregationsResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchLinkAggregationsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchLinkAggregationsResource{}
 )
 
 func NewNetworksSwitchLinkAggregationsResource() resource.Resource {
@@ -197,6 +196,7 @@ func (r *NetworksSwitchLinkAggregationsResource) Create(ctx context.Context, req
 			data = ResponseSwitchGetNetworkSwitchLinkAggregationsItemToBodyRs(data, &responseVerifyItem2, false)
 			// Path params update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 
 		}
@@ -250,6 +250,7 @@ func (r *NetworksSwitchLinkAggregationsResource) Create(ctx context.Context, req
 		data = ResponseSwitchGetNetworkSwitchLinkAggregationsItemToBodyRs(data, &responseVerifyItem2, false)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -325,6 +326,7 @@ func (r *NetworksSwitchLinkAggregationsResource) Read(ctx context.Context, req r
 		data = ResponseSwitchGetNetworkSwitchLinkAggregationsItemToBodyRs(data, &responseVerifyItem2, true)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -336,18 +338,11 @@ func (r *NetworksSwitchLinkAggregationsResource) Read(ctx context.Context, req r
 }
 
 func (r *NetworksSwitchLinkAggregationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "network_id", "id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+func (r *NetworksSwitchLinkAggregationsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "id")
 }
 
 func (r *NetworksSwitchLinkAggregationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -383,6 +378,7 @@ func (r *NetworksSwitchLinkAggregationsResource) Update(ctx context.Context, req
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchLinkAggregationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
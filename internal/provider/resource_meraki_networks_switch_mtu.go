@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchMtuResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchMtuResource{}
+	_ resource.Resource                = &NetworksSwitchMtuResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchMtuResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchMtuResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchMtuResource{}
 )
 
 func NewNetworksSwitchMtuResource() resource.Resource {
@@ -43,7 +44,8 @@ func NewNetworksSwitchMtuResource() resource.Resource {
 }
 
 type NetworksSwitchMtuResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSwitchMtuResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +54,7 @@ func (r *NetworksSwitchMtuResource) Configure(ctx context.Context, req resource.
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -111,6 +114,10 @@ func (r *NetworksSwitchMtuResource) Schema(_ context.Context, _ resource.SchemaR
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -158,6 +165,7 @@ func (r *NetworksSwitchMtuResource) Create(ctx context.Context, req resource.Cre
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -199,9 +207,14 @@ func (r *NetworksSwitchMtuResource) Read(ctx context.Context, req resource.ReadR
 	//entro aqui 2
 	data = ResponseSwitchGetNetworkSwitchMtuItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchMtuResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSwitchMtuResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSwitchMtuResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -230,11 +243,44 @@ func (r *NetworksSwitchMtuResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchMtuResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSwitchMtu", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSwitchMtuRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSwitchMtu", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSwitchMtuRs{
+		DefaultMtuSize: types.Int64Value(9578),
+		Overrides:      &[]ResponseSwitchGetNetworkSwitchMtuOverridesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Switch.UpdateNetworkSwitchMtu(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSwitchMtu",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSwitchMtu",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -243,6 +289,7 @@ type NetworksSwitchMtuRs struct {
 	NetworkID      types.String                                    `tfsdk:"network_id"`
 	DefaultMtuSize types.Int64                                     `tfsdk:"default_mtu_size"`
 	Overrides      *[]ResponseSwitchGetNetworkSwitchMtuOverridesRs `tfsdk:"overrides"`
+	ResetOnDestroy types.Bool                                      `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetNetworkSwitchMtuOverridesRs struct {
@@ -286,7 +333,10 @@ func (r *NetworksSwitchMtuRs) toSdkApiRequestUpdate(ctx context.Context) *meraki
 	out := merakigosdk.RequestSwitchUpdateNetworkSwitchMtu{
 		DefaultMtuSize: int64ToIntPointer(defaultMtuSize),
 		Overrides: func() *[]merakigosdk.RequestSwitchUpdateNetworkSwitchMtuOverrides {
-			if len(requestSwitchUpdateNetworkSwitchMtuOverrides) > 0 {
+			if len(requestSwitchUpdateNetworkSwitchMtuOverrides) > 0 || r.Overrides != nil {
+				if len(requestSwitchUpdateNetworkSwitchMtuOverrides) == 0 {
+					requestSwitchUpdateNetworkSwitchMtuOverrides = make([]merakigosdk.RequestSwitchUpdateNetworkSwitchMtuOverrides, 0)
+				}
 				return &requestSwitchUpdateNetworkSwitchMtuOverrides
 			}
 			return nil
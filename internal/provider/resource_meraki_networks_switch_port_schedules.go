@@ -25,7 +25,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -38,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchPortSchedulesResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchPortSchedulesResource{}
+	_ resource.Resource                = &NetworksSwitchPortSchedulesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchPortSchedulesResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchPortSchedulesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchPortSchedulesResource{}
 )
 
 func NewNetworksSwitchPortSchedulesResource() resource.Resource {
@@ -477,6 +478,7 @@ func (r *NetworksSwitchPortSchedulesResource) Create(ctx context.Context, req re
 			data = ResponseSwitchGetNetworkSwitchPortSchedulesItemToBodyRs(data, &responseVerifyItem4, false)
 			diags := resp.State.Set(ctx, &data)
 			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 		return
@@ -530,6 +532,7 @@ func (r *NetworksSwitchPortSchedulesResource) Create(ctx context.Context, req re
 		data = ResponseSwitchGetNetworkSwitchPortSchedulesItemToBodyRs(data, &responseVerifyItem2, false)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -596,6 +599,7 @@ func (r *NetworksSwitchPortSchedulesResource) Read(ctx context.Context, req reso
 		data = ResponseSwitchGetNetworkSwitchPortSchedulesItemToBodyRs(data, &responseVerifyItem2, true)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddWarning(
@@ -608,7 +612,11 @@ func (r *NetworksSwitchPortSchedulesResource) Read(ctx context.Context, req reso
 }
 
 func (r *NetworksSwitchPortSchedulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSwitchPortSchedulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSwitchPortSchedulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -643,6 +651,7 @@ func (r *NetworksSwitchPortSchedulesResource) Update(ctx context.Context, req re
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchPortSchedulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
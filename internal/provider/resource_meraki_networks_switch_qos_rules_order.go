@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -38,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchQosRulesOrderResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchQosRulesOrderResource{}
+	_ resource.Resource                = &NetworksSwitchQosRulesOrderResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchQosRulesOrderResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchQosRulesOrderResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchQosRulesOrderResource{}
 )
 
 func NewNetworksSwitchQosRulesOrderResource() resource.Resource {
@@ -196,6 +195,7 @@ func (r *NetworksSwitchQosRulesOrderResource) Create(ctx context.Context, req re
 			data = ResponseSwitchGetNetworkSwitchQosRuleItemToBodyRs(data, responseVerifyItem, false)
 			diags := resp.State.Set(ctx, &data)
 			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
@@ -237,6 +237,7 @@ func (r *NetworksSwitchQosRulesOrderResource) Create(ctx context.Context, req re
 	} else {
 		data = ResponseSwitchGetNetworkSwitchQosRuleItemToBodyRs(data, responseGet, false)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	}
 }
@@ -292,21 +293,15 @@ func (r *NetworksSwitchQosRulesOrderResource) Read(ctx context.Context, req reso
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchQosRulesOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "network_id", "qos_rule_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("qos_rule_id"), idParts[1])...)
+func (r *NetworksSwitchQosRulesOrderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "qos_rule_id")
 }
 
 func (r *NetworksSwitchQosRulesOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -341,6 +336,7 @@ func (r *NetworksSwitchQosRulesOrderResource) Update(ctx context.Context, req re
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchQosRulesOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
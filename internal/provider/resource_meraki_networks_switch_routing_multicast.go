@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -35,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchRoutingMulticastResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchRoutingMulticastResource{}
+	_ resource.Resource                = &NetworksSwitchRoutingMulticastResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchRoutingMulticastResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchRoutingMulticastResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchRoutingMulticastResource{}
 )
 
 func NewNetworksSwitchRoutingMulticastResource() resource.Resource {
@@ -44,7 +45,8 @@ func NewNetworksSwitchRoutingMulticastResource() resource.Resource {
 }
 
 type NetworksSwitchRoutingMulticastResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSwitchRoutingMulticastResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +55,7 @@ func (r *NetworksSwitchRoutingMulticastResource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -146,6 +149,10 @@ func (r *NetworksSwitchRoutingMulticastResource) Schema(_ context.Context, _ res
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -193,6 +200,7 @@ func (r *NetworksSwitchRoutingMulticastResource) Create(ctx context.Context, req
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -234,9 +242,14 @@ func (r *NetworksSwitchRoutingMulticastResource) Read(ctx context.Context, req r
 	//entro aqui 2
 	data = ResponseSwitchGetNetworkSwitchRoutingMulticastItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchRoutingMulticastResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSwitchRoutingMulticastResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSwitchRoutingMulticastResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -265,11 +278,47 @@ func (r *NetworksSwitchRoutingMulticastResource) Update(ctx context.Context, req
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchRoutingMulticastResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSwitchRoutingMulticast", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSwitchRoutingMulticastRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSwitchRoutingMulticast", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSwitchRoutingMulticastRs{
+		DefaultSettings: &ResponseSwitchGetNetworkSwitchRoutingMulticastDefaultSettingsRs{
+			FloodUnknownMulticastTrafficEnabled: types.BoolValue(true),
+			IgmpSnoopingEnabled:                 types.BoolValue(true),
+		},
+		Overrides: &[]ResponseSwitchGetNetworkSwitchRoutingMulticastOverridesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Switch.UpdateNetworkSwitchRoutingMulticast(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSwitchRoutingMulticast",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSwitchRoutingMulticast",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -278,6 +327,7 @@ type NetworksSwitchRoutingMulticastRs struct {
 	NetworkID       types.String                                                     `tfsdk:"network_id"`
 	DefaultSettings *ResponseSwitchGetNetworkSwitchRoutingMulticastDefaultSettingsRs `tfsdk:"default_settings"`
 	Overrides       *[]ResponseSwitchGetNetworkSwitchRoutingMulticastOverridesRs     `tfsdk:"overrides"`
+	ResetOnDestroy  types.Bool                                                       `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetNetworkSwitchRoutingMulticastDefaultSettingsRs struct {
@@ -354,7 +404,10 @@ func (r *NetworksSwitchRoutingMulticastRs) toSdkApiRequestUpdate(ctx context.Con
 	out := merakigosdk.RequestSwitchUpdateNetworkSwitchRoutingMulticast{
 		DefaultSettings: requestSwitchUpdateNetworkSwitchRoutingMulticastDefaultSettings,
 		Overrides: func() *[]merakigosdk.RequestSwitchUpdateNetworkSwitchRoutingMulticastOverrides {
-			if len(requestSwitchUpdateNetworkSwitchRoutingMulticastOverrides) > 0 {
+			if len(requestSwitchUpdateNetworkSwitchRoutingMulticastOverrides) > 0 || r.Overrides != nil {
+				if len(requestSwitchUpdateNetworkSwitchRoutingMulticastOverrides) == 0 {
+					requestSwitchUpdateNetworkSwitchRoutingMulticastOverrides = make([]merakigosdk.RequestSwitchUpdateNetworkSwitchRoutingMulticastOverrides, 0)
+				}
 				return &requestSwitchUpdateNetworkSwitchRoutingMulticastOverrides
 			}
 			return nil
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -35,8 +32,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchRoutingMulticastRendezvousPointsResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchRoutingMulticastRendezvousPointsResource{}
+	_ resource.Resource                = &NetworksSwitchRoutingMulticastRendezvousPointsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchRoutingMulticastRendezvousPointsResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchRoutingMulticastRendezvousPointsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchRoutingMulticastRendezvousPointsResource{}
 )
 
 func NewNetworksSwitchRoutingMulticastRendezvousPointsResource() resource.Resource {
@@ -149,6 +148,7 @@ func (r *NetworksSwitchRoutingMulticastRendezvousPointsResource) Create(ctx cont
 			data = ResponseSwitchGetNetworkSwitchRoutingMulticastRendezvousPointItemToBodyRs(data, responseVerifyItem, false)
 			diags := resp.State.Set(ctx, &data)
 			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
@@ -190,6 +190,7 @@ func (r *NetworksSwitchRoutingMulticastRendezvousPointsResource) Create(ctx cont
 	} else {
 		data = ResponseSwitchGetNetworkSwitchRoutingMulticastRendezvousPointItemToBodyRs(data, responseGet, false)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	}
 }
@@ -245,20 +246,14 @@ func (r *NetworksSwitchRoutingMulticastRendezvousPointsResource) Read(ctx contex
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchRoutingMulticastRendezvousPointsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "network_id", "rendezvous_point_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rendezvous_point_id"), idParts[1])...)
+func (r *NetworksSwitchRoutingMulticastRendezvousPointsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "rendezvous_point_id")
 }
 
 func (r *NetworksSwitchRoutingMulticastRendezvousPointsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -293,6 +288,7 @@ func (r *NetworksSwitchRoutingMulticastRendezvousPointsResource) Update(ctx cont
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchRoutingMulticastRendezvousPointsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
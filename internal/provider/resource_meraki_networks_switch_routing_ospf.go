@@ -26,7 +26,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -41,8 +40,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchRoutingOspfResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchRoutingOspfResource{}
+	_ resource.Resource                = &NetworksSwitchRoutingOspfResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchRoutingOspfResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchRoutingOspfResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchRoutingOspfResource{}
 )
 
 func NewNetworksSwitchRoutingOspfResource() resource.Resource {
@@ -50,7 +51,8 @@ func NewNetworksSwitchRoutingOspfResource() resource.Resource {
 }
 
 type NetworksSwitchRoutingOspfResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSwitchRoutingOspfResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -59,6 +61,7 @@ func (r *NetworksSwitchRoutingOspfResource) Configure(ctx context.Context, req r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -155,6 +158,7 @@ func (r *NetworksSwitchRoutingOspfResource) Schema(_ context.Context, _ resource
 					},
 					"passphrase": schema.StringAttribute{
 						MarkdownDescription: `MD5 authentication passphrase`,
+						Sensitive:           true,
 						Optional:            true,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
@@ -166,6 +170,10 @@ func (r *NetworksSwitchRoutingOspfResource) Schema(_ context.Context, _ resource
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"v3": schema.SingleNestedAttribute{
 				MarkdownDescription: `OSPF v3 configuration`,
 				Optional:            true,
@@ -285,6 +293,7 @@ func (r *NetworksSwitchRoutingOspfResource) Create(ctx context.Context, req reso
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -326,9 +335,14 @@ func (r *NetworksSwitchRoutingOspfResource) Read(ctx context.Context, req resour
 	//entro aqui 2
 	data = ResponseSwitchGetNetworkSwitchRoutingOspfItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchRoutingOspfResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSwitchRoutingOspfResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSwitchRoutingOspfResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -357,11 +371,46 @@ func (r *NetworksSwitchRoutingOspfResource) Update(ctx context.Context, req reso
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchRoutingOspfResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSwitchRoutingOspf", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSwitchRoutingOspfRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSwitchRoutingOspf", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSwitchRoutingOspfRs{
+		DeadTimerInSeconds:       types.Int64Value(40),
+		Enabled:                  types.BoolValue(false),
+		HelloTimerInSeconds:      types.Int64Value(10),
+		Md5AuthenticationEnabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Switch.UpdateNetworkSwitchRoutingOspf(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSwitchRoutingOspf",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSwitchRoutingOspf",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -375,6 +424,7 @@ type NetworksSwitchRoutingOspfRs struct {
 	Md5AuthenticationEnabled types.Bool                                                       `tfsdk:"md5_authentication_enabled"`
 	Md5AuthenticationKey     *ResponseSwitchGetNetworkSwitchRoutingOspfMd5AuthenticationKeyRs `tfsdk:"md5_authentication_key"`
 	V3                       *ResponseSwitchGetNetworkSwitchRoutingOspfV3Rs                   `tfsdk:"v3"`
+	ResetOnDestroy           types.Bool                                                       `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetNetworkSwitchRoutingOspfAreasRs struct {
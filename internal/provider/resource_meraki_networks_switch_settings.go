@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -39,8 +38,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchSettingsResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchSettingsResource{}
+	_ resource.Resource                = &NetworksSwitchSettingsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchSettingsResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchSettingsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchSettingsResource{}
 )
 
 func NewNetworksSwitchSettingsResource() resource.Resource {
@@ -48,7 +49,8 @@ func NewNetworksSwitchSettingsResource() resource.Resource {
 }
 
 type NetworksSwitchSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSwitchSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,6 +59,7 @@ func (r *NetworksSwitchSettingsResource) Configure(ctx context.Context, req reso
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -122,6 +125,10 @@ func (r *NetworksSwitchSettingsResource) Schema(_ context.Context, _ resource.Sc
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"uplink_client_sampling": schema.SingleNestedAttribute{
 				MarkdownDescription: `Uplink client sampling`,
 				Optional:            true,
@@ -200,6 +207,7 @@ func (r *NetworksSwitchSettingsResource) Create(ctx context.Context, req resourc
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -241,9 +249,14 @@ func (r *NetworksSwitchSettingsResource) Read(ctx context.Context, req resource.
 	//entro aqui 2
 	data = ResponseSwitchGetNetworkSwitchSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSwitchSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSwitchSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -272,11 +285,51 @@ func (r *NetworksSwitchSettingsResource) Update(ctx context.Context, req resourc
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSwitchSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSwitchSettingsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSwitchSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSwitchSettingsRs{
+		MacBlocklist: &ResponseSwitchGetNetworkSwitchSettingsMacBlocklistRs{
+			Enabled: types.BoolValue(false),
+		},
+		PowerExceptions: &[]ResponseSwitchGetNetworkSwitchSettingsPowerExceptionsRs{},
+		UplinkClientSampling: &ResponseSwitchGetNetworkSwitchSettingsUplinkClientSamplingRs{
+			Enabled: types.BoolValue(false),
+		},
+		UseCombinedPower: types.BoolValue(false),
+		VLAN:             types.Int64Value(1),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Switch.UpdateNetworkSwitchSettings(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSwitchSettings",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSwitchSettings",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -288,6 +341,7 @@ type NetworksSwitchSettingsRs struct {
 	UplinkClientSampling *ResponseSwitchGetNetworkSwitchSettingsUplinkClientSamplingRs `tfsdk:"uplink_client_sampling"`
 	UseCombinedPower     types.Bool                                                    `tfsdk:"use_combined_power"`
 	VLAN                 types.Int64                                                   `tfsdk:"vlan"`
+	ResetOnDestroy       types.Bool                                                    `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetNetworkSwitchSettingsMacBlocklistRs struct {
@@ -361,7 +415,10 @@ func (r *NetworksSwitchSettingsRs) toSdkApiRequestUpdate(ctx context.Context) *m
 	out := merakigosdk.RequestSwitchUpdateNetworkSwitchSettings{
 		MacBlocklist: requestSwitchUpdateNetworkSwitchSettingsMacBlocklist,
 		PowerExceptions: func() *[]merakigosdk.RequestSwitchUpdateNetworkSwitchSettingsPowerExceptions {
-			if len(requestSwitchUpdateNetworkSwitchSettingsPowerExceptions) > 0 {
+			if len(requestSwitchUpdateNetworkSwitchSettingsPowerExceptions) > 0 || r.PowerExceptions != nil {
+				if len(requestSwitchUpdateNetworkSwitchSettingsPowerExceptions) == 0 {
+					requestSwitchUpdateNetworkSwitchSettingsPowerExceptions = make([]merakigosdk.RequestSwitchUpdateNetworkSwitchSettingsPowerExceptions, 0)
+				}
 				return &requestSwitchUpdateNetworkSwitchSettingsPowerExceptions
 			}
 			return nil
@@ -19,13 +19,15 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
-	"strings"
+	"time"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
@@ -37,8 +39,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchStacksResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchStacksResource{}
+	_ resource.Resource                = &NetworksSwitchStacksResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchStacksResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchStacksResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchStacksResource{}
 )
 
 func NewNetworksSwitchStacksResource() resource.Resource {
@@ -62,8 +66,14 @@ func (r *NetworksSwitchStacksResource) Metadata(_ context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_networks_switch_stacks"
 }
 
-func (r *NetworksSwitchStacksResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NetworksSwitchStacksResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: `ID of the Switch stack`,
@@ -115,7 +125,7 @@ func (r *NetworksSwitchStacksResource) Schema(_ context.Context, _ resource.Sche
 				Required:            true,
 			},
 			"serials": schema.ListAttribute{
-				MarkdownDescription: `Serials of the switches in the switch stack`,
+				MarkdownDescription: `Serials of the switches in the switch stack. Changing it adds the new switches to the stack, then removes the switches that are not listed, the active switch last, and waits until the stack reports all its members. The creation of a stack also waits for its members. The waits are bounded by the ` + "`create`" + ` and ` + "`update`" + ` timeouts, 30 minutes by default.`,
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.List{
@@ -192,6 +202,7 @@ func (r *NetworksSwitchStacksResource) Create(ctx context.Context, req resource.
 				data = ResponseSwitchGetNetworkSwitchStackItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -243,10 +254,43 @@ func (r *NetworksSwitchStacksResource) Create(ctx context.Context, req resource.
 			)
 			return
 		}
+		if !data.Serials.IsNull() && !data.Serials.IsUnknown() {
+			// Like Update, wait until the stack reports its members, so that the resources
+			// that depend on it do not race the stack.
+			var serials []string
+			resp.Diagnostics.Append(data.Serials.ElementsAs(ctx, &serials, false)...)
+			createTimeout, diags := data.Timeouts.Create(ctx, switchStackMembersTimeout)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+			defer cancel()
+			response, err := waitForSwitchStackMembers(waitCtx, r.client, vvNetworkID, vvSwitchStackID, serials)
+			if response != nil {
+				plannedSerials := data.Serials
+				data = ResponseSwitchGetNetworkSwitchStackItemToBodyRs(data, response, false)
+				data.Members = switchStackMembersToRs(response)
+				// The stack lists its serials in its own order.
+				if err == nil {
+					data.Serials = plannedSerials
+				}
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Failure when waiting for the members of switch stack "+vvSwitchStackID,
+					err.Error(),
+				)
+			}
+			return
+		}
 		responseVerifyItem2, restyRespGet, err := r.client.Switch.GetNetworkSwitchStack(vvNetworkID, vvSwitchStackID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseSwitchGetNetworkSwitchStackItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -311,33 +355,85 @@ func (r *NetworksSwitchStacksResource) Read(ctx context.Context, req resource.Re
 	//entro aqui 2
 	data = ResponseSwitchGetNetworkSwitchStackItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchStacksResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "switch_stack_id")
+}
+
+func (r *NetworksSwitchStacksResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "switch_stack_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+// Update changes the members of the stack to serials. The name of a stack cannot be changed.
+func (r *NetworksSwitchStacksResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NetworksSwitchStacksRs
+	var item types.Object
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &item)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(item.As(ctx, &plan, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	changed := func(planned, current types.String) bool {
+		return !planned.IsUnknown() && !planned.IsNull() && !planned.Equal(current)
+	}
+	if !plan.NetworkID.Equal(state.NetworkID) || changed(plan.Name, state.Name) || changed(plan.SwitchStackID, state.SwitchStackID) {
 		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,switchStackId. Got: %q", req.ID),
+			"Update operation not supported in NetworksSwitchStacks",
+			"Only the serials of a switch stack can be updated.",
 		)
 		return
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("switch_stack_id"), idParts[1])...)
-}
-
-func (r *NetworksSwitchStacksResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan NetworksSwitchStacksRs
-	merge(ctx, req, resp, &plan)
+	state.Timeouts = plan.Timeouts
+	if plan.Serials.IsNull() || plan.Serials.IsUnknown() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
 
+	vvNetworkID := state.NetworkID.ValueString()
+	vvSwitchStackID := state.ID.ValueString()
+	if vvSwitchStackID == "" {
+		vvSwitchStackID = state.SwitchStackID.ValueString()
+	}
+	var serials []string
+	resp.Diagnostics.Append(plan.Serials.ElementsAs(ctx, &serials, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, switchStackMembersTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
-	} // No update
-	resp.Diagnostics.AddError(
-		"Update operation not supported in NetworksSwitchStacks",
-		"Update operation not supported in NetworksSwitchStacks",
-	)
-	return
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	response, err := reconcileSwitchStack(waitCtx, r.client, vvNetworkID, vvSwitchStackID, serials)
+	if response != nil {
+		data := state
+		data.ID = types.StringValue(response.ID)
+		data.IsMonitorOnly = types.BoolPointerValue(response.IsMonitorOnly)
+		data.Members = switchStackMembersToRs(response)
+		// The stack lists its serials in its own order.
+		data.Serials = plan.Serials
+		if err != nil {
+			data.Serials = StringSliceToList(response.Serials)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when updating the members of switch stack "+vvSwitchStackID,
+			err.Error(),
+		)
+	}
 }
 
 func (r *NetworksSwitchStacksResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -379,6 +475,7 @@ type NetworksSwitchStacksRs struct {
 	Members       *[]ResponseSwitchGetNetworkSwitchStackMembersRs `tfsdk:"members"`
 	Name          types.String                                    `tfsdk:"name"`
 	Serials       types.List                                      `tfsdk:"serials"`
+	Timeouts      timeouts.Value                                  `tfsdk:"timeouts"`
 }
 
 type ResponseSwitchGetNetworkSwitchStackMembersRs struct {
@@ -469,10 +566,149 @@ func ResponseSwitchGetNetworkSwitchStackItemToBodyRs(state NetworksSwitchStacksR
 			}
 			return types.String{}
 		}(),
-		Serials: StringSliceToList(response.Serials),
+		Serials:  StringSliceToList(response.Serials),
+		Timeouts: state.Timeouts,
 	}
 	if is_read {
 		return mergeInterfacesOnlyPath(state, itemState).(NetworksSwitchStacksRs)
 	}
 	return mergeInterfaces(state, itemState, true).(NetworksSwitchStacksRs)
 }
+
+// switchStackMembersTimeout bounds the wait for the members of a stack when the timeouts
+// block does not set one.
+const switchStackMembersTimeout = 30 * time.Minute
+
+// switchStackRemoveOrder is the order in which the members of a stack are removed: the active
+// switch goes last, so that the stack keeps a working active switch while it shrinks.
+var switchStackRemoveOrder = map[string]int{"member": 0, "standby": 1, "active": 2}
+
+// reconcileSwitchStack adds the switches of serials that are not in the stack, then removes the
+// members that are not in serials, and waits until the stack reports exactly serials as its
+// members. The switches are added first so that the stack never has fewer members than at the
+// end. It returns the last read of the stack, also with an error when there is one.
+func reconcileSwitchStack(ctx context.Context, client *merakigosdk.Client, networkID string, switchStackID string, serials []string) (*merakigosdk.ResponseSwitchGetNetworkSwitchStack, error) {
+	response, restyResp, err := client.Switch.GetNetworkSwitchStack(networkID, switchStackID)
+	if err != nil || response == nil {
+		if restyResp != nil {
+			return nil, fmt.Errorf("failure when executing GetNetworkSwitchStack: %s", restyResp.String())
+		}
+		return nil, fmt.Errorf("failure when executing GetNetworkSwitchStack: %v", err)
+	}
+	wanted := map[string]bool{}
+	for _, serial := range serials {
+		wanted[serial] = true
+	}
+	current := map[string]bool{}
+	for _, serial := range response.Serials {
+		current[serial] = true
+	}
+	roles := map[string]string{}
+	if response.Members != nil {
+		for _, member := range *response.Members {
+			roles[member.Serial] = member.Role
+		}
+	}
+	var removes []string
+	for _, serial := range response.Serials {
+		if !wanted[serial] {
+			removes = append(removes, serial)
+		}
+	}
+	sort.SliceStable(removes, func(i, j int) bool {
+		return switchStackRemoveOrder[roles[removes[i]]] < switchStackRemoveOrder[roles[removes[j]]]
+	})
+
+	for _, serial := range serials {
+		if current[serial] {
+			continue
+		}
+		_, restyResp, err := client.Switch.AddNetworkSwitchStack(networkID, switchStackID, &merakigosdk.RequestSwitchAddNetworkSwitchStack{Serial: serial})
+		if err != nil {
+			if restyResp != nil {
+				err = errors.New(restyResp.String())
+			}
+			return response, fmt.Errorf("failure when executing AddNetworkSwitchStack for %s: %s", serial, err)
+		}
+	}
+	for _, serial := range removes {
+		_, restyResp, err := client.Switch.RemoveNetworkSwitchStack(networkID, switchStackID, &merakigosdk.RequestSwitchRemoveNetworkSwitchStack{Serial: serial})
+		if err != nil {
+			if restyResp != nil {
+				err = errors.New(restyResp.String())
+			}
+			return response, fmt.Errorf("failure when executing RemoveNetworkSwitchStack for %s: %s", serial, err)
+		}
+	}
+
+	stack, err := waitForSwitchStackMembers(ctx, client, networkID, switchStackID, serials)
+	if stack != nil {
+		response = stack
+	}
+	return response, err
+}
+
+// waitForSwitchStackMembers waits until the stack reports exactly serials as its members. It
+// returns the last read of the stack, also with an error when there is one.
+func waitForSwitchStackMembers(ctx context.Context, client *merakigosdk.Client, networkID string, switchStackID string, serials []string) (*merakigosdk.ResponseSwitchGetNetworkSwitchStack, error) {
+	wanted := map[string]bool{}
+	for _, serial := range serials {
+		wanted[serial] = true
+	}
+	var response *merakigosdk.ResponseSwitchGetNetworkSwitchStack
+	err := poll(ctx, func() (bool, error) {
+		stack, restyResp, err := client.Switch.GetNetworkSwitchStack(networkID, switchStackID)
+		if err != nil || stack == nil {
+			if restyResp != nil {
+				return false, fmt.Errorf("failure when executing GetNetworkSwitchStack: %s", restyResp.String())
+			}
+			return false, fmt.Errorf("failure when executing GetNetworkSwitchStack: %v", err)
+		}
+		response = stack
+		return switchStackHasMembers(stack, wanted), nil
+	})
+	return response, err
+}
+
+// switchStackMembersToRs returns the members of the stack.
+func switchStackMembersToRs(response *merakigosdk.ResponseSwitchGetNetworkSwitchStack) *[]ResponseSwitchGetNetworkSwitchStackMembersRs {
+	if response.Members == nil {
+		return nil
+	}
+	stringToRs := func(value string) types.String {
+		if value == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(value)
+	}
+	members := make([]ResponseSwitchGetNetworkSwitchStackMembersRs, len(*response.Members))
+	for i, member := range *response.Members {
+		members[i] = ResponseSwitchGetNetworkSwitchStackMembersRs{
+			Mac:    stringToRs(member.Mac),
+			Model:  stringToRs(member.Model),
+			Name:   stringToRs(member.Name),
+			Role:   stringToRs(member.Role),
+			Serial: stringToRs(member.Serial),
+		}
+	}
+	return &members
+}
+
+// switchStackHasMembers reports whether the serials and the members of the stack are the
+// switches of wanted.
+func switchStackHasMembers(stack *merakigosdk.ResponseSwitchGetNetworkSwitchStack, wanted map[string]bool) bool {
+	if len(stack.Serials) != len(wanted) || stack.Members == nil || len(*stack.Members) != len(wanted) {
+		return false
+	}
+	for _, serial := range stack.Serials {
+		if !wanted[serial] {
+			return false
+		}
+	}
+	for _, member := range *stack.Members {
+		if !wanted[member.Serial] {
+			return false
+		}
+	}
+	return true
+}
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +37,11 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchStacksRoutingInterfacesResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchStacksRoutingInterfacesResource{}
+	_ resource.Resource                 = &NetworksSwitchStacksRoutingInterfacesResource{}
+	_ resource.ResourceWithConfigure    = &NetworksSwitchStacksRoutingInterfacesResource{}
+	_ resource.ResourceWithImportState  = &NetworksSwitchStacksRoutingInterfacesResource{}
+	_ resource.ResourceWithIdentity     = &NetworksSwitchStacksRoutingInterfacesResource{}
+	_ resource.ResourceWithUpgradeState = &NetworksSwitchStacksRoutingInterfacesResource{}
 )
 
 func NewNetworksSwitchStacksRoutingInterfacesResource() resource.Resource {
@@ -65,8 +65,14 @@ func (r *NetworksSwitchStacksRoutingInterfacesResource) Metadata(_ context.Conte
 	resp.TypeName = req.ProviderTypeName + "_networks_switch_stacks_routing_interfaces"
 }
 
+// UpgradeState upgrades the states written before the VLAN IDs were numbers.
+func (r *NetworksSwitchStacksRoutingInterfacesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(1)
+}
+
 func (r *NetworksSwitchStacksRoutingInterfacesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"default_gateway": schema.StringAttribute{
 				MarkdownDescription: `IPv4 default gateway`,
@@ -314,6 +320,7 @@ func (r *NetworksSwitchStacksRoutingInterfacesResource) Create(ctx context.Conte
 				data = ResponseSwitchGetNetworkSwitchStackRoutingInterfaceItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -369,6 +376,7 @@ func (r *NetworksSwitchStacksRoutingInterfacesResource) Create(ctx context.Conte
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseSwitchGetNetworkSwitchStackRoutingInterfaceItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -446,22 +454,15 @@ func (r *NetworksSwitchStacksRoutingInterfacesResource) Read(ctx context.Context
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchStacksRoutingInterfacesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "network_id", "switch_stack_id", "interface_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("switch_stack_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface_id"), idParts[2])...)
+func (r *NetworksSwitchStacksRoutingInterfacesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "switch_stack_id", "interface_id")
 }
 
 func (r *NetworksSwitchStacksRoutingInterfacesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -497,6 +498,7 @@ func (r *NetworksSwitchStacksRoutingInterfacesResource) Update(ctx context.Conte
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchStacksRoutingInterfacesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchStacksRoutingInterfacesDhcpResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchStacksRoutingInterfacesDhcpResource{}
+	_ resource.Resource                = &NetworksSwitchStacksRoutingInterfacesDhcpResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchStacksRoutingInterfacesDhcpResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchStacksRoutingInterfacesDhcpResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchStacksRoutingInterfacesDhcpResource{}
 )
 
 func NewNetworksSwitchStacksRoutingInterfacesDhcpResource() resource.Resource {
@@ -49,7 +48,8 @@ func NewNetworksSwitchStacksRoutingInterfacesDhcpResource() resource.Resource {
 }
 
 type NetworksSwitchStacksRoutingInterfacesDhcpResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSwitchStacksRoutingInterfacesDhcpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -58,6 +58,7 @@ func (r *NetworksSwitchStacksRoutingInterfacesDhcpResource) Configure(ctx contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -275,6 +276,10 @@ func (r *NetworksSwitchStacksRoutingInterfacesDhcpResource) Schema(_ context.Con
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"switch_stack_id": schema.StringAttribute{
 				MarkdownDescription: `switchStackId path parameter. Switch stack ID`,
 				Required:            true,
@@ -328,6 +333,7 @@ func (r *NetworksSwitchStacksRoutingInterfacesDhcpResource) Create(ctx context.C
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -371,20 +377,14 @@ func (r *NetworksSwitchStacksRoutingInterfacesDhcpResource) Read(ctx context.Con
 	//entro aqui 2
 	data = ResponseSwitchGetNetworkSwitchStackRoutingInterfaceDhcpItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchStacksRoutingInterfacesDhcpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "switch_stack_id", "interface_id")
+}
 
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,switchStackId,interfaceId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("switch_stack_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface_id"), idParts[2])...)
+func (r *NetworksSwitchStacksRoutingInterfacesDhcpResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "switch_stack_id", "interface_id")
 }
 
 func (r *NetworksSwitchStacksRoutingInterfacesDhcpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -415,11 +415,45 @@ func (r *NetworksSwitchStacksRoutingInterfacesDhcpResource) Update(ctx context.C
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchStacksRoutingInterfacesDhcpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSwitchStacksRoutingInterfacesDhcp", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSwitchStacksRoutingInterfacesDhcpRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSwitchStacksRoutingInterfacesDhcp", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvSwitchStackID := state.SwitchStackID.ValueString()
+	vvInterfaceID := state.InterfaceID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSwitchStacksRoutingInterfacesDhcpRs{
+		DhcpMode: types.StringValue("dhcpDisabled"),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Switch.UpdateNetworkSwitchStackRoutingInterfaceDhcp(vvNetworkID, vvSwitchStackID, vvInterfaceID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSwitchStackRoutingInterfaceDhcp",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSwitchStackRoutingInterfaceDhcp",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -439,6 +473,7 @@ type NetworksSwitchStacksRoutingInterfacesDhcpRs struct {
 	DNSNameserversOption types.String                                                                   `tfsdk:"dns_nameservers_option"`
 	FixedIPAssignments   *[]ResponseSwitchGetNetworkSwitchStackRoutingInterfaceDhcpFixedIpAssignmentsRs `tfsdk:"fixed_ip_assignments"`
 	ReservedIPRanges     *[]ResponseSwitchGetNetworkSwitchStackRoutingInterfaceDhcpReservedIpRangesRs   `tfsdk:"reserved_ip_ranges"`
+	ResetOnDestroy       types.Bool                                                                     `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetNetworkSwitchStackRoutingInterfaceDhcpDhcpOptionsRs struct {
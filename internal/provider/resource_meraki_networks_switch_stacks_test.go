@@ -0,0 +1,262 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testSwitchStackPath = "/api/v1/networks/N_1/switch/stacks/S_1"
+
+// simulateSwitchStack applies the add and remove requests of the mock to the stack, like the
+// Meraki API does once the switches have joined or left the stack.
+func simulateSwitchStack(t *testing.T, mock *merakiMock) {
+	t.Helper()
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+	go func() {
+		ticker := time.NewTicker(5 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			serials := []string{"Q2SW-000A-000A", "Q2SW-000B-000B", "Q2SW-000C-000C"}
+			for _, request := range mock.requestsFor("POST", testSwitchStackPath+"/add") {
+				body, _ := request.Body.(map[string]interface{})
+				serials = append(serials, body["serial"].(string))
+			}
+			for _, request := range mock.requestsFor("POST", testSwitchStackPath+"/remove") {
+				body, _ := request.Body.(map[string]interface{})
+				for i, serial := range serials {
+					if serial == body["serial"] {
+						serials = append(serials[:i], serials[i+1:]...)
+						break
+					}
+				}
+			}
+			sort.Strings(serials)
+			members := []interface{}{}
+			for _, serial := range serials {
+				members = append(members, map[string]interface{}{"serial": serial, "role": "member"})
+			}
+			mock.setBody(testSwitchStackPath, map[string]interface{}{
+				"id":      "S_1",
+				"name":    "core-stack",
+				"serials": serials,
+				"members": members,
+			})
+		}
+	}()
+}
+
+// switchStackTimeoutsNull is the timeouts block of a configuration that does not set it.
+func switchStackTimeoutsNull() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+		"create": types.StringType,
+		"update": types.StringType,
+	})}
+}
+
+// updateSwitchStack runs Update from a stack of switches A, B and C to the planned serials.
+func updateSwitchStack(t *testing.T, mock *merakiMock, name string, serials []string) *resource.UpdateResponse {
+	t.Helper()
+	ctx := context.Background()
+	r := &NetworksSwitchStacksResource{client: mock.client(t)}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	current := NetworksSwitchStacksRs{
+		NetworkID:     types.StringValue("N_1"),
+		SwitchStackID: types.StringNull(),
+		ID:            types.StringValue("S_1"),
+		IsMonitorOnly: types.BoolValue(false),
+		Name:          types.StringValue("core-stack"),
+		Serials:       StringSliceToList([]string{"Q2SW-000A-000A", "Q2SW-000B-000B", "Q2SW-000C-000C"}),
+		Timeouts:      switchStackTimeoutsNull(),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &current); diags.HasError() {
+		t.Fatal(diags)
+	}
+	planned := current
+	planned.Name = types.StringValue(name)
+	planned.Serials = StringSliceToList(serials)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, &planned); diags.HasError() {
+		t.Fatal(diags)
+	}
+	// The members are computed, so Terraform plans them as unknown.
+	members := schemaResp.Schema.Attributes["members"].GetType().(types.ListType)
+	if diags := plan.SetAttribute(ctx, path.Root("members"), types.ListUnknown(members.ElemType)); diags.HasError() {
+		t.Fatal(diags)
+	}
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	return resp
+}
+
+func TestSwitchStackUpdateSerials(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	mock := newMerakiMock(t, "switch_stacks")
+	simulateSwitchStack(t, mock)
+	resp := updateSwitchStack(t, mock, "core-stack", []string{"Q2SW-000C-000C", "Q2SW-000D-000D"})
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	// D is added before the active switch A and the standby switch B are removed, A last.
+	var operations []string
+	for _, request := range mock.requestsFor("POST", testSwitchStackPath+"/add") {
+		operations = append(operations, "add "+request.Body.(map[string]interface{})["serial"].(string))
+	}
+	for _, request := range mock.requestsFor("POST", testSwitchStackPath+"/remove") {
+		operations = append(operations, "remove "+request.Body.(map[string]interface{})["serial"].(string))
+	}
+	want := []string{"add Q2SW-000D-000D", "remove Q2SW-000B-000B", "remove Q2SW-000A-000A"}
+	if !reflect.DeepEqual(operations, want) {
+		t.Errorf("operations = %v, want %v", operations, want)
+	}
+	if adds, removes := mock.requestsFor("POST", testSwitchStackPath+"/add"), mock.requestsFor("POST", testSwitchStackPath+"/remove"); len(adds) == 1 && len(removes) > 0 {
+		mock.mu.Lock()
+		order := []string{}
+		for _, request := range mock.requests {
+			if strings.HasPrefix(request.Path, testSwitchStackPath+"/") {
+				order = append(order, request.Path[len(testSwitchStackPath)+1:])
+			}
+		}
+		mock.mu.Unlock()
+		if !reflect.DeepEqual(order, []string{"add", "remove", "remove"}) {
+			t.Errorf("requests = %v, want the add before the removes", order)
+		}
+	}
+
+	var data NetworksSwitchStacksRs
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if serials := elementsToStrings(context.Background(), data.Serials); !reflect.DeepEqual(serials, []string{"Q2SW-000C-000C", "Q2SW-000D-000D"}) {
+		t.Errorf("serials = %v, want the planned serials", serials)
+	}
+	if data.Members == nil || len(*data.Members) != 2 {
+		t.Errorf("members = %v, want the 2 switches of the stack", data.Members)
+	}
+}
+
+func TestSwitchStackUpdateName(t *testing.T) {
+	mock := newMerakiMock(t, "switch_stacks")
+	resp := updateSwitchStack(t, mock, "renamed", []string{"Q2SW-000A-000A", "Q2SW-000B-000B", "Q2SW-000C-000C"})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Update renamed the stack")
+	}
+	if requests := len(mock.requestsFor("GET", testSwitchStackPath)); requests != 0 {
+		t.Errorf("GET requests = %d, want none", requests)
+	}
+}
+
+func TestSwitchStackCreateWaitsForMembers(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	ctx := context.Background()
+	mock := newMerakiMock(t, "switch_stacks")
+	// The stack is not found before its creation, then it only reports switch A until the
+	// other switches have joined.
+	mock.failNext("GET", "/api/v1/networks/N_1/switch/stacks", 404)
+	mock.setBody(testSwitchStackPath, map[string]interface{}{
+		"id":      "S_1",
+		"name":    "core-stack",
+		"serials": []string{"Q2SW-000A-000A"},
+		"members": []interface{}{map[string]interface{}{"serial": "Q2SW-000A-000A", "role": "active"}},
+	})
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		// The switches join once Create has read the stack after creating it.
+		for len(mock.requestsFor("POST", "/api/v1/networks/N_1/switch/stacks")) == 0 || len(mock.requestsFor("GET", testSwitchStackPath)) == 0 {
+			select {
+			case <-done:
+				return
+			case <-time.After(5 * time.Millisecond):
+			}
+		}
+		stack, _ := mock.body("/api/v1/networks/N_1/switch/stacks")
+		mock.setBody(testSwitchStackPath, map[string]interface{}{
+			"id":      "S_1",
+			"name":    "core-stack",
+			"serials": stack.(map[string]interface{})["serials"],
+			"members": []interface{}{
+				map[string]interface{}{"serial": "Q2SW-000A-000A", "role": "active"},
+				map[string]interface{}{"serial": "Q2SW-000B-000B", "role": "standby"},
+				map[string]interface{}{"serial": "Q2SW-000C-000C", "role": "member"},
+			},
+		})
+	}()
+
+	r := &NetworksSwitchStacksResource{client: mock.client(t)}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	planned := NetworksSwitchStacksRs{
+		NetworkID:     types.StringValue("N_1"),
+		SwitchStackID: types.StringNull(),
+		ID:            types.StringUnknown(),
+		IsMonitorOnly: types.BoolUnknown(),
+		Name:          types.StringValue("core-stack"),
+		Serials:       StringSliceToList([]string{"Q2SW-000A-000A", "Q2SW-000B-000B", "Q2SW-000C-000C"}),
+		Timeouts:      switchStackTimeoutsNull(),
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, &planned); diags.HasError() {
+		t.Fatal(diags)
+	}
+	members := schemaResp.Schema.Attributes["members"].GetType().(types.ListType)
+	if diags := plan.SetAttribute(ctx, path.Root("members"), types.ListUnknown(members.ElemType)); diags.HasError() {
+		t.Fatal(diags)
+	}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var data NetworksSwitchStacksRs
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if requests := len(mock.requestsFor("GET", testSwitchStackPath)); requests < 2 {
+		t.Errorf("GET requests of the stack = %d, want the reads until the members joined", requests)
+	}
+	if data.Members == nil || len(*data.Members) != 3 {
+		t.Errorf("members = %v, want the 3 switches of the stack", data.Members)
+	}
+}
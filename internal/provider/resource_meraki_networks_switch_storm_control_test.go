@@ -0,0 +1,91 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccMerakiNetworksSwitchStormControl_basic(t *testing.T) {
+	mock := newMerakiMock(t, "networks_switch_storm_control")
+	resourceName := "meraki_networks_switch_storm_control.example"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMerakiNetworksSwitchStormControlReset(mock),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMerakiNetworksSwitchStormControlConfig(mock, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "network_id", "N_24329156"),
+					resource.TestCheckResourceAttr(resourceName, "broadcast_threshold", "30"),
+					resource.TestCheckResourceAttr(resourceName, "multicast_threshold", "30"),
+					resource.TestCheckResourceAttr(resourceName, "unknown_unicast_threshold", "30"),
+				),
+			},
+			{
+				Config: testAccMerakiNetworksSwitchStormControlConfig(mock, 40),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "broadcast_threshold", "40"),
+					resource.TestCheckResourceAttr(resourceName, "multicast_threshold", "40"),
+					resource.TestCheckResourceAttr(resourceName, "unknown_unicast_threshold", "40"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        "N_24329156",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "network_id",
+				ImportStateVerifyIgnore:              []string{"reset_on_destroy"},
+			},
+		},
+	})
+}
+
+// testAccCheckMerakiNetworksSwitchStormControlReset checks that destroying the resource restored
+// the Meraki default thresholds.
+func testAccCheckMerakiNetworksSwitchStormControlReset(mock *merakiMock) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		body, ok := mock.body("/api/v1/networks/N_24329156/switch/stormControl")
+		if !ok {
+			return fmt.Errorf("storm control settings not found")
+		}
+		for _, key := range []string{"broadcastThreshold", "multicastThreshold", "unknownUnicastThreshold"} {
+			if value := body.(map[string]interface{})[key]; value != float64(100) {
+				return fmt.Errorf("%s is %v after destroy, expected 100", key, value)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccMerakiNetworksSwitchStormControlConfig(mock *merakiMock, threshold int) string {
+	return testAccProviderConfig(mock) + fmt.Sprintf(`
+resource "meraki_networks_switch_storm_control" "example" {
+  network_id                = "N_24329156"
+  broadcast_threshold       = %[1]d
+  multicast_threshold       = %[1]d
+  unknown_unicast_threshold = %[1]d
+  reset_on_destroy          = true
+}
+`, threshold)
+}
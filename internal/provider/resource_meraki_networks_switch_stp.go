@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -35,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSwitchStpResource{}
-	_ resource.ResourceWithConfigure = &NetworksSwitchStpResource{}
+	_ resource.Resource                = &NetworksSwitchStpResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSwitchStpResource{}
+	_ resource.ResourceWithImportState = &NetworksSwitchStpResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSwitchStpResource{}
 )
 
 func NewNetworksSwitchStpResource() resource.Resource {
@@ -44,15 +45,17 @@ func NewNetworksSwitchStpResource() resource.Resource {
 }
 
 type NetworksSwitchStpResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSwitchStpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -67,6 +70,10 @@ func (r *NetworksSwitchStpResource) Schema(_ context.Context, _ resource.SchemaR
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rstp_enabled": schema.BoolAttribute{
 				MarkdownDescription: `The spanning tree protocol status in network`,
 				Optional:            true,
@@ -167,6 +174,7 @@ func (r *NetworksSwitchStpResource) Create(ctx context.Context, req resource.Cre
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -208,9 +216,14 @@ func (r *NetworksSwitchStpResource) Read(ctx context.Context, req resource.ReadR
 	//entro aqui 2
 	data = ResponseSwitchGetNetworkSwitchStpItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSwitchStpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSwitchStpResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSwitchStpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -242,11 +255,44 @@ func (r *NetworksSwitchStpResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSwitchStpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSwitchStp", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSwitchStpRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSwitchStp", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSwitchStpRs{
+		RstpEnabled:       types.BoolValue(true),
+		StpBridgePriority: &[]ResponseSwitchGetNetworkSwitchStpStpBridgePriorityRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Switch.UpdateNetworkSwitchStp(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSwitchStp",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSwitchStp",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -255,6 +301,7 @@ type NetworksSwitchStpRs struct {
 	NetworkID         types.String                                            `tfsdk:"network_id"`
 	RstpEnabled       types.Bool                                              `tfsdk:"rstp_enabled"`
 	StpBridgePriority *[]ResponseSwitchGetNetworkSwitchStpStpBridgePriorityRs `tfsdk:"stp_bridge_priority"`
+	ResetOnDestroy    types.Bool                                              `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetNetworkSwitchStpStpBridgePriorityRs struct {
@@ -303,7 +350,10 @@ func (r *NetworksSwitchStpRs) toSdkApiRequestUpdate(ctx context.Context) *meraki
 	out := merakigosdk.RequestSwitchUpdateNetworkSwitchStp{
 		RstpEnabled: rstpEnabled,
 		StpBridgePriority: func() *[]merakigosdk.RequestSwitchUpdateNetworkSwitchStpStpBridgePriority {
-			if len(requestSwitchUpdateNetworkSwitchStpStpBridgePriority) > 0 {
+			if len(requestSwitchUpdateNetworkSwitchStpStpBridgePriority) > 0 || r.StpBridgePriority != nil {
+				if len(requestSwitchUpdateNetworkSwitchStpStpBridgePriority) == 0 {
+					requestSwitchUpdateNetworkSwitchStpStpBridgePriority = make([]merakigosdk.RequestSwitchUpdateNetworkSwitchStpStpBridgePriority, 0)
+				}
 				return &requestSwitchUpdateNetworkSwitchStpStpBridgePriority
 			}
 			return nil
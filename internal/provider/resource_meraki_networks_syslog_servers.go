@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -36,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksSyslogServersResource{}
-	_ resource.ResourceWithConfigure = &NetworksSyslogServersResource{}
+	_ resource.Resource                = &NetworksSyslogServersResource{}
+	_ resource.ResourceWithConfigure   = &NetworksSyslogServersResource{}
+	_ resource.ResourceWithImportState = &NetworksSyslogServersResource{}
+	_ resource.ResourceWithIdentity    = &NetworksSyslogServersResource{}
 )
 
 func NewNetworksSyslogServersResource() resource.Resource {
@@ -45,15 +46,17 @@ func NewNetworksSyslogServersResource() resource.Resource {
 }
 
 type NetworksSyslogServersResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksSyslogServersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -68,6 +71,10 @@ func (r *NetworksSyslogServersResource) Schema(_ context.Context, _ resource.Sch
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"servers": schema.ListNestedAttribute{
 				MarkdownDescription: `List of the syslog servers for this network`,
 				Optional:            true,
@@ -150,6 +157,7 @@ func (r *NetworksSyslogServersResource) Create(ctx context.Context, req resource
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -191,9 +199,14 @@ func (r *NetworksSyslogServersResource) Read(ctx context.Context, req resource.R
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkSyslogServersItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksSyslogServersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksSyslogServersResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksSyslogServersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -222,18 +235,51 @@ func (r *NetworksSyslogServersResource) Update(ctx context.Context, req resource
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksSyslogServersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksSyslogServers", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksSyslogServersRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksSyslogServers", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksSyslogServersRs{
+		Servers: &[]ResponseNetworksGetNetworkSyslogServersServersRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Networks.UpdateNetworkSyslogServers(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkSyslogServers",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkSyslogServers",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksSyslogServersRs struct {
-	NetworkID types.String                                        `tfsdk:"network_id"`
-	Servers   *[]ResponseNetworksGetNetworkSyslogServersServersRs `tfsdk:"servers"`
+	NetworkID      types.String                                        `tfsdk:"network_id"`
+	Servers        *[]ResponseNetworksGetNetworkSyslogServersServersRs `tfsdk:"servers"`
+	ResetOnDestroy types.Bool                                          `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseNetworksGetNetworkSyslogServersServersRs struct {
@@ -268,7 +314,10 @@ func (r *NetworksSyslogServersRs) toSdkApiRequestUpdate(ctx context.Context) *me
 	}
 	out := merakigosdk.RequestNetworksUpdateNetworkSyslogServers{
 		Servers: func() *[]merakigosdk.RequestNetworksUpdateNetworkSyslogServersServers {
-			if len(requestNetworksUpdateNetworkSyslogServersServers) > 0 {
+			if len(requestNetworksUpdateNetworkSyslogServersServers) > 0 || r.Servers != nil {
+				if len(requestNetworksUpdateNetworkSyslogServersServers) == 0 {
+					requestNetworksUpdateNetworkSyslogServersServers = make([]merakigosdk.RequestNetworksUpdateNetworkSyslogServersServers, 0)
+				}
 				return &requestNetworksUpdateNetworkSyslogServersServers
 			}
 			return nil
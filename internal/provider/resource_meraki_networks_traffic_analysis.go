@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -36,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksTrafficAnalysisResource{}
-	_ resource.ResourceWithConfigure = &NetworksTrafficAnalysisResource{}
+	_ resource.Resource                = &NetworksTrafficAnalysisResource{}
+	_ resource.ResourceWithConfigure   = &NetworksTrafficAnalysisResource{}
+	_ resource.ResourceWithImportState = &NetworksTrafficAnalysisResource{}
+	_ resource.ResourceWithIdentity    = &NetworksTrafficAnalysisResource{}
 )
 
 func NewNetworksTrafficAnalysisResource() resource.Resource {
@@ -45,7 +46,8 @@ func NewNetworksTrafficAnalysisResource() resource.Resource {
 }
 
 type NetworksTrafficAnalysisResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksTrafficAnalysisResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -54,6 +56,7 @@ func (r *NetworksTrafficAnalysisResource) Configure(ctx context.Context, req res
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -129,6 +132,10 @@ func (r *NetworksTrafficAnalysisResource) Schema(_ context.Context, _ resource.S
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -176,6 +183,7 @@ func (r *NetworksTrafficAnalysisResource) Create(ctx context.Context, req resour
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -217,9 +225,14 @@ func (r *NetworksTrafficAnalysisResource) Read(ctx context.Context, req resource
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkTrafficAnalysisItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksTrafficAnalysisResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksTrafficAnalysisResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksTrafficAnalysisResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -248,11 +261,44 @@ func (r *NetworksTrafficAnalysisResource) Update(ctx context.Context, req resour
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksTrafficAnalysisResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksTrafficAnalysis", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksTrafficAnalysisRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksTrafficAnalysis", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksTrafficAnalysisRs{
+		CustomPieChartItems: &[]ResponseNetworksGetNetworkTrafficAnalysisCustomPieChartItemsRs{},
+		Mode:                types.StringValue("basic"),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Networks.UpdateNetworkTrafficAnalysis(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkTrafficAnalysis",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkTrafficAnalysis",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -261,6 +307,7 @@ type NetworksTrafficAnalysisRs struct {
 	NetworkID           types.String                                                      `tfsdk:"network_id"`
 	CustomPieChartItems *[]ResponseNetworksGetNetworkTrafficAnalysisCustomPieChartItemsRs `tfsdk:"custom_pie_chart_items"`
 	Mode                types.String                                                      `tfsdk:"mode"`
+	ResetOnDestroy      types.Bool                                                        `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseNetworksGetNetworkTrafficAnalysisCustomPieChartItemsRs struct {
@@ -295,7 +342,10 @@ func (r *NetworksTrafficAnalysisRs) toSdkApiRequestUpdate(ctx context.Context) *
 	}
 	out := merakigosdk.RequestNetworksUpdateNetworkTrafficAnalysis{
 		CustomPieChartItems: func() *[]merakigosdk.RequestNetworksUpdateNetworkTrafficAnalysisCustomPieChartItems {
-			if len(requestNetworksUpdateNetworkTrafficAnalysisCustomPieChartItems) > 0 {
+			if len(requestNetworksUpdateNetworkTrafficAnalysisCustomPieChartItems) > 0 || r.CustomPieChartItems != nil {
+				if len(requestNetworksUpdateNetworkTrafficAnalysisCustomPieChartItems) == 0 {
+					requestNetworksUpdateNetworkTrafficAnalysisCustomPieChartItems = make([]merakigosdk.RequestNetworksUpdateNetworkTrafficAnalysisCustomPieChartItems, 0)
+				}
 				return &requestNetworksUpdateNetworkTrafficAnalysisCustomPieChartItems
 			}
 			return nil
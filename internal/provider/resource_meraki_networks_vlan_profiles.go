@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -37,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksVLANProfilesResource{}
-	_ resource.ResourceWithConfigure = &NetworksVLANProfilesResource{}
+	_ resource.Resource                = &NetworksVLANProfilesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksVLANProfilesResource{}
+	_ resource.ResourceWithImportState = &NetworksVLANProfilesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksVLANProfilesResource{}
 )
 
 func NewNetworksVLANProfilesResource() resource.Resource {
@@ -226,6 +225,7 @@ func (r *NetworksVLANProfilesResource) Create(ctx context.Context, req resource.
 				data = ResponseNetworksGetNetworkVLANProfileItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -281,6 +281,7 @@ func (r *NetworksVLANProfilesResource) Create(ctx context.Context, req resource.
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseNetworksGetNetworkVLANProfileItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -345,19 +346,14 @@ func (r *NetworksVLANProfilesResource) Read(ctx context.Context, req resource.Re
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkVLANProfileItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksVLANProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "iname")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,iname. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("iname"), idParts[1])...)
+func (r *NetworksVLANProfilesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "iname")
 }
 
 func (r *NetworksVLANProfilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -387,6 +383,7 @@ func (r *NetworksVLANProfilesResource) Update(ctx context.Context, req resource.
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksVLANProfilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
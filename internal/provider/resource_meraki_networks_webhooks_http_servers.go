@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -36,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWebhooksHTTPServersResource{}
-	_ resource.ResourceWithConfigure = &NetworksWebhooksHTTPServersResource{}
+	_ resource.Resource                = &NetworksWebhooksHTTPServersResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWebhooksHTTPServersResource{}
+	_ resource.ResourceWithImportState = &NetworksWebhooksHTTPServersResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWebhooksHTTPServersResource{}
 )
 
 func NewNetworksWebhooksHTTPServersResource() resource.Resource {
@@ -113,6 +112,7 @@ func (r *NetworksWebhooksHTTPServersResource) Schema(_ context.Context, _ resour
 			},
 			"shared_secret": schema.StringAttribute{
 				MarkdownDescription: `A shared secret that will be included in POSTs sent to the HTTP server. This secret can be used to verify that the request was sent by Meraki.`,
+				Sensitive:           true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -191,6 +191,7 @@ func (r *NetworksWebhooksHTTPServersResource) Create(ctx context.Context, req re
 				data = ResponseNetworksGetNetworkWebhooksHTTPServerItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -246,6 +247,7 @@ func (r *NetworksWebhooksHTTPServersResource) Create(ctx context.Context, req re
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseNetworksGetNetworkWebhooksHTTPServerItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -310,19 +312,14 @@ func (r *NetworksWebhooksHTTPServersResource) Read(ctx context.Context, req reso
 	//entro aqui 2
 	data = ResponseNetworksGetNetworkWebhooksHTTPServerItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWebhooksHTTPServersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "http_server_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,httpServerId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("http_server_id"), idParts[1])...)
+func (r *NetworksWebhooksHTTPServersResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "http_server_id")
 }
 
 func (r *NetworksWebhooksHTTPServersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -352,6 +349,7 @@ func (r *NetworksWebhooksHTTPServersResource) Update(ctx context.Context, req re
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWebhooksHTTPServersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -34,6 +34,7 @@ import (
 var (
 	_ resource.Resource              = &NetworksWirelessAirMarshalRulesCreateResource{}
 	_ resource.ResourceWithConfigure = &NetworksWirelessAirMarshalRulesCreateResource{}
+	_ resource.ResourceWithMoveState = &NetworksWirelessAirMarshalRulesCreateResource{}
 )
 
 func NewNetworksWirelessAirMarshalRulesCreateResource() resource.Resource {
@@ -57,6 +58,11 @@ func (r *NetworksWirelessAirMarshalRulesCreateResource) Metadata(_ context.Conte
 	resp.TypeName = req.ProviderTypeName + "_networks_wireless_air_marshal_rules_create"
 }
 
+// MoveState moves the states of the former meraki_networks_wireless_air_marshal_rules resource.
+func (r *NetworksWirelessAirMarshalRulesCreateResource) MoveState(ctx context.Context) []resource.StateMover {
+	return moveStateFrom("meraki_networks_wireless_air_marshal_rules_create")
+}
+
 // resourceAction
 func (r *NetworksWirelessAirMarshalRulesCreateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +36,11 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessAlternateManagementInterfaceResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessAlternateManagementInterfaceResource{}
+	_ resource.Resource                 = &NetworksWirelessAlternateManagementInterfaceResource{}
+	_ resource.ResourceWithConfigure    = &NetworksWirelessAlternateManagementInterfaceResource{}
+	_ resource.ResourceWithImportState  = &NetworksWirelessAlternateManagementInterfaceResource{}
+	_ resource.ResourceWithIdentity     = &NetworksWirelessAlternateManagementInterfaceResource{}
+	_ resource.ResourceWithUpgradeState = &NetworksWirelessAlternateManagementInterfaceResource{}
 )
 
 func NewNetworksWirelessAlternateManagementInterfaceResource() resource.Resource {
@@ -46,7 +48,8 @@ func NewNetworksWirelessAlternateManagementInterfaceResource() resource.Resource
 }
 
 type NetworksWirelessAlternateManagementInterfaceResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessAlternateManagementInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +58,7 @@ func (r *NetworksWirelessAlternateManagementInterfaceResource) Configure(ctx con
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -62,8 +66,14 @@ func (r *NetworksWirelessAlternateManagementInterfaceResource) Metadata(_ contex
 	resp.TypeName = req.ProviderTypeName + "_networks_wireless_alternate_management_interface"
 }
 
+// UpgradeState upgrades the states written before the VLAN IDs were numbers.
+func (r *NetworksWirelessAlternateManagementInterfaceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(1)
+}
+
 func (r *NetworksWirelessAlternateManagementInterfaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"access_points": schema.ListNestedAttribute{
 				MarkdownDescription: `Array of access point serial number and IP assignment. Note: accessPoints IP assignment is not applicable for template networks, in other words, do not put 'accessPoints' in the body when updating template networks. Also, an empty 'accessPoints' array will remove all previous static IP assignments`,
@@ -141,6 +151,10 @@ func (r *NetworksWirelessAlternateManagementInterfaceResource) Schema(_ context.
 				ElementType: types.StringType,
 				Default:     listdefault.StaticValue(types.ListNull(types.StringType)),
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"vlan_id": schema.Int64Attribute{
 				MarkdownDescription: `Alternate management interface VLAN, must be between 1 and 4094`,
 				Optional:            true,
@@ -195,6 +209,7 @@ func (r *NetworksWirelessAlternateManagementInterfaceResource) Create(ctx contex
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -236,9 +251,14 @@ func (r *NetworksWirelessAlternateManagementInterfaceResource) Read(ctx context.
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessAlternateManagementInterfaceItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessAlternateManagementInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksWirelessAlternateManagementInterfaceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksWirelessAlternateManagementInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -267,21 +287,54 @@ func (r *NetworksWirelessAlternateManagementInterfaceResource) Update(ctx contex
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessAlternateManagementInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessAlternateManagementInterface", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessAlternateManagementInterfaceRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessAlternateManagementInterface", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessAlternateManagementInterfaceRs{
+		Enabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Wireless.UpdateNetworkWirelessAlternateManagementInterface(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkWirelessAlternateManagementInterface",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessAlternateManagementInterface",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksWirelessAlternateManagementInterfaceRs struct {
-	NetworkID    types.String                                                                    `tfsdk:"network_id"`
-	AccessPoints *[]ResponseWirelessGetNetworkWirelessAlternateManagementInterfaceAccessPointsRs `tfsdk:"access_points"`
-	Enabled      types.Bool                                                                      `tfsdk:"enabled"`
-	Protocols    types.List                                                                      `tfsdk:"protocols"`
-	VLANID       types.Int64                                                                     `tfsdk:"vlan_id"`
+	NetworkID      types.String                                                                    `tfsdk:"network_id"`
+	AccessPoints   *[]ResponseWirelessGetNetworkWirelessAlternateManagementInterfaceAccessPointsRs `tfsdk:"access_points"`
+	Enabled        types.Bool                                                                      `tfsdk:"enabled"`
+	Protocols      types.List                                                                      `tfsdk:"protocols"`
+	VLANID         types.Int64                                                                     `tfsdk:"vlan_id"`
+	ResetOnDestroy types.Bool                                                                      `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseWirelessGetNetworkWirelessAlternateManagementInterfaceAccessPointsRs struct {
@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
@@ -39,8 +38,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessBillingResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessBillingResource{}
+	_ resource.Resource                = &NetworksWirelessBillingResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessBillingResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessBillingResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessBillingResource{}
 )
 
 func NewNetworksWirelessBillingResource() resource.Resource {
@@ -48,7 +49,8 @@ func NewNetworksWirelessBillingResource() resource.Resource {
 }
 
 type NetworksWirelessBillingResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessBillingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,6 +59,7 @@ func (r *NetworksWirelessBillingResource) Configure(ctx context.Context, req res
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -144,6 +147,10 @@ func (r *NetworksWirelessBillingResource) Schema(_ context.Context, _ resource.S
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -191,6 +198,7 @@ func (r *NetworksWirelessBillingResource) Create(ctx context.Context, req resour
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -232,9 +240,14 @@ func (r *NetworksWirelessBillingResource) Read(ctx context.Context, req resource
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessBillingItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessBillingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksWirelessBillingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksWirelessBillingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -263,19 +276,52 @@ func (r *NetworksWirelessBillingResource) Update(ctx context.Context, req resour
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessBillingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessBilling", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessBillingRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessBilling", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessBillingRs{
+		Plans: &[]ResponseWirelessGetNetworkWirelessBillingPlansRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Wireless.UpdateNetworkWirelessBilling(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkWirelessBilling",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessBilling",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksWirelessBillingRs struct {
-	NetworkID types.String                                        `tfsdk:"network_id"`
-	Currency  types.String                                        `tfsdk:"currency"`
-	Plans     *[]ResponseWirelessGetNetworkWirelessBillingPlansRs `tfsdk:"plans"`
+	NetworkID      types.String                                        `tfsdk:"network_id"`
+	Currency       types.String                                        `tfsdk:"currency"`
+	Plans          *[]ResponseWirelessGetNetworkWirelessBillingPlansRs `tfsdk:"plans"`
+	ResetOnDestroy types.Bool                                          `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseWirelessGetNetworkWirelessBillingPlansRs struct {
@@ -344,7 +390,10 @@ func (r *NetworksWirelessBillingRs) toSdkApiRequestUpdate(ctx context.Context) *
 	out := merakigosdk.RequestWirelessUpdateNetworkWirelessBilling{
 		Currency: *currency,
 		Plans: func() *[]merakigosdk.RequestWirelessUpdateNetworkWirelessBillingPlans {
-			if len(requestWirelessUpdateNetworkWirelessBillingPlans) > 0 {
+			if len(requestWirelessUpdateNetworkWirelessBillingPlans) > 0 || r.Plans != nil {
+				if len(requestWirelessUpdateNetworkWirelessBillingPlans) == 0 {
+					requestWirelessUpdateNetworkWirelessBillingPlans = make([]merakigosdk.RequestWirelessUpdateNetworkWirelessBillingPlans, 0)
+				}
 				return &requestWirelessUpdateNetworkWirelessBillingPlans
 			}
 			return nil
@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessBluetoothSettingsResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessBluetoothSettingsResource{}
+	_ resource.Resource                = &NetworksWirelessBluetoothSettingsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessBluetoothSettingsResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessBluetoothSettingsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessBluetoothSettingsResource{}
 )
 
 func NewNetworksWirelessBluetoothSettingsResource() resource.Resource {
@@ -46,7 +47,8 @@ func NewNetworksWirelessBluetoothSettingsResource() resource.Resource {
 }
 
 type NetworksWirelessBluetoothSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessBluetoothSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +57,7 @@ func (r *NetworksWirelessBluetoothSettingsResource) Configure(ctx context.Contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -108,6 +111,10 @@ func (r *NetworksWirelessBluetoothSettingsResource) Schema(_ context.Context, _
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"scanning_enabled": schema.BoolAttribute{
 				MarkdownDescription: `Whether APs will scan for Bluetooth enabled clients.`,
 				Optional:            true,
@@ -169,6 +176,7 @@ func (r *NetworksWirelessBluetoothSettingsResource) Create(ctx context.Context,
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -210,9 +218,14 @@ func (r *NetworksWirelessBluetoothSettingsResource) Read(ctx context.Context, re
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessBluetoothSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessBluetoothSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksWirelessBluetoothSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksWirelessBluetoothSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -241,11 +254,44 @@ func (r *NetworksWirelessBluetoothSettingsResource) Update(ctx context.Context,
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessBluetoothSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessBluetoothSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessBluetoothSettingsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessBluetoothSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessBluetoothSettingsRs{
+		AdvertisingEnabled: types.BoolValue(false),
+		ScanningEnabled:    types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Wireless.UpdateNetworkWirelessBluetoothSettings(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkWirelessBluetoothSettings",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessBluetoothSettings",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -259,6 +305,7 @@ type NetworksWirelessBluetoothSettingsRs struct {
 	Minor                    types.Int64  `tfsdk:"minor"`
 	ScanningEnabled          types.Bool   `tfsdk:"scanning_enabled"`
 	UUID                     types.String `tfsdk:"uuid"`
+	ResetOnDestroy           types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -36,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessElectronicShelfLabelResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessElectronicShelfLabelResource{}
+	_ resource.Resource                = &NetworksWirelessElectronicShelfLabelResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessElectronicShelfLabelResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessElectronicShelfLabelResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessElectronicShelfLabelResource{}
 )
 
 func NewNetworksWirelessElectronicShelfLabelResource() resource.Resource {
@@ -45,7 +46,8 @@ func NewNetworksWirelessElectronicShelfLabelResource() resource.Resource {
 }
 
 type NetworksWirelessElectronicShelfLabelResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessElectronicShelfLabelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -54,6 +56,7 @@ func (r *NetworksWirelessElectronicShelfLabelResource) Configure(ctx context.Con
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -96,6 +99,10 @@ func (r *NetworksWirelessElectronicShelfLabelResource) Schema(_ context.Context,
 				MarkdownDescription: `networkId path parameter. Network ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -143,6 +150,7 @@ func (r *NetworksWirelessElectronicShelfLabelResource) Create(ctx context.Contex
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -184,9 +192,14 @@ func (r *NetworksWirelessElectronicShelfLabelResource) Read(ctx context.Context,
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessElectronicShelfLabelItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessElectronicShelfLabelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksWirelessElectronicShelfLabelResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksWirelessElectronicShelfLabelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -215,20 +228,53 @@ func (r *NetworksWirelessElectronicShelfLabelResource) Update(ctx context.Contex
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessElectronicShelfLabelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessElectronicShelfLabel", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessElectronicShelfLabelRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessElectronicShelfLabel", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessElectronicShelfLabelRs{
+		Enabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Wireless.UpdateNetworkWirelessElectronicShelfLabel(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkWirelessElectronicShelfLabel",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessElectronicShelfLabel",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksWirelessElectronicShelfLabelRs struct {
-	NetworkID types.String `tfsdk:"network_id"`
-	Enabled   types.Bool   `tfsdk:"enabled"`
-	Hostname  types.String `tfsdk:"hostname"`
-	Mode      types.String `tfsdk:"mode"`
+	NetworkID      types.String `tfsdk:"network_id"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Hostname       types.String `tfsdk:"hostname"`
+	Mode           types.String `tfsdk:"mode"`
+	ResetOnDestroy types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
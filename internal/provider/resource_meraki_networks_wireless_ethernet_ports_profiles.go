@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -38,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessEthernetPortsProfilesResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessEthernetPortsProfilesResource{}
+	_ resource.Resource                = &NetworksWirelessEthernetPortsProfilesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessEthernetPortsProfilesResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessEthernetPortsProfilesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessEthernetPortsProfilesResource{}
 )
 
 func NewNetworksWirelessEthernetPortsProfilesResource() resource.Resource {
@@ -210,6 +209,7 @@ func (r *NetworksWirelessEthernetPortsProfilesResource) Create(ctx context.Conte
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -252,19 +252,14 @@ func (r *NetworksWirelessEthernetPortsProfilesResource) Read(ctx context.Context
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessEthernetPortsProfileItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessEthernetPortsProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "profile_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,profileId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("profile_id"), idParts[1])...)
+func (r *NetworksWirelessEthernetPortsProfilesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "profile_id")
 }
 
 func (r *NetworksWirelessEthernetPortsProfilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -294,6 +289,7 @@ func (r *NetworksWirelessEthernetPortsProfilesResource) Update(ctx context.Conte
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessEthernetPortsProfilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -19,9 +19,7 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	"log"
 
@@ -29,7 +27,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -46,8 +43,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessRfProfilesResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessRfProfilesResource{}
+	_ resource.Resource                = &NetworksWirelessRfProfilesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessRfProfilesResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessRfProfilesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessRfProfilesResource{}
 )
 
 func NewNetworksWirelessRfProfilesResource() resource.Resource {
@@ -1589,6 +1588,7 @@ func (r *NetworksWirelessRfProfilesResource) Create(ctx context.Context, req res
 				data = ResponseWirelessGetNetworkWirelessRfProfileItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -1644,6 +1644,7 @@ func (r *NetworksWirelessRfProfilesResource) Create(ctx context.Context, req res
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseWirelessGetNetworkWirelessRfProfileItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -1721,20 +1722,14 @@ func (r *NetworksWirelessRfProfilesResource) Read(ctx context.Context, req resou
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessRfProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "network_id", "rf_profile_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rf_profile_id"), idParts[1])...)
+func (r *NetworksWirelessRfProfilesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "rf_profile_id")
 }
 
 func (r *NetworksWirelessRfProfilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -1769,6 +1764,7 @@ func (r *NetworksWirelessRfProfilesResource) Update(ctx context.Context, req res
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessRfProfilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -38,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessSettingsResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessSettingsResource{}
+	_ resource.Resource                = &NetworksWirelessSettingsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessSettingsResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessSettingsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessSettingsResource{}
 )
 
 func NewNetworksWirelessSettingsResource() resource.Resource {
@@ -47,7 +48,8 @@ func NewNetworksWirelessSettingsResource() resource.Resource {
 }
 
 type NetworksWirelessSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,6 +58,7 @@ func (r *NetworksWirelessSettingsResource) Configure(ctx context.Context, req re
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -151,6 +154,10 @@ func (r *NetworksWirelessSettingsResource) Schema(_ context.Context, _ resource.
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"upgradestrategy": schema.StringAttribute{
 				MarkdownDescription: `The default strategy that network devices will use to perform an upgrade. Requires firmware version MR 26.8 or higher.
                                   Allowed values: [minimizeClientDowntime,minimizeUpgradeTime]`,
@@ -212,6 +219,7 @@ func (r *NetworksWirelessSettingsResource) Create(ctx context.Context, req resou
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -253,9 +261,14 @@ func (r *NetworksWirelessSettingsResource) Read(ctx context.Context, req resourc
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "network_id")
+}
+
+func (r *NetworksWirelessSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id")
 }
 
 func (r *NetworksWirelessSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -284,11 +297,47 @@ func (r *NetworksWirelessSettingsResource) Update(ctx context.Context, req resou
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessSettingsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessSettingsRs{
+		IPv6BridgeEnabled:        types.BoolValue(false),
+		LedLightsOn:              types.BoolValue(true),
+		LocationAnalyticsEnabled: types.BoolValue(false),
+		MeshingEnabled:           types.BoolValue(true),
+		Upgradestrategy:          types.StringValue("minimizeUpgradeTime"),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Wireless.UpdateNetworkWirelessSettings(vvNetworkID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkWirelessSettings",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSettings",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -302,6 +351,7 @@ type NetworksWirelessSettingsRs struct {
 	NamedVLANs               *ResponseWirelessGetNetworkWirelessSettingsNamedVlansRs       `tfsdk:"named_vlans"`
 	RegulatoryDomain         *ResponseWirelessGetNetworkWirelessSettingsRegulatoryDomainRs `tfsdk:"regulatory_domain"`
 	Upgradestrategy          types.String                                                  `tfsdk:"upgradestrategy"`
+	ResetOnDestroy           types.Bool                                                    `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseWirelessGetNetworkWirelessSettingsNamedVlansRs struct {
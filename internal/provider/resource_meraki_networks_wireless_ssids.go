@@ -20,15 +20,14 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"strconv"
-	"strings"
 
 	"log"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -41,13 +40,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessSSIDsResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessSSIDsResource{}
+	_ resource.Resource                 = &NetworksWirelessSSIDsResource{}
+	_ resource.ResourceWithConfigure    = &NetworksWirelessSSIDsResource{}
+	_ resource.ResourceWithImportState  = &NetworksWirelessSSIDsResource{}
+	_ resource.ResourceWithIdentity     = &NetworksWirelessSSIDsResource{}
+	_ resource.ResourceWithUpgradeState = &NetworksWirelessSSIDsResource{}
 )
 
 func NewNetworksWirelessSSIDsResource() resource.Resource {
@@ -55,15 +58,19 @@ func NewNetworksWirelessSSIDsResource() resource.Resource {
 }
 
 type NetworksWirelessSSIDsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	batcher        *actionBatcher
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessSSIDsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.batcher = providerData.Batcher
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -71,8 +78,14 @@ func (r *NetworksWirelessSSIDsResource) Metadata(_ context.Context, req resource
 	resp.TypeName = req.ProviderTypeName + "_networks_wireless_ssids"
 }
 
+// UpgradeState upgrades the states written before the VLAN IDs were numbers.
+func (r *NetworksWirelessSSIDsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(1)
+}
+
 func (r *NetworksWirelessSSIDsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"active_directory": schema.SingleNestedAttribute{
 				MarkdownDescription: `The current setting for Active Directory. Only valid if splashPage is 'Password-protected with Active Directory'`,
@@ -634,6 +647,7 @@ func (r *NetworksWirelessSSIDsResource) Schema(_ context.Context, _ resource.Sch
 			"psk": schema.StringAttribute{
 				MarkdownDescription: `The passkey for the SSID. This param is only valid if the authMode is 'psk'`,
 				Optional:            true,
+				Sensitive:           true,
 			},
 			"radius_accounting_enabled": schema.BoolAttribute{
 				MarkdownDescription: `Whether or not RADIUS accounting is enabled`,
@@ -671,6 +685,17 @@ func (r *NetworksWirelessSSIDsResource) Schema(_ context.Context, _ resource.Sch
 						"secret": schema.StringAttribute{
 							MarkdownDescription: `Shared key used to authenticate messages between the APs and RADIUS server`,
 							Optional:            true,
+							Sensitive:           true,
+						},
+						"secret_wo": schema.StringAttribute{
+							MarkdownDescription: `Write-only shared key used to authenticate messages between the APs and RADIUS server. It is sent to Meraki on create and update but never stored in the state. Requires Terraform 1.11 or later.`,
+							Optional:            true,
+							Sensitive:           true,
+							WriteOnly:           true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("secret")),
+								stringvalidator.AlsoRequires(path.MatchRoot("secret_wo_version")),
+							},
 						},
 					},
 				},
@@ -804,6 +829,17 @@ func (r *NetworksWirelessSSIDsResource) Schema(_ context.Context, _ resource.Sch
 						"secret": schema.StringAttribute{
 							MarkdownDescription: `RADIUS client shared secret`,
 							Optional:            true,
+							Sensitive:           true,
+						},
+						"secret_wo": schema.StringAttribute{
+							MarkdownDescription: `Write-only RADIUS client shared secret. It is sent to Meraki on create and update but never stored in the state. Requires Terraform 1.11 or later.`,
+							Optional:            true,
+							Sensitive:           true,
+							WriteOnly:           true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("secret")),
+								stringvalidator.AlsoRequires(path.MatchRoot("secret_wo_version")),
+							},
 						},
 					},
 				},
@@ -812,6 +848,14 @@ func (r *NetworksWirelessSSIDsResource) Schema(_ context.Context, _ resource.Sch
 				MarkdownDescription: `If true, Meraki devices will periodically send Access-Request messages to configured RADIUS servers using identity 'meraki_8021x_test' to ensure that the RADIUS servers are reachable.`,
 				Optional:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
+			"secret_wo_version": schema.Int64Attribute{
+				MarkdownDescription: `Version of the secret_wo values of radius_servers and radius_accounting_servers. Change it to push new secret_wo values.`,
+				Optional:            true,
+			},
 			"secondary_concentrator_network_id": schema.StringAttribute{
 				MarkdownDescription: `The secondary concentrator to use when the ipAssignmentMode is 'VPN'. If configured, the APs will switch to using this concentrator if the primary concentrator is unreachable. This param is optional. ('disabled' represents no secondary concentrator.)`,
 				Optional:            true,
@@ -953,6 +997,10 @@ func (r *NetworksWirelessSSIDsResource) Create(ctx context.Context, req resource
 		UnhandledUnknownAsEmpty: true,
 	})...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(data.setWriteOnlySecrets(ctx, req.Config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -963,38 +1011,16 @@ func (r *NetworksWirelessSSIDsResource) Create(ctx context.Context, req resource
 
 	// UPDATE NO CREATE
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Wireless.UpdateNetworkWirelessSSID(vvNetworkID, vvNumber, dataRequest)
-	//Update
-	if err != nil || restyResp2 == nil || response == nil {
-		if restyResp2 != nil {
-			resp.Diagnostics.AddError(
-				"Failure when executing UpdateNetworkWirelessSSID",
-				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
-			)
-			return
-		}
-		resp.Diagnostics.AddError(
-			"Failure when executing UpdateNetworkWirelessSSID",
-			err.Error(),
-		)
-		return
-	}
-
-	//Assign Path Params required
-	var responseGet *merakigosdk.ResponseWirelessGetNetworkWirelessSSID
-
-	err = json.Unmarshal(restyResp2.Body(), &responseGet)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failure when unmarshalling response",
-			err.Error(),
-		)
+	responseGet, diags := r.updateSSID(ctx, vvNetworkID, vvNumber, dataRequest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	data = ResponseWirelessGetNetworkWirelessSSIDItemToBodyRs(data, responseGet, false)
-	diags := resp.State.Set(ctx, &data)
+	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -1037,25 +1063,24 @@ func (r *NetworksWirelessSSIDsResource) Read(ctx context.Context, req resource.R
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessSSIDItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessSSIDsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "number")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,number. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), idParts[1])...)
+func (r *NetworksWirelessSSIDsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number")
 }
 
 func (r *NetworksWirelessSSIDsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan NetworksWirelessSSIDsRs
 	merge(ctx, req, resp, &plan)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(plan.setWriteOnlySecrets(ctx, req.Config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1063,27 +1088,107 @@ func (r *NetworksWirelessSSIDsResource) Update(ctx context.Context, req resource
 	vvNetworkID := plan.NetworkID.ValueString()
 	vvNumber := plan.Number.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Wireless.UpdateNetworkWirelessSSID(vvNetworkID, vvNumber, dataRequest)
+	_, diags := r.updateSSID(ctx, vvNetworkID, vvNumber, dataRequest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+// updateSSID updates the SSID and returns it. The update runs in an action batch of the
+// organization of the network when meraki_batch_writes is set, the SSID is then read again
+// since an action batch does not return it.
+func (r *NetworksWirelessSSIDsResource) updateSSID(ctx context.Context, networkID string, number string, request *merakigosdk.RequestWirelessUpdateNetworkWirelessSSID) (*merakigosdk.ResponseWirelessGetNetworkWirelessSSID, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if r.batcher != nil {
+		err := r.batcher.RunForNetwork(ctx, networkID, actionBatchAction{
+			Resource:  "/networks/" + networkID + "/wireless/ssids/" + number,
+			Operation: "update",
+			Body:      request,
+		})
+		if err != nil {
+			diags.AddError(
+				"Failure when executing UpdateNetworkWirelessSSID in an action batch",
+				err.Error(),
+			)
+			return nil, diags
+		}
+		responseGet, restyResp1, err := r.client.Wireless.GetNetworkWirelessSSID(networkID, number)
+		if err != nil || responseGet == nil {
+			if restyResp1 != nil {
+				diags.AddError(
+					"Failure when executing GetNetworkWirelessSSID",
+					restyResp1.String(),
+				)
+				return nil, diags
+			}
+			diags.AddError(
+				"Failure when executing GetNetworkWirelessSSID",
+				err.Error(),
+			)
+		}
+		return responseGet, diags
+	}
+	response, restyResp2, err := r.client.Wireless.UpdateNetworkWirelessSSID(networkID, number, request)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Failure when executing UpdateNetworkWirelessSSID",
 				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
 			)
-			return
+			return nil, diags
 		}
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Failure when executing UpdateNetworkWirelessSSID",
 			err.Error(),
 		)
-		return
+		return nil, diags
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	//Assign Path Params required
+	var responseGet *merakigosdk.ResponseWirelessGetNetworkWirelessSSID
+
+	err = json.Unmarshal(restyResp2.Body(), &responseGet)
+	if err != nil {
+		diags.AddError(
+			"Failure when unmarshalling response",
+			err.Error(),
+		)
+	}
+	return responseGet, diags
 }
 
 func (r *NetworksWirelessSSIDsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDs", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessSSIDsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDs", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvNumber := state.Number.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessSSIDsRs{
+		AuthMode:          types.StringValue("open"),
+		AvailableOnAllAps: types.BoolValue(true),
+		Enabled:           types.BoolValue(false),
+		IPAssignmentMode:  types.StringValue("NAT mode"),
+		Visible:           types.BoolValue(true),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, diags := r.updateSSID(ctx, vvNetworkID, vvNumber, dataRequest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -1152,10 +1257,12 @@ type NetworksWirelessSSIDsRs struct {
 	RadiusServerTimeout              types.Int64                                                        `tfsdk:"radius_server_timeout"`
 	RadiusTestingEnabled             types.Bool                                                         `tfsdk:"radius_testing_enabled"`
 	SecondaryConcentratorNetworkID   types.String                                                       `tfsdk:"secondary_concentrator_network_id"`
+	SecretWoVersion                  types.Int64                                                        `tfsdk:"secret_wo_version"`
 	SpeedBurst                       *RequestWirelessUpdateNetworkWirelessSsidSpeedBurstRs              `tfsdk:"speed_burst"`
 	SplashGuestSponsorDomains        types.List                                                         `tfsdk:"splash_guest_sponsor_domains"`
 	UseVLANTagging                   types.Bool                                                         `tfsdk:"use_vlan_tagging"`
 	VLANID                           types.Int64                                                        `tfsdk:"vlan_id"`
+	ResetOnDestroy                   types.Bool                                                         `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseWirelessGetNetworkWirelessSsidRadiusAccountingServersRs struct {
@@ -1164,6 +1271,7 @@ type ResponseWirelessGetNetworkWirelessSsidRadiusAccountingServersRs struct {
 	Port          types.Int64  `tfsdk:"port"`
 	RadsecEnabled types.Bool   `tfsdk:"radsec_enabled"`
 	Secret        types.String `tfsdk:"secret"`
+	SecretWo      types.String `tfsdk:"secret_wo"`
 }
 
 type ResponseWirelessGetNetworkWirelessSsidRadiusServersRs struct {
@@ -1172,6 +1280,7 @@ type ResponseWirelessGetNetworkWirelessSsidRadiusServersRs struct {
 	Port          types.Int64  `tfsdk:"port"`
 	RadsecEnabled types.Bool   `tfsdk:"radsec_enabled"`
 	Secret        types.String `tfsdk:"secret"`
+	SecretWo      types.String `tfsdk:"secret_wo"`
 }
 
 type RequestWirelessUpdateNetworkWirelessSsidActiveDirectoryRs struct {
@@ -1302,6 +1411,27 @@ type RequestWirelessUpdateNetworkWirelessSsidSpeedBurstRs struct {
 	Enabled types.Bool `tfsdk:"enabled"`
 }
 
+// setWriteOnlySecrets loads the secret_wo values of the RADIUS servers from the configuration,
+// as write-only values are not part of the plan.
+func (r *NetworksWirelessSSIDsRs) setWriteOnlySecrets(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.RadiusServers != nil {
+		for i := range *r.RadiusServers {
+			secretWo, d := writeOnlyString(ctx, config, path.Root("radius_servers").AtListIndex(i).AtName("secret_wo"))
+			diags.Append(d...)
+			(*r.RadiusServers)[i].SecretWo = secretWo
+		}
+	}
+	if r.RadiusAccountingServers != nil {
+		for i := range *r.RadiusAccountingServers {
+			secretWo, d := writeOnlyString(ctx, config, path.Root("radius_accounting_servers").AtListIndex(i).AtName("secret_wo"))
+			diags.Append(d...)
+			(*r.RadiusAccountingServers)[i].SecretWo = secretWo
+		}
+	}
+	return diags
+}
+
 // FromBody
 func (r *NetworksWirelessSSIDsRs) toSdkApiRequestUpdate(ctx context.Context) *merakigosdk.RequestWirelessUpdateNetworkWirelessSSID {
 	emptyString := ""
@@ -1817,6 +1947,9 @@ func (r *NetworksWirelessSSIDsRs) toSdkApiRequestUpdate(ctx context.Context) *me
 				return nil
 			}()
 			secret := rItem1.Secret.ValueString()
+			if !rItem1.SecretWo.IsUnknown() && !rItem1.SecretWo.IsNull() {
+				secret = rItem1.SecretWo.ValueString()
+			}
 			requestWirelessUpdateNetworkWirelessSSIDRadiusAccountingServers = append(requestWirelessUpdateNetworkWirelessSSIDRadiusAccountingServers, merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDRadiusAccountingServers{
 				CaCertificate: caCertificate,
 				Host:          host,
@@ -1947,6 +2080,9 @@ func (r *NetworksWirelessSSIDsRs) toSdkApiRequestUpdate(ctx context.Context) *me
 				return nil
 			}()
 			secret := rItem1.Secret.ValueString()
+			if !rItem1.SecretWo.IsUnknown() && !rItem1.SecretWo.IsNull() {
+				secret = rItem1.SecretWo.ValueString()
+			}
 			requestWirelessUpdateNetworkWirelessSSIDRadiusServers = append(requestWirelessUpdateNetworkWirelessSSIDRadiusServers, merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDRadiusServers{
 				CaCertificate: caCertificate,
 				Host:          host,
@@ -2105,6 +2241,16 @@ func (r *NetworksWirelessSSIDsRs) toSdkApiRequestUpdate(ctx context.Context) *me
 
 // From gosdk to TF Structs Schema
 func ResponseWirelessGetNetworkWirelessSSIDItemToBodyRs(state NetworksWirelessSSIDsRs, response *merakigosdk.ResponseWirelessGetNetworkWirelessSSID, is_read bool) NetworksWirelessSSIDsRs {
+	itemState := ResponseWirelessGetNetworkWirelessSSIDItemToRs(state, response)
+	if is_read {
+		return mergeInterfacesOnlyPath(state, itemState).(NetworksWirelessSSIDsRs)
+	}
+	return mergeInterfaces(state, itemState, true).(NetworksWirelessSSIDsRs)
+}
+
+// ResponseWirelessGetNetworkWirelessSSIDItemToRs converts the response of the API to the state of the resource,
+// without merging it with the current state.
+func ResponseWirelessGetNetworkWirelessSSIDItemToRs(state NetworksWirelessSSIDsRs, response *merakigosdk.ResponseWirelessGetNetworkWirelessSSID) NetworksWirelessSSIDsRs {
 	// Put the secret in the state
 
 	itemState := NetworksWirelessSSIDsRs{
@@ -2392,10 +2538,7 @@ func ResponseWirelessGetNetworkWirelessSSIDItemToBodyRs(state NetworksWirelessSS
 			}
 		}
 	}
-	if is_read {
-		return mergeInterfacesOnlyPath(state, itemState).(NetworksWirelessSSIDsRs)
-	}
-	return mergeInterfaces(state, itemState, true).(NetworksWirelessSSIDsRs)
+	return itemState
 }
 
 // WpaEquivalentPlanModifier is a plan modifier that treats "wpa" and "wpa-eap" as equivalent
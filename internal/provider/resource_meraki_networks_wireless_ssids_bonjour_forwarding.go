@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -38,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessSSIDsBonjourForwardingResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessSSIDsBonjourForwardingResource{}
+	_ resource.Resource                = &NetworksWirelessSSIDsBonjourForwardingResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessSSIDsBonjourForwardingResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessSSIDsBonjourForwardingResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessSSIDsBonjourForwardingResource{}
 )
 
 func NewNetworksWirelessSSIDsBonjourForwardingResource() resource.Resource {
@@ -47,7 +46,8 @@ func NewNetworksWirelessSSIDsBonjourForwardingResource() resource.Resource {
 }
 
 type NetworksWirelessSSIDsBonjourForwardingResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessSSIDsBonjourForwardingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,6 +56,7 @@ func (r *NetworksWirelessSSIDsBonjourForwardingResource) Configure(ctx context.C
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -98,6 +99,10 @@ func (r *NetworksWirelessSSIDsBonjourForwardingResource) Schema(_ context.Contex
 				MarkdownDescription: `number path parameter.`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `Bonjour forwarding rules`,
 				Optional:            true,
@@ -181,6 +186,7 @@ func (r *NetworksWirelessSSIDsBonjourForwardingResource) Create(ctx context.Cont
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -223,19 +229,14 @@ func (r *NetworksWirelessSSIDsBonjourForwardingResource) Read(ctx context.Contex
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessSSIDBonjourForwardingItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessSSIDsBonjourForwardingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "number")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,number. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), idParts[1])...)
+func (r *NetworksWirelessSSIDsBonjourForwardingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number")
 }
 
 func (r *NetworksWirelessSSIDsBonjourForwardingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -265,21 +266,55 @@ func (r *NetworksWirelessSSIDsBonjourForwardingResource) Update(ctx context.Cont
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessSSIDsBonjourForwardingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsBonjourForwarding", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessSSIDsBonjourForwardingRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsBonjourForwarding", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvNumber := state.Number.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessSSIDsBonjourForwardingRs{
+		Enabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Wireless.UpdateNetworkWirelessSSIDBonjourForwarding(vvNetworkID, vvNumber, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkWirelessSSIDBonjourForwarding",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDBonjourForwarding",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksWirelessSSIDsBonjourForwardingRs struct {
-	NetworkID types.String                                                        `tfsdk:"network_id"`
-	Number    types.String                                                        `tfsdk:"number"`
-	Enabled   types.Bool                                                          `tfsdk:"enabled"`
-	Exception *ResponseWirelessGetNetworkWirelessSsidBonjourForwardingExceptionRs `tfsdk:"exception"`
-	Rules     *[]ResponseWirelessGetNetworkWirelessSsidBonjourForwardingRulesRs   `tfsdk:"rules"`
+	NetworkID      types.String                                                        `tfsdk:"network_id"`
+	Number         types.String                                                        `tfsdk:"number"`
+	Enabled        types.Bool                                                          `tfsdk:"enabled"`
+	Exception      *ResponseWirelessGetNetworkWirelessSsidBonjourForwardingExceptionRs `tfsdk:"exception"`
+	Rules          *[]ResponseWirelessGetNetworkWirelessSsidBonjourForwardingRulesRs   `tfsdk:"rules"`
+	ResetOnDestroy types.Bool                                                          `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseWirelessGetNetworkWirelessSsidBonjourForwardingExceptionRs struct {
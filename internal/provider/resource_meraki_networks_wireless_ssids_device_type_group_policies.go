@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource{}
+	_ resource.Resource                = &NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource{}
 )
 
 func NewNetworksWirelessSSIDsDeviceTypeGroupPoliciesResource() resource.Resource {
@@ -49,7 +48,8 @@ func NewNetworksWirelessSSIDsDeviceTypeGroupPoliciesResource() resource.Resource
 }
 
 type NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -58,6 +58,7 @@ func (r *NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource) Configure(ctx con
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -140,6 +141,10 @@ func (r *NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource) Schema(_ context.
 				MarkdownDescription: `number path parameter.`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -188,6 +193,7 @@ func (r *NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource) Create(ctx contex
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -230,19 +236,14 @@ func (r *NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource) Read(ctx context.
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessSSIDDeviceTypeGroupPoliciesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "number")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,number. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), idParts[1])...)
+func (r *NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number")
 }
 
 func (r *NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -272,11 +273,45 @@ func (r *NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource) Update(ctx contex
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessSSIDsDeviceTypeGroupPoliciesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsDeviceTypeGroupPolicies", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessSSIDsDeviceTypeGroupPoliciesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsDeviceTypeGroupPolicies", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvNumber := state.Number.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessSSIDsDeviceTypeGroupPoliciesRs{
+		DeviceTypePolicies: &[]ResponseWirelessGetNetworkWirelessSsidDeviceTypeGroupPoliciesDeviceTypePoliciesRs{},
+		Enabled:            types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Wireless.UpdateNetworkWirelessSSIDDeviceTypeGroupPolicies(vvNetworkID, vvNumber, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkWirelessSSIDDeviceTypeGroupPolicies",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDDeviceTypeGroupPolicies",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -286,6 +321,7 @@ type NetworksWirelessSSIDsDeviceTypeGroupPoliciesRs struct {
 	Number             types.String                                                                         `tfsdk:"number"`
 	DeviceTypePolicies *[]ResponseWirelessGetNetworkWirelessSsidDeviceTypeGroupPoliciesDeviceTypePoliciesRs `tfsdk:"device_type_policies"`
 	Enabled            types.Bool                                                                           `tfsdk:"enabled"`
+	ResetOnDestroy     types.Bool                                                                           `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseWirelessGetNetworkWirelessSsidDeviceTypeGroupPoliciesDeviceTypePoliciesRs struct {
@@ -324,7 +360,10 @@ func (r *NetworksWirelessSSIDsDeviceTypeGroupPoliciesRs) toSdkApiRequestUpdate(c
 	}
 	out := merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDDeviceTypeGroupPolicies{
 		DeviceTypePolicies: func() *[]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDDeviceTypeGroupPoliciesDeviceTypePolicies {
-			if len(requestWirelessUpdateNetworkWirelessSSIDDeviceTypeGroupPoliciesDeviceTypePolicies) > 0 {
+			if len(requestWirelessUpdateNetworkWirelessSSIDDeviceTypeGroupPoliciesDeviceTypePolicies) > 0 || r.DeviceTypePolicies != nil {
+				if len(requestWirelessUpdateNetworkWirelessSSIDDeviceTypeGroupPoliciesDeviceTypePolicies) == 0 {
+					requestWirelessUpdateNetworkWirelessSSIDDeviceTypeGroupPoliciesDeviceTypePolicies = make([]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDDeviceTypeGroupPoliciesDeviceTypePolicies, 0)
+				}
 				return &requestWirelessUpdateNetworkWirelessSSIDDeviceTypeGroupPoliciesDeviceTypePolicies
 			}
 			return nil
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -36,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessSSIDsEapOverrideResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessSSIDsEapOverrideResource{}
+	_ resource.Resource                = &NetworksWirelessSSIDsEapOverrideResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessSSIDsEapOverrideResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessSSIDsEapOverrideResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessSSIDsEapOverrideResource{}
 )
 
 func NewNetworksWirelessSSIDsEapOverrideResource() resource.Resource {
@@ -45,7 +44,8 @@ func NewNetworksWirelessSSIDsEapOverrideResource() resource.Resource {
 }
 
 type NetworksWirelessSSIDsEapOverrideResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessSSIDsEapOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -54,6 +54,7 @@ func (r *NetworksWirelessSSIDsEapOverrideResource) Configure(ctx context.Context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -182,6 +183,7 @@ func (r *NetworksWirelessSSIDsEapOverrideResource) Create(ctx context.Context, r
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -224,19 +226,14 @@ func (r *NetworksWirelessSSIDsEapOverrideResource) Read(ctx context.Context, req
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessSSIDEapOverrideItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessSSIDsEapOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "number")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,number. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), idParts[1])...)
+func (r *NetworksWirelessSSIDsEapOverrideResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number")
 }
 
 func (r *NetworksWirelessSSIDsEapOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -266,9 +263,15 @@ func (r *NetworksWirelessSSIDsEapOverrideResource) Update(ctx context.Context, r
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessSSIDsEapOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of NetworksWirelessSSIDsEapOverride not reset", "meraki_reset_on_destroy is set, but the EAP timers of an SSID have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsEapOverride", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
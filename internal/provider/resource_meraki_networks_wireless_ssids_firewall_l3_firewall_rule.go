@@ -0,0 +1,330 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// RESOURCE NORMAL
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &NetworksWirelessSSIDsFirewallL3FirewallRuleResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessSSIDsFirewallL3FirewallRuleResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessSSIDsFirewallL3FirewallRuleResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessSSIDsFirewallL3FirewallRuleResource{}
+)
+
+func NewNetworksWirelessSSIDsFirewallL3FirewallRuleResource() resource.Resource {
+	return &NetworksWirelessSSIDsFirewallL3FirewallRuleResource{}
+}
+
+type NetworksWirelessSSIDsFirewallL3FirewallRuleResource struct {
+	client *merakigosdk.Client
+}
+
+func (r *NetworksWirelessSSIDsFirewallL3FirewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+}
+
+// Metadata returns the data source type name.
+func (r *NetworksWirelessSSIDsFirewallL3FirewallRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks_wireless_ssids_firewall_l3_firewall_rule"
+}
+
+func (r *NetworksWirelessSSIDsFirewallL3FirewallRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages one rule of the L3 firewall of an SSID. The other rules of the list, like the ones of `meraki_networks_wireless_ssids_firewall_l3_firewall_rule` resources of other configurations, are kept. Do not use it with `meraki_networks_wireless_ssids_firewall_l3_firewall_rules`, which owns the whole list.",
+		Attributes: map[string]schema.Attribute{
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: `networkId path parameter. Network ID`,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number": schema.StringAttribute{
+				MarkdownDescription: `number path parameter.`,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: `Position of the rule in the list, starting at 1. The rule is inserted before the rule that has this position, and the rules owned elsewhere keep their order. When the list has less rules, the rule is the last one (not including the local LAN access rule and the default rule).`,
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: `Description of the rule (optional)`,
+				Optional:            true,
+			},
+			"dest_cidr": schema.StringAttribute{
+				MarkdownDescription: `Comma-separated list of destination IP address(es) (in IP or CIDR notation), fully-qualified domain names (FQDN) or 'any'`,
+				Required:            true,
+			},
+			"dest_port": schema.StringAttribute{
+				MarkdownDescription: `Comma-separated list of destination port(s) (integer in the range 1-65535), or 'any'`,
+				Optional:            true,
+			},
+			"policy": schema.StringAttribute{
+				MarkdownDescription: `'allow' or 'deny' traffic specified by this rule
+                                        Allowed values: [allow,deny]`,
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"allow",
+						"deny",
+					),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: `The type of protocol (must be 'tcp', 'udp', 'icmp', 'icmp6' or 'any')
+                                        Allowed values: [any,icmp,icmp6,tcp,udp]`,
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"any",
+						"icmp",
+						"icmp6",
+						"tcp",
+						"udp",
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *NetworksWirelessSSIDsFirewallL3FirewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworksWirelessSSIDsFirewallL3FirewallRuleRs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := networksWirelessSSIDsFirewallL3FirewallRules(r.client, data.NetworkID.ValueString(), data.Number.ValueString())
+	err := rules.Insert(data.toSdkApiRequestRule(), data.Priority.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDFirewallL3FirewallRules",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *NetworksWirelessSSIDsFirewallL3FirewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworksWirelessSSIDsFirewallL3FirewallRuleRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := networksWirelessSSIDsFirewallL3FirewallRules(r.client, data.NetworkID.ValueString(), data.Number.ValueString())
+	// Imported
+	if data.Policy.IsNull() {
+		rule, found, err := rules.At(data.Priority.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing GetNetworkWirelessSSIDFirewallL3FirewallRules",
+				err.Error(),
+			)
+			return
+		}
+		if !found {
+			resp.Diagnostics.AddError(
+				"Rule not found",
+				fmt.Sprintf("The L3 firewall of SSID %s of network %s has no rule with priority %d.", data.Number.ValueString(), data.NetworkID.ValueString(), data.Priority.ValueInt64()),
+			)
+			return
+		}
+		data = RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRulesToRs(data, rule)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+		return
+	}
+	priority, found, err := rules.Read(data.toSdkApiRequestRule(), data.Priority.ValueInt64())
+	if errors.Is(err, errFirewallRulesNotFound) {
+		found, err = false, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing GetNetworkWirelessSSIDFirewallL3FirewallRules",
+			err.Error(),
+		)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddWarning(
+			"Resource not found",
+			"Deleting resource",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.Priority = types.Int64Value(priority)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *NetworksWirelessSSIDsFirewallL3FirewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importFirewallRule(ctx, req, resp, "network_id", "number")
+}
+
+func (r *NetworksWirelessSSIDsFirewallL3FirewallRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number", "priority")
+}
+
+func (r *NetworksWirelessSSIDsFirewallL3FirewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NetworksWirelessSSIDsFirewallL3FirewallRuleRs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := networksWirelessSSIDsFirewallL3FirewallRules(r.client, plan.NetworkID.ValueString(), plan.Number.ValueString())
+	err := rules.Replace(state.toSdkApiRequestRule(), state.Priority.ValueInt64(), plan.toSdkApiRequestRule(), plan.Priority.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDFirewallL3FirewallRules",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *NetworksWirelessSSIDsFirewallL3FirewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NetworksWirelessSSIDsFirewallL3FirewallRuleRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := networksWirelessSSIDsFirewallL3FirewallRules(r.client, state.NetworkID.ValueString(), state.Number.ValueString())
+	err := rules.Remove(state.toSdkApiRequestRule(), state.Priority.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDFirewallL3FirewallRules",
+			err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// TF Structs Schema
+type NetworksWirelessSSIDsFirewallL3FirewallRuleRs struct {
+	NetworkID types.String `tfsdk:"network_id"`
+	Number    types.String `tfsdk:"number"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	Comment   types.String `tfsdk:"comment"`
+	DestCidr  types.String `tfsdk:"dest_cidr"`
+	DestPort  types.String `tfsdk:"dest_port"`
+	Policy    types.String `tfsdk:"policy"`
+	Protocol  types.String `tfsdk:"protocol"`
+}
+
+// networksWirelessSSIDsFirewallL3FirewallRules returns the L3 firewall rules of the SSID, without
+// the local LAN access rule and the default rule.
+func networksWirelessSSIDsFirewallL3FirewallRules(client *merakigosdk.Client, networkID string, number string) firewallRules[merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules] {
+	return firewallRules[merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules]{
+		key:          "networks/" + networkID + "/wireless/ssids/" + number + "/firewall/l3FirewallRules",
+		getOperation: "GetNetworkWirelessSSIDFirewallL3FirewallRules",
+		putOperation: "UpdateNetworkWirelessSSIDFirewallL3FirewallRules",
+		get: func() ([]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules, *resty.Response, error) {
+			response, restyResp, err := client.Wireless.GetNetworkWirelessSSIDFirewallL3FirewallRules(networkID, number)
+			if err != nil || response == nil || response.Rules == nil {
+				return nil, restyResp, err
+			}
+			responseRules := *response.Rules
+			for len(responseRules) > 0 {
+				comment := responseRules[len(responseRules)-1].Comment
+				if comment != "Default rule" && comment != "Wireless clients accessing LAN" {
+					break
+				}
+				responseRules = responseRules[:len(responseRules)-1]
+			}
+			rules := []merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules{}
+			for _, rule := range responseRules {
+				rules = append(rules, merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules(rule))
+			}
+			return rules, restyResp, nil
+		},
+		put: func(rules []merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules) (*resty.Response, error) {
+			if rules == nil {
+				rules = []merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules{}
+			}
+			_, restyResp, err := client.Wireless.UpdateNetworkWirelessSSIDFirewallL3FirewallRules(networkID, number, &merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRules{
+				Rules: &rules,
+			})
+			return restyResp, err
+		},
+		equal: func(a, b merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules) bool {
+			return a.Comment == b.Comment &&
+				firewallRuleFieldEqual(a.DestCidr, b.DestCidr) &&
+				firewallRuleFieldEqual(a.DestPort, b.DestPort) &&
+				firewallRuleFieldEqual(a.Policy, b.Policy) &&
+				firewallRuleFieldEqual(a.Protocol, b.Protocol)
+		},
+	}
+}
+
+// FromBody
+func (r *NetworksWirelessSSIDsFirewallL3FirewallRuleRs) toSdkApiRequestRule() merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules {
+	return merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules{
+		Comment:  r.Comment.ValueString(),
+		DestCidr: r.DestCidr.ValueString(),
+		DestPort: r.DestPort.ValueString(),
+		Policy:   r.Policy.ValueString(),
+		Protocol: r.Protocol.ValueString(),
+	}
+}
+
+// From gosdk to TF Structs Schema
+func RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRulesToRs(state NetworksWirelessSSIDsFirewallL3FirewallRuleRs, rule merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules) NetworksWirelessSSIDsFirewallL3FirewallRuleRs {
+	return NetworksWirelessSSIDsFirewallL3FirewallRuleRs{
+		NetworkID: state.NetworkID,
+		Number:    state.Number,
+		Priority:  state.Priority,
+		Comment:   firewallRuleStringToRs(rule.Comment),
+		DestCidr:  firewallRuleStringToRs(rule.DestCidr),
+		DestPort:  firewallRuleStringToRs(rule.DestPort),
+		Policy:    firewallRuleStringToRs(rule.Policy),
+		Protocol:  firewallRuleStringToRs(rule.Protocol),
+	}
+}
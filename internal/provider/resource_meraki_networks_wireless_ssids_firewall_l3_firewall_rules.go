@@ -20,14 +20,12 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"strconv"
 	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +38,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessSSIDsFirewallL3FirewallRulesResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessSSIDsFirewallL3FirewallRulesResource{}
+	_ resource.Resource                = &NetworksWirelessSSIDsFirewallL3FirewallRulesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessSSIDsFirewallL3FirewallRulesResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessSSIDsFirewallL3FirewallRulesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessSSIDsFirewallL3FirewallRulesResource{}
 )
 
 func NewNetworksWirelessSSIDsFirewallL3FirewallRulesResource() resource.Resource {
@@ -49,15 +49,17 @@ func NewNetworksWirelessSSIDsFirewallL3FirewallRulesResource() resource.Resource
 }
 
 type NetworksWirelessSSIDsFirewallL3FirewallRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessSSIDsFirewallL3FirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -83,6 +85,10 @@ func (r *NetworksWirelessSSIDsFirewallL3FirewallRulesResource) Schema(_ context.
 				MarkdownDescription: `number path parameter.`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `An ordered array of the firewall rules for this SSID (not including the local LAN access rule or the default rule).`,
 				Optional:            true,
@@ -210,6 +216,7 @@ func (r *NetworksWirelessSSIDsFirewallL3FirewallRulesResource) Create(ctx contex
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -252,19 +259,14 @@ func (r *NetworksWirelessSSIDsFirewallL3FirewallRulesResource) Read(ctx context.
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessSSIDFirewallL3FirewallRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessSSIDsFirewallL3FirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "number")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,number. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), idParts[1])...)
+func (r *NetworksWirelessSSIDsFirewallL3FirewallRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number")
 }
 
 func (r *NetworksWirelessSSIDsFirewallL3FirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -294,11 +296,45 @@ func (r *NetworksWirelessSSIDsFirewallL3FirewallRulesResource) Update(ctx contex
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessSSIDsFirewallL3FirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsFirewallL3FirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessSSIDsFirewallL3FirewallRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsFirewallL3FirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvNumber := state.Number.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessSSIDsFirewallL3FirewallRulesRs{
+		AllowLanAccess: types.BoolValue(true),
+		Rules:          &[]ResponseWirelessGetNetworkWirelessSsidFirewallL3FirewallRulesRulesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Wireless.UpdateNetworkWirelessSSIDFirewallL3FirewallRules(vvNetworkID, vvNumber, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkWirelessSSIDFirewallL3FirewallRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDFirewallL3FirewallRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -308,6 +344,7 @@ type NetworksWirelessSSIDsFirewallL3FirewallRulesRs struct {
 	Number         types.String                                                            `tfsdk:"number"`
 	AllowLanAccess types.Bool                                                              `tfsdk:"allow_lan_access"`
 	Rules          *[]ResponseWirelessGetNetworkWirelessSsidFirewallL3FirewallRulesRulesRs `tfsdk:"rules"`
+	ResetOnDestroy types.Bool                                                              `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseWirelessGetNetworkWirelessSsidFirewallL3FirewallRulesRulesRs struct {
@@ -348,7 +385,10 @@ func (r *NetworksWirelessSSIDsFirewallL3FirewallRulesRs) toSdkApiRequestUpdate(c
 	out := merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRules{
 		AllowLanAccess: allowLanAccess,
 		Rules: func() *[]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules {
-			if len(requestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules) > 0 {
+			if len(requestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules) > 0 || r.Rules != nil {
+				if len(requestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules) == 0 {
+					requestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules = make([]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules, 0)
+				}
 				return &requestWirelessUpdateNetworkWirelessSSIDFirewallL3FirewallRulesRules
 			}
 			return nil
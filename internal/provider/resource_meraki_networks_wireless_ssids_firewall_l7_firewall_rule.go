@@ -0,0 +1,352 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// RESOURCE NORMAL
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &NetworksWirelessSSIDsFirewallL7FirewallRuleResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessSSIDsFirewallL7FirewallRuleResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessSSIDsFirewallL7FirewallRuleResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessSSIDsFirewallL7FirewallRuleResource{}
+)
+
+func NewNetworksWirelessSSIDsFirewallL7FirewallRuleResource() resource.Resource {
+	return &NetworksWirelessSSIDsFirewallL7FirewallRuleResource{}
+}
+
+type NetworksWirelessSSIDsFirewallL7FirewallRuleResource struct {
+	client *merakigosdk.Client
+}
+
+func (r *NetworksWirelessSSIDsFirewallL7FirewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+}
+
+// Metadata returns the data source type name.
+func (r *NetworksWirelessSSIDsFirewallL7FirewallRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks_wireless_ssids_firewall_l7_firewall_rule"
+}
+
+func (r *NetworksWirelessSSIDsFirewallL7FirewallRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages one rule of the L7 firewall of an SSID. The other rules of the list, like the ones of `meraki_networks_wireless_ssids_firewall_l7_firewall_rule` resources of other configurations, are kept. Do not use it with `meraki_networks_wireless_ssids_firewall_l7_firewall_rules`, which owns the whole list.",
+		Attributes: map[string]schema.Attribute{
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: `networkId path parameter. Network ID`,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number": schema.StringAttribute{
+				MarkdownDescription: `number path parameter.`,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: `Position of the rule in the list, starting at 1. The rule is inserted before the rule that has this position, and the rules owned elsewhere keep their order. When the list has less rules, the rule is the last one.`,
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"policy": schema.StringAttribute{
+				MarkdownDescription: `'Deny' traffic specified by this rule
+                                        Allowed values: [deny]`,
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"deny",
+					),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: `Type of the L7 rule. One of: 'application', 'applicationCategory', 'host', 'port', 'ipRange', 'blockedCountries', 'allowedCountries'`,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"application",
+						"applicationCategory",
+						"host",
+						"ipRange",
+						"port",
+						"blockedCountries",
+						"allowedCountries",
+					),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: `The 'value' of what you want to block: the host, port or IP range, or the ID of the application or of the application category, like 'meraki:layer7/category/1'. The application categories and application ids can be retrieved from the the 'MX L7 application categories' endpoint.`,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("value_list")),
+				},
+			},
+			"value_list": schema.SetAttribute{
+				MarkdownDescription: `The countries of the 'blockedCountries' and 'allowedCountries' rules, in the two-letter ISO 3166-1 alpha-2 format.`,
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *NetworksWirelessSSIDsFirewallL7FirewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworksWirelessSSIDsFirewallL7FirewallRuleRs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := networksWirelessSSIDsFirewallL7FirewallRules(r.client, data.NetworkID.ValueString(), data.Number.ValueString())
+	err := rules.Insert(data.toSdkApiRequestRule(ctx), data.Priority.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDFirewallL7FirewallRules",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *NetworksWirelessSSIDsFirewallL7FirewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworksWirelessSSIDsFirewallL7FirewallRuleRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := networksWirelessSSIDsFirewallL7FirewallRules(r.client, data.NetworkID.ValueString(), data.Number.ValueString())
+	// Imported
+	if data.Policy.IsNull() {
+		rule, found, err := rules.At(data.Priority.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing GetNetworkWirelessSSIDFirewallL7FirewallRules",
+				err.Error(),
+			)
+			return
+		}
+		if !found {
+			resp.Diagnostics.AddError(
+				"Rule not found",
+				fmt.Sprintf("The L7 firewall of SSID %s of network %s has no rule with priority %d.", data.Number.ValueString(), data.NetworkID.ValueString(), data.Priority.ValueInt64()),
+			)
+			return
+		}
+		data = RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRulesToRs(data, rule)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+		return
+	}
+	priority, found, err := rules.Read(data.toSdkApiRequestRule(ctx), data.Priority.ValueInt64())
+	if errors.Is(err, errFirewallRulesNotFound) {
+		found, err = false, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing GetNetworkWirelessSSIDFirewallL7FirewallRules",
+			err.Error(),
+		)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddWarning(
+			"Resource not found",
+			"Deleting resource",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.Priority = types.Int64Value(priority)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *NetworksWirelessSSIDsFirewallL7FirewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importFirewallRule(ctx, req, resp, "network_id", "number")
+}
+
+func (r *NetworksWirelessSSIDsFirewallL7FirewallRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number", "priority")
+}
+
+func (r *NetworksWirelessSSIDsFirewallL7FirewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NetworksWirelessSSIDsFirewallL7FirewallRuleRs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := networksWirelessSSIDsFirewallL7FirewallRules(r.client, plan.NetworkID.ValueString(), plan.Number.ValueString())
+	err := rules.Replace(state.toSdkApiRequestRule(ctx), state.Priority.ValueInt64(), plan.toSdkApiRequestRule(ctx), plan.Priority.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDFirewallL7FirewallRules",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *NetworksWirelessSSIDsFirewallL7FirewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NetworksWirelessSSIDsFirewallL7FirewallRuleRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := networksWirelessSSIDsFirewallL7FirewallRules(r.client, state.NetworkID.ValueString(), state.Number.ValueString())
+	err := rules.Remove(state.toSdkApiRequestRule(ctx), state.Priority.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDFirewallL7FirewallRules",
+			err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// TF Structs Schema
+type NetworksWirelessSSIDsFirewallL7FirewallRuleRs struct {
+	NetworkID types.String `tfsdk:"network_id"`
+	Number    types.String `tfsdk:"number"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	Policy    types.String `tfsdk:"policy"`
+	Type      types.String `tfsdk:"type"`
+	Value     types.String `tfsdk:"value"`
+	ValueList types.Set    `tfsdk:"value_list"`
+}
+
+// networksWirelessSSIDsFirewallL7FirewallRules returns the L7 firewall rules of the SSID.
+func networksWirelessSSIDsFirewallL7FirewallRules(client *merakigosdk.Client, networkID string, number string) firewallRules[merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules] {
+	return firewallRules[merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules]{
+		key:          "networks/" + networkID + "/wireless/ssids/" + number + "/firewall/l7FirewallRules",
+		getOperation: "GetNetworkWirelessSSIDFirewallL7FirewallRules",
+		putOperation: "UpdateNetworkWirelessSSIDFirewallL7FirewallRules",
+		get: func() ([]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules, *resty.Response, error) {
+			response, restyResp, err := client.Wireless.GetNetworkWirelessSSIDFirewallL7FirewallRules(networkID, number)
+			if err != nil || response == nil || response.Rules == nil {
+				return nil, restyResp, err
+			}
+			rules := []merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules{}
+			for _, rule := range *response.Rules {
+				var value interface{}
+				switch {
+				case rule.Value != nil:
+					value = *rule.Value
+				case rule.ValueObj != nil:
+					value = merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRulesValue{
+						ID:   rule.ValueObj.ID,
+						Name: rule.ValueObj.Name,
+					}
+				case rule.ValueList != nil:
+					value = *rule.ValueList
+				}
+				rules = append(rules, merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules{
+					Policy: rule.Policy,
+					Type:   rule.Type,
+					Value:  value,
+				})
+			}
+			return rules, restyResp, nil
+		},
+		put: func(rules []merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules) (*resty.Response, error) {
+			if rules == nil {
+				rules = []merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules{}
+			}
+			_, restyResp, err := client.Wireless.UpdateNetworkWirelessSSIDFirewallL7FirewallRules(networkID, number, &merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRules{
+				Rules: &rules,
+			})
+			return restyResp, err
+		},
+		equal: func(a, b merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules) bool {
+			return firewallRuleFieldEqual(a.Policy, b.Policy) &&
+				a.Type == b.Type &&
+				firewallL7RuleValue(a.Value) == firewallL7RuleValue(b.Value)
+		},
+	}
+}
+
+// FromBody
+func (r *NetworksWirelessSSIDsFirewallL7FirewallRuleRs) toSdkApiRequestRule(ctx context.Context) merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules {
+	var value interface{}
+	switch r.Type.ValueString() {
+	case "application", "applicationCategory":
+		value = merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRulesValue{
+			ID: r.Value.ValueString(),
+		}
+	case "blockedCountries", "allowedCountries":
+		var valueList []string
+		r.ValueList.ElementsAs(ctx, &valueList, false)
+		value = valueList
+	default:
+		value = r.Value.ValueString()
+	}
+	return merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules{
+		Policy: r.Policy.ValueString(),
+		Type:   r.Type.ValueString(),
+		Value:  value,
+	}
+}
+
+// From gosdk to TF Structs Schema
+func RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRulesToRs(state NetworksWirelessSSIDsFirewallL7FirewallRuleRs, rule merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules) NetworksWirelessSSIDsFirewallL7FirewallRuleRs {
+	itemState := NetworksWirelessSSIDsFirewallL7FirewallRuleRs{
+		NetworkID: state.NetworkID,
+		Number:    state.Number,
+		Priority:  state.Priority,
+		Policy:    firewallRuleStringToRs(rule.Policy),
+		Type:      firewallRuleStringToRs(rule.Type),
+		Value:     types.StringNull(),
+		ValueList: types.SetNull(types.StringType),
+	}
+	switch value := rule.Value.(type) {
+	case []string:
+		itemState.ValueList = StringSliceToSet(value)
+	default:
+		itemState.Value = types.StringValue(firewallL7RuleValue(value))
+	}
+	return itemState
+}
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -40,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessSSIDsFirewallL7FirewallRulesResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessSSIDsFirewallL7FirewallRulesResource{}
+	_ resource.Resource                = &NetworksWirelessSSIDsFirewallL7FirewallRulesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessSSIDsFirewallL7FirewallRulesResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessSSIDsFirewallL7FirewallRulesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessSSIDsFirewallL7FirewallRulesResource{}
 )
 
 func NewNetworksWirelessSSIDsFirewallL7FirewallRulesResource() resource.Resource {
@@ -49,15 +48,17 @@ func NewNetworksWirelessSSIDsFirewallL7FirewallRulesResource() resource.Resource
 }
 
 type NetworksWirelessSSIDsFirewallL7FirewallRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessSSIDsFirewallL7FirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -76,6 +77,10 @@ func (r *NetworksWirelessSSIDsFirewallL7FirewallRulesResource) Schema(_ context.
 				MarkdownDescription: `number path parameter.`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `An ordered array of the firewall rules for this SSID (not including the local LAN access rule or the default rule).`,
 				Optional:            true,
@@ -202,6 +207,7 @@ func (r *NetworksWirelessSSIDsFirewallL7FirewallRulesResource) Create(ctx contex
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -244,19 +250,14 @@ func (r *NetworksWirelessSSIDsFirewallL7FirewallRulesResource) Read(ctx context.
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessSSIDFirewallL7FirewallRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessSSIDsFirewallL7FirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "number")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,number. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), idParts[1])...)
+func (r *NetworksWirelessSSIDsFirewallL7FirewallRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number")
 }
 
 func (r *NetworksWirelessSSIDsFirewallL7FirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -286,19 +287,53 @@ func (r *NetworksWirelessSSIDsFirewallL7FirewallRulesResource) Update(ctx contex
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessSSIDsFirewallL7FirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsFirewallL7FirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessSSIDsFirewallL7FirewallRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsFirewallL7FirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvNumber := state.Number.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessSSIDsFirewallL7FirewallRulesRs{
+		Rules: &[]ResponseWirelessGetNetworkWirelessSsidFirewallL7FirewallRulesRulesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Wireless.UpdateNetworkWirelessSSIDFirewallL7FirewallRules(vvNetworkID, vvNumber, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkWirelessSSIDFirewallL7FirewallRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDFirewallL7FirewallRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
 // TF Structs Schema
 type NetworksWirelessSSIDsFirewallL7FirewallRulesRs struct {
-	NetworkID types.String                                                            `tfsdk:"network_id"`
-	Number    types.String                                                            `tfsdk:"number"`
-	Rules     *[]ResponseWirelessGetNetworkWirelessSsidFirewallL7FirewallRulesRulesRs `tfsdk:"rules"`
+	NetworkID      types.String                                                            `tfsdk:"network_id"`
+	Number         types.String                                                            `tfsdk:"number"`
+	Rules          *[]ResponseWirelessGetNetworkWirelessSsidFirewallL7FirewallRulesRulesRs `tfsdk:"rules"`
+	ResetOnDestroy types.Bool                                                              `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseWirelessGetNetworkWirelessSsidFirewallL7FirewallRulesRulesRs struct {
@@ -350,6 +385,9 @@ func (r *NetworksWirelessSSIDsFirewallL7FirewallRulesRs) toSdkApiRequestUpdate(c
 	out := merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRules{
 		Rules: func() *[]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules {
 			if len(requestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules) > 0 || r.Rules != nil {
+				if len(requestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules) == 0 {
+					requestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules = make([]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules, 0)
+				}
 				return &requestWirelessUpdateNetworkWirelessSSIDFirewallL7FirewallRulesRules
 			}
 			return nil
@@ -19,16 +19,13 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	"log"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -43,8 +40,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessSSIDsHotspot20Resource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessSSIDsHotspot20Resource{}
+	_ resource.Resource                = &NetworksWirelessSSIDsHotspot20Resource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessSSIDsHotspot20Resource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessSSIDsHotspot20Resource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessSSIDsHotspot20Resource{}
 )
 
 func NewNetworksWirelessSSIDsHotspot20Resource() resource.Resource {
@@ -52,7 +51,8 @@ func NewNetworksWirelessSSIDsHotspot20Resource() resource.Resource {
 }
 
 type NetworksWirelessSSIDsHotspot20Resource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessSSIDsHotspot20Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -61,6 +61,7 @@ func (r *NetworksWirelessSSIDsHotspot20Resource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -241,6 +242,10 @@ func (r *NetworksWirelessSSIDsHotspot20Resource) Schema(_ context.Context, _ res
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"roam_consort_ois": schema.ListAttribute{
 				MarkdownDescription: `An array of roaming consortium OIs (hexadecimal number 3-5 octets in length)`,
 				Optional:            true,
@@ -395,6 +400,7 @@ func (r *NetworksWirelessSSIDsHotspot20Resource) Create(ctx context.Context, req
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -437,19 +443,14 @@ func (r *NetworksWirelessSSIDsHotspot20Resource) Read(ctx context.Context, req r
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessSSIDHotspot20ItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessSSIDsHotspot20Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "number")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,number. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), idParts[1])...)
+func (r *NetworksWirelessSSIDsHotspot20Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number")
 }
 
 func (r *NetworksWirelessSSIDsHotspot20Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -479,11 +480,46 @@ func (r *NetworksWirelessSSIDsHotspot20Resource) Update(ctx context.Context, req
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessSSIDsHotspot20Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsHotspot20", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessSSIDsHotspot20Rs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsHotspot20", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvNumber := state.Number.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessSSIDsHotspot20Rs{
+		Enabled:   types.BoolValue(false),
+		MccMncs:   &[]ResponseWirelessGetNetworkWirelessSsidHotspot20MccMncsRs{},
+		NaiRealms: &[]ResponseWirelessGetNetworkWirelessSsidHotspot20NaiRealmsRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.client.Wireless.UpdateNetworkWirelessSSIDHotspot20(vvNetworkID, vvNumber, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkWirelessSSIDHotspot20",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDHotspot20",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -499,6 +535,7 @@ type NetworksWirelessSSIDsHotspot20Rs struct {
 	Operator          *ResponseWirelessGetNetworkWirelessSsidHotspot20OperatorRs    `tfsdk:"operator"`
 	RoamConsortOis    types.List                                                    `tfsdk:"roam_consort_ois"`
 	Venue             *ResponseWirelessGetNetworkWirelessSsidHotspot20VenueRs       `tfsdk:"venue"`
+	ResetOnDestroy    types.Bool                                                    `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseWirelessGetNetworkWirelessSsidHotspot20MccMncsRs struct {
@@ -629,13 +666,19 @@ func (r *NetworksWirelessSSIDsHotspot20Rs) toSdkApiRequestUpdate(ctx context.Con
 		Domains: domains,
 		Enabled: enabled,
 		MccMncs: func() *[]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDHotspot20MccMncs {
-			if len(requestWirelessUpdateNetworkWirelessSSIDHotspot20MccMncs) > 0 {
+			if len(requestWirelessUpdateNetworkWirelessSSIDHotspot20MccMncs) > 0 || r.MccMncs != nil {
+				if len(requestWirelessUpdateNetworkWirelessSSIDHotspot20MccMncs) == 0 {
+					requestWirelessUpdateNetworkWirelessSSIDHotspot20MccMncs = make([]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDHotspot20MccMncs, 0)
+				}
 				return &requestWirelessUpdateNetworkWirelessSSIDHotspot20MccMncs
 			}
 			return nil
 		}(),
 		NaiRealms: func() *[]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDHotspot20NaiRealms {
-			if len(requestWirelessUpdateNetworkWirelessSSIDHotspot20NaiRealms) > 0 {
+			if len(requestWirelessUpdateNetworkWirelessSSIDHotspot20NaiRealms) > 0 || r.NaiRealms != nil {
+				if len(requestWirelessUpdateNetworkWirelessSSIDHotspot20NaiRealms) == 0 {
+					requestWirelessUpdateNetworkWirelessSSIDHotspot20NaiRealms = make([]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDHotspot20NaiRealms, 0)
+				}
 				return &requestWirelessUpdateNetworkWirelessSSIDHotspot20NaiRealms
 			}
 			return nil
@@ -19,24 +19,26 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessSSIDsIDentityPsksResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessSSIDsIDentityPsksResource{}
+	_ resource.Resource                = &NetworksWirelessSSIDsIDentityPsksResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessSSIDsIDentityPsksResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessSSIDsIDentityPsksResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessSSIDsIDentityPsksResource{}
 )
 
 func NewNetworksWirelessSSIDsIDentityPsksResource() resource.Resource {
@@ -110,10 +112,25 @@ func (r *NetworksWirelessSSIDsIDentityPsksResource) Schema(_ context.Context, _
 			"passphrase": schema.StringAttribute{
 				MarkdownDescription: `The passphrase for client authentication`,
 				Optional:            true,
+				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"passphrase_wo": schema.StringAttribute{
+				MarkdownDescription: `Write-only passphrase for client authentication. It is sent to Meraki on create and update but never stored in the state. Requires Terraform 1.11 or later.`,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("passphrase")),
+					stringvalidator.AlsoRequires(path.MatchRoot("passphrase_wo_version")),
+				},
+			},
+			"passphrase_wo_version": schema.Int64Attribute{
+				MarkdownDescription: `Version of passphrase_wo. Change it to push a new passphrase_wo value.`,
+				Optional:            true,
+			},
 			"wifi_personal_network_id": schema.StringAttribute{
 				MarkdownDescription: `The WiFi Personal Network unique identifier`,
 				Computed:            true,
@@ -142,6 +159,11 @@ func (r *NetworksWirelessSSIDsIDentityPsksResource) Create(ctx context.Context,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	passphraseWo, diags := writeOnlyString(ctx, req.Config, path.Root("passphrase_wo"))
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	data.PassphraseWo = passphraseWo
 	// Has Paths
 	vvNetworkID := data.NetworkID.ValueString()
 	vvNumber := data.Number.ValueString()
@@ -181,8 +203,10 @@ func (r *NetworksWirelessSSIDsIDentityPsksResource) Create(ctx context.Context,
 			responseVerifyItem2, _, _ := r.client.Wireless.GetNetworkWirelessSSIDIDentityPsk(vvNetworkID, vvNumber, vvIDentityPskID)
 			if responseVerifyItem2 != nil {
 				data = ResponseWirelessGetNetworkWirelessSSIDIDentityPskItemToBodyRs(data, responseVerifyItem2, false)
+				data.hideWriteOnlyPassphrase()
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -237,7 +261,9 @@ func (r *NetworksWirelessSSIDsIDentityPsksResource) Create(ctx context.Context,
 		responseVerifyItem2, restyRespGet, err := r.client.Wireless.GetNetworkWirelessSSIDIDentityPsk(vvNetworkID, vvNumber, vvIDentityPskID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseWirelessGetNetworkWirelessSSIDIDentityPskItemToBodyRs(data, responseVerifyItem2, false)
+			data.hideWriteOnlyPassphrase()
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -302,21 +328,16 @@ func (r *NetworksWirelessSSIDsIDentityPsksResource) Read(ctx context.Context, re
 	}
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessSSIDIDentityPskItemToBodyRs(data, responseGet, true)
+	data.hideWriteOnlyPassphrase()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessSSIDsIDentityPsksResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "number", "identity_psk_id")
+}
 
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,number,identityPskId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identity_psk_id"), idParts[2])...)
+func (r *NetworksWirelessSSIDsIDentityPsksResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number", "identity_psk_id")
 }
 
 func (r *NetworksWirelessSSIDsIDentityPsksResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -326,6 +347,11 @@ func (r *NetworksWirelessSSIDsIDentityPsksResource) Update(ctx context.Context,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	passphraseWo, diags := writeOnlyString(ctx, req.Config, path.Root("passphrase_wo"))
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	plan.PassphraseWo = passphraseWo
 	//Path Params
 	vvNetworkID := plan.NetworkID.ValueString()
 	vvNumber := plan.Number.ValueString()
@@ -346,7 +372,9 @@ func (r *NetworksWirelessSSIDsIDentityPsksResource) Update(ctx context.Context,
 		)
 		return
 	}
+	plan.hideWriteOnlyPassphrase()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessSSIDsIDentityPsksResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -391,9 +419,19 @@ type NetworksWirelessSSIDsIDentityPsksRs struct {
 	ID                    types.String `tfsdk:"id"`
 	Name                  types.String `tfsdk:"name"`
 	Passphrase            types.String `tfsdk:"passphrase"`
+	PassphraseWo          types.String `tfsdk:"passphrase_wo"`
+	PassphraseWoVersion   types.Int64  `tfsdk:"passphrase_wo_version"`
 	WifiPersonalNetworkID types.String `tfsdk:"wifi_personal_network_id"`
 }
 
+// hideWriteOnlyPassphrase keeps the passphrase returned by Meraki out of the state when it is
+// managed through passphrase_wo.
+func (r *NetworksWirelessSSIDsIDentityPsksRs) hideWriteOnlyPassphrase() {
+	if !r.PassphraseWoVersion.IsNull() {
+		r.Passphrase = types.StringNull()
+	}
+}
+
 // FromBody
 func (r *NetworksWirelessSSIDsIDentityPsksRs) toSdkApiRequestCreate(ctx context.Context) *merakigosdk.RequestWirelessCreateNetworkWirelessSSIDIDentityPsk {
 	emptyString := ""
@@ -416,7 +454,9 @@ func (r *NetworksWirelessSSIDsIDentityPsksRs) toSdkApiRequestCreate(ctx context.
 		name = &emptyString
 	}
 	passphrase := new(string)
-	if !r.Passphrase.IsUnknown() && !r.Passphrase.IsNull() {
+	if !r.PassphraseWo.IsUnknown() && !r.PassphraseWo.IsNull() {
+		*passphrase = r.PassphraseWo.ValueString()
+	} else if !r.Passphrase.IsUnknown() && !r.Passphrase.IsNull() {
 		*passphrase = r.Passphrase.ValueString()
 	} else {
 		passphrase = &emptyString
@@ -450,7 +490,9 @@ func (r *NetworksWirelessSSIDsIDentityPsksRs) toSdkApiRequestUpdate(ctx context.
 		name = &emptyString
 	}
 	passphrase := new(string)
-	if !r.Passphrase.IsUnknown() && !r.Passphrase.IsNull() {
+	if !r.PassphraseWo.IsUnknown() && !r.PassphraseWo.IsNull() {
+		*passphrase = r.PassphraseWo.ValueString()
+	} else if !r.Passphrase.IsUnknown() && !r.Passphrase.IsNull() {
 		*passphrase = r.Passphrase.ValueString()
 	} else {
 		passphrase = &emptyString
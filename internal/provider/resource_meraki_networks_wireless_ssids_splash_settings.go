@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -41,8 +38,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessSSIDsSplashSettingsResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessSSIDsSplashSettingsResource{}
+	_ resource.Resource                = &NetworksWirelessSSIDsSplashSettingsResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessSSIDsSplashSettingsResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessSSIDsSplashSettingsResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessSSIDsSplashSettingsResource{}
 )
 
 func NewNetworksWirelessSSIDsSplashSettingsResource() resource.Resource {
@@ -50,7 +49,8 @@ func NewNetworksWirelessSSIDsSplashSettingsResource() resource.Resource {
 }
 
 type NetworksWirelessSSIDsSplashSettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessSSIDsSplashSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -59,6 +59,7 @@ func (r *NetworksWirelessSSIDsSplashSettingsResource) Configure(ctx context.Cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -185,6 +186,10 @@ func (r *NetworksWirelessSSIDsSplashSettingsResource) Schema(_ context.Context,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"self_registration": schema.SingleNestedAttribute{
 				MarkdownDescription: `Self-registration for splash with Meraki authentication.`,
 				Optional:            true,
@@ -535,6 +540,7 @@ func (r *NetworksWirelessSSIDsSplashSettingsResource) Create(ctx context.Context
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -577,19 +583,14 @@ func (r *NetworksWirelessSSIDsSplashSettingsResource) Read(ctx context.Context,
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessSSIDSplashSettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessSSIDsSplashSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "number")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,number. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), idParts[1])...)
+func (r *NetworksWirelessSSIDsSplashSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number")
 }
 
 func (r *NetworksWirelessSSIDsSplashSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -619,11 +620,49 @@ func (r *NetworksWirelessSSIDsSplashSettingsResource) Update(ctx context.Context
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessSSIDsSplashSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsSplashSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessSSIDsSplashSettingsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsSplashSettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvNumber := state.Number.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessSSIDsSplashSettingsRs{
+		AllowSimultaneousLogins:         types.BoolValue(false),
+		BlockAllTrafficBeforeSignOn:     types.BoolValue(false),
+		ControllerDisconnectionBehavior: types.StringValue("default"),
+		SplashTimeout:                   types.Int64Value(1440),
+		UseRedirectURL:                  types.BoolValue(false),
+		UseSplashURL:                    types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Wireless.UpdateNetworkWirelessSSIDSplashSettings(vvNetworkID, vvNumber, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkWirelessSSIDSplashSettings",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDSplashSettings",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -650,6 +689,7 @@ type NetworksWirelessSSIDsSplashSettingsRs struct {
 	UseRedirectURL                  types.Bool                                                                `tfsdk:"use_redirect_url"`
 	UseSplashURL                    types.Bool                                                                `tfsdk:"use_splash_url"`
 	WelcomeMessage                  types.String                                                              `tfsdk:"welcome_message"`
+	ResetOnDestroy                  types.Bool                                                                `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseWirelessGetNetworkWirelessSsidSplashSettingsBillingRs struct {
@@ -19,16 +19,13 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	"log"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -43,8 +40,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessSSIDsTrafficShapingRulesResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessSSIDsTrafficShapingRulesResource{}
+	_ resource.Resource                = &NetworksWirelessSSIDsTrafficShapingRulesResource{}
+	_ resource.ResourceWithConfigure   = &NetworksWirelessSSIDsTrafficShapingRulesResource{}
+	_ resource.ResourceWithImportState = &NetworksWirelessSSIDsTrafficShapingRulesResource{}
+	_ resource.ResourceWithIdentity    = &NetworksWirelessSSIDsTrafficShapingRulesResource{}
 )
 
 func NewNetworksWirelessSSIDsTrafficShapingRulesResource() resource.Resource {
@@ -52,15 +51,17 @@ func NewNetworksWirelessSSIDsTrafficShapingRulesResource() resource.Resource {
 }
 
 type NetworksWirelessSSIDsTrafficShapingRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessSSIDsTrafficShapingRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client := req.ProviderData.(MerakiProviderData).Client
-	r.client = client
+	providerData := req.ProviderData.(MerakiProviderData)
+	r.client = providerData.Client
+	r.resetOnDestroy = providerData.ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -86,6 +87,10 @@ func (r *NetworksWirelessSSIDsTrafficShapingRulesResource) Schema(_ context.Cont
 				MarkdownDescription: `number path parameter.`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `    An array of traffic shaping rules. Rules are applied in the order that
     they are specified in. An empty list (or null) means no rules. Note that
@@ -262,6 +267,7 @@ func (r *NetworksWirelessSSIDsTrafficShapingRulesResource) Create(ctx context.Co
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -304,19 +310,14 @@ func (r *NetworksWirelessSSIDsTrafficShapingRulesResource) Read(ctx context.Cont
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessSSIDTrafficShapingRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessSSIDsTrafficShapingRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "number")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,number. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), idParts[1])...)
+func (r *NetworksWirelessSSIDsTrafficShapingRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number")
 }
 
 func (r *NetworksWirelessSSIDsTrafficShapingRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -346,11 +347,46 @@ func (r *NetworksWirelessSSIDsTrafficShapingRulesResource) Update(ctx context.Co
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessSSIDsTrafficShapingRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsTrafficShapingRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state NetworksWirelessSSIDsTrafficShapingRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsTrafficShapingRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvNetworkID := state.NetworkID.ValueString()
+	vvNumber := state.Number.ValueString()
+	// Meraki defaults
+	defaults := NetworksWirelessSSIDsTrafficShapingRulesRs{
+		DefaultRulesEnabled:   types.BoolValue(true),
+		Rules:                 &[]ResponseWirelessGetNetworkWirelessSsidTrafficShapingRulesRulesRs{},
+		TrafficShapingEnabled: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.client.Wireless.UpdateNetworkWirelessSSIDTrafficShapingRules(vvNetworkID, vvNumber, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateNetworkWirelessSSIDTrafficShapingRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateNetworkWirelessSSIDTrafficShapingRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -361,6 +397,7 @@ type NetworksWirelessSSIDsTrafficShapingRulesRs struct {
 	DefaultRulesEnabled   types.Bool                                                          `tfsdk:"default_rules_enabled"`
 	Rules                 *[]ResponseWirelessGetNetworkWirelessSsidTrafficShapingRulesRulesRs `tfsdk:"rules"`
 	TrafficShapingEnabled types.Bool                                                          `tfsdk:"traffic_shaping_enabled"`
+	ResetOnDestroy        types.Bool                                                          `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseWirelessGetNetworkWirelessSsidTrafficShapingRulesRulesRs struct {
@@ -478,7 +515,10 @@ func (r *NetworksWirelessSSIDsTrafficShapingRulesRs) toSdkApiRequestUpdate(ctx c
 	out := merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDTrafficShapingRules{
 		DefaultRulesEnabled: defaultRulesEnabled,
 		Rules: func() *[]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDTrafficShapingRulesRules {
-			if len(requestWirelessUpdateNetworkWirelessSSIDTrafficShapingRulesRules) > 0 {
+			if len(requestWirelessUpdateNetworkWirelessSSIDTrafficShapingRulesRules) > 0 || r.Rules != nil {
+				if len(requestWirelessUpdateNetworkWirelessSSIDTrafficShapingRulesRules) == 0 {
+					requestWirelessUpdateNetworkWirelessSSIDTrafficShapingRulesRules = make([]merakigosdk.RequestWirelessUpdateNetworkWirelessSSIDTrafficShapingRulesRules, 0)
+				}
 				return &requestWirelessUpdateNetworkWirelessSSIDTrafficShapingRulesRules
 			}
 			return nil
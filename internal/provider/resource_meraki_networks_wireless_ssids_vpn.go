@@ -19,16 +19,13 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	"log"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -43,8 +40,11 @@ import (
 )
 
 var (
-	_ resource.Resource              = &NetworksWirelessSSIDsVpnResource{}
-	_ resource.ResourceWithConfigure = &NetworksWirelessSSIDsVpnResource{}
+	_ resource.Resource                 = &NetworksWirelessSSIDsVpnResource{}
+	_ resource.ResourceWithConfigure    = &NetworksWirelessSSIDsVpnResource{}
+	_ resource.ResourceWithImportState  = &NetworksWirelessSSIDsVpnResource{}
+	_ resource.ResourceWithIdentity     = &NetworksWirelessSSIDsVpnResource{}
+	_ resource.ResourceWithUpgradeState = &NetworksWirelessSSIDsVpnResource{}
 )
 
 func NewNetworksWirelessSSIDsVpnResource() resource.Resource {
@@ -52,7 +52,8 @@ func NewNetworksWirelessSSIDsVpnResource() resource.Resource {
 }
 
 type NetworksWirelessSSIDsVpnResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	resetOnDestroy bool
 }
 
 func (r *NetworksWirelessSSIDsVpnResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -61,6 +62,7 @@ func (r *NetworksWirelessSSIDsVpnResource) Configure(ctx context.Context, req re
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -68,8 +70,14 @@ func (r *NetworksWirelessSSIDsVpnResource) Metadata(_ context.Context, req resou
 	resp.TypeName = req.ProviderTypeName + "_networks_wireless_ssids_vpn"
 }
 
+// UpgradeState upgrades the states written before the VLAN IDs were numbers.
+func (r *NetworksWirelessSSIDsVpnResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(1)
+}
+
 func (r *NetworksWirelessSSIDsVpnResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"concentrator": schema.SingleNestedAttribute{
 				MarkdownDescription: `The VPN concentrator settings for this SSID.`,
@@ -257,6 +265,7 @@ func (r *NetworksWirelessSSIDsVpnResource) Create(ctx context.Context, req resou
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -299,19 +308,14 @@ func (r *NetworksWirelessSSIDsVpnResource) Read(ctx context.Context, req resourc
 	//entro aqui 2
 	data = ResponseWirelessGetNetworkWirelessSSIDVpnItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *NetworksWirelessSSIDsVpnResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "network_id", "number")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: networkId,number. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), idParts[1])...)
+func (r *NetworksWirelessSSIDsVpnResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("network_id", "number")
 }
 
 func (r *NetworksWirelessSSIDsVpnResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -341,9 +345,15 @@ func (r *NetworksWirelessSSIDsVpnResource) Update(ctx context.Context, req resou
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *NetworksWirelessSSIDsVpnResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of NetworksWirelessSSIDsVpn not reset", "meraki_reset_on_destroy is set, but the VPN concentrator of an SSID have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting NetworksWirelessSSIDsVpn", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
@@ -25,7 +25,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -38,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsResource{}
+	_ resource.Resource                = &OrganizationsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsResource{}
 )
 
 func NewOrganizationsResource() resource.Resource {
@@ -47,7 +48,8 @@ func NewOrganizationsResource() resource.Resource {
 }
 
 type OrganizationsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,6 +58,7 @@ func (r *OrganizationsResource) Configure(ctx context.Context, req resource.Conf
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -255,6 +258,7 @@ func (r *OrganizationsResource) Create(ctx context.Context, req resource.CreateR
 				data = ResponseOrganizationsGetOrganizationItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
@@ -311,6 +315,7 @@ func (r *OrganizationsResource) Create(ctx context.Context, req resource.CreateR
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseOrganizationsGetOrganizationItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -358,7 +363,7 @@ func (r *OrganizationsResource) Read(ctx context.Context, req resource.ReadReque
 	// Has Item2
 
 	vvOrganizationID := data.OrganizationID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganization(vvOrganizationID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganization(vvOrganizationID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -386,9 +391,14 @@ func (r *OrganizationsResource) Read(ctx context.Context, req resource.ReadReque
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "organization_id")
+}
+
+func (r *OrganizationsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id")
 }
 
 func (r *OrganizationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -404,7 +414,7 @@ func (r *OrganizationsResource) Update(ctx context.Context, req resource.UpdateR
 	//Path Params
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganization(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganization(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -422,6 +432,7 @@ func (r *OrganizationsResource) Update(ctx context.Context, req resource.UpdateR
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -442,7 +453,7 @@ func (r *OrganizationsResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	vvOrganizationID := state.OrganizationID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganization(vvOrganizationID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganization(vvOrganizationID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganization", err.Error())
@@ -21,11 +21,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
+	"time"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -38,8 +39,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsActionBatchesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsActionBatchesResource{}
+	_ resource.Resource                = &OrganizationsActionBatchesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsActionBatchesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsActionBatchesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsActionBatchesResource{}
 )
 
 func NewOrganizationsActionBatchesResource() resource.Resource {
@@ -47,7 +50,8 @@ func NewOrganizationsActionBatchesResource() resource.Resource {
 }
 
 type OrganizationsActionBatchesResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsActionBatchesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,6 +60,7 @@ func (r *OrganizationsActionBatchesResource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -63,8 +68,14 @@ func (r *OrganizationsActionBatchesResource) Metadata(_ context.Context, req res
 	resp.TypeName = req.ProviderTypeName + "_organizations_action_batches"
 }
 
-func (r *OrganizationsActionBatchesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *OrganizationsActionBatchesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
 		Attributes: map[string]schema.Attribute{
 			"action_batch_id": schema.StringAttribute{
 				MarkdownDescription: `actionBatchId path parameter. Action batch ID`,
@@ -165,6 +176,7 @@ func (r *OrganizationsActionBatchesResource) Schema(_ context.Context, _ resourc
 					},
 					"shared_secret": schema.StringAttribute{
 						MarkdownDescription: `A shared secret that will be included in the requests sent to the callback URL. It can be used to verify that the request was sent by Meraki. If using this field, please also specify an url.`,
+						Sensitive:           true,
 						Computed:            true,
 						Optional:            true,
 						PlanModifiers: []planmodifier.String{
@@ -248,6 +260,10 @@ func (r *OrganizationsActionBatchesResource) Schema(_ context.Context, _ resourc
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: `Wait until a confirmed action batch is completed or failed, so that ` + "`status`" + ` holds the final result and the created resources. The errors of a failed batch are reported as errors of the apply. The wait is bounded by the ` + "`create`" + ` and ` + "`update`" + ` timeouts, 30 minutes by default.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -281,7 +297,7 @@ func (r *OrganizationsActionBatchesResource) Create(ctx context.Context, req res
 
 	vvActionBatchID := data.ActionBatchID.ValueString()
 	if vvActionBatchID != "" {
-		responseVerifyItem, restyRespGet, err := r.client.Organizations.GetOrganizationActionBatch(vvOrganizationID, vvActionBatchID)
+		responseVerifyItem, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationActionBatch(vvOrganizationID, vvActionBatchID)
 		if err != nil || responseVerifyItem == nil {
 			if restyRespGet != nil {
 				if restyRespGet.StatusCode() != 404 {
@@ -299,11 +315,12 @@ func (r *OrganizationsActionBatchesResource) Create(ctx context.Context, req res
 			data = ResponseOrganizationsGetOrganizationActionBatchItemToBodyRs(data, responseVerifyItem, false)
 			diags := resp.State.Set(ctx, &data)
 			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
 
-	response, restyResp2, err := r.client.Organizations.CreateOrganizationActionBatch(vvOrganizationID, data.toSdkApiRequestCreate(ctx))
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationActionBatch(vvOrganizationID, data.toSdkApiRequestCreate(ctx))
 
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
@@ -321,7 +338,22 @@ func (r *OrganizationsActionBatchesResource) Create(ctx context.Context, req res
 	}
 	//Items
 	vvActionBatchID = response.ID
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationActionBatch(vvOrganizationID, vvActionBatchID)
+	if data.WaitForCompletion.ValueBool() && data.Confirmed.ValueBool() {
+		createTimeout, diags := data.Timeouts.Create(ctx, actionBatchDefaultTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+		defer cancel()
+		if err := waitForActionBatch(waitCtx, r.clients.Client(vvOrganizationID), vvOrganizationID, vvActionBatchID); err != nil {
+			resp.Diagnostics.AddError(
+				"Failure when waiting for the action batch "+vvActionBatchID,
+				err.Error(),
+			)
+		}
+	}
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationActionBatch(vvOrganizationID, vvActionBatchID)
 	// Has item and has items
 
 	if err != nil || responseGet == nil {
@@ -339,8 +371,14 @@ func (r *OrganizationsActionBatchesResource) Create(ctx context.Context, req res
 		return
 	} else {
 		data = ResponseOrganizationsGetOrganizationActionBatchItemToBodyRs(data, responseGet, false)
+		data.ActionBatchID = types.StringValue(vvActionBatchID)
+		data.Status = ResponseOrganizationsGetOrganizationActionBatchItemToRs(data, responseGet).Status
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+		if data.WaitForCompletion.ValueBool() {
+			resp.Diagnostics.Append(actionBatchErrors(responseGet)...)
+		}
 	}
 
 }
@@ -368,7 +406,7 @@ func (r *OrganizationsActionBatchesResource) Read(ctx context.Context, req resou
 
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvActionBatchID := data.ActionBatchID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationActionBatch(vvOrganizationID, vvActionBatchID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationActionBatch(vvOrganizationID, vvActionBatchID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -393,23 +431,18 @@ func (r *OrganizationsActionBatchesResource) Read(ctx context.Context, req resou
 	}
 	//entro aqui 2
 	data = ResponseOrganizationsGetOrganizationActionBatchItemToBodyRs(data, responseGet, true)
+	data.Status = ResponseOrganizationsGetOrganizationActionBatchItemToRs(data, responseGet).Status
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsActionBatchesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "action_batch_id", "organization_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("action_batch_id"), idParts[0])...)
+func (r *OrganizationsActionBatchesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("action_batch_id", "organization_id")
 }
 
 func (r *OrganizationsActionBatchesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -426,7 +459,7 @@ func (r *OrganizationsActionBatchesResource) Update(ctx context.Context, req res
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvActionBatchID := data.ActionBatchID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationActionBatch(vvOrganizationID, vvActionBatchID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationActionBatch(vvOrganizationID, vvActionBatchID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -441,9 +474,83 @@ func (r *OrganizationsActionBatchesResource) Update(ctx context.Context, req res
 		)
 		return
 	}
+	if data.WaitForCompletion.ValueBool() && data.Confirmed.ValueBool() {
+		updateTimeout, diags := data.Timeouts.Update(ctx, actionBatchDefaultTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+		defer cancel()
+		if err := waitForActionBatch(waitCtx, r.clients.Client(vvOrganizationID), vvOrganizationID, vvActionBatchID); err != nil {
+			resp.Diagnostics.AddError(
+				"Failure when waiting for the action batch "+vvActionBatchID,
+				err.Error(),
+			)
+		}
+	}
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationActionBatch(vvOrganizationID, vvActionBatchID)
+	if err != nil || responseGet == nil {
+		if restyRespGet != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing GetOrganizationActionBatch",
+				restyRespGet.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing GetOrganizationActionBatch",
+			err.Error(),
+		)
+		return
+	}
+	data.Status = ResponseOrganizationsGetOrganizationActionBatchItemToRs(data, responseGet).Status
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	if data.WaitForCompletion.ValueBool() {
+		resp.Diagnostics.Append(actionBatchErrors(responseGet)...)
+	}
+}
+
+// actionBatchDefaultTimeout bounds the wait for an action batch when the timeouts block
+// does not set one.
+const actionBatchDefaultTimeout = 30 * time.Minute
+
+// waitForActionBatch polls GetOrganizationActionBatch until the action batch is completed
+// or failed.
+func waitForActionBatch(ctx context.Context, client *merakigosdk.Client, organizationID string, actionBatchID string) error {
+	return poll(ctx, func() (bool, error) {
+		response, restyResp, err := client.Organizations.GetOrganizationActionBatch(organizationID, actionBatchID)
+		if err != nil || response == nil {
+			if restyResp != nil {
+				return false, fmt.Errorf("failure when executing GetOrganizationActionBatch: %s", restyResp.String())
+			}
+			return false, fmt.Errorf("failure when executing GetOrganizationActionBatch: %v", err)
+		}
+		if response.Status == nil {
+			return false, nil
+		}
+		completed := response.Status.Completed != nil && *response.Status.Completed
+		failed := response.Status.Failed != nil && *response.Status.Failed
+		return completed || failed, nil
+	})
+}
+
+// actionBatchErrors returns an error diagnostic for each error of a failed action batch.
+func actionBatchErrors(response *merakigosdk.ResponseOrganizationsGetOrganizationActionBatch) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if response.Status == nil || response.Status.Failed == nil || !*response.Status.Failed {
+		return diags
+	}
+	if len(response.Status.Errors) == 0 {
+		diags.AddError("Action batch "+response.ID+" failed", "Meraki did not return the errors of the action batch.")
+	}
+	for _, message := range response.Status.Errors {
+		diags.AddError("Action batch "+response.ID+" failed", message)
+	}
+	return diags
 }
 
 func (r *OrganizationsActionBatchesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -465,7 +572,7 @@ func (r *OrganizationsActionBatchesResource) Delete(ctx context.Context, req res
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvActionBatchID := state.ActionBatchID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationActionBatch(vvOrganizationID, vvActionBatchID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationActionBatch(vvOrganizationID, vvActionBatchID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationActionBatch", err.Error())
@@ -486,6 +593,9 @@ type OrganizationsActionBatchesRs struct {
 	ID             types.String                                                `tfsdk:"id"`
 	Status         *ResponseOrganizationsGetOrganizationActionBatchStatusRs    `tfsdk:"status"`
 	Synchronous    types.Bool                                                  `tfsdk:"synchronous"`
+	// Not in the API
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ResponseOrganizationsGetOrganizationActionBatchActionsRs struct {
@@ -625,6 +735,16 @@ func (r *OrganizationsActionBatchesRs) toSdkApiRequestUpdate(ctx context.Context
 
 // From gosdk to TF Structs Schema
 func ResponseOrganizationsGetOrganizationActionBatchItemToBodyRs(state OrganizationsActionBatchesRs, response *merakigosdk.ResponseOrganizationsGetOrganizationActionBatch, is_read bool) OrganizationsActionBatchesRs {
+	itemState := ResponseOrganizationsGetOrganizationActionBatchItemToRs(state, response)
+	if is_read {
+		return mergeInterfacesOnlyPath(state, itemState).(OrganizationsActionBatchesRs)
+	}
+	return mergeInterfaces(state, itemState, true).(OrganizationsActionBatchesRs)
+}
+
+// ResponseOrganizationsGetOrganizationActionBatchItemToRs converts the response of the API to the state of the resource,
+// without merging it with the current state.
+func ResponseOrganizationsGetOrganizationActionBatchItemToRs(state OrganizationsActionBatchesRs, response *merakigosdk.ResponseOrganizationsGetOrganizationActionBatch) OrganizationsActionBatchesRs {
 	itemState := OrganizationsActionBatchesRs{
 		Actions: func() *[]ResponseOrganizationsGetOrganizationActionBatchActionsRs {
 			if response.Actions != nil {
@@ -732,9 +852,8 @@ func ResponseOrganizationsGetOrganizationActionBatchItemToBodyRs(state Organizat
 			}
 			return types.Bool{}
 		}(),
+		WaitForCompletion: state.WaitForCompletion,
+		Timeouts:          state.Timeouts,
 	}
-	if is_read {
-		return mergeInterfacesOnlyPath(state, itemState).(OrganizationsActionBatchesRs)
-	}
-	return mergeInterfaces(state, itemState, true).(OrganizationsActionBatchesRs)
+	return itemState
 }
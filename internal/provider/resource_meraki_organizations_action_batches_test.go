@@ -0,0 +1,134 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testActionBatchPath = "/api/v1/organizations/2930418/actionBatches/123"
+
+func TestWaitForActionBatch(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	mock := newMerakiMock(t, "organizations_action_batches")
+	time.AfterFunc(50*time.Millisecond, func() {
+		mock.setBody(testActionBatchPath, map[string]interface{}{
+			"id":             "123",
+			"organizationId": "2930418",
+			"confirmed":      true,
+			"status": map[string]interface{}{
+				"completed": true,
+				"failed":    false,
+				"errors":    []string{},
+				"createdResources": []map[string]string{
+					{"id": "L_1", "uri": "/networks/L_1"},
+				},
+			},
+		})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := mock.client(t)
+	if err := waitForActionBatch(ctx, client, "2930418", "123"); err != nil {
+		t.Fatal(err)
+	}
+	response, _, err := client.Organizations.GetOrganizationActionBatch("2930418", "123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := ResponseOrganizationsGetOrganizationActionBatchItemToRs(OrganizationsActionBatchesRs{}, response)
+	if data.Status == nil || data.Status.CreatedResources == nil || (*data.Status.CreatedResources)[0].ID.ValueString() != "L_1" {
+		t.Errorf("created resources not read: %+v", data.Status)
+	}
+	if diags := actionBatchErrors(response); diags.HasError() {
+		t.Errorf("actionBatchErrors() = %v for a completed batch", diags)
+	}
+}
+
+func TestWaitForActionBatchFailed(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	mock := newMerakiMock(t, "organizations_action_batches")
+	mock.setBody(testActionBatchPath, map[string]interface{}{
+		"id": "123",
+		"status": map[string]interface{}{
+			"completed": false,
+			"failed":    true,
+			"errors":    []string{"Network name already taken", "Invalid time zone"},
+		},
+	})
+
+	client := mock.client(t)
+	if err := waitForActionBatch(context.Background(), client, "2930418", "123"); err != nil {
+		t.Fatal(err)
+	}
+	response, _, err := client.Organizations.GetOrganizationActionBatch("2930418", "123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	diags := actionBatchErrors(response)
+	if diags.ErrorsCount() != 2 || diags[0].Detail() != "Network name already taken" {
+		t.Errorf("actionBatchErrors() = %v, want one error per failed action", diags)
+	}
+}
+
+func TestWaitForActionBatchTimeout(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	mock := newMerakiMock(t, "organizations_action_batches")
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := waitForActionBatch(ctx, mock.client(t), "2930418", "123"); err == nil {
+		t.Error("waitForActionBatch() did not time out for a pending batch")
+	}
+}
+
+// TestOrganizationsActionBatchesImportState checks that the import identifier keeps its
+// action_batch_id,organization_id order.
+func TestOrganizationsActionBatchesImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &OrganizationsActionBatchesResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "123,2930418"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	for attribute, want := range map[string]string{"action_batch_id": "123", "organization_id": "2930418"} {
+		var value types.String
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if value.ValueString() != want {
+			t.Errorf("%s = %q, want %q", attribute, value.ValueString(), want)
+		}
+	}
+}
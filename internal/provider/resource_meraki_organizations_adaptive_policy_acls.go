@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -39,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsAdaptivePolicyACLsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsAdaptivePolicyACLsResource{}
+	_ resource.Resource                = &OrganizationsAdaptivePolicyACLsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsAdaptivePolicyACLsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsAdaptivePolicyACLsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsAdaptivePolicyACLsResource{}
 )
 
 func NewOrganizationsAdaptivePolicyACLsResource() resource.Resource {
@@ -48,7 +47,8 @@ func NewOrganizationsAdaptivePolicyACLsResource() resource.Resource {
 }
 
 type OrganizationsAdaptivePolicyACLsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsAdaptivePolicyACLsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,6 +57,7 @@ func (r *OrganizationsAdaptivePolicyACLsResource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -217,7 +218,7 @@ func (r *OrganizationsAdaptivePolicyACLsResource) Create(ctx context.Context, re
 
 	vvName := data.Name.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Organizations.GetOrganizationAdaptivePolicyACLs(vvOrganizationID)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyACLs(vvOrganizationID)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -244,20 +245,21 @@ func (r *OrganizationsAdaptivePolicyACLsResource) Create(ctx context.Context, re
 				)
 				return
 			}
-			r.client.Organizations.UpdateOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID, data.toSdkApiRequestUpdate(ctx))
+			r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID, data.toSdkApiRequestUpdate(ctx))
 
-			responseVerifyItem2, _, _ := r.client.Organizations.GetOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID)
+			responseVerifyItem2, _, _ := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID)
 			if responseVerifyItem2 != nil {
 				data = ResponseOrganizationsGetOrganizationAdaptivePolicyACLItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Organizations.CreateOrganizationAdaptivePolicyACL(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationAdaptivePolicyACL(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -273,7 +275,7 @@ func (r *OrganizationsAdaptivePolicyACLsResource) Create(ctx context.Context, re
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationAdaptivePolicyACLs(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyACLs(vvOrganizationID)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -302,10 +304,11 @@ func (r *OrganizationsAdaptivePolicyACLsResource) Create(ctx context.Context, re
 			)
 			return
 		}
-		responseVerifyItem2, restyRespGet, err := r.client.Organizations.GetOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID)
+		responseVerifyItem2, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseOrganizationsGetOrganizationAdaptivePolicyACLItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -344,7 +347,7 @@ func (r *OrganizationsAdaptivePolicyACLsResource) Read(ctx context.Context, req
 
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvACLID := data.ACLID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -370,19 +373,14 @@ func (r *OrganizationsAdaptivePolicyACLsResource) Read(ctx context.Context, req
 	//entro aqui 2
 	data = ResponseOrganizationsGetOrganizationAdaptivePolicyACLItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsAdaptivePolicyACLsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "organization_id", "acl_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: organizationId,aclId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("acl_id"), idParts[1])...)
+func (r *OrganizationsAdaptivePolicyACLsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "acl_id")
 }
 
 func (r *OrganizationsAdaptivePolicyACLsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -396,7 +394,7 @@ func (r *OrganizationsAdaptivePolicyACLsResource) Update(ctx context.Context, re
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	vvACLID := plan.ACLID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -412,6 +410,7 @@ func (r *OrganizationsAdaptivePolicyACLsResource) Update(ctx context.Context, re
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsAdaptivePolicyACLsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -433,7 +432,7 @@ func (r *OrganizationsAdaptivePolicyACLsResource) Delete(ctx context.Context, re
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvACLID := state.ACLID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationAdaptivePolicyACL(vvOrganizationID, vvACLID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationAdaptivePolicyACL", err.Error())
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -38,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsAdaptivePolicyGroupsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsAdaptivePolicyGroupsResource{}
+	_ resource.Resource                = &OrganizationsAdaptivePolicyGroupsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsAdaptivePolicyGroupsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsAdaptivePolicyGroupsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsAdaptivePolicyGroupsResource{}
 )
 
 func NewOrganizationsAdaptivePolicyGroupsResource() resource.Resource {
@@ -47,7 +46,8 @@ func NewOrganizationsAdaptivePolicyGroupsResource() resource.Resource {
 }
 
 type OrganizationsAdaptivePolicyGroupsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsAdaptivePolicyGroupsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,6 +56,7 @@ func (r *OrganizationsAdaptivePolicyGroupsResource) Configure(ctx context.Contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -185,7 +186,7 @@ func (r *OrganizationsAdaptivePolicyGroupsResource) Create(ctx context.Context,
 
 	vvName := data.Name.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Organizations.GetOrganizationAdaptivePolicyGroups(vvOrganizationID)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyGroups(vvOrganizationID)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -212,20 +213,21 @@ func (r *OrganizationsAdaptivePolicyGroupsResource) Create(ctx context.Context,
 				)
 				return
 			}
-			r.client.Organizations.UpdateOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID, data.toSdkApiRequestUpdate(ctx))
+			r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID, data.toSdkApiRequestUpdate(ctx))
 
-			responseVerifyItem2, _, _ := r.client.Organizations.GetOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID)
+			responseVerifyItem2, _, _ := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID)
 			if responseVerifyItem2 != nil {
 				data = ResponseOrganizationsGetOrganizationAdaptivePolicyGroupItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Organizations.CreateOrganizationAdaptivePolicyGroup(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationAdaptivePolicyGroup(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -241,7 +243,7 @@ func (r *OrganizationsAdaptivePolicyGroupsResource) Create(ctx context.Context,
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationAdaptivePolicyGroups(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyGroups(vvOrganizationID)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -270,10 +272,11 @@ func (r *OrganizationsAdaptivePolicyGroupsResource) Create(ctx context.Context,
 			)
 			return
 		}
-		responseVerifyItem2, restyRespGet, err := r.client.Organizations.GetOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID)
+		responseVerifyItem2, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseOrganizationsGetOrganizationAdaptivePolicyGroupItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -324,7 +327,7 @@ func (r *OrganizationsAdaptivePolicyGroupsResource) Read(ctx context.Context, re
 	// organization_id
 	vvID := data.GroupID.ValueString()
 	// id
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -352,20 +355,14 @@ func (r *OrganizationsAdaptivePolicyGroupsResource) Read(ctx context.Context, re
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsAdaptivePolicyGroupsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "organization_id", "id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+func (r *OrganizationsAdaptivePolicyGroupsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "id")
 }
 
 func (r *OrganizationsAdaptivePolicyGroupsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -383,7 +380,7 @@ func (r *OrganizationsAdaptivePolicyGroupsResource) Update(ctx context.Context,
 	// organization_id
 	vvID := data.GroupID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -401,6 +398,7 @@ func (r *OrganizationsAdaptivePolicyGroupsResource) Update(ctx context.Context,
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsAdaptivePolicyGroupsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -422,7 +420,7 @@ func (r *OrganizationsAdaptivePolicyGroupsResource) Delete(ctx context.Context,
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvID := state.GroupID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationAdaptivePolicyGroup(vvOrganizationID, vvID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationAdaptivePolicyGroup", err.Error())
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -40,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsAdaptivePolicyPoliciesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsAdaptivePolicyPoliciesResource{}
+	_ resource.Resource                = &OrganizationsAdaptivePolicyPoliciesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsAdaptivePolicyPoliciesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsAdaptivePolicyPoliciesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsAdaptivePolicyPoliciesResource{}
 )
 
 func NewOrganizationsAdaptivePolicyPoliciesResource() resource.Resource {
@@ -49,7 +48,8 @@ func NewOrganizationsAdaptivePolicyPoliciesResource() resource.Resource {
 }
 
 type OrganizationsAdaptivePolicyPoliciesResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsAdaptivePolicyPoliciesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -58,6 +58,7 @@ func (r *OrganizationsAdaptivePolicyPoliciesResource) Configure(ctx context.Cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -239,7 +240,7 @@ func (r *OrganizationsAdaptivePolicyPoliciesResource) Create(ctx context.Context
 
 	vvID := data.ID.ValueString()
 	if vvID != "" {
-		responseVerifyItem, restyRespGet, err := r.client.Organizations.GetOrganizationAdaptivePolicyPolicy(vvOrganizationID, vvID)
+		responseVerifyItem, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyPolicy(vvOrganizationID, vvID)
 		if err != nil || responseVerifyItem == nil {
 			if restyRespGet != nil {
 				if restyRespGet.StatusCode() != 404 {
@@ -257,11 +258,12 @@ func (r *OrganizationsAdaptivePolicyPoliciesResource) Create(ctx context.Context
 			data = ResponseOrganizationsGetOrganizationAdaptivePolicyPolicyItemToBodyRs(data, responseVerifyItem, false)
 			diags := resp.State.Set(ctx, &data)
 			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
 
-	response, restyResp1, err := r.client.Organizations.CreateOrganizationAdaptivePolicyPolicy(vvOrganizationID, data.toSdkApiRequestCreate(ctx))
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationAdaptivePolicyPolicy(vvOrganizationID, data.toSdkApiRequestCreate(ctx))
 
 	if err != nil || restyResp1 == nil || response == nil {
 		if restyResp1 != nil {
@@ -279,7 +281,7 @@ func (r *OrganizationsAdaptivePolicyPoliciesResource) Create(ctx context.Context
 	}
 	//Items
 	vvID = response.AdaptivePolicyID
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationAdaptivePolicyPolicy(vvOrganizationID, vvID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyPolicy(vvOrganizationID, vvID)
 	// Has item and has items
 
 	if err != nil || responseGet == nil {
@@ -299,6 +301,7 @@ func (r *OrganizationsAdaptivePolicyPoliciesResource) Create(ctx context.Context
 		data = ResponseOrganizationsGetOrganizationAdaptivePolicyPolicyItemToBodyRs(data, responseGet, false)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	}
 }
@@ -325,7 +328,7 @@ func (r *OrganizationsAdaptivePolicyPoliciesResource) Read(ctx context.Context,
 
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvID := data.ID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationAdaptivePolicyPolicy(vvOrganizationID, vvID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicyPolicy(vvOrganizationID, vvID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -353,20 +356,14 @@ func (r *OrganizationsAdaptivePolicyPoliciesResource) Read(ctx context.Context,
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsAdaptivePolicyPoliciesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "organization_id", "id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+func (r *OrganizationsAdaptivePolicyPoliciesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "id")
 }
 
 func (r *OrganizationsAdaptivePolicyPoliciesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -383,7 +380,7 @@ func (r *OrganizationsAdaptivePolicyPoliciesResource) Update(ctx context.Context
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvID := data.ID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationAdaptivePolicyPolicy(vvOrganizationID, vvID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationAdaptivePolicyPolicy(vvOrganizationID, vvID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -401,6 +398,7 @@ func (r *OrganizationsAdaptivePolicyPoliciesResource) Update(ctx context.Context
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsAdaptivePolicyPoliciesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -422,7 +420,7 @@ func (r *OrganizationsAdaptivePolicyPoliciesResource) Delete(ctx context.Context
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvID := state.ID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationAdaptivePolicyPolicy(vvOrganizationID, vvID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationAdaptivePolicyPolicy(vvOrganizationID, vvID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationAdaptivePolicyPolicy", err.Error())
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsAdaptivePolicySettingsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsAdaptivePolicySettingsResource{}
+	_ resource.Resource                = &OrganizationsAdaptivePolicySettingsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsAdaptivePolicySettingsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsAdaptivePolicySettingsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsAdaptivePolicySettingsResource{}
 )
 
 func NewOrganizationsAdaptivePolicySettingsResource() resource.Resource {
@@ -43,7 +44,9 @@ func NewOrganizationsAdaptivePolicySettingsResource() resource.Resource {
 }
 
 type OrganizationsAdaptivePolicySettingsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	clients        *merakiClientPool
+	resetOnDestroy bool
 }
 
 func (r *OrganizationsAdaptivePolicySettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +55,8 @@ func (r *OrganizationsAdaptivePolicySettingsResource) Configure(ctx context.Cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -105,7 +110,7 @@ func (r *OrganizationsAdaptivePolicySettingsResource) Create(ctx context.Context
 
 	// UPDATE NO CREATE
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationAdaptivePolicySettings(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationAdaptivePolicySettings(vvOrganizationID, dataRequest)
 	//Update
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
@@ -124,6 +129,7 @@ func (r *OrganizationsAdaptivePolicySettingsResource) Create(ctx context.Context
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -139,7 +145,7 @@ func (r *OrganizationsAdaptivePolicySettingsResource) Read(ctx context.Context,
 	// Has Item2
 
 	vvOrganizationID := data.OrganizationID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationAdaptivePolicySettings(vvOrganizationID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdaptivePolicySettings(vvOrganizationID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -165,9 +171,14 @@ func (r *OrganizationsAdaptivePolicySettingsResource) Read(ctx context.Context,
 	//entro aqui 2
 	data = ResponseOrganizationsGetOrganizationAdaptivePolicySettingsItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsAdaptivePolicySettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "organization_id")
+}
+
+func (r *OrganizationsAdaptivePolicySettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id")
 }
 
 func (r *OrganizationsAdaptivePolicySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -180,7 +191,7 @@ func (r *OrganizationsAdaptivePolicySettingsResource) Update(ctx context.Context
 	//Path Params
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationAdaptivePolicySettings(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationAdaptivePolicySettings(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -196,9 +207,15 @@ func (r *OrganizationsAdaptivePolicySettingsResource) Update(ctx context.Context
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsAdaptivePolicySettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of OrganizationsAdaptivePolicySettings not reset", "meraki_reset_on_destroy is set, but the networks with adaptive policy have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting OrganizationsAdaptivePolicySettings", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
@@ -19,15 +19,12 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -39,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsAdminsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsAdminsResource{}
+	_ resource.Resource                = &OrganizationsAdminsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsAdminsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsAdminsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsAdminsResource{}
 )
 
 func NewOrganizationsAdminsResource() resource.Resource {
@@ -48,7 +47,8 @@ func NewOrganizationsAdminsResource() resource.Resource {
 }
 
 type OrganizationsAdminsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsAdminsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,6 +57,7 @@ func (r *OrganizationsAdminsResource) Configure(ctx context.Context, req resourc
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -247,7 +248,7 @@ func (r *OrganizationsAdminsResource) Create(ctx context.Context, req resource.C
 
 	vvEmail := data.Email.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Organizations.GetOrganizationAdmins(vvOrganizationID, nil)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdmins(vvOrganizationID, nil)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -274,7 +275,7 @@ func (r *OrganizationsAdminsResource) Create(ctx context.Context, req resource.C
 				)
 				return
 			}
-			r.client.Organizations.UpdateOrganizationAdmin(vvOrganizationID, responseVerifyItem2.ID, data.toSdkApiRequestUpdate(ctx))
+			r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationAdmin(vvOrganizationID, responseVerifyItem2.ID, data.toSdkApiRequestUpdate(ctx))
 
 			responseVerifyItem2.Email = data.Email.ValueString()
 
@@ -287,13 +288,14 @@ func (r *OrganizationsAdminsResource) Create(ctx context.Context, req resource.C
 
 			diags := resp.State.Set(ctx, &data)
 			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Organizations.CreateOrganizationAdmin(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationAdmin(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -309,7 +311,7 @@ func (r *OrganizationsAdminsResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationAdmins(vvOrganizationID, nil)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdmins(vvOrganizationID, nil)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -340,6 +342,7 @@ func (r *OrganizationsAdminsResource) Create(ctx context.Context, req resource.C
 		data = ResponseOrganizationsGetOrganizationAdminsItemToBodyRs(data, &responseVerifyItem2, false)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -377,7 +380,7 @@ func (r *OrganizationsAdminsResource) Read(ctx context.Context, req resource.Rea
 	vvName := data.ID.ValueString()
 	// name
 
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationAdmins(vvOrganizationID, nil)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAdmins(vvOrganizationID, nil)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -409,6 +412,7 @@ func (r *OrganizationsAdminsResource) Read(ctx context.Context, req resource.Rea
 		data = ResponseOrganizationsGetOrganizationAdminsItemToBodyRs(data, &responseVerifyItem2, true)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -420,18 +424,11 @@ func (r *OrganizationsAdminsResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *OrganizationsAdminsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "organization_id", "id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+func (r *OrganizationsAdminsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "id")
 }
 
 func (r *OrganizationsAdminsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -449,7 +446,7 @@ func (r *OrganizationsAdminsResource) Update(ctx context.Context, req resource.U
 	// organization_id
 	vvAdminID := data.ID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationAdmin(vvOrganizationID, vvAdminID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationAdmin(vvOrganizationID, vvAdminID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -467,6 +464,7 @@ func (r *OrganizationsAdminsResource) Update(ctx context.Context, req resource.U
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsAdminsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -488,7 +486,7 @@ func (r *OrganizationsAdminsResource) Delete(ctx context.Context, req resource.D
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvAdminID := state.ID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationAdmin(vvOrganizationID, vvAdminID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationAdmin(vvOrganizationID, vvAdminID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationAdmin", err.Error())
@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -41,8 +40,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsAlertsProfilesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsAlertsProfilesResource{}
+	_ resource.Resource                = &OrganizationsAlertsProfilesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsAlertsProfilesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsAlertsProfilesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsAlertsProfilesResource{}
 )
 
 func NewOrganizationsAlertsProfilesResource() resource.Resource {
@@ -50,7 +51,8 @@ func NewOrganizationsAlertsProfilesResource() resource.Resource {
 }
 
 type OrganizationsAlertsProfilesResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsAlertsProfilesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -59,6 +61,7 @@ func (r *OrganizationsAlertsProfilesResource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -278,7 +281,7 @@ func (r *OrganizationsAlertsProfilesResource) Create(ctx context.Context, req re
 	vvAlertConfigID := data.AlertConfigID.ValueString()
 	//Reviw This  Has Item Not item
 
-	responseVerifyItem, restyResp1, err := r.client.Organizations.GetOrganizationAlertsProfiles(vvOrganizationID)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAlertsProfiles(vvOrganizationID)
 
 	//Only Item
 	var responseVerifyItem2 merakigosdk.ResponseItemOrganizationsGetOrganizationAlertsProfiles
@@ -297,10 +300,11 @@ func (r *OrganizationsAlertsProfilesResource) Create(ctx context.Context, req re
 		data = ResponseOrganizationsGetOrganizationAlertsProfilesItemToBodyRs(data, &responseVerifyItem2, false)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	}
 	// HAS CRREATE
-	response, restyResp2, err := r.client.Organizations.CreateOrganizationAlertsProfile(vvOrganizationID, data.toSdkApiRequestCreate(ctx))
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationAlertsProfile(vvOrganizationID, data.toSdkApiRequestCreate(ctx))
 
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp1 != nil {
@@ -319,7 +323,7 @@ func (r *OrganizationsAlertsProfilesResource) Create(ctx context.Context, req re
 
 	vvAlertConfigID = response.ID
 	//Items
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationAlertsProfiles(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAlertsProfiles(vvOrganizationID)
 	// Has only items
 
 	responseStruct2 = structToMap(responseGet)
@@ -337,6 +341,7 @@ func (r *OrganizationsAlertsProfilesResource) Create(ctx context.Context, req re
 		data = ResponseOrganizationsGetOrganizationAlertsProfilesItemToBodyRs(data, &responseVerifyItem2, false)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -372,7 +377,7 @@ func (r *OrganizationsAlertsProfilesResource) Read(ctx context.Context, req reso
 	// organization_id
 	vvAlertConfigID := data.AlertConfigID.ValueString()
 	// alert_config_id
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationAlertsProfiles(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationAlertsProfiles(vvOrganizationID)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -412,6 +417,7 @@ func (r *OrganizationsAlertsProfilesResource) Read(ctx context.Context, req reso
 		data = ResponseOrganizationsGetOrganizationAlertsProfilesItemToBodyRs(data, &responseVerifyItem2, true)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddWarning(
@@ -424,7 +430,11 @@ func (r *OrganizationsAlertsProfilesResource) Read(ctx context.Context, req reso
 }
 
 func (r *OrganizationsAlertsProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "organization_id")
+}
+
+func (r *OrganizationsAlertsProfilesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id")
 }
 
 func (r *OrganizationsAlertsProfilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -441,7 +451,7 @@ func (r *OrganizationsAlertsProfilesResource) Update(ctx context.Context, req re
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvAlertConfigID := data.AlertConfigID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationAlertsProfile(vvOrganizationID, vvAlertConfigID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationAlertsProfile(vvOrganizationID, vvAlertConfigID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -459,6 +469,7 @@ func (r *OrganizationsAlertsProfilesResource) Update(ctx context.Context, req re
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsAlertsProfilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -480,7 +491,7 @@ func (r *OrganizationsAlertsProfilesResource) Delete(ctx context.Context, req re
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvAlertConfigID := state.AlertConfigID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationAlertsProfile(vvOrganizationID, vvAlertConfigID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationAlertsProfile(vvOrganizationID, vvAlertConfigID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationAlertsProfile", err.Error())
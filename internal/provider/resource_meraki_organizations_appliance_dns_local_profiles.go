@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -35,8 +32,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsApplianceDNSLocalProfilesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsApplianceDNSLocalProfilesResource{}
+	_ resource.Resource                = &OrganizationsApplianceDNSLocalProfilesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsApplianceDNSLocalProfilesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsApplianceDNSLocalProfilesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsApplianceDNSLocalProfilesResource{}
 )
 
 func NewOrganizationsApplianceDNSLocalProfilesResource() resource.Resource {
@@ -44,7 +43,8 @@ func NewOrganizationsApplianceDNSLocalProfilesResource() resource.Resource {
 }
 
 type OrganizationsApplianceDNSLocalProfilesResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsApplianceDNSLocalProfilesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +53,7 @@ func (r *OrganizationsApplianceDNSLocalProfilesResource) Configure(ctx context.C
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -107,7 +108,7 @@ func (r *OrganizationsApplianceDNSLocalProfilesResource) Create(ctx context.Cont
 
 	vvName := data.Name.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Appliance.GetOrganizationApplianceDNSLocalProfiles(vvOrganizationID, nil)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSLocalProfiles(vvOrganizationID, nil)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -137,13 +138,14 @@ func (r *OrganizationsApplianceDNSLocalProfilesResource) Create(ctx context.Cont
 			data = ResponseApplianceGetOrganizationApplianceDNSLocalProfilesItemToBodyRs(data, &responseVerifyItem2, false)
 			// Path params update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Appliance.CreateOrganizationApplianceDNSLocalProfile(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Appliance.CreateOrganizationApplianceDNSLocalProfile(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -159,7 +161,7 @@ func (r *OrganizationsApplianceDNSLocalProfilesResource) Create(ctx context.Cont
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Appliance.GetOrganizationApplianceDNSLocalProfiles(vvOrganizationID, nil)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSLocalProfiles(vvOrganizationID, nil)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -190,6 +192,7 @@ func (r *OrganizationsApplianceDNSLocalProfilesResource) Create(ctx context.Cont
 		data = ResponseApplianceGetOrganizationApplianceDNSLocalProfilesItemToBodyRs(data, &responseVerifyItem2, false)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -225,7 +228,7 @@ func (r *OrganizationsApplianceDNSLocalProfilesResource) Read(ctx context.Contex
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvName := data.Name.ValueString()
 
-	responseGet, restyResp1, err := r.client.Appliance.GetOrganizationApplianceDNSLocalProfiles(vvOrganizationID, nil)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSLocalProfiles(vvOrganizationID, nil)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -265,6 +268,7 @@ func (r *OrganizationsApplianceDNSLocalProfilesResource) Read(ctx context.Contex
 		data = ResponseApplianceGetOrganizationApplianceDNSLocalProfilesItemToBodyRs(data, &responseVerifyItem2, true)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -276,18 +280,11 @@ func (r *OrganizationsApplianceDNSLocalProfilesResource) Read(ctx context.Contex
 }
 
 func (r *OrganizationsApplianceDNSLocalProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "organization_id", "name")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+func (r *OrganizationsApplianceDNSLocalProfilesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "name")
 }
 
 func (r *OrganizationsApplianceDNSLocalProfilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -41,7 +41,8 @@ func NewOrganizationsApplianceDNSLocalProfilesAssignmentsBulkCreateResource() re
 }
 
 type OrganizationsApplianceDNSLocalProfilesAssignmentsBulkCreateResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsApplianceDNSLocalProfilesAssignmentsBulkCreateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsApplianceDNSLocalProfilesAssignmentsBulkCreateResource) Co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -180,7 +182,7 @@ func (r *OrganizationsApplianceDNSLocalProfilesAssignmentsBulkCreateResource) Cr
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Appliance.BulkOrganizationApplianceDNSLocalProfilesAssignmentsCreate(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.BulkOrganizationApplianceDNSLocalProfilesAssignmentsCreate(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
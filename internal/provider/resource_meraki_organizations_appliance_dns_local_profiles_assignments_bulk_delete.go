@@ -41,7 +41,8 @@ func NewOrganizationsApplianceDNSLocalProfilesAssignmentsBulkDeleteResource() re
 }
 
 type OrganizationsApplianceDNSLocalProfilesAssignmentsBulkDeleteResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsApplianceDNSLocalProfilesAssignmentsBulkDeleteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsApplianceDNSLocalProfilesAssignmentsBulkDeleteResource) Co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -156,7 +158,7 @@ func (r *OrganizationsApplianceDNSLocalProfilesAssignmentsBulkDeleteResource) Cr
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Appliance.CreateOrganizationApplianceDNSLocalProfilesAssignmentsBulkDelete(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.CreateOrganizationApplianceDNSLocalProfilesAssignmentsBulkDelete(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
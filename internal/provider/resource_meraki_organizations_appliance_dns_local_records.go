@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -36,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsApplianceDNSLocalRecordsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsApplianceDNSLocalRecordsResource{}
+	_ resource.Resource                = &OrganizationsApplianceDNSLocalRecordsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsApplianceDNSLocalRecordsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsApplianceDNSLocalRecordsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsApplianceDNSLocalRecordsResource{}
 )
 
 func NewOrganizationsApplianceDNSLocalRecordsResource() resource.Resource {
@@ -45,7 +44,8 @@ func NewOrganizationsApplianceDNSLocalRecordsResource() resource.Resource {
 }
 
 type OrganizationsApplianceDNSLocalRecordsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsApplianceDNSLocalRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -54,6 +54,7 @@ func (r *OrganizationsApplianceDNSLocalRecordsResource) Configure(ctx context.Co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -135,7 +136,7 @@ func (r *OrganizationsApplianceDNSLocalRecordsResource) Create(ctx context.Conte
 
 	vvHostname := data.Hostname.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Appliance.GetOrganizationApplianceDNSLocalRecords(vvOrganizationID, nil)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSLocalRecords(vvOrganizationID, nil)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -165,13 +166,14 @@ func (r *OrganizationsApplianceDNSLocalRecordsResource) Create(ctx context.Conte
 			data = ResponseApplianceGetOrganizationApplianceDNSLocalRecordsItemToBodyRs(data, &responseVerifyItem2, false)
 			// Path params update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	_, restyResp2, err := r.client.Appliance.CreateOrganizationApplianceDNSLocalRecord(vvOrganizationID, dataRequest)
+	_, restyResp2, err := r.clients.Client(vvOrganizationID).Appliance.CreateOrganizationApplianceDNSLocalRecord(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -187,7 +189,7 @@ func (r *OrganizationsApplianceDNSLocalRecordsResource) Create(ctx context.Conte
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Appliance.GetOrganizationApplianceDNSLocalRecords(vvOrganizationID, nil)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSLocalRecords(vvOrganizationID, nil)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -218,6 +220,7 @@ func (r *OrganizationsApplianceDNSLocalRecordsResource) Create(ctx context.Conte
 		data = ResponseApplianceGetOrganizationApplianceDNSLocalRecordsItemToBodyRs(data, &responseVerifyItem2, false)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -253,7 +256,7 @@ func (r *OrganizationsApplianceDNSLocalRecordsResource) Read(ctx context.Context
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvHostname := data.Hostname.ValueString()
 
-	responseGet, restyResp1, err := r.client.Appliance.GetOrganizationApplianceDNSLocalRecords(vvOrganizationID, nil)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSLocalRecords(vvOrganizationID, nil)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -293,6 +296,7 @@ func (r *OrganizationsApplianceDNSLocalRecordsResource) Read(ctx context.Context
 		data = ResponseApplianceGetOrganizationApplianceDNSLocalRecordsItemToBodyRs(data, &responseVerifyItem2, true)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -304,18 +308,11 @@ func (r *OrganizationsApplianceDNSLocalRecordsResource) Read(ctx context.Context
 }
 
 func (r *OrganizationsApplianceDNSLocalRecordsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "organization_id", "hostname")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), idParts[1])...)
+func (r *OrganizationsApplianceDNSLocalRecordsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "hostname")
 }
 
 func (r *OrganizationsApplianceDNSLocalRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -38,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsApplianceDNSSplitProfilesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsApplianceDNSSplitProfilesResource{}
+	_ resource.Resource                = &OrganizationsApplianceDNSSplitProfilesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsApplianceDNSSplitProfilesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsApplianceDNSSplitProfilesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsApplianceDNSSplitProfilesResource{}
 )
 
 func NewOrganizationsApplianceDNSSplitProfilesResource() resource.Resource {
@@ -47,7 +46,8 @@ func NewOrganizationsApplianceDNSSplitProfilesResource() resource.Resource {
 }
 
 type OrganizationsApplianceDNSSplitProfilesResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsApplianceDNSSplitProfilesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,6 +56,7 @@ func (r *OrganizationsApplianceDNSSplitProfilesResource) Configure(ctx context.C
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -142,7 +143,7 @@ func (r *OrganizationsApplianceDNSSplitProfilesResource) Create(ctx context.Cont
 
 	vvName := data.Name.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Appliance.GetOrganizationApplianceDNSSplitProfiles(vvOrganizationID, nil)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSSplitProfiles(vvOrganizationID, nil)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -172,13 +173,14 @@ func (r *OrganizationsApplianceDNSSplitProfilesResource) Create(ctx context.Cont
 			data = ResponseApplianceGetOrganizationApplianceDNSSplitProfilesItemToBodyRs(data, &responseVerifyItem2, false)
 			// Path params update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Appliance.CreateOrganizationApplianceDNSSplitProfile(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Appliance.CreateOrganizationApplianceDNSSplitProfile(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -194,7 +196,7 @@ func (r *OrganizationsApplianceDNSSplitProfilesResource) Create(ctx context.Cont
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Appliance.GetOrganizationApplianceDNSSplitProfiles(vvOrganizationID, nil)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSSplitProfiles(vvOrganizationID, nil)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -225,6 +227,7 @@ func (r *OrganizationsApplianceDNSSplitProfilesResource) Create(ctx context.Cont
 		data = ResponseApplianceGetOrganizationApplianceDNSSplitProfilesItemToBodyRs(data, &responseVerifyItem2, false)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -260,7 +263,7 @@ func (r *OrganizationsApplianceDNSSplitProfilesResource) Read(ctx context.Contex
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvName := data.Name.ValueString()
 
-	responseGet, restyResp1, err := r.client.Appliance.GetOrganizationApplianceDNSSplitProfiles(vvOrganizationID, nil)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceDNSSplitProfiles(vvOrganizationID, nil)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -300,6 +303,7 @@ func (r *OrganizationsApplianceDNSSplitProfilesResource) Read(ctx context.Contex
 		data = ResponseApplianceGetOrganizationApplianceDNSSplitProfilesItemToBodyRs(data, &responseVerifyItem2, true)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -311,18 +315,11 @@ func (r *OrganizationsApplianceDNSSplitProfilesResource) Read(ctx context.Contex
 }
 
 func (r *OrganizationsApplianceDNSSplitProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "organization_id", "name")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+func (r *OrganizationsApplianceDNSSplitProfilesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "name")
 }
 
 func (r *OrganizationsApplianceDNSSplitProfilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -41,7 +41,8 @@ func NewOrganizationsApplianceDNSSplitProfilesAssignmentsBulkCreateResource() re
 }
 
 type OrganizationsApplianceDNSSplitProfilesAssignmentsBulkCreateResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsApplianceDNSSplitProfilesAssignmentsBulkCreateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsApplianceDNSSplitProfilesAssignmentsBulkCreateResource) Co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -180,7 +182,7 @@ func (r *OrganizationsApplianceDNSSplitProfilesAssignmentsBulkCreateResource) Cr
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Appliance.CreateOrganizationApplianceDNSSplitProfilesAssignmentsBulkCreate(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.CreateOrganizationApplianceDNSSplitProfilesAssignmentsBulkCreate(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
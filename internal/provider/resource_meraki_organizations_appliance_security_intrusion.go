@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsApplianceSecurityIntrusionResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsApplianceSecurityIntrusionResource{}
+	_ resource.Resource                = &OrganizationsApplianceSecurityIntrusionResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsApplianceSecurityIntrusionResource{}
+	_ resource.ResourceWithImportState = &OrganizationsApplianceSecurityIntrusionResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsApplianceSecurityIntrusionResource{}
 )
 
 func NewOrganizationsApplianceSecurityIntrusionResource() resource.Resource {
@@ -43,7 +44,9 @@ func NewOrganizationsApplianceSecurityIntrusionResource() resource.Resource {
 }
 
 type OrganizationsApplianceSecurityIntrusionResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	clients        *merakiClientPool
+	resetOnDestroy bool
 }
 
 func (r *OrganizationsApplianceSecurityIntrusionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +55,8 @@ func (r *OrganizationsApplianceSecurityIntrusionResource) Configure(ctx context.
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -92,6 +97,10 @@ func (r *OrganizationsApplianceSecurityIntrusionResource) Schema(_ context.Conte
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -120,7 +129,7 @@ func (r *OrganizationsApplianceSecurityIntrusionResource) Create(ctx context.Con
 
 	// UPDATE NO CREATE
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	restyResp2, err := r.client.Appliance.UpdateOrganizationApplianceSecurityIntrusion(vvOrganizationID, dataRequest)
+	restyResp2, err := r.clients.Client(vvOrganizationID).Appliance.UpdateOrganizationApplianceSecurityIntrusion(vvOrganizationID, dataRequest)
 	//Update
 	if err != nil || restyResp2 == nil {
 		if restyResp2 != nil {
@@ -139,6 +148,7 @@ func (r *OrganizationsApplianceSecurityIntrusionResource) Create(ctx context.Con
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -154,7 +164,7 @@ func (r *OrganizationsApplianceSecurityIntrusionResource) Read(ctx context.Conte
 	// Has Item2
 
 	vvOrganizationID := data.OrganizationID.ValueString()
-	responseGet, restyRespGet, err := r.client.Appliance.GetOrganizationApplianceSecurityIntrusion(vvOrganizationID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceSecurityIntrusion(vvOrganizationID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -180,9 +190,14 @@ func (r *OrganizationsApplianceSecurityIntrusionResource) Read(ctx context.Conte
 	//entro aqui 2
 	data = ResponseApplianceGetOrganizationApplianceSecurityIntrusionItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsApplianceSecurityIntrusionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "organization_id")
+}
+
+func (r *OrganizationsApplianceSecurityIntrusionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id")
 }
 
 func (r *OrganizationsApplianceSecurityIntrusionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -195,7 +210,7 @@ func (r *OrganizationsApplianceSecurityIntrusionResource) Update(ctx context.Con
 	//Path Params
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	restyResp2, err := r.client.Appliance.UpdateOrganizationApplianceSecurityIntrusion(vvOrganizationID, dataRequest)
+	restyResp2, err := r.clients.Client(vvOrganizationID).Appliance.UpdateOrganizationApplianceSecurityIntrusion(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -211,11 +226,43 @@ func (r *OrganizationsApplianceSecurityIntrusionResource) Update(ctx context.Con
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsApplianceSecurityIntrusionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting OrganizationsApplianceSecurityIntrusion", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state OrganizationsApplianceSecurityIntrusionRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting OrganizationsApplianceSecurityIntrusion", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvOrganizationID := state.OrganizationID.ValueString()
+	// Meraki defaults
+	defaults := OrganizationsApplianceSecurityIntrusionRs{
+		AllowedRules: &[]ResponseApplianceGetOrganizationApplianceSecurityIntrusionAllowedRulesRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.clients.Client(vvOrganizationID).Appliance.UpdateOrganizationApplianceSecurityIntrusion(vvOrganizationID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateOrganizationApplianceSecurityIntrusion",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateOrganizationApplianceSecurityIntrusion",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -223,6 +270,7 @@ func (r *OrganizationsApplianceSecurityIntrusionResource) Delete(ctx context.Con
 type OrganizationsApplianceSecurityIntrusionRs struct {
 	OrganizationID types.String                                                                `tfsdk:"organization_id"`
 	AllowedRules   *[]ResponseApplianceGetOrganizationApplianceSecurityIntrusionAllowedRulesRs `tfsdk:"allowed_rules"`
+	ResetOnDestroy types.Bool                                                                  `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetOrganizationApplianceSecurityIntrusionAllowedRulesRs struct {
@@ -247,7 +295,10 @@ func (r *OrganizationsApplianceSecurityIntrusionRs) toSdkApiRequestUpdate(ctx co
 	}
 	out := merakigosdk.RequestApplianceUpdateOrganizationApplianceSecurityIntrusion{
 		AllowedRules: func() *[]merakigosdk.RequestApplianceUpdateOrganizationApplianceSecurityIntrusionAllowedRules {
-			if len(requestApplianceUpdateOrganizationApplianceSecurityIntrusionAllowedRules) > 0 {
+			if len(requestApplianceUpdateOrganizationApplianceSecurityIntrusionAllowedRules) > 0 || r.AllowedRules != nil {
+				if len(requestApplianceUpdateOrganizationApplianceSecurityIntrusionAllowedRules) == 0 {
+					requestApplianceUpdateOrganizationApplianceSecurityIntrusionAllowedRules = make([]merakigosdk.RequestApplianceUpdateOrganizationApplianceSecurityIntrusionAllowedRules, 0)
+				}
 				return &requestApplianceUpdateOrganizationApplianceSecurityIntrusionAllowedRules
 			}
 			return nil
@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -39,8 +38,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsApplianceVpnThirdPartyVpnpeersResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsApplianceVpnThirdPartyVpnpeersResource{}
+	_ resource.Resource                = &OrganizationsApplianceVpnThirdPartyVpnpeersResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsApplianceVpnThirdPartyVpnpeersResource{}
+	_ resource.ResourceWithImportState = &OrganizationsApplianceVpnThirdPartyVpnpeersResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsApplianceVpnThirdPartyVpnpeersResource{}
 )
 
 func NewOrganizationsApplianceVpnThirdPartyVpnpeersResource() resource.Resource {
@@ -48,7 +49,9 @@ func NewOrganizationsApplianceVpnThirdPartyVpnpeersResource() resource.Resource
 }
 
 type OrganizationsApplianceVpnThirdPartyVpnpeersResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	clients        *merakiClientPool
+	resetOnDestroy bool
 }
 
 func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,6 +60,8 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Configure(ctx cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -233,6 +238,7 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Schema(_ context.C
 						},
 						"secret": schema.StringAttribute{
 							MarkdownDescription: `The shared secret with the VPN peer`,
+							Sensitive:           true,
 							Optional:            true,
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
@@ -400,6 +406,7 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Schema(_ context.C
 						},
 						"secret": schema.StringAttribute{
 							MarkdownDescription: `The shared secret with the VPN peer`,
+							Sensitive:           true,
 							Computed:            true,
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
@@ -408,6 +415,10 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Schema(_ context.C
 					},
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -436,7 +447,7 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Create(ctx context
 
 	if vvOrganizationID != "" {
 		//dentro
-		responseVerifyItem, restyResp1, err := r.client.Appliance.GetOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID)
+		responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID)
 		// No Post
 		if err != nil || restyResp1 == nil || responseVerifyItem == nil {
 			resp.Diagnostics.AddError(
@@ -457,7 +468,7 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Create(ctx context
 
 	// UPDATE NO CREATE
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	restyResp2, err := r.client.Appliance.UpdateOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID, dataRequest)
+	restyResp2, err := r.clients.Client(vvOrganizationID).Appliance.UpdateOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID, dataRequest)
 	//Update
 	if err != nil || restyResp2 == nil {
 		if restyResp2 != nil {
@@ -476,7 +487,7 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Create(ctx context
 
 	//Assign Path Params required
 
-	responseGet, restyResp1, err := r.client.Appliance.GetOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID)
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -496,6 +507,7 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Create(ctx context
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -521,7 +533,7 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Read(ctx context.C
 	// Has Item2
 
 	vvOrganizationID := data.OrganizationID.ValueString()
-	responseGet, restyRespGet, err := r.client.Appliance.GetOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -549,9 +561,14 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Read(ctx context.C
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "organization_id")
+}
+
+func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id")
 }
 
 func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -567,7 +584,7 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Update(ctx context
 	//Path Params
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	restyResp2, err := r.client.Appliance.UpdateOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID, dataRequest)
+	restyResp2, err := r.clients.Client(vvOrganizationID).Appliance.UpdateOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -585,11 +602,43 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Update(ctx context
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsApplianceVpnThirdPartyVpnpeersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting OrganizationsApplianceVpnThirdPartyVpnpeers", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state OrganizationsApplianceVpnThirdPartyVpnpeersRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting OrganizationsApplianceVpnThirdPartyVpnpeers", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvOrganizationID := state.OrganizationID.ValueString()
+	// Meraki defaults
+	defaults := OrganizationsApplianceVpnThirdPartyVpnpeersRs{
+		Peers: &[]ResponseApplianceGetOrganizationApplianceVpnThirdPartyVpnpeersPeersRs{},
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	restyResp2, err := r.clients.Client(vvOrganizationID).Appliance.UpdateOrganizationApplianceVpnThirdPartyVpnpeers(vvOrganizationID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateOrganizationApplianceVpnThirdPartyVpnpeers",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateOrganizationApplianceVpnThirdPartyVpnpeers",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -598,6 +647,7 @@ type OrganizationsApplianceVpnThirdPartyVpnpeersRs struct {
 	OrganizationID types.String                                                             `tfsdk:"organization_id"`
 	Peers          *[]ResponseApplianceGetOrganizationApplianceVpnThirdPartyVpnpeersPeersRs `tfsdk:"peers"`
 	PeersResponse  *[]ResponseApplianceGetOrganizationApplianceVpnThirdPartyVpnpeersPeersRs `tfsdk:"peers_response"`
+	ResetOnDestroy types.Bool                                                               `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetOrganizationApplianceVpnThirdPartyVpnpeersPeersRs struct {
@@ -713,7 +763,10 @@ func (r *OrganizationsApplianceVpnThirdPartyVpnpeersRs) toSdkApiRequestUpdate(ct
 	}
 	out := merakigosdk.RequestApplianceUpdateOrganizationApplianceVpnThirdPartyVpnpeers{
 		Peers: func() *[]merakigosdk.RequestApplianceUpdateOrganizationApplianceVpnThirdPartyVpnpeersPeers {
-			if len(requestApplianceUpdateOrganizationApplianceVpnThirdPartyVpnpeersPeers) > 0 {
+			if len(requestApplianceUpdateOrganizationApplianceVpnThirdPartyVpnpeersPeers) > 0 || r.Peers != nil {
+				if len(requestApplianceUpdateOrganizationApplianceVpnThirdPartyVpnpeersPeers) == 0 {
+					requestApplianceUpdateOrganizationApplianceVpnThirdPartyVpnpeersPeers = make([]merakigosdk.RequestApplianceUpdateOrganizationApplianceVpnThirdPartyVpnpeersPeers, 0)
+				}
 				return &requestApplianceUpdateOrganizationApplianceVpnThirdPartyVpnpeersPeers
 			}
 			return nil
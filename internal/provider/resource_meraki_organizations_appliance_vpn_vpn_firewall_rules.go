@@ -24,7 +24,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsApplianceVpnVpnFirewallRulesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsApplianceVpnVpnFirewallRulesResource{}
+	_ resource.Resource                = &OrganizationsApplianceVpnVpnFirewallRulesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsApplianceVpnVpnFirewallRulesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsApplianceVpnVpnFirewallRulesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsApplianceVpnVpnFirewallRulesResource{}
 )
 
 func NewOrganizationsApplianceVpnVpnFirewallRulesResource() resource.Resource {
@@ -46,7 +47,9 @@ func NewOrganizationsApplianceVpnVpnFirewallRulesResource() resource.Resource {
 }
 
 type OrganizationsApplianceVpnVpnFirewallRulesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	clients        *merakiClientPool
+	resetOnDestroy bool
 }
 
 func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +58,8 @@ func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) Configure(ctx contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -69,6 +74,10 @@ func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) Schema(_ context.Con
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rules": schema.ListNestedAttribute{
 				MarkdownDescription: `An ordered array of the firewall rules (not including the default rule)`,
 				Optional:            true,
@@ -189,7 +198,7 @@ func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) Create(ctx context.C
 
 	// UPDATE NO CREATE
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Appliance.UpdateOrganizationApplianceVpnVpnFirewallRules(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Appliance.UpdateOrganizationApplianceVpnVpnFirewallRules(vvOrganizationID, dataRequest)
 	//Update
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
@@ -208,6 +217,7 @@ func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) Create(ctx context.C
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -223,7 +233,7 @@ func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) Read(ctx context.Con
 	// Has Item2
 
 	vvOrganizationID := data.OrganizationID.ValueString()
-	responseGet, restyRespGet, err := r.client.Appliance.GetOrganizationApplianceVpnVpnFirewallRules(vvOrganizationID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Appliance.GetOrganizationApplianceVpnVpnFirewallRules(vvOrganizationID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -249,9 +259,14 @@ func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) Read(ctx context.Con
 	//entro aqui 2
 	data = ResponseApplianceGetOrganizationApplianceVpnVpnFirewallRulesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "organization_id")
+}
+
+func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id")
 }
 
 func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -264,7 +279,7 @@ func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) Update(ctx context.C
 	//Path Params
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Appliance.UpdateOrganizationApplianceVpnVpnFirewallRules(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Appliance.UpdateOrganizationApplianceVpnVpnFirewallRules(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -280,11 +295,44 @@ func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) Update(ctx context.C
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsApplianceVpnVpnFirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting OrganizationsApplianceVpnVpnFirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state OrganizationsApplianceVpnVpnFirewallRulesRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting OrganizationsApplianceVpnVpnFirewallRules", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvOrganizationID := state.OrganizationID.ValueString()
+	// Meraki defaults
+	defaults := OrganizationsApplianceVpnVpnFirewallRulesRs{
+		Rules:             &[]ResponseApplianceGetOrganizationApplianceVpnVpnFirewallRulesRulesRs{},
+		SyslogDefaultRule: types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.clients.Client(vvOrganizationID).Appliance.UpdateOrganizationApplianceVpnVpnFirewallRules(vvOrganizationID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateOrganizationApplianceVpnVpnFirewallRules",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateOrganizationApplianceVpnVpnFirewallRules",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -293,6 +341,7 @@ type OrganizationsApplianceVpnVpnFirewallRulesRs struct {
 	OrganizationID    types.String                                                           `tfsdk:"organization_id"`
 	Rules             *[]ResponseApplianceGetOrganizationApplianceVpnVpnFirewallRulesRulesRs `tfsdk:"rules"`
 	SyslogDefaultRule types.Bool                                                             `tfsdk:"syslog_default_rule"`
+	ResetOnDestroy    types.Bool                                                             `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseApplianceGetOrganizationApplianceVpnVpnFirewallRulesRulesRs struct {
@@ -346,7 +395,10 @@ func (r *OrganizationsApplianceVpnVpnFirewallRulesRs) toSdkApiRequestUpdate(ctx
 	}
 	out := merakigosdk.RequestApplianceUpdateOrganizationApplianceVpnVpnFirewallRules{
 		Rules: func() *[]merakigosdk.RequestApplianceUpdateOrganizationApplianceVpnVpnFirewallRulesRules {
-			if len(requestApplianceUpdateOrganizationApplianceVpnVpnFirewallRulesRules) > 0 {
+			if len(requestApplianceUpdateOrganizationApplianceVpnVpnFirewallRulesRules) > 0 || r.Rules != nil {
+				if len(requestApplianceUpdateOrganizationApplianceVpnVpnFirewallRulesRules) == 0 {
+					requestApplianceUpdateOrganizationApplianceVpnVpnFirewallRulesRules = make([]merakigosdk.RequestApplianceUpdateOrganizationApplianceVpnVpnFirewallRulesRules, 0)
+				}
 				return &requestApplianceUpdateOrganizationApplianceVpnVpnFirewallRulesRules
 			}
 			return nil
@@ -41,7 +41,8 @@ func NewOrganizationsAssetsResource() resource.Resource {
 }
 
 type OrganizationsAssetsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsAssetsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsAssetsResource) Configure(ctx context.Context, req resourc
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -139,7 +141,7 @@ func (r *OrganizationsAssetsResource) Create(ctx context.Context, req resource.C
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvThemeIDentifier := data.ThemeIDentifier.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Organizations.CreateOrganizationSplashThemeAsset(vvOrganizationID, vvThemeIDentifier, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationSplashThemeAsset(vvOrganizationID, vvThemeIDentifier, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
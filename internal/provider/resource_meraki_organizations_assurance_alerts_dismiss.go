@@ -41,7 +41,8 @@ func NewOrganizationsAssuranceAlertsDismissResource() resource.Resource {
 }
 
 type OrganizationsAssuranceAlertsDismissResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsAssuranceAlertsDismissResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsAssuranceAlertsDismissResource) Configure(ctx context.Cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -103,7 +105,7 @@ func (r *OrganizationsAssuranceAlertsDismissResource) Create(ctx context.Context
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	restyResp1, err := r.client.Organizations.DismissOrganizationAssuranceAlerts(vvOrganizationID, dataRequest)
+	restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.DismissOrganizationAssuranceAlerts(vvOrganizationID, dataRequest)
 	if err != nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
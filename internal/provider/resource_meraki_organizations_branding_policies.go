@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,8 +37,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsBrandingPoliciesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsBrandingPoliciesResource{}
+	_ resource.Resource                = &OrganizationsBrandingPoliciesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsBrandingPoliciesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsBrandingPoliciesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsBrandingPoliciesResource{}
 )
 
 func NewOrganizationsBrandingPoliciesResource() resource.Resource {
@@ -49,7 +48,8 @@ func NewOrganizationsBrandingPoliciesResource() resource.Resource {
 }
 
 type OrganizationsBrandingPoliciesResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsBrandingPoliciesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -58,6 +58,7 @@ func (r *OrganizationsBrandingPoliciesResource) Configure(ctx context.Context, r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -471,7 +472,7 @@ func (r *OrganizationsBrandingPoliciesResource) Create(ctx context.Context, req
 
 	vvName := data.Name.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Organizations.GetOrganizationBrandingPolicies(vvOrganizationID)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationBrandingPolicies(vvOrganizationID)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -498,20 +499,21 @@ func (r *OrganizationsBrandingPoliciesResource) Create(ctx context.Context, req
 				)
 				return
 			}
-			r.client.Organizations.UpdateOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID, data.toSdkApiRequestUpdate(ctx))
+			r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID, data.toSdkApiRequestUpdate(ctx))
 
-			responseVerifyItem2, _, _ := r.client.Organizations.GetOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID)
+			responseVerifyItem2, _, _ := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID)
 			if responseVerifyItem2 != nil {
 				data = ResponseOrganizationsGetOrganizationBrandingPolicyItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Organizations.CreateOrganizationBrandingPolicy(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationBrandingPolicy(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -527,7 +529,7 @@ func (r *OrganizationsBrandingPoliciesResource) Create(ctx context.Context, req
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationBrandingPolicies(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationBrandingPolicies(vvOrganizationID)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -556,10 +558,11 @@ func (r *OrganizationsBrandingPoliciesResource) Create(ctx context.Context, req
 			)
 			return
 		}
-		responseVerifyItem2, restyRespGet, err := r.client.Organizations.GetOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID)
+		responseVerifyItem2, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseOrganizationsGetOrganizationBrandingPolicyItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -598,7 +601,7 @@ func (r *OrganizationsBrandingPoliciesResource) Read(ctx context.Context, req re
 
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvBrandingPolicyID := data.BrandingPolicyID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -624,19 +627,14 @@ func (r *OrganizationsBrandingPoliciesResource) Read(ctx context.Context, req re
 	//entro aqui 2
 	data = ResponseOrganizationsGetOrganizationBrandingPolicyItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsBrandingPoliciesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "organization_id", "branding_policy_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: organizationId,brandingPolicyId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branding_policy_id"), idParts[1])...)
+func (r *OrganizationsBrandingPoliciesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "branding_policy_id")
 }
 
 func (r *OrganizationsBrandingPoliciesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -650,7 +648,7 @@ func (r *OrganizationsBrandingPoliciesResource) Update(ctx context.Context, req
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	vvBrandingPolicyID := plan.BrandingPolicyID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -666,6 +664,7 @@ func (r *OrganizationsBrandingPoliciesResource) Update(ctx context.Context, req
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsBrandingPoliciesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -687,7 +686,7 @@ func (r *OrganizationsBrandingPoliciesResource) Delete(ctx context.Context, req
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvBrandingPolicyID := state.BrandingPolicyID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationBrandingPolicy(vvOrganizationID, vvBrandingPolicyID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationBrandingPolicy", err.Error())
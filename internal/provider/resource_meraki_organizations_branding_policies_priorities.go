@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
@@ -34,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsBrandingPoliciesPrioritiesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsBrandingPoliciesPrioritiesResource{}
+	_ resource.Resource                = &OrganizationsBrandingPoliciesPrioritiesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsBrandingPoliciesPrioritiesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsBrandingPoliciesPrioritiesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsBrandingPoliciesPrioritiesResource{}
 )
 
 func NewOrganizationsBrandingPoliciesPrioritiesResource() resource.Resource {
@@ -43,7 +44,9 @@ func NewOrganizationsBrandingPoliciesPrioritiesResource() resource.Resource {
 }
 
 type OrganizationsBrandingPoliciesPrioritiesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	clients        *merakiClientPool
+	resetOnDestroy bool
 }
 
 func (r *OrganizationsBrandingPoliciesPrioritiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +55,8 @@ func (r *OrganizationsBrandingPoliciesPrioritiesResource) Configure(ctx context.
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -106,7 +111,7 @@ func (r *OrganizationsBrandingPoliciesPrioritiesResource) Create(ctx context.Con
 
 	// UPDATE NO CREATE
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationBrandingPoliciesPriorities(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationBrandingPoliciesPriorities(vvOrganizationID, dataRequest)
 	//Update
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
@@ -125,6 +130,7 @@ func (r *OrganizationsBrandingPoliciesPrioritiesResource) Create(ctx context.Con
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -140,7 +146,7 @@ func (r *OrganizationsBrandingPoliciesPrioritiesResource) Read(ctx context.Conte
 	// Has Item2
 
 	vvOrganizationID := data.OrganizationID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationBrandingPoliciesPriorities(vvOrganizationID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationBrandingPoliciesPriorities(vvOrganizationID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -166,9 +172,14 @@ func (r *OrganizationsBrandingPoliciesPrioritiesResource) Read(ctx context.Conte
 	//entro aqui 2
 	data = ResponseOrganizationsGetOrganizationBrandingPoliciesPrioritiesItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsBrandingPoliciesPrioritiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "organization_id")
+}
+
+func (r *OrganizationsBrandingPoliciesPrioritiesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id")
 }
 
 func (r *OrganizationsBrandingPoliciesPrioritiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -181,7 +192,7 @@ func (r *OrganizationsBrandingPoliciesPrioritiesResource) Update(ctx context.Con
 	//Path Params
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationBrandingPoliciesPriorities(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationBrandingPoliciesPriorities(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -197,9 +208,15 @@ func (r *OrganizationsBrandingPoliciesPrioritiesResource) Update(ctx context.Con
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsBrandingPoliciesPrioritiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of OrganizationsBrandingPoliciesPriorities not reset", "meraki_reset_on_destroy is set, but the priorities of the branding policies have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting OrganizationsBrandingPoliciesPriorities", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -35,8 +32,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsCameraCustomAnalyticsArtifactsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsCameraCustomAnalyticsArtifactsResource{}
+	_ resource.Resource                = &OrganizationsCameraCustomAnalyticsArtifactsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsCameraCustomAnalyticsArtifactsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsCameraCustomAnalyticsArtifactsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsCameraCustomAnalyticsArtifactsResource{}
 )
 
 func NewOrganizationsCameraCustomAnalyticsArtifactsResource() resource.Resource {
@@ -44,7 +43,8 @@ func NewOrganizationsCameraCustomAnalyticsArtifactsResource() resource.Resource
 }
 
 type OrganizationsCameraCustomAnalyticsArtifactsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsCameraCustomAnalyticsArtifactsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +53,7 @@ func (r *OrganizationsCameraCustomAnalyticsArtifactsResource) Configure(ctx cont
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -125,7 +126,7 @@ func (r *OrganizationsCameraCustomAnalyticsArtifactsResource) Create(ctx context
 
 	vvName := data.Name.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Camera.GetOrganizationCameraCustomAnalyticsArtifacts(vvOrganizationID)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraCustomAnalyticsArtifacts(vvOrganizationID)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -152,18 +153,19 @@ func (r *OrganizationsCameraCustomAnalyticsArtifactsResource) Create(ctx context
 				)
 				return
 			}
-			responseVerifyItem2, _, _ := r.client.Camera.GetOrganizationCameraCustomAnalyticsArtifact(vvOrganizationID, vvArtifactID)
+			responseVerifyItem2, _, _ := r.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraCustomAnalyticsArtifact(vvOrganizationID, vvArtifactID)
 			if responseVerifyItem2 != nil {
 				data = ResponseCameraGetOrganizationCameraCustomAnalyticsArtifactItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Camera.CreateOrganizationCameraCustomAnalyticsArtifact(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Camera.CreateOrganizationCameraCustomAnalyticsArtifact(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -179,7 +181,7 @@ func (r *OrganizationsCameraCustomAnalyticsArtifactsResource) Create(ctx context
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Camera.GetOrganizationCameraCustomAnalyticsArtifacts(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraCustomAnalyticsArtifacts(vvOrganizationID)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -208,10 +210,11 @@ func (r *OrganizationsCameraCustomAnalyticsArtifactsResource) Create(ctx context
 			)
 			return
 		}
-		responseVerifyItem2, restyRespGet, err := r.client.Camera.GetOrganizationCameraCustomAnalyticsArtifact(vvOrganizationID, vvArtifactID)
+		responseVerifyItem2, restyRespGet, err := r.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraCustomAnalyticsArtifact(vvOrganizationID, vvArtifactID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseCameraGetOrganizationCameraCustomAnalyticsArtifactItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -250,7 +253,7 @@ func (r *OrganizationsCameraCustomAnalyticsArtifactsResource) Read(ctx context.C
 
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvArtifactID := data.ArtifactID.ValueString()
-	responseGet, restyRespGet, err := r.client.Camera.GetOrganizationCameraCustomAnalyticsArtifact(vvOrganizationID, vvArtifactID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraCustomAnalyticsArtifact(vvOrganizationID, vvArtifactID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -276,19 +279,14 @@ func (r *OrganizationsCameraCustomAnalyticsArtifactsResource) Read(ctx context.C
 	//entro aqui 2
 	data = ResponseCameraGetOrganizationCameraCustomAnalyticsArtifactItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsCameraCustomAnalyticsArtifactsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "organization_id", "artifact_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: organizationId,artifactId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("artifact_id"), idParts[1])...)
+func (r *OrganizationsCameraCustomAnalyticsArtifactsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "artifact_id")
 }
 
 func (r *OrganizationsCameraCustomAnalyticsArtifactsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -324,7 +322,7 @@ func (r *OrganizationsCameraCustomAnalyticsArtifactsResource) Delete(ctx context
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvArtifactID := state.ArtifactID.ValueString()
-	_, err := r.client.Camera.DeleteOrganizationCameraCustomAnalyticsArtifact(vvOrganizationID, vvArtifactID)
+	_, err := r.clients.Client(vvOrganizationID).Camera.DeleteOrganizationCameraCustomAnalyticsArtifact(vvOrganizationID, vvArtifactID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationCameraCustomAnalyticsArtifact", err.Error())
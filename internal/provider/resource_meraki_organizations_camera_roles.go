@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -36,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsCameraRolesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsCameraRolesResource{}
+	_ resource.Resource                = &OrganizationsCameraRolesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsCameraRolesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsCameraRolesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsCameraRolesResource{}
 )
 
 func NewOrganizationsCameraRolesResource() resource.Resource {
@@ -45,7 +44,8 @@ func NewOrganizationsCameraRolesResource() resource.Resource {
 }
 
 type OrganizationsCameraRolesResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsCameraRolesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -54,6 +54,7 @@ func (r *OrganizationsCameraRolesResource) Configure(ctx context.Context, req re
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -232,7 +233,7 @@ func (r *OrganizationsCameraRolesResource) Create(ctx context.Context, req resou
 
 	vvName := data.Name.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Camera.GetOrganizationCameraRoles(vvOrganizationID)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraRoles(vvOrganizationID)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -259,20 +260,21 @@ func (r *OrganizationsCameraRolesResource) Create(ctx context.Context, req resou
 				)
 				return
 			}
-			r.client.Camera.UpdateOrganizationCameraRole(vvOrganizationID, vvRoleID, data.toSdkApiRequestUpdate(ctx))
+			r.clients.Client(vvOrganizationID).Camera.UpdateOrganizationCameraRole(vvOrganizationID, vvRoleID, data.toSdkApiRequestUpdate(ctx))
 
-			responseVerifyItem2, _, _ := r.client.Camera.GetOrganizationCameraRole(vvOrganizationID, vvRoleID)
+			responseVerifyItem2, _, _ := r.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraRole(vvOrganizationID, vvRoleID)
 			if responseVerifyItem2 != nil {
 				data = ResponseCameraGetOrganizationCameraRoleItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	restyResp2, err := r.client.Camera.CreateOrganizationCameraRole(vvOrganizationID, dataRequest)
+	restyResp2, err := r.clients.Client(vvOrganizationID).Camera.CreateOrganizationCameraRole(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -288,7 +290,7 @@ func (r *OrganizationsCameraRolesResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Camera.GetOrganizationCameraRoles(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraRoles(vvOrganizationID)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -317,10 +319,11 @@ func (r *OrganizationsCameraRolesResource) Create(ctx context.Context, req resou
 			)
 			return
 		}
-		responseVerifyItem2, restyRespGet, err := r.client.Camera.GetOrganizationCameraRole(vvOrganizationID, vvRoleID)
+		responseVerifyItem2, restyRespGet, err := r.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraRole(vvOrganizationID, vvRoleID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseCameraGetOrganizationCameraRoleItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -359,7 +362,7 @@ func (r *OrganizationsCameraRolesResource) Read(ctx context.Context, req resourc
 
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvRoleID := data.RoleID.ValueString()
-	responseGet, restyRespGet, err := r.client.Camera.GetOrganizationCameraRole(vvOrganizationID, vvRoleID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Camera.GetOrganizationCameraRole(vvOrganizationID, vvRoleID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -385,19 +388,14 @@ func (r *OrganizationsCameraRolesResource) Read(ctx context.Context, req resourc
 	//entro aqui 2
 	data = ResponseCameraGetOrganizationCameraRoleItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsCameraRolesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "organization_id", "role_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: organizationId,roleId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), idParts[1])...)
+func (r *OrganizationsCameraRolesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "role_id")
 }
 
 func (r *OrganizationsCameraRolesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -411,7 +409,7 @@ func (r *OrganizationsCameraRolesResource) Update(ctx context.Context, req resou
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	vvRoleID := plan.RoleID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	restyResp2, err := r.client.Camera.UpdateOrganizationCameraRole(vvOrganizationID, vvRoleID, dataRequest)
+	restyResp2, err := r.clients.Client(vvOrganizationID).Camera.UpdateOrganizationCameraRole(vvOrganizationID, vvRoleID, dataRequest)
 	if err != nil || restyResp2 == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -427,6 +425,7 @@ func (r *OrganizationsCameraRolesResource) Update(ctx context.Context, req resou
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsCameraRolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -448,7 +447,7 @@ func (r *OrganizationsCameraRolesResource) Delete(ctx context.Context, req resou
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvRoleID := state.RoleID.ValueString()
-	_, err := r.client.Camera.DeleteOrganizationCameraRole(vvOrganizationID, vvRoleID)
+	_, err := r.clients.Client(vvOrganizationID).Camera.DeleteOrganizationCameraRole(vvOrganizationID, vvRoleID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationCameraRole", err.Error())
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -36,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource{}
+	_ resource.Resource                = &OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource{}
 )
 
 func NewOrganizationsCellularGatewayEsimsServiceProvidersAccountsResource() resource.Resource {
@@ -45,7 +44,8 @@ func NewOrganizationsCellularGatewayEsimsServiceProvidersAccountsResource() reso
 }
 
 type OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -54,6 +54,7 @@ func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Conf
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -160,7 +161,7 @@ func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Crea
 
 	vvUsername := data.Username.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.CellularGateway.GetOrganizationCellularGatewayEsimsServiceProvidersAccounts(vvOrganizationID, nil)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).CellularGateway.GetOrganizationCellularGatewayEsimsServiceProvidersAccounts(vvOrganizationID, nil)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -187,17 +188,18 @@ func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Crea
 				)
 				return
 			}
-			r.client.CellularGateway.UpdateOrganizationCellularGatewayEsimsServiceProvidersAccount(vvOrganizationID, responseVerifyItem2.AccountID, data.toSdkApiRequestUpdate(ctx))
+			r.clients.Client(vvOrganizationID).CellularGateway.UpdateOrganizationCellularGatewayEsimsServiceProvidersAccount(vvOrganizationID, responseVerifyItem2.AccountID, data.toSdkApiRequestUpdate(ctx))
 			data = ResponseCellularGatewayGetOrganizationCellularGatewayEsimsServiceProvidersAccountsItemToBodyRs(data, &responseVerifyItem2, false)
 			// Path params update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.CellularGateway.CreateOrganizationCellularGatewayEsimsServiceProvidersAccount(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).CellularGateway.CreateOrganizationCellularGatewayEsimsServiceProvidersAccount(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -213,7 +215,7 @@ func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Crea
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.CellularGateway.GetOrganizationCellularGatewayEsimsServiceProvidersAccounts(vvOrganizationID, nil)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).CellularGateway.GetOrganizationCellularGatewayEsimsServiceProvidersAccounts(vvOrganizationID, nil)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -244,6 +246,7 @@ func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Crea
 		data = ResponseCellularGatewayGetOrganizationCellularGatewayEsimsServiceProvidersAccountsItemToBodyRs(data, &responseVerifyItem2, false)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -279,7 +282,7 @@ func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Read
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvUsername := data.Username.ValueString()
 
-	responseGet, restyResp1, err := r.client.CellularGateway.GetOrganizationCellularGatewayEsimsServiceProvidersAccounts(vvOrganizationID, nil)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).CellularGateway.GetOrganizationCellularGatewayEsimsServiceProvidersAccounts(vvOrganizationID, nil)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -319,6 +322,7 @@ func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Read
 		data = ResponseCellularGatewayGetOrganizationCellularGatewayEsimsServiceProvidersAccountsItemToBodyRs(data, &responseVerifyItem2, true)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -329,18 +333,11 @@ func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Read
 	}
 }
 func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "organization_id", "username")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), idParts[1])...)
+func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "username")
 }
 
 func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -357,7 +354,7 @@ func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Upda
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvAccountID := data.AccountID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.CellularGateway.UpdateOrganizationCellularGatewayEsimsServiceProvidersAccount(vvOrganizationID, vvAccountID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).CellularGateway.UpdateOrganizationCellularGatewayEsimsServiceProvidersAccount(vvOrganizationID, vvAccountID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -375,6 +372,7 @@ func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Upda
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -396,7 +394,7 @@ func (r *OrganizationsCellularGatewayEsimsServiceProvidersAccountsResource) Dele
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvAccountID := state.AccountID.ValueString()
-	_, err := r.client.CellularGateway.DeleteOrganizationCellularGatewayEsimsServiceProvidersAccount(vvOrganizationID, vvAccountID)
+	_, err := r.clients.Client(vvOrganizationID).CellularGateway.DeleteOrganizationCellularGatewayEsimsServiceProvidersAccount(vvOrganizationID, vvAccountID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationCellularGatewayEsimsServiceProvidersAccount", err.Error())
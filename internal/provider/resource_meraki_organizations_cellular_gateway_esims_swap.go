@@ -41,7 +41,8 @@ func NewOrganizationsCellularGatewayEsimsSwapResource() resource.Resource {
 }
 
 type OrganizationsCellularGatewayEsimsSwapResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsCellularGatewayEsimsSwapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsCellularGatewayEsimsSwapResource) Configure(ctx context.Co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -166,7 +168,7 @@ func (r *OrganizationsCellularGatewayEsimsSwapResource) Create(ctx context.Conte
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.CellularGateway.CreateOrganizationCellularGatewayEsimsSwap(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).CellularGateway.CreateOrganizationCellularGatewayEsimsSwap(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
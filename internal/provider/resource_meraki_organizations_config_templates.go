@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -35,8 +32,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsConfigTemplatesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsConfigTemplatesResource{}
+	_ resource.Resource                = &OrganizationsConfigTemplatesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsConfigTemplatesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsConfigTemplatesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsConfigTemplatesResource{}
 )
 
 func NewOrganizationsConfigTemplatesResource() resource.Resource {
@@ -44,7 +43,8 @@ func NewOrganizationsConfigTemplatesResource() resource.Resource {
 }
 
 type OrganizationsConfigTemplatesResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsConfigTemplatesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +53,7 @@ func (r *OrganizationsConfigTemplatesResource) Configure(ctx context.Context, re
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -136,7 +137,7 @@ func (r *OrganizationsConfigTemplatesResource) Create(ctx context.Context, req r
 
 	vvName := data.Name.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Organizations.GetOrganizationConfigTemplates(vvOrganizationID)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationConfigTemplates(vvOrganizationID)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -163,20 +164,21 @@ func (r *OrganizationsConfigTemplatesResource) Create(ctx context.Context, req r
 				)
 				return
 			}
-			r.client.Organizations.UpdateOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID, data.toSdkApiRequestUpdate(ctx))
+			r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID, data.toSdkApiRequestUpdate(ctx))
 
-			responseVerifyItem2, _, _ := r.client.Organizations.GetOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID)
+			responseVerifyItem2, _, _ := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID)
 			if responseVerifyItem2 != nil {
 				data = ResponseOrganizationsGetOrganizationConfigTemplateItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Organizations.CreateOrganizationConfigTemplate(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationConfigTemplate(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -192,7 +194,7 @@ func (r *OrganizationsConfigTemplatesResource) Create(ctx context.Context, req r
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationConfigTemplates(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationConfigTemplates(vvOrganizationID)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -221,10 +223,11 @@ func (r *OrganizationsConfigTemplatesResource) Create(ctx context.Context, req r
 			)
 			return
 		}
-		responseVerifyItem2, restyRespGet, err := r.client.Organizations.GetOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID)
+		responseVerifyItem2, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseOrganizationsGetOrganizationConfigTemplateItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -263,7 +266,7 @@ func (r *OrganizationsConfigTemplatesResource) Read(ctx context.Context, req res
 
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvConfigTemplateID := data.ConfigTemplateID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -289,19 +292,14 @@ func (r *OrganizationsConfigTemplatesResource) Read(ctx context.Context, req res
 	//entro aqui 2
 	data = ResponseOrganizationsGetOrganizationConfigTemplateItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsConfigTemplatesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "organization_id", "config_template_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: organizationId,configTemplateId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config_template_id"), idParts[1])...)
+func (r *OrganizationsConfigTemplatesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "config_template_id")
 }
 
 func (r *OrganizationsConfigTemplatesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -315,7 +313,7 @@ func (r *OrganizationsConfigTemplatesResource) Update(ctx context.Context, req r
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	vvConfigTemplateID := plan.ConfigTemplateID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -331,6 +329,7 @@ func (r *OrganizationsConfigTemplatesResource) Update(ctx context.Context, req r
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsConfigTemplatesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -352,7 +351,7 @@ func (r *OrganizationsConfigTemplatesResource) Delete(ctx context.Context, req r
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvConfigTemplateID := state.ConfigTemplateID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationConfigTemplate(vvOrganizationID, vvConfigTemplateID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationConfigTemplate", err.Error())
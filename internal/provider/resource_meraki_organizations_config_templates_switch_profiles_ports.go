@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -42,8 +39,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsConfigTemplatesSwitchProfilesPortsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsConfigTemplatesSwitchProfilesPortsResource{}
+	_ resource.Resource                = &OrganizationsConfigTemplatesSwitchProfilesPortsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsConfigTemplatesSwitchProfilesPortsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsConfigTemplatesSwitchProfilesPortsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsConfigTemplatesSwitchProfilesPortsResource{}
 )
 
 func NewOrganizationsConfigTemplatesSwitchProfilesPortsResource() resource.Resource {
@@ -51,7 +50,9 @@ func NewOrganizationsConfigTemplatesSwitchProfilesPortsResource() resource.Resou
 }
 
 type OrganizationsConfigTemplatesSwitchProfilesPortsResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	clients        *merakiClientPool
+	resetOnDestroy bool
 }
 
 func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -60,6 +61,8 @@ func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) Configure(ctx
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -259,6 +262,10 @@ func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) Schema(_ conte
 				MarkdownDescription: `profileId path parameter. Profile ID`,
 				Required:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"rstp_enabled": schema.BoolAttribute{
 				MarkdownDescription: `The rapid spanning tree protocol status.`,
 				Optional:            true,
@@ -425,7 +432,7 @@ func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) Create(ctx con
 
 	// UPDATE NO CREATE
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Switch.UpdateOrganizationConfigTemplateSwitchProfilePort(vvOrganizationID, vvConfigTemplateID, vvProfileID, vvPortID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Switch.UpdateOrganizationConfigTemplateSwitchProfilePort(vvOrganizationID, vvConfigTemplateID, vvProfileID, vvPortID, dataRequest)
 	//Update
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
@@ -444,6 +451,7 @@ func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) Create(ctx con
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -462,7 +470,7 @@ func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) Read(ctx conte
 	vvConfigTemplateID := data.ConfigTemplateID.ValueString()
 	vvProfileID := data.ProfileID.ValueString()
 	vvPortID := data.PortID.ValueString()
-	responseGet, restyRespGet, err := r.client.Switch.GetOrganizationConfigTemplateSwitchProfilePort(vvOrganizationID, vvConfigTemplateID, vvProfileID, vvPortID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Switch.GetOrganizationConfigTemplateSwitchProfilePort(vvOrganizationID, vvConfigTemplateID, vvProfileID, vvPortID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -488,21 +496,14 @@ func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) Read(ctx conte
 	//entro aqui 2
 	data = ResponseSwitchGetOrganizationConfigTemplateSwitchProfilePortItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "organization_id", "config_template_id", "profile_id", "port_id")
+}
 
-	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: organizationId,configTemplateId,profileId,portId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config_template_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("profile_id"), idParts[2])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port_id"), idParts[3])...)
+func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "config_template_id", "profile_id", "port_id")
 }
 
 func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -518,7 +519,7 @@ func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) Update(ctx con
 	vvProfileID := plan.ProfileID.ValueString()
 	vvPortID := plan.PortID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Switch.UpdateOrganizationConfigTemplateSwitchProfilePort(vvOrganizationID, vvConfigTemplateID, vvProfileID, vvPortID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Switch.UpdateOrganizationConfigTemplateSwitchProfilePort(vvOrganizationID, vvConfigTemplateID, vvProfileID, vvPortID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -534,11 +535,57 @@ func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) Update(ctx con
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsConfigTemplatesSwitchProfilesPortsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting OrganizationsConfigTemplatesSwitchProfilesPorts", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state OrganizationsConfigTemplatesSwitchProfilesPortsRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting OrganizationsConfigTemplatesSwitchProfilesPorts", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvOrganizationID := state.OrganizationID.ValueString()
+	vvConfigTemplateID := state.ConfigTemplateID.ValueString()
+	vvProfileID := state.ProfileID.ValueString()
+	vvPortID := state.PortID.ValueString()
+	// Meraki defaults
+	defaults := OrganizationsConfigTemplatesSwitchProfilesPortsRs{
+		AccessPolicyType: types.StringValue("Open"),
+		AllowedVLANs:     types.StringValue("all"),
+		DaiTrusted:       types.BoolValue(false),
+		Enabled:          types.BoolValue(true),
+		IsolationEnabled: types.BoolValue(false),
+		LinkNegotiation:  types.StringValue("Auto negotiate"),
+		PoeEnabled:       types.BoolValue(true),
+		RstpEnabled:      types.BoolValue(true),
+		StpGuard:         types.StringValue("disabled"),
+		Type:             types.StringValue("trunk"),
+		Udld:             types.StringValue("Alert only"),
+		VLAN:             types.Int64Value(1),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.clients.Client(vvOrganizationID).Switch.UpdateOrganizationConfigTemplateSwitchProfilePort(vvOrganizationID, vvConfigTemplateID, vvProfileID, vvPortID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateOrganizationConfigTemplateSwitchProfilePort",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateOrganizationConfigTemplateSwitchProfilePort",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -577,6 +624,7 @@ type OrganizationsConfigTemplatesSwitchProfilesPortsRs struct {
 	Udld                        types.String                                                                    `tfsdk:"udld"`
 	VLAN                        types.Int64                                                                     `tfsdk:"vlan"`
 	VoiceVLAN                   types.Int64                                                                     `tfsdk:"voice_vlan"`
+	ResetOnDestroy              types.Bool                                                                      `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseSwitchGetOrganizationConfigTemplateSwitchProfilePortDot3AzRs struct {
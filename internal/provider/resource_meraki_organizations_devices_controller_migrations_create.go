@@ -41,7 +41,8 @@ func NewOrganizationsDevicesControllerMigrationsCreateResource() resource.Resour
 }
 
 type OrganizationsDevicesControllerMigrationsCreateResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsDevicesControllerMigrationsCreateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsDevicesControllerMigrationsCreateResource) Configure(ctx c
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -158,7 +160,7 @@ func (r *OrganizationsDevicesControllerMigrationsCreateResource) Create(ctx cont
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Organizations.CreateOrganizationDevicesControllerMigration(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationDevicesControllerMigration(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -41,7 +41,8 @@ func NewOrganizationsDevicesDetailsBulkUpdateResource() resource.Resource {
 }
 
 type OrganizationsDevicesDetailsBulkUpdateResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsDevicesDetailsBulkUpdateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsDevicesDetailsBulkUpdateResource) Configure(ctx context.Co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -140,7 +142,7 @@ func (r *OrganizationsDevicesDetailsBulkUpdateResource) Create(ctx context.Conte
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Organizations.BulkUpdateOrganizationDevicesDetails(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.BulkUpdateOrganizationDevicesDetails(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
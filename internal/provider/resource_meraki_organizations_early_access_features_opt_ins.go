@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -37,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsEarlyAccessFeaturesOptInsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsEarlyAccessFeaturesOptInsResource{}
+	_ resource.Resource                = &OrganizationsEarlyAccessFeaturesOptInsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsEarlyAccessFeaturesOptInsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsEarlyAccessFeaturesOptInsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsEarlyAccessFeaturesOptInsResource{}
 )
 
 func NewOrganizationsEarlyAccessFeaturesOptInsResource() resource.Resource {
@@ -46,7 +45,8 @@ func NewOrganizationsEarlyAccessFeaturesOptInsResource() resource.Resource {
 }
 
 type OrganizationsEarlyAccessFeaturesOptInsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +55,7 @@ func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Configure(ctx context.C
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -183,7 +184,7 @@ func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Create(ctx context.Cont
 
 	if vvOrganizationID != "" && vvOptInID != "" {
 		//dentro
-		responseVerifyItem, restyResp1, err := r.client.Organizations.GetOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, vvOptInID)
+		responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, vvOptInID)
 		//Has Post
 		if err != nil {
 			if restyResp1 != nil {
@@ -201,12 +202,13 @@ func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Create(ctx context.Cont
 			data = ResponseOrganizationsGetOrganizationEarlyAccessFeaturesOptInItemToBodyRs(data, responseVerifyItem, false)
 			//Path params in update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Organizations.CreateOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -224,7 +226,7 @@ func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Create(ctx context.Cont
 
 	//Assign Path Params required
 
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, vvOptInID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, vvOptInID)
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -244,6 +246,7 @@ func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Create(ctx context.Cont
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -271,7 +274,7 @@ func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Read(ctx context.Contex
 	vvOrganizationID := data.OrganizationID.ValueString()
 	// organization_id
 	vvOptInID := data.OptInID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, vvOptInID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, vvOptInID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -299,21 +302,15 @@ func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Read(ctx context.Contex
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsEarlyAccessFeaturesOptInsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "organization_id", "opt_in_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("opt_in_id"), idParts[1])...)
+func (r *OrganizationsEarlyAccessFeaturesOptInsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "opt_in_id")
 }
 
 func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -330,7 +327,7 @@ func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Update(ctx context.Cont
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvOptInID := data.OptInID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, vvOptInID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, vvOptInID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -348,6 +345,7 @@ func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Update(ctx context.Cont
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -369,7 +367,7 @@ func (r *OrganizationsEarlyAccessFeaturesOptInsResource) Delete(ctx context.Cont
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvOptInID := state.OptInID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, vvOptInID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationEarlyAccessFeaturesOptIn(vvOrganizationID, vvOptInID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationEarlyAccessFeaturesOptIn", err.Error())
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -36,8 +33,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsInsightMonitoredMediaServersResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsInsightMonitoredMediaServersResource{}
+	_ resource.Resource                = &OrganizationsInsightMonitoredMediaServersResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsInsightMonitoredMediaServersResource{}
+	_ resource.ResourceWithImportState = &OrganizationsInsightMonitoredMediaServersResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsInsightMonitoredMediaServersResource{}
 )
 
 func NewOrganizationsInsightMonitoredMediaServersResource() resource.Resource {
@@ -45,7 +44,8 @@ func NewOrganizationsInsightMonitoredMediaServersResource() resource.Resource {
 }
 
 type OrganizationsInsightMonitoredMediaServersResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsInsightMonitoredMediaServersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -54,6 +54,7 @@ func (r *OrganizationsInsightMonitoredMediaServersResource) Configure(ctx contex
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -130,7 +131,7 @@ func (r *OrganizationsInsightMonitoredMediaServersResource) Create(ctx context.C
 
 	vvName := data.Name.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Insight.GetOrganizationInsightMonitoredMediaServers(vvOrganizationID)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Insight.GetOrganizationInsightMonitoredMediaServers(vvOrganizationID)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -157,20 +158,21 @@ func (r *OrganizationsInsightMonitoredMediaServersResource) Create(ctx context.C
 				)
 				return
 			}
-			r.client.Insight.UpdateOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID, data.toSdkApiRequestUpdate(ctx))
+			r.clients.Client(vvOrganizationID).Insight.UpdateOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID, data.toSdkApiRequestUpdate(ctx))
 
-			responseVerifyItem2, _, _ := r.client.Insight.GetOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID)
+			responseVerifyItem2, _, _ := r.clients.Client(vvOrganizationID).Insight.GetOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID)
 			if responseVerifyItem2 != nil {
 				data = ResponseInsightGetOrganizationInsightMonitoredMediaServerItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Insight.CreateOrganizationInsightMonitoredMediaServer(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Insight.CreateOrganizationInsightMonitoredMediaServer(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -186,7 +188,7 @@ func (r *OrganizationsInsightMonitoredMediaServersResource) Create(ctx context.C
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Insight.GetOrganizationInsightMonitoredMediaServers(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Insight.GetOrganizationInsightMonitoredMediaServers(vvOrganizationID)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -215,10 +217,11 @@ func (r *OrganizationsInsightMonitoredMediaServersResource) Create(ctx context.C
 			)
 			return
 		}
-		responseVerifyItem2, restyRespGet, err := r.client.Insight.GetOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID)
+		responseVerifyItem2, restyRespGet, err := r.clients.Client(vvOrganizationID).Insight.GetOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseInsightGetOrganizationInsightMonitoredMediaServerItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -257,7 +260,7 @@ func (r *OrganizationsInsightMonitoredMediaServersResource) Read(ctx context.Con
 
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvMonitoredMediaServerID := data.MonitoredMediaServerID.ValueString()
-	responseGet, restyRespGet, err := r.client.Insight.GetOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Insight.GetOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -283,19 +286,14 @@ func (r *OrganizationsInsightMonitoredMediaServersResource) Read(ctx context.Con
 	//entro aqui 2
 	data = ResponseInsightGetOrganizationInsightMonitoredMediaServerItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsInsightMonitoredMediaServersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "organization_id", "monitored_media_server_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: organizationId,monitoredMediaServerId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitored_media_server_id"), idParts[1])...)
+func (r *OrganizationsInsightMonitoredMediaServersResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "monitored_media_server_id")
 }
 
 func (r *OrganizationsInsightMonitoredMediaServersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -309,7 +307,7 @@ func (r *OrganizationsInsightMonitoredMediaServersResource) Update(ctx context.C
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	vvMonitoredMediaServerID := plan.MonitoredMediaServerID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Insight.UpdateOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Insight.UpdateOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -325,6 +323,7 @@ func (r *OrganizationsInsightMonitoredMediaServersResource) Update(ctx context.C
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsInsightMonitoredMediaServersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -346,7 +345,7 @@ func (r *OrganizationsInsightMonitoredMediaServersResource) Delete(ctx context.C
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvMonitoredMediaServerID := state.MonitoredMediaServerID.ValueString()
-	_, err := r.client.Insight.DeleteOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID)
+	_, err := r.clients.Client(vvOrganizationID).Insight.DeleteOrganizationInsightMonitoredMediaServer(vvOrganizationID, vvMonitoredMediaServerID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationInsightMonitoredMediaServer", err.Error())
@@ -41,7 +41,8 @@ func NewOrganizationsIntegrationsXdrNetworksEnableResource() resource.Resource {
 }
 
 type OrganizationsIntegrationsXdrNetworksEnableResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsIntegrationsXdrNetworksEnableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsIntegrationsXdrNetworksEnableResource) Configure(ctx conte
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -157,7 +159,7 @@ func (r *OrganizationsIntegrationsXdrNetworksEnableResource) Create(ctx context.
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Organizations.EnableOrganizationIntegrationsXdrNetworks(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.EnableOrganizationIntegrationsXdrNetworks(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -42,7 +42,8 @@ func NewOrganizationsInventoryOnboardingCloudMonitoringExportEventsResource() re
 }
 
 type OrganizationsInventoryOnboardingCloudMonitoringExportEventsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsInventoryOnboardingCloudMonitoringExportEventsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -51,6 +52,7 @@ func (r *OrganizationsInventoryOnboardingCloudMonitoringExportEventsResource) Co
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -130,7 +132,7 @@ func (r *OrganizationsInventoryOnboardingCloudMonitoringExportEventsResource) Cr
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	restyResp1, err := r.client.Organizations.CreateOrganizationInventoryOnboardingCloudMonitoringExportEvent(vvOrganizationID, dataRequest)
+	restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationInventoryOnboardingCloudMonitoringExportEvent(vvOrganizationID, dataRequest)
 	if err != nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -41,7 +41,8 @@ func NewOrganizationsInventoryOnboardingCloudMonitoringImportsResource() resourc
 }
 
 type OrganizationsInventoryOnboardingCloudMonitoringImportsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsInventoryOnboardingCloudMonitoringImportsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsInventoryOnboardingCloudMonitoringImportsResource) Configu
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -169,7 +171,7 @@ func (r *OrganizationsInventoryOnboardingCloudMonitoringImportsResource) Create(
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Organizations.CreateOrganizationInventoryOnboardingCloudMonitoringImport(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationInventoryOnboardingCloudMonitoringImport(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -43,7 +43,8 @@ func NewOrganizationsInventoryOnboardingCloudMonitoringPrepareResource() resourc
 }
 
 type OrganizationsInventoryOnboardingCloudMonitoringPrepareResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsInventoryOnboardingCloudMonitoringPrepareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +53,7 @@ func (r *OrganizationsInventoryOnboardingCloudMonitoringPrepareResource) Configu
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -458,7 +460,7 @@ func (r *OrganizationsInventoryOnboardingCloudMonitoringPrepareResource) Create(
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Organizations.CreateOrganizationInventoryOnboardingCloudMonitoringPrepare(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationInventoryOnboardingCloudMonitoringPrepare(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -20,6 +20,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
@@ -32,8 +33,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsInventoryReleaseResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsInventoryReleaseResource{}
+	_ resource.Resource               = &OrganizationsInventoryReleaseResource{}
+	_ resource.ResourceWithConfigure  = &OrganizationsInventoryReleaseResource{}
+	_ resource.ResourceWithModifyPlan = &OrganizationsInventoryReleaseResource{}
 )
 
 func NewOrganizationsInventoryReleaseResource() resource.Resource {
@@ -41,7 +43,9 @@ func NewOrganizationsInventoryReleaseResource() resource.Resource {
 }
 
 type OrganizationsInventoryReleaseResource struct {
-	client *merakigosdk.Client
+	client                     *merakigosdk.Client
+	clients                    *merakiClientPool
+	allowDestructiveOperations bool
 }
 
 func (r *OrganizationsInventoryReleaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +54,8 @@ func (r *OrganizationsInventoryReleaseResource) Configure(ctx context.Context, r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.allowDestructiveOperations = req.ProviderData.(MerakiProviderData).AllowDestructiveOperations
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +67,7 @@ func (r *OrganizationsInventoryReleaseResource) Metadata(_ context.Context, req
 func (r *OrganizationsInventoryReleaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"confirm_target": confirmTargetAttribute("the `organization_id` whose devices are released"),
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Required:            true,
@@ -93,6 +100,20 @@ func (r *OrganizationsInventoryReleaseResource) Schema(_ context.Context, _ reso
 		},
 	}
 }
+
+// ModifyPlan checks confirm_target and warns about the released devices when the resource is created.
+func (r *OrganizationsInventoryReleaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+	var config OrganizationsInventoryRelease
+	resp.Diagnostics.Append(destructiveConfig(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(planDestructiveOperation(config.ConfirmTarget, config.destructiveOperation(ctx), r.allowDestructiveOperations, r.client != nil)...)
+}
+
 func (r *OrganizationsInventoryReleaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var data OrganizationsInventoryRelease
@@ -108,13 +129,22 @@ func (r *OrganizationsInventoryReleaseResource) Create(ctx context.Context, req
 		UnhandledUnknownAsEmpty: true,
 	})...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config OrganizationsInventoryRelease
+	resp.Diagnostics.Append(destructiveConfig(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkDestructiveOperation(config.ConfirmTarget, config.destructiveOperation(ctx), r.allowDestructiveOperations)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Organizations.ReleaseFromOrganizationInventory(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.ReleaseFromOrganizationInventory(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -140,7 +170,7 @@ func (r *OrganizationsInventoryReleaseResource) Read(ctx context.Context, req re
 }
 
 func (r *OrganizationsInventoryReleaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// resp.Diagnostics.AddWarning("Error Update Resource", "This resource has no update method in the meraki lab, the resource was deleted only in terraform.")
+	updateConfirmTarget(ctx, req, resp)
 }
 
 func (r *OrganizationsInventoryReleaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -151,6 +181,7 @@ func (r *OrganizationsInventoryReleaseResource) Delete(ctx context.Context, req
 // TF Structs Schema
 type OrganizationsInventoryRelease struct {
 	OrganizationID types.String                                            `tfsdk:"organization_id"`
+	ConfirmTarget  types.String                                            `tfsdk:"confirm_target"`
 	Item           *ResponseOrganizationsReleaseFromOrganizationInventory  `tfsdk:"item"`
 	Parameters     *RequestOrganizationsReleaseFromOrganizationInventoryRs `tfsdk:"parameters"`
 }
@@ -182,3 +213,16 @@ func ResponseOrganizationsReleaseFromOrganizationInventoryItemToBody(state Organ
 	state.Item = &itemState
 	return state
 }
+
+// destructiveOperation returns the release of the devices from the inventory of the organization.
+func (r *OrganizationsInventoryRelease) destructiveOperation(ctx context.Context) destructiveOperation {
+	serials := types.ListNull(types.StringType)
+	if r.Parameters != nil {
+		serials = r.Parameters.Serials
+	}
+	return destructiveOperation{
+		Action:     fmt.Sprintf("release the devices %s from the inventory of organization %s", destructiveValues(ctx, serials), destructiveValue(r.OrganizationID)),
+		Target:     r.OrganizationID,
+		TargetKind: "the organization ID",
+	}
+}
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -35,8 +32,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsLicensesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsLicensesResource{}
+	_ resource.Resource                = &OrganizationsLicensesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsLicensesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsLicensesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsLicensesResource{}
 )
 
 func NewOrganizationsLicensesResource() resource.Resource {
@@ -44,7 +43,9 @@ func NewOrganizationsLicensesResource() resource.Resource {
 }
 
 type OrganizationsLicensesResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	clients        *merakiClientPool
+	resetOnDestroy bool
 }
 
 func (r *OrganizationsLicensesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +54,8 @@ func (r *OrganizationsLicensesResource) Configure(ctx context.Context, req resou
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -189,7 +192,7 @@ func (r *OrganizationsLicensesResource) Create(ctx context.Context, req resource
 
 	// UPDATE NO CREATE
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationLicense(vvOrganizationID, vvLicenseID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationLicense(vvOrganizationID, vvLicenseID, dataRequest)
 	//Update
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
@@ -208,6 +211,7 @@ func (r *OrganizationsLicensesResource) Create(ctx context.Context, req resource
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -224,7 +228,7 @@ func (r *OrganizationsLicensesResource) Read(ctx context.Context, req resource.R
 
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvLicenseID := data.LicenseID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationLicense(vvOrganizationID, vvLicenseID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationLicense(vvOrganizationID, vvLicenseID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -250,19 +254,14 @@ func (r *OrganizationsLicensesResource) Read(ctx context.Context, req resource.R
 	//entro aqui 2
 	data = ResponseOrganizationsGetOrganizationLicenseItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsLicensesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "organization_id", "license_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: organizationId,licenseId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("license_id"), idParts[1])...)
+func (r *OrganizationsLicensesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "license_id")
 }
 
 func (r *OrganizationsLicensesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -276,7 +275,7 @@ func (r *OrganizationsLicensesResource) Update(ctx context.Context, req resource
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	vvLicenseID := plan.LicenseID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationLicense(vvOrganizationID, vvLicenseID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationLicense(vvOrganizationID, vvLicenseID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -292,9 +291,15 @@ func (r *OrganizationsLicensesResource) Update(ctx context.Context, req resource
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsLicensesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.resetOnDestroy {
+		resp.Diagnostics.AddWarning("Settings of OrganizationsLicenses not reset", "meraki_reset_on_destroy is set, but the device assignments of a license have no Meraki default to restore. The resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	//missing delete
 	resp.Diagnostics.AddWarning("Error deleting OrganizationsLicenses", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
 	resp.State.RemoveResource(ctx)
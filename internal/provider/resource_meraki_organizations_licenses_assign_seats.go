@@ -42,7 +42,8 @@ func NewOrganizationsLicensesAssignSeatsResource() resource.Resource {
 }
 
 type OrganizationsLicensesAssignSeatsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsLicensesAssignSeatsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -51,6 +52,7 @@ func (r *OrganizationsLicensesAssignSeatsResource) Configure(ctx context.Context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -223,7 +225,7 @@ func (r *OrganizationsLicensesAssignSeatsResource) Create(ctx context.Context, r
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Organizations.AssignOrganizationLicensesSeats(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.AssignOrganizationLicensesSeats(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -20,6 +20,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
@@ -32,8 +33,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsLicensesMoveResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsLicensesMoveResource{}
+	_ resource.Resource               = &OrganizationsLicensesMoveResource{}
+	_ resource.ResourceWithConfigure  = &OrganizationsLicensesMoveResource{}
+	_ resource.ResourceWithModifyPlan = &OrganizationsLicensesMoveResource{}
 )
 
 func NewOrganizationsLicensesMoveResource() resource.Resource {
@@ -41,7 +43,9 @@ func NewOrganizationsLicensesMoveResource() resource.Resource {
 }
 
 type OrganizationsLicensesMoveResource struct {
-	client *merakigosdk.Client
+	client                     *merakigosdk.Client
+	clients                    *merakiClientPool
+	allowDestructiveOperations bool
 }
 
 func (r *OrganizationsLicensesMoveResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +54,8 @@ func (r *OrganizationsLicensesMoveResource) Configure(ctx context.Context, req r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.allowDestructiveOperations = req.ProviderData.(MerakiProviderData).AllowDestructiveOperations
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -61,6 +67,7 @@ func (r *OrganizationsLicensesMoveResource) Metadata(_ context.Context, req reso
 func (r *OrganizationsLicensesMoveResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"confirm_target": confirmTargetAttribute("the `organization_id` the licenses are moved from"),
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: `organizationId path parameter. Organization ID`,
 				Required:            true,
@@ -105,6 +112,20 @@ func (r *OrganizationsLicensesMoveResource) Schema(_ context.Context, _ resource
 		},
 	}
 }
+
+// ModifyPlan checks confirm_target and warns about the moved licenses when the resource is created.
+func (r *OrganizationsLicensesMoveResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+	var config OrganizationsLicensesMove
+	resp.Diagnostics.Append(destructiveConfig(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(planDestructiveOperation(config.ConfirmTarget, config.destructiveOperation(ctx), r.allowDestructiveOperations, r.client != nil)...)
+}
+
 func (r *OrganizationsLicensesMoveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var data OrganizationsLicensesMove
@@ -120,13 +141,22 @@ func (r *OrganizationsLicensesMoveResource) Create(ctx context.Context, req reso
 		UnhandledUnknownAsEmpty: true,
 	})...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config OrganizationsLicensesMove
+	resp.Diagnostics.Append(destructiveConfig(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkDestructiveOperation(config.ConfirmTarget, config.destructiveOperation(ctx), r.allowDestructiveOperations)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Organizations.MoveOrganizationLicenses(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.MoveOrganizationLicenses(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -152,7 +182,7 @@ func (r *OrganizationsLicensesMoveResource) Read(ctx context.Context, req resour
 }
 
 func (r *OrganizationsLicensesMoveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// resp.Diagnostics.AddWarning("Error Update Resource", "This resource has no update method in the meraki lab, the resource was deleted only in terraform.")
+	updateConfirmTarget(ctx, req, resp)
 }
 
 func (r *OrganizationsLicensesMoveResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -163,6 +193,7 @@ func (r *OrganizationsLicensesMoveResource) Delete(ctx context.Context, req reso
 // TF Structs Schema
 type OrganizationsLicensesMove struct {
 	OrganizationID types.String                                    `tfsdk:"organization_id"`
+	ConfirmTarget  types.String                                    `tfsdk:"confirm_target"`
 	Item           *ResponseOrganizationsMoveOrganizationLicenses  `tfsdk:"item"`
 	Parameters     *RequestOrganizationsMoveOrganizationLicensesRs `tfsdk:"parameters"`
 }
@@ -210,3 +241,16 @@ func ResponseOrganizationsMoveOrganizationLicensesItemToBody(state Organizations
 	state.Item = &itemState
 	return state
 }
+
+// destructiveOperation returns the move of the licenses to the destination organization.
+func (r *OrganizationsLicensesMove) destructiveOperation(ctx context.Context) destructiveOperation {
+	licenseIDs, destination := types.ListNull(types.StringType), types.StringNull()
+	if r.Parameters != nil {
+		licenseIDs, destination = r.Parameters.LicenseIDs, r.Parameters.DestOrganizationID
+	}
+	return destructiveOperation{
+		Action:     fmt.Sprintf("move the licenses %s from organization %s to organization %s", destructiveValues(ctx, licenseIDs), destructiveValue(r.OrganizationID), destructiveValue(destination)),
+		Target:     r.OrganizationID,
+		TargetKind: "the organization ID",
+	}
+}
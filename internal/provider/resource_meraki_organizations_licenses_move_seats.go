@@ -42,7 +42,8 @@ func NewOrganizationsLicensesMoveSeatsResource() resource.Resource {
 }
 
 type OrganizationsLicensesMoveSeatsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsLicensesMoveSeatsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -51,6 +52,7 @@ func (r *OrganizationsLicensesMoveSeatsResource) Configure(ctx context.Context,
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -140,7 +142,7 @@ func (r *OrganizationsLicensesMoveSeatsResource) Create(ctx context.Context, req
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Organizations.MoveOrganizationLicensesSeats(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.MoveOrganizationLicensesSeats(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
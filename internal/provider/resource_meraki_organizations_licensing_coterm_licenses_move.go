@@ -44,7 +44,8 @@ func NewOrganizationsLicensingCotermLicensesMoveResource() resource.Resource {
 }
 
 type OrganizationsLicensingCotermLicensesMoveResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsLicensingCotermLicensesMoveResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +54,7 @@ func (r *OrganizationsLicensingCotermLicensesMoveResource) Configure(ctx context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -335,7 +337,7 @@ func (r *OrganizationsLicensingCotermLicensesMoveResource) Create(ctx context.Co
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Licensing.MoveOrganizationLicensingCotermLicenses(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Licensing.MoveOrganizationLicensingCotermLicenses(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsLoginSecurityResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsLoginSecurityResource{}
+	_ resource.Resource                = &OrganizationsLoginSecurityResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsLoginSecurityResource{}
+	_ resource.ResourceWithImportState = &OrganizationsLoginSecurityResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsLoginSecurityResource{}
 )
 
 func NewOrganizationsLoginSecurityResource() resource.Resource {
@@ -46,7 +47,9 @@ func NewOrganizationsLoginSecurityResource() resource.Resource {
 }
 
 type OrganizationsLoginSecurityResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	clients        *merakiClientPool
+	resetOnDestroy bool
 }
 
 func (r *OrganizationsLoginSecurityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +58,8 @@ func (r *OrganizationsLoginSecurityResource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -200,6 +205,10 @@ func (r *OrganizationsLoginSecurityResource) Schema(_ context.Context, _ resourc
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -228,7 +237,7 @@ func (r *OrganizationsLoginSecurityResource) Create(ctx context.Context, req res
 
 	// UPDATE NO CREATE
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationLoginSecurity(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationLoginSecurity(vvOrganizationID, dataRequest)
 	//Update
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
@@ -247,6 +256,7 @@ func (r *OrganizationsLoginSecurityResource) Create(ctx context.Context, req res
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -262,7 +272,7 @@ func (r *OrganizationsLoginSecurityResource) Read(ctx context.Context, req resou
 	// Has Item2
 
 	vvOrganizationID := data.OrganizationID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationLoginSecurity(vvOrganizationID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationLoginSecurity(vvOrganizationID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -288,9 +298,14 @@ func (r *OrganizationsLoginSecurityResource) Read(ctx context.Context, req resou
 	//entro aqui 2
 	data = ResponseOrganizationsGetOrganizationLoginSecurityItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsLoginSecurityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "organization_id")
+}
+
+func (r *OrganizationsLoginSecurityResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id")
 }
 
 func (r *OrganizationsLoginSecurityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -303,7 +318,7 @@ func (r *OrganizationsLoginSecurityResource) Update(ctx context.Context, req res
 	//Path Params
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationLoginSecurity(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationLoginSecurity(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -319,11 +334,53 @@ func (r *OrganizationsLoginSecurityResource) Update(ctx context.Context, req res
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsLoginSecurityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting OrganizationsLoginSecurity", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state OrganizationsLoginSecurityRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting OrganizationsLoginSecurity", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvOrganizationID := state.OrganizationID.ValueString()
+	// Meraki defaults
+	defaults := OrganizationsLoginSecurityRs{
+		APIAuthentication: &ResponseOrganizationsGetOrganizationLoginSecurityApiAuthenticationRs{
+			IPRestrictionsForKeys: &ResponseOrganizationsGetOrganizationLoginSecurityApiAuthenticationIpRestrictionsForKeysRs{
+				Enabled: types.BoolValue(false),
+			},
+		},
+		EnforceAccountLockout:     types.BoolValue(false),
+		EnforceDifferentPasswords: types.BoolValue(false),
+		EnforceIDleTimeout:        types.BoolValue(false),
+		EnforceLoginIPRanges:      types.BoolValue(false),
+		EnforcePasswordExpiration: types.BoolValue(false),
+		EnforceTwoFactorAuth:      types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationLoginSecurity(vvOrganizationID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateOrganizationLoginSecurity",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateOrganizationLoginSecurity",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -344,6 +401,7 @@ type OrganizationsLoginSecurityRs struct {
 	MinimumPasswordLength     types.Int64                                                           `tfsdk:"minimum_password_length"`
 	NumDifferentPasswords     types.Int64                                                           `tfsdk:"num_different_passwords"`
 	PasswordExpirationDays    types.Int64                                                           `tfsdk:"password_expiration_days"`
+	ResetOnDestroy            types.Bool                                                            `tfsdk:"reset_on_destroy"`
 }
 
 type ResponseOrganizationsGetOrganizationLoginSecurityApiAuthenticationRs struct {
@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -37,8 +34,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsPolicyObjectsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsPolicyObjectsResource{}
+	_ resource.Resource                = &OrganizationsPolicyObjectsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsPolicyObjectsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsPolicyObjectsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsPolicyObjectsResource{}
 )
 
 func NewOrganizationsPolicyObjectsResource() resource.Resource {
@@ -46,7 +45,8 @@ func NewOrganizationsPolicyObjectsResource() resource.Resource {
 }
 
 type OrganizationsPolicyObjectsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsPolicyObjectsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,6 +55,7 @@ func (r *OrganizationsPolicyObjectsResource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -196,7 +197,7 @@ func (r *OrganizationsPolicyObjectsResource) Create(ctx context.Context, req res
 	// organization_id
 	vvName := data.Name.ValueString()
 	//Items
-	responseVerifyItem, restyResp1, err := r.client.Organizations.GetOrganizationPolicyObjects(vvOrganizationID, &merakigosdk.GetOrganizationPolicyObjectsQueryParams{
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationPolicyObjects(vvOrganizationID, &merakigosdk.GetOrganizationPolicyObjectsQueryParams{
 		PerPage: -1,
 	})
 	//Have Create
@@ -222,18 +223,19 @@ func (r *OrganizationsPolicyObjectsResource) Create(ctx context.Context, req res
 				)
 				return
 			}
-			r.client.Organizations.UpdateOrganizationPolicyObject(vvOrganizationID, vvPolicyObjectID, data.toSdkApiRequestUpdate(ctx))
-			responseVerifyItem2, _, _ := r.client.Organizations.GetOrganizationPolicyObject(vvOrganizationID, vvPolicyObjectID)
+			r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationPolicyObject(vvOrganizationID, vvPolicyObjectID, data.toSdkApiRequestUpdate(ctx))
+			responseVerifyItem2, _, _ := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationPolicyObject(vvOrganizationID, vvPolicyObjectID)
 			if responseVerifyItem2 != nil {
 				data = ResponseOrganizationsGetOrganizationPolicyObjectItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
 	}
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	_, restyResp2, err := r.client.Organizations.CreateOrganizationPolicyObject(vvOrganizationID, dataRequest)
+	_, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationPolicyObject(vvOrganizationID, dataRequest)
 
 	if err != nil || restyResp2 == nil {
 		if restyResp1 != nil {
@@ -250,7 +252,7 @@ func (r *OrganizationsPolicyObjectsResource) Create(ctx context.Context, req res
 		return
 	}
 	//Items
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationPolicyObjects(vvOrganizationID, &merakigosdk.GetOrganizationPolicyObjectsQueryParams{
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationPolicyObjects(vvOrganizationID, &merakigosdk.GetOrganizationPolicyObjectsQueryParams{
 		PerPage: -1,
 	})
 	// Has item and has items
@@ -281,10 +283,11 @@ func (r *OrganizationsPolicyObjectsResource) Create(ctx context.Context, req res
 			)
 			return
 		}
-		responseVerifyItem2, restyRespGet, err := r.client.Organizations.GetOrganizationPolicyObject(vvOrganizationID, vvPolicyObjectID)
+		responseVerifyItem2, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationPolicyObject(vvOrganizationID, vvPolicyObjectID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseOrganizationsGetOrganizationPolicyObjectItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -334,7 +337,7 @@ func (r *OrganizationsPolicyObjectsResource) Read(ctx context.Context, req resou
 	// organization_id
 	vvPolicyObjectID := data.PolicyObjectID.ValueString()
 	// policy_object_id
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationPolicyObject(vvOrganizationID, vvPolicyObjectID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationPolicyObject(vvOrganizationID, vvPolicyObjectID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -362,20 +365,14 @@ func (r *OrganizationsPolicyObjectsResource) Read(ctx context.Context, req resou
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsPolicyObjectsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "organization_id", "policy_object_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_object_id"), idParts[1])...)
+func (r *OrganizationsPolicyObjectsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "policy_object_id")
 }
 
 func (r *OrganizationsPolicyObjectsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -392,7 +389,7 @@ func (r *OrganizationsPolicyObjectsResource) Update(ctx context.Context, req res
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvPolicyObjectID := data.PolicyObjectID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationPolicyObject(vvOrganizationID, vvPolicyObjectID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationPolicyObject(vvOrganizationID, vvPolicyObjectID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -410,6 +407,7 @@ func (r *OrganizationsPolicyObjectsResource) Update(ctx context.Context, req res
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsPolicyObjectsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -431,7 +429,7 @@ func (r *OrganizationsPolicyObjectsResource) Delete(ctx context.Context, req res
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvPolicyObjectID := state.PolicyObjectID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationPolicyObject(vvOrganizationID, vvPolicyObjectID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationPolicyObject(vvOrganizationID, vvPolicyObjectID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationPolicyObject", err.Error())
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -38,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsPolicyObjectsGroupsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsPolicyObjectsGroupsResource{}
+	_ resource.Resource                = &OrganizationsPolicyObjectsGroupsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsPolicyObjectsGroupsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsPolicyObjectsGroupsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsPolicyObjectsGroupsResource{}
 )
 
 func NewOrganizationsPolicyObjectsGroupsResource() resource.Resource {
@@ -47,7 +46,8 @@ func NewOrganizationsPolicyObjectsGroupsResource() resource.Resource {
 }
 
 type OrganizationsPolicyObjectsGroupsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsPolicyObjectsGroupsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,6 +56,7 @@ func (r *OrganizationsPolicyObjectsGroupsResource) Configure(ctx context.Context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -159,7 +160,7 @@ func (r *OrganizationsPolicyObjectsGroupsResource) Create(ctx context.Context, r
 	// organization_id
 	vvName := data.Name.ValueString()
 	//Items
-	responseVerifyItem, restyResp1, err := r.client.Organizations.GetOrganizationPolicyObjectsGroups(vvOrganizationID, &merakigosdk.GetOrganizationPolicyObjectsGroupsQueryParams{
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationPolicyObjectsGroups(vvOrganizationID, &merakigosdk.GetOrganizationPolicyObjectsGroupsQueryParams{
 		PerPage: -1,
 	})
 	log.Println("responseVerifyItem", responseVerifyItem)
@@ -186,18 +187,19 @@ func (r *OrganizationsPolicyObjectsGroupsResource) Create(ctx context.Context, r
 				)
 				return
 			}
-			r.client.Organizations.UpdateOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID, data.toSdkApiRequestUpdate(ctx))
-			responseVerifyItem2, _, _ := r.client.Organizations.GetOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID)
+			r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID, data.toSdkApiRequestUpdate(ctx))
+			responseVerifyItem2, _, _ := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID)
 			if responseVerifyItem2 != nil {
 				data = ResponseOrganizationsGetOrganizationPolicyObjectsGroupItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
 	}
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	_, restyResp2, err := r.client.Organizations.CreateOrganizationPolicyObjectsGroup(vvOrganizationID, dataRequest)
+	_, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationPolicyObjectsGroup(vvOrganizationID, dataRequest)
 
 	if err != nil || restyResp2 == nil {
 		if restyResp1 != nil {
@@ -214,7 +216,7 @@ func (r *OrganizationsPolicyObjectsGroupsResource) Create(ctx context.Context, r
 		return
 	}
 	//Items
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationPolicyObjectsGroups(vvOrganizationID, &merakigosdk.GetOrganizationPolicyObjectsGroupsQueryParams{
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationPolicyObjectsGroups(vvOrganizationID, &merakigosdk.GetOrganizationPolicyObjectsGroupsQueryParams{
 		PerPage: -1,
 	})
 	// Has item and has items
@@ -245,10 +247,11 @@ func (r *OrganizationsPolicyObjectsGroupsResource) Create(ctx context.Context, r
 			)
 			return
 		}
-		responseVerifyItem2, restyRespGet, err := r.client.Organizations.GetOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID)
+		responseVerifyItem2, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseOrganizationsGetOrganizationPolicyObjectsGroupItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -298,7 +301,7 @@ func (r *OrganizationsPolicyObjectsGroupsResource) Read(ctx context.Context, req
 	// organization_id
 	vvPolicyObjectGroupID := data.PolicyObjectGroupID.ValueString()
 	// policy_object_group_id
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -326,20 +329,14 @@ func (r *OrganizationsPolicyObjectsGroupsResource) Read(ctx context.Context, req
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsPolicyObjectsGroupsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "organization_id", "policy_object_group_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_object_group_id"), idParts[1])...)
+func (r *OrganizationsPolicyObjectsGroupsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "policy_object_group_id")
 }
 
 func (r *OrganizationsPolicyObjectsGroupsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -356,7 +353,7 @@ func (r *OrganizationsPolicyObjectsGroupsResource) Update(ctx context.Context, r
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvPolicyObjectGroupID := data.PolicyObjectGroupID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -374,6 +371,7 @@ func (r *OrganizationsPolicyObjectsGroupsResource) Update(ctx context.Context, r
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsPolicyObjectsGroupsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -395,7 +393,7 @@ func (r *OrganizationsPolicyObjectsGroupsResource) Delete(ctx context.Context, r
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvPolicyObjectGroupID := state.PolicyObjectGroupID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationPolicyObjectsGroup(vvOrganizationID, vvPolicyObjectGroupID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationPolicyObjectsGroup", err.Error())
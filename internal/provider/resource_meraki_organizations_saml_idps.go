@@ -19,13 +19,10 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -35,8 +32,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsSamlIDpsResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsSamlIDpsResource{}
+	_ resource.Resource                = &OrganizationsSamlIDpsResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsSamlIDpsResource{}
+	_ resource.ResourceWithImportState = &OrganizationsSamlIDpsResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsSamlIDpsResource{}
 )
 
 func NewOrganizationsSamlIDpsResource() resource.Resource {
@@ -44,7 +43,8 @@ func NewOrganizationsSamlIDpsResource() resource.Resource {
 }
 
 type OrganizationsSamlIDpsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsSamlIDpsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +53,7 @@ func (r *OrganizationsSamlIDpsResource) Configure(ctx context.Context, req resou
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -126,7 +127,7 @@ func (r *OrganizationsSamlIDpsResource) Create(ctx context.Context, req resource
 
 	vvIDpID := data.IDpID.ValueString()
 	if vvIDpID != "" {
-		responseVerifyItem, restyRespGet, err := r.client.Organizations.GetOrganizationSamlIDp(vvOrganizationID, vvIDpID)
+		responseVerifyItem, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationSamlIDp(vvOrganizationID, vvIDpID)
 		if err != nil || responseVerifyItem == nil {
 			if restyRespGet != nil {
 				if restyRespGet.StatusCode() != 404 {
@@ -144,11 +145,12 @@ func (r *OrganizationsSamlIDpsResource) Create(ctx context.Context, req resource
 			data := ResponseOrganizationsGetOrganizationSamlIDpItemToBodyRs(data, responseVerifyItem, false)
 			diags := resp.State.Set(ctx, &data)
 			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		}
 	}
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Organizations.CreateOrganizationSamlIDp(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationSamlIDp(vvOrganizationID, dataRequest)
 
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
@@ -167,7 +169,7 @@ func (r *OrganizationsSamlIDpsResource) Create(ctx context.Context, req resource
 	//Items
 	res := *response
 	vvIDpID = res.IDpID
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationSamlIDp(vvOrganizationID, vvIDpID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationSamlIDp(vvOrganizationID, vvIDpID)
 	// Has item and has items
 
 	if err != nil || responseGet == nil {
@@ -187,6 +189,7 @@ func (r *OrganizationsSamlIDpsResource) Create(ctx context.Context, req resource
 	data = ResponseOrganizationsGetOrganizationSamlIDpItemToBodyRs(data, responseGet, false)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 	return
 
 }
@@ -214,7 +217,7 @@ func (r *OrganizationsSamlIDpsResource) Read(ctx context.Context, req resource.R
 
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvIDpID := data.IDpID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationSamlIDp(vvOrganizationID, vvIDpID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationSamlIDp(vvOrganizationID, vvIDpID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -242,21 +245,15 @@ func (r *OrganizationsSamlIDpsResource) Read(ctx context.Context, req resource.R
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsSamlIDpsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "organization_id", "idp_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("idp_id"), idParts[1])...)
+func (r *OrganizationsSamlIDpsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "idp_id")
 }
 
 func (r *OrganizationsSamlIDpsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -273,7 +270,7 @@ func (r *OrganizationsSamlIDpsResource) Update(ctx context.Context, req resource
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvIDpID := data.IDpID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	_, restyResp2, err := r.client.Organizations.UpdateOrganizationSamlIDp(vvOrganizationID, vvIDpID, dataRequest)
+	_, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationSamlIDp(vvOrganizationID, vvIDpID, dataRequest)
 	if err != nil || restyResp2 == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -291,6 +288,7 @@ func (r *OrganizationsSamlIDpsResource) Update(ctx context.Context, req resource
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsSamlIDpsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -312,7 +310,7 @@ func (r *OrganizationsSamlIDpsResource) Delete(ctx context.Context, req resource
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvIDpID := state.IDpID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationSamlIDp(vvOrganizationID, vvIDpID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationSamlIDp(vvOrganizationID, vvIDpID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationSamlIDp", err.Error())
@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -38,8 +35,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsSamlRolesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsSamlRolesResource{}
+	_ resource.Resource                = &OrganizationsSamlRolesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsSamlRolesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsSamlRolesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsSamlRolesResource{}
 )
 
 func NewOrganizationsSamlRolesResource() resource.Resource {
@@ -47,7 +46,8 @@ func NewOrganizationsSamlRolesResource() resource.Resource {
 }
 
 type OrganizationsSamlRolesResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsSamlRolesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,6 +56,7 @@ func (r *OrganizationsSamlRolesResource) Configure(ctx context.Context, req reso
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -224,7 +225,7 @@ func (r *OrganizationsSamlRolesResource) Create(ctx context.Context, req resourc
 
 	vvRole := data.Role.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Organizations.GetOrganizationSamlRoles(vvOrganizationID)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationSamlRoles(vvOrganizationID)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -251,20 +252,21 @@ func (r *OrganizationsSamlRolesResource) Create(ctx context.Context, req resourc
 				)
 				return
 			}
-			r.client.Organizations.UpdateOrganizationSamlRole(vvOrganizationID, vvSamlRoleID, data.toSdkApiRequestUpdate(ctx))
+			r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationSamlRole(vvOrganizationID, vvSamlRoleID, data.toSdkApiRequestUpdate(ctx))
 
-			responseVerifyItem2, _, _ := r.client.Organizations.GetOrganizationSamlRole(vvOrganizationID, vvSamlRoleID)
+			responseVerifyItem2, _, _ := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationSamlRole(vvOrganizationID, vvSamlRoleID)
 			if responseVerifyItem2 != nil {
 				data = ResponseOrganizationsGetOrganizationSamlRoleItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Organizations.CreateOrganizationSamlRole(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationSamlRole(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -280,7 +282,7 @@ func (r *OrganizationsSamlRolesResource) Create(ctx context.Context, req resourc
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationSamlRoles(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationSamlRoles(vvOrganizationID)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -309,10 +311,11 @@ func (r *OrganizationsSamlRolesResource) Create(ctx context.Context, req resourc
 			)
 			return
 		}
-		responseVerifyItem2, restyRespGet, err := r.client.Organizations.GetOrganizationSamlRole(vvOrganizationID, vvSamlRoleID)
+		responseVerifyItem2, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationSamlRole(vvOrganizationID, vvSamlRoleID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseOrganizationsGetOrganizationSamlRoleItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -351,7 +354,7 @@ func (r *OrganizationsSamlRolesResource) Read(ctx context.Context, req resource.
 
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvSamlRoleID := data.SamlRoleID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationSamlRole(vvOrganizationID, vvSamlRoleID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationSamlRole(vvOrganizationID, vvSamlRoleID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -377,19 +380,14 @@ func (r *OrganizationsSamlRolesResource) Read(ctx context.Context, req resource.
 	//entro aqui 2
 	data = ResponseOrganizationsGetOrganizationSamlRoleItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsSamlRolesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+	importStateParts(ctx, req, resp, "organization_id", "saml_role_id")
+}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: organizationId,samlRoleId. Got: %q", req.ID),
-		)
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("saml_role_id"), idParts[1])...)
+func (r *OrganizationsSamlRolesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "saml_role_id")
 }
 
 func (r *OrganizationsSamlRolesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -403,7 +401,7 @@ func (r *OrganizationsSamlRolesResource) Update(ctx context.Context, req resourc
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	vvSamlRoleID := plan.SamlRoleID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationSamlRole(vvOrganizationID, vvSamlRoleID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationSamlRole(vvOrganizationID, vvSamlRoleID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -419,6 +417,7 @@ func (r *OrganizationsSamlRolesResource) Update(ctx context.Context, req resourc
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsSamlRolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -440,7 +439,7 @@ func (r *OrganizationsSamlRolesResource) Delete(ctx context.Context, req resourc
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvSamlRoleID := state.SamlRoleID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationSamlRole(vvOrganizationID, vvSamlRoleID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationSamlRole(vvOrganizationID, vvSamlRoleID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationSamlRole", err.Error())
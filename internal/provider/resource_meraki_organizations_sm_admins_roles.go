@@ -19,14 +19,11 @@ package provider
 // RESOURCE NORMAL
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -39,8 +36,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsSmAdminsRolesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsSmAdminsRolesResource{}
+	_ resource.Resource                = &OrganizationsSmAdminsRolesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsSmAdminsRolesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsSmAdminsRolesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsSmAdminsRolesResource{}
 )
 
 func NewOrganizationsSmAdminsRolesResource() resource.Resource {
@@ -48,7 +47,8 @@ func NewOrganizationsSmAdminsRolesResource() resource.Resource {
 }
 
 type OrganizationsSmAdminsRolesResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsSmAdminsRolesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,6 +57,7 @@ func (r *OrganizationsSmAdminsRolesResource) Configure(ctx context.Context, req
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -141,7 +142,7 @@ func (r *OrganizationsSmAdminsRolesResource) Create(ctx context.Context, req res
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvName := data.Name.ValueString()
 	//Item
-	responseVerifyItem, restyResp1, err := r.client.Sm.GetOrganizationSmAdminsRoles(vvOrganizationID, nil)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Sm.GetOrganizationSmAdminsRoles(vvOrganizationID, nil)
 	//Have Create
 	if err != nil || restyResp1 == nil {
 		if restyResp1.StatusCode() != 404 {
@@ -166,18 +167,19 @@ func (r *OrganizationsSmAdminsRolesResource) Create(ctx context.Context, req res
 				)
 				return
 			}
-			r.client.Sm.UpdateOrganizationSmAdminsRole(vvOrganizationID, vvRoleID, data.toSdkApiRequestUpdate(ctx))
-			responseVerifyItem2, _, _ := r.client.Sm.GetOrganizationSmAdminsRole(vvOrganizationID, vvRoleID)
+			r.clients.Client(vvOrganizationID).Sm.UpdateOrganizationSmAdminsRole(vvOrganizationID, vvRoleID, data.toSdkApiRequestUpdate(ctx))
+			responseVerifyItem2, _, _ := r.clients.Client(vvOrganizationID).Sm.GetOrganizationSmAdminsRole(vvOrganizationID, vvRoleID)
 			if responseVerifyItem2 != nil {
 				data = ResponseSmGetOrganizationSmAdminsRoleItemToBodyRs(data, responseVerifyItem2, false)
 				// Path params update assigned
 				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 				return
 			}
 		}
 	}
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Sm.CreateOrganizationSmAdminsRole(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Sm.CreateOrganizationSmAdminsRole(vvOrganizationID, dataRequest)
 
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp1 != nil {
@@ -194,7 +196,7 @@ func (r *OrganizationsSmAdminsRolesResource) Create(ctx context.Context, req res
 		return
 	}
 	//Item
-	responseGet, restyResp1, err := r.client.Sm.GetOrganizationSmAdminsRoles(vvOrganizationID, nil)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Sm.GetOrganizationSmAdminsRoles(vvOrganizationID, nil)
 	// Has item and not has items
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -222,10 +224,11 @@ func (r *OrganizationsSmAdminsRolesResource) Create(ctx context.Context, req res
 			)
 			return
 		}
-		responseVerifyItem2, restyRespGet, _ := r.client.Sm.GetOrganizationSmAdminsRole(vvOrganizationID, vvRoleID)
+		responseVerifyItem2, restyRespGet, _ := r.clients.Client(vvOrganizationID).Sm.GetOrganizationSmAdminsRole(vvOrganizationID, vvRoleID)
 		if responseVerifyItem2 != nil && err == nil {
 			data = ResponseSmGetOrganizationSmAdminsRoleItemToBodyRs(data, responseVerifyItem2, false)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 		} else {
 			if restyRespGet != nil {
@@ -251,18 +254,11 @@ func (r *OrganizationsSmAdminsRolesResource) Create(ctx context.Context, req res
 }
 
 func (r *OrganizationsSmAdminsRolesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
-		return
-	}
+	importStateParts(ctx, req, resp, "organization_id", "role_id")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), idParts[1])...)
+func (r *OrganizationsSmAdminsRolesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id", "role_id")
 }
 
 func (r *OrganizationsSmAdminsRolesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -288,7 +284,7 @@ func (r *OrganizationsSmAdminsRolesResource) Read(ctx context.Context, req resou
 
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvRoleID := data.RoleID.ValueString()
-	responseGet, restyRespGet, err := r.client.Sm.GetOrganizationSmAdminsRole(vvOrganizationID, vvRoleID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Sm.GetOrganizationSmAdminsRole(vvOrganizationID, vvRoleID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -316,6 +312,7 @@ func (r *OrganizationsSmAdminsRolesResource) Read(ctx context.Context, req resou
 	diags := resp.State.Set(ctx, &data)
 	//update path params assigned
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsSmAdminsRolesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -332,7 +329,7 @@ func (r *OrganizationsSmAdminsRolesResource) Update(ctx context.Context, req res
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvRoleID := data.RoleID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Sm.UpdateOrganizationSmAdminsRole(vvOrganizationID, vvRoleID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Sm.UpdateOrganizationSmAdminsRole(vvOrganizationID, vvRoleID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -350,6 +347,7 @@ func (r *OrganizationsSmAdminsRolesResource) Update(ctx context.Context, req res
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &data)...)
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsSmAdminsRolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -371,7 +369,7 @@ func (r *OrganizationsSmAdminsRolesResource) Delete(ctx context.Context, req res
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvRoleID := state.RoleID.ValueString()
-	_, err := r.client.Sm.DeleteOrganizationSmAdminsRole(vvOrganizationID, vvRoleID)
+	_, err := r.clients.Client(vvOrganizationID).Sm.DeleteOrganizationSmAdminsRole(vvOrganizationID, vvRoleID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationSmAdminsRole", err.Error())
@@ -43,7 +43,8 @@ func NewOrganizationsSmSentryPoliciesAssignmentsResource() resource.Resource {
 }
 
 type OrganizationsSmSentryPoliciesAssignmentsResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsSmSentryPoliciesAssignmentsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -52,6 +53,7 @@ func (r *OrganizationsSmSentryPoliciesAssignmentsResource) Configure(ctx context
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -236,7 +238,7 @@ func (r *OrganizationsSmSentryPoliciesAssignmentsResource) Create(ctx context.Co
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp1, err := r.client.Sm.UpdateOrganizationSmSentryPoliciesAssignments(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Sm.UpdateOrganizationSmSentryPoliciesAssignments(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -25,7 +25,6 @@ import (
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -39,8 +38,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsSNMPResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsSNMPResource{}
+	_ resource.Resource                = &OrganizationsSNMPResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsSNMPResource{}
+	_ resource.ResourceWithImportState = &OrganizationsSNMPResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsSNMPResource{}
 )
 
 func NewOrganizationsSNMPResource() resource.Resource {
@@ -48,7 +49,9 @@ func NewOrganizationsSNMPResource() resource.Resource {
 }
 
 type OrganizationsSNMPResource struct {
-	client *merakigosdk.Client
+	client         *merakigosdk.Client
+	clients        *merakiClientPool
+	resetOnDestroy bool
 }
 
 func (r *OrganizationsSNMPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,6 +60,8 @@ func (r *OrganizationsSNMPResource) Configure(ctx context.Context, req resource.
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
+	r.resetOnDestroy = req.ProviderData.(MerakiProviderData).ResetOnDestroy
 }
 
 // Metadata returns the data source type name.
@@ -90,8 +95,13 @@ func (r *OrganizationsSNMPResource) Schema(_ context.Context, _ resource.SchemaR
 				MarkdownDescription: `The port of the SNMP server.`,
 				Computed:            true,
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: `Restore the Meraki default settings when the resource is destroyed. If not set, it uses the provider meraki_reset_on_destroy flag.`,
+				Optional:            true,
+			},
 			"v2_community_string": schema.StringAttribute{
 				MarkdownDescription: `The community string for SNMP version 2c, if enabled.`,
+				Sensitive:           true,
 				Computed:            true,
 			},
 			"v2c_enabled": schema.BoolAttribute{
@@ -117,6 +127,7 @@ func (r *OrganizationsSNMPResource) Schema(_ context.Context, _ resource.SchemaR
 			},
 			"v3_auth_pass": schema.StringAttribute{
 				MarkdownDescription: `The SNMP version 3 authentication password. Must be at least 8 characters if specified.`,
+				Sensitive:           true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -145,6 +156,7 @@ func (r *OrganizationsSNMPResource) Schema(_ context.Context, _ resource.SchemaR
 			},
 			"v3_priv_pass": schema.StringAttribute{
 				MarkdownDescription: `The SNMP version 3 privacy password. Must be at least 8 characters if specified.`,
+				Sensitive:           true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -182,7 +194,7 @@ func (r *OrganizationsSNMPResource) Create(ctx context.Context, req resource.Cre
 
 	// UPDATE NO CREATE
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationSNMP(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationSNMP(vvOrganizationID, dataRequest)
 	//Update
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
@@ -213,6 +225,7 @@ func (r *OrganizationsSNMPResource) Create(ctx context.Context, req resource.Cre
 
 	// Assign data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -228,7 +241,7 @@ func (r *OrganizationsSNMPResource) Read(ctx context.Context, req resource.ReadR
 	// Has Item2
 
 	vvOrganizationID := data.OrganizationID.ValueString()
-	responseGet, restyRespGet, err := r.client.Organizations.GetOrganizationSNMP(vvOrganizationID)
+	responseGet, restyRespGet, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationSNMP(vvOrganizationID)
 	if err != nil || restyRespGet == nil {
 		if restyRespGet != nil {
 			if restyRespGet.StatusCode() == 404 {
@@ -254,9 +267,14 @@ func (r *OrganizationsSNMPResource) Read(ctx context.Context, req resource.ReadR
 	//entro aqui 2
 	data = ResponseOrganizationsGetOrganizationSNMPItemToBodyRs(data, responseGet, true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 func (r *OrganizationsSNMPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "organization_id")
+}
+
+func (r *OrganizationsSNMPResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id")
 }
 
 func (r *OrganizationsSNMPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -269,7 +287,7 @@ func (r *OrganizationsSNMPResource) Update(ctx context.Context, req resource.Upd
 	//Path Params
 	vvOrganizationID := plan.OrganizationID.ValueString()
 	dataRequest := plan.toSdkApiRequestUpdate(ctx)
-	response, restyResp2, err := r.client.Organizations.UpdateOrganizationSNMP(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationSNMP(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -285,11 +303,44 @@ func (r *OrganizationsSNMPResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *OrganizationsSNMPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	//missing delete
-	resp.Diagnostics.AddWarning("Error deleting OrganizationsSNMP", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+	var state OrganizationsSNMPRs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !resetOnDestroy(state.ResetOnDestroy, r.resetOnDestroy) {
+		//missing delete
+		resp.Diagnostics.AddWarning("Error deleting OrganizationsSNMP", "This resource has no delete method in the meraki lab, the resource was deleted only in terraform.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	//Path Params
+	vvOrganizationID := state.OrganizationID.ValueString()
+	// Meraki defaults
+	defaults := OrganizationsSNMPRs{
+		V2CEnabled: types.BoolValue(false),
+		V3Enabled:  types.BoolValue(false),
+	}
+	dataRequest := defaults.toSdkApiRequestUpdate(ctx)
+	_, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.UpdateOrganizationSNMP(vvOrganizationID, dataRequest)
+	if err != nil || restyResp2 == nil {
+		if restyResp2 != nil {
+			resp.Diagnostics.AddError(
+				"Failure when executing UpdateOrganizationSNMP",
+				"Status: "+strconv.Itoa(restyResp2.StatusCode())+"\n"+restyResp2.String(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failure when executing UpdateOrganizationSNMP",
+			err.Error(),
+		)
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -307,6 +358,7 @@ type OrganizationsSNMPRs struct {
 	V3User            types.String `tfsdk:"v3_user"`
 	V3AuthPass        types.String `tfsdk:"v3_auth_pass"`
 	V3PrivPass        types.String `tfsdk:"v3_priv_pass"`
+	ResetOnDestroy    types.Bool   `tfsdk:"reset_on_destroy"`
 }
 
 // FromBody
@@ -23,7 +23,6 @@ import (
 
 	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -33,8 +32,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &OrganizationsSplashThemesResource{}
-	_ resource.ResourceWithConfigure = &OrganizationsSplashThemesResource{}
+	_ resource.Resource                = &OrganizationsSplashThemesResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationsSplashThemesResource{}
+	_ resource.ResourceWithImportState = &OrganizationsSplashThemesResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationsSplashThemesResource{}
 )
 
 func NewOrganizationsSplashThemesResource() resource.Resource {
@@ -42,7 +43,8 @@ func NewOrganizationsSplashThemesResource() resource.Resource {
 }
 
 type OrganizationsSplashThemesResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsSplashThemesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -51,6 +53,7 @@ func (r *OrganizationsSplashThemesResource) Configure(ctx context.Context, req r
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -130,7 +133,7 @@ func (r *OrganizationsSplashThemesResource) Create(ctx context.Context, req reso
 
 	vvName := data.Name.ValueString()
 
-	responseVerifyItem, restyResp1, err := r.client.Organizations.GetOrganizationSplashThemes(vvOrganizationID)
+	responseVerifyItem, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationSplashThemes(vvOrganizationID)
 	//Has Post
 	if err != nil {
 		if restyResp1 != nil {
@@ -160,13 +163,14 @@ func (r *OrganizationsSplashThemesResource) Create(ctx context.Context, req reso
 			data = ResponseOrganizationsGetOrganizationSplashThemesItemToBodyRs(data, &responseVerifyItem2, false)
 			// Path params update assigned
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 			return
 
 		}
 	}
 
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp2, err := r.client.Organizations.CreateOrganizationSplashTheme(vvOrganizationID, dataRequest)
+	response, restyResp2, err := r.clients.Client(vvOrganizationID).Organizations.CreateOrganizationSplashTheme(vvOrganizationID, dataRequest)
 	if err != nil || restyResp2 == nil || response == nil {
 		if restyResp2 != nil {
 			resp.Diagnostics.AddError(
@@ -182,7 +186,7 @@ func (r *OrganizationsSplashThemesResource) Create(ctx context.Context, req reso
 		return
 	}
 
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationSplashThemes(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationSplashThemes(vvOrganizationID)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -213,6 +217,7 @@ func (r *OrganizationsSplashThemesResource) Create(ctx context.Context, req reso
 		data = ResponseOrganizationsGetOrganizationSplashThemesItemToBodyRs(data, &responseVerifyItem2, false)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -248,7 +253,7 @@ func (r *OrganizationsSplashThemesResource) Read(ctx context.Context, req resour
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvName := data.Name.ValueString()
 
-	responseGet, restyResp1, err := r.client.Organizations.GetOrganizationSplashThemes(vvOrganizationID)
+	responseGet, restyResp1, err := r.clients.Client(vvOrganizationID).Organizations.GetOrganizationSplashThemes(vvOrganizationID)
 
 	if err != nil || responseGet == nil {
 		if restyResp1 != nil {
@@ -288,6 +293,7 @@ func (r *OrganizationsSplashThemesResource) Read(ctx context.Context, req resour
 		data = ResponseOrganizationsGetOrganizationSplashThemesItemToBodyRs(data, &responseVerifyItem2, true)
 		diags := resp.State.Set(ctx, &data)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	} else {
 		resp.Diagnostics.AddError(
@@ -298,7 +304,11 @@ func (r *OrganizationsSplashThemesResource) Read(ctx context.Context, req resour
 	}
 }
 func (r *OrganizationsSplashThemesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+	importStateParts(ctx, req, resp, "organization_id")
+}
+
+func (r *OrganizationsSplashThemesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = importIdentitySchema("organization_id")
 }
 
 func (r *OrganizationsSplashThemesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -337,7 +347,7 @@ func (r *OrganizationsSplashThemesResource) Delete(ctx context.Context, req reso
 
 	vvOrganizationID := state.OrganizationID.ValueString()
 	vvID := state.ID.ValueString()
-	_, err := r.client.Organizations.DeleteOrganizationSplashTheme(vvOrganizationID, vvID)
+	_, err := r.clients.Client(vvOrganizationID).Organizations.DeleteOrganizationSplashTheme(vvOrganizationID, vvID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failure when executing DeleteOrganizationSplashTheme", err.Error())
@@ -41,7 +41,8 @@ func NewOrganizationsWirelessRadioAutoRfChannelsRecalculateResource() resource.R
 }
 
 type OrganizationsWirelessRadioAutoRfChannelsRecalculateResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsWirelessRadioAutoRfChannelsRecalculateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsWirelessRadioAutoRfChannelsRecalculateResource) Configure(
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -113,7 +115,7 @@ func (r *OrganizationsWirelessRadioAutoRfChannelsRecalculateResource) Create(ctx
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Wireless.RecalculateOrganizationWirelessRadioAutoRfChannels(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Wireless.RecalculateOrganizationWirelessRadioAutoRfChannels(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
@@ -42,7 +42,8 @@ func NewOrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesCreateResourc
 }
 
 type OrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesCreateResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesCreateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -51,6 +52,7 @@ func (r *OrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesCreateResour
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -221,7 +223,7 @@ func (r *OrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesCreateResour
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	dataRequest := data.toSdkApiRequestCreate(ctx)
-	response, restyResp1, err := r.client.Wireless.CreateOrganizationWirelessSSIDsFirewallIsolationAllowlistEntry(vvOrganizationID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Wireless.CreateOrganizationWirelessSSIDsFirewallIsolationAllowlistEntry(vvOrganizationID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
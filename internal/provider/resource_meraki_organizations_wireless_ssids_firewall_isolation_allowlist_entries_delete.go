@@ -41,7 +41,8 @@ func NewOrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesDeleteResourc
 }
 
 type OrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesDeleteResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesDeleteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesDeleteResour
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -99,7 +101,7 @@ func (r *OrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesDeleteResour
 	//Has Paths
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvEntryID := data.EntryID.ValueString()
-	restyResp1, err := r.client.Wireless.DeleteOrganizationWirelessSSIDsFirewallIsolationAllowlistEntry(vvOrganizationID, vvEntryID)
+	restyResp1, err := r.clients.Client(vvOrganizationID).Wireless.DeleteOrganizationWirelessSSIDsFirewallIsolationAllowlistEntry(vvOrganizationID, vvEntryID)
 	if err != nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
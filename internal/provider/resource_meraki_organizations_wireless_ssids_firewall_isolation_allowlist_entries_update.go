@@ -41,7 +41,8 @@ func NewOrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesUpdateResourc
 }
 
 type OrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesUpdateResource struct {
-	client *merakigosdk.Client
+	client  *merakigosdk.Client
+	clients *merakiClientPool
 }
 
 func (r *OrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesUpdateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,6 +51,7 @@ func (r *OrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesUpdateResour
 	}
 	client := req.ProviderData.(MerakiProviderData).Client
 	r.client = client
+	r.clients = req.ProviderData.(MerakiProviderData).Clients
 }
 
 // Metadata returns the data source type name.
@@ -196,7 +198,7 @@ func (r *OrganizationsWirelessSSIDsFirewallIsolationAllowlistEntriesUpdateResour
 	vvOrganizationID := data.OrganizationID.ValueString()
 	vvEntryID := data.EntryID.ValueString()
 	dataRequest := data.toSdkApiRequestUpdate(ctx)
-	response, restyResp1, err := r.client.Wireless.UpdateOrganizationWirelessSSIDsFirewallIsolationAllowlistEntry(vvOrganizationID, vvEntryID, dataRequest)
+	response, restyResp1, err := r.clients.Client(vvOrganizationID).Wireless.UpdateOrganizationWirelessSSIDsFirewallIsolationAllowlistEntry(vvOrganizationID, vvEntryID, dataRequest)
 	if err != nil || response == nil {
 		if restyResp1 != nil {
 			resp.Diagnostics.AddError(
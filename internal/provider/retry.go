@@ -0,0 +1,111 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"syscall"
+	"time"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// retryMaxWaitTime caps the exponential backoff between two attempts.
+const retryMaxWaitTime = 30 * time.Second
+
+// retrySafePOSTPaths are the POST requests that can be sent twice. Repeating them only blinks
+// the LEDs again, while repeating a POST such as a claim or a creation can fail or create a
+// duplicate. The live tools are not safe either: after a 5xx or a timeout the first job may
+// already run, and a second cable test restarts the test on the port. They are only retried
+// on 429, by the SDK.
+var retrySafePOSTPaths = []*regexp.Regexp{
+	regexp.MustCompile(`/api/v1/devices/[^/]+/blinkLeds$`),
+}
+
+// retryPolicy retries the idempotent requests that failed with a transient status or a
+// network error. The 429 responses are retried by the SDK, with meraki_retries and the
+// Retry-After header.
+type retryPolicy struct {
+	statuses map[int]bool
+}
+
+func newRetryPolicy(statuses []int64) *retryPolicy {
+	p := &retryPolicy{statuses: map[int]bool{}}
+	for _, status := range statuses {
+		if status != http.StatusTooManyRequests {
+			p.statuses[int(status)] = true
+		}
+	}
+	return p
+}
+
+// install sets the retries and the timeout of each attempt on the resty client of the SDK.
+func (p *retryPolicy) install(client *merakigosdk.Client, retries int, waitTime time.Duration, timeout time.Duration) {
+	client.RestyClient().
+		SetTimeout(timeout).
+		SetRetryCount(retries).
+		SetRetryWaitTime(waitTime).
+		SetRetryMaxWaitTime(retryMaxWaitTime).
+		AddRetryCondition(p.shouldRetry)
+}
+
+func (p *retryPolicy) shouldRetry(r *resty.Response, err error) bool {
+	if r == nil || r.Request == nil || !retryable(r.Request) {
+		return false
+	}
+	if err != nil {
+		return isTransientError(err)
+	}
+	return p.statuses[r.StatusCode()]
+}
+
+// retryable reports whether sending the request twice has the same effect as sending it once.
+func retryable(r *resty.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		for _, path := range retrySafePOSTPaths {
+			if path.MatchString(r.URL) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isTransientError reports whether the error is a timeout or a connection that was refused,
+// reset or closed, as opposed to an invalid request or certificate.
+func isTransientError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE)
+}
@@ -0,0 +1,145 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+)
+
+const testSwitchPortPath = "/api/v1/devices/Q234-ABCD-0001/switch/ports/1"
+
+func newTestRetryClient(t *testing.T, mock *merakiMock) *merakigosdk.Client {
+	t.Helper()
+	client := mock.client(t)
+	newRetryPolicy(DEFAULT_RETRY_ON_STATUS).install(client, 3, time.Millisecond, 5*time.Second)
+	return client
+}
+
+func TestRetryPolicyIdempotentRequests(t *testing.T) {
+	mock := newMerakiMock(t, "action_batcher")
+	client := newTestRetryClient(t, mock)
+
+	mock.failNext("GET", testSwitchPortPath, 503, 0)
+	response, _, err := client.Switch.GetDeviceSwitchPort("Q234-ABCD-0001", "1")
+	if err != nil {
+		t.Fatalf("GET not retried: %s", err)
+	}
+	if response.Name != "Port 1" {
+		t.Errorf("name = %q, want Port 1", response.Name)
+	}
+	if n := len(mock.requestsFor("GET", testSwitchPortPath)); n != 3 {
+		t.Errorf("%d GET requests, want 3", n)
+	}
+
+	mock.failNext("PUT", testSwitchPortPath, 502, 504)
+	if _, _, err := client.Switch.UpdateDeviceSwitchPort("Q234-ABCD-0001", "1", &merakigosdk.RequestSwitchUpdateDeviceSwitchPort{Name: "Uplink"}); err != nil {
+		t.Fatalf("PUT not retried: %s", err)
+	}
+	if n := len(mock.requestsFor("PUT", testSwitchPortPath)); n != 3 {
+		t.Errorf("%d PUT requests, want 3", n)
+	}
+}
+
+func TestRetryPolicyGivesUp(t *testing.T) {
+	mock := newMerakiMock(t, "action_batcher")
+	client := newTestRetryClient(t, mock)
+
+	mock.failNext("GET", testSwitchPortPath, 503, 503, 503, 503, 503)
+	if _, _, err := client.Switch.GetDeviceSwitchPort("Q234-ABCD-0001", "1"); err == nil {
+		t.Fatal("GET succeeded after the retries")
+	}
+	if n := len(mock.requestsFor("GET", testSwitchPortPath)); n != 4 {
+		t.Errorf("%d GET requests, want 1 and 3 retries", n)
+	}
+
+	mock.failNext("GET", "/api/v1/devices/Q234-ABCD-0001/switch/ports/2", 500)
+	if _, _, err := client.Switch.GetDeviceSwitchPort("Q234-ABCD-0001", "2"); err == nil {
+		t.Fatal("GET retried on a status that is not in meraki_retry_on_status")
+	}
+}
+
+func TestRetryPolicyPOST(t *testing.T) {
+	mock := newMerakiMock(t, "action_batcher")
+	client := newTestRetryClient(t, mock)
+
+	claimPath := "/api/v1/networks/N_24329156/devices/claim"
+	mock.failNext("POST", claimPath, 503)
+	_, _, err := client.Networks.ClaimNetworkDevices("N_24329156", &merakigosdk.RequestNetworksClaimNetworkDevices{Serials: []string{"Q234-ABCD-0002"}}, nil)
+	if err == nil {
+		t.Fatal("claim succeeded, want the 503 without a retry")
+	}
+	if n := len(mock.requestsFor("POST", claimPath)); n != 1 {
+		t.Errorf("%d claim requests, want 1", n)
+	}
+
+	blinkPath := "/api/v1/devices/Q234-ABCD-0001/blinkLeds"
+	mock.failNext("POST", blinkPath, 503)
+	duration := 20
+	if _, _, err := client.Devices.BlinkDeviceLeds("Q234-ABCD-0001", &merakigosdk.RequestDevicesBlinkDeviceLeds{Duration: &duration}); err != nil {
+		t.Fatalf("blink LEDs not retried: %s", err)
+	}
+	if n := len(mock.requestsFor("POST", blinkPath)); n != 2 {
+		t.Errorf("%d blink LEDs requests, want 2", n)
+	}
+
+	// The first cable test may already run after a 503, a retry would restart it.
+	cablePath := "/api/v1/devices/Q234-ABCD-0001/liveTools/cableTest"
+	mock.failNext("POST", cablePath, 503)
+	if _, _, err := client.Devices.CreateDeviceLiveToolsCableTest("Q234-ABCD-0001", &merakigosdk.RequestDevicesCreateDeviceLiveToolsCableTest{Ports: []string{"1"}}); err == nil {
+		t.Fatal("cable test succeeded, want the 503 without a retry")
+	}
+	if n := len(mock.requestsFor("POST", cablePath)); n != 1 {
+		t.Errorf("%d cable test requests, want 1", n)
+	}
+	// A 429 is retried by the SDK, the API did not run the request.
+	mock.failNext("POST", cablePath, 429)
+	if _, _, err := client.Devices.CreateDeviceLiveToolsCableTest("Q234-ABCD-0001", &merakigosdk.RequestDevicesCreateDeviceLiveToolsCableTest{Ports: []string{"1"}}); err != nil {
+		t.Fatalf("cable test not retried after a 429: %s", err)
+	}
+	if n := len(mock.requestsFor("POST", cablePath)); n != 3 {
+		t.Errorf("%d cable test requests, want 3", n)
+	}
+}
+
+type testTimeoutError struct{}
+
+func (testTimeoutError) Error() string   { return "i/o timeout" }
+func (testTimeoutError) Timeout() bool   { return true }
+func (testTimeoutError) Temporary() bool { return true }
+
+func TestIsTransientError(t *testing.T) {
+	var _ net.Error = testTimeoutError{}
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{fmt.Errorf("Get %q: %w", "https://api.meraki.com", testTimeoutError{}), true},
+		{context.DeadlineExceeded, true},
+		{fmt.Errorf("x509: certificate signed by unknown authority"), false},
+	}
+	for _, test := range tests {
+		if got := isTransientError(test.err); got != test.want {
+			t.Errorf("isTransientError(%v) = %v, want %v", test.err, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// NewServer returns the protocol 6 server of the provider. It is the framework server, plus
+// the throttling summary of the Meraki API in the diagnostics of the applies.
+func NewServer(version string) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		p := &MerakiProvider{version: version}
+		return &merakiProviderServer{
+			ProviderServer: providerserver.NewProtocol6(p)(),
+			provider:       p,
+		}
+	}
+}
+
+// merakiProviderServer wraps the framework server of the provider.
+type merakiProviderServer struct {
+	tfprotov6.ProviderServer
+	provider *MerakiProvider
+}
+
+// ApplyResourceChange adds a warning with the time the requests waited for the rate limits of
+// the organizations, once it is longer than rateLimitSummaryThreshold. Terraform does not tell
+// the provider when an apply ends, so the summary covers the throttling since the previous one.
+func (s *merakiProviderServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if resp == nil {
+		return resp, err
+	}
+	if limiter := s.provider.limiter.Load(); limiter != nil {
+		if summary := limiter.summary(); summary != "" {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  "Meraki API requests throttled",
+				Detail:   "The requests to the Meraki API waited for the rate limits of their organization.\n" + summary,
+			})
+		}
+	}
+	return resp, err
+}
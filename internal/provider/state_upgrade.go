@@ -0,0 +1,191 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Schema versions
+//
+// The schema of a resource starts at version 0. When a regeneration changes the type of an
+// attribute of a resource, like a String that becomes an Int64 or a list that becomes a set,
+// the states written by the previous releases no longer decode. Such a change bumps the
+// Version of the schema and adds the resource to the resources with a UpgradeState method,
+// which returns stateUpgraders(version). TestSchemaVersions fails when the types of a
+// resource change without a new version.
+//
+// When a resource is renamed, its former type name is added to movedResources and the
+// resource gets a MoveState method, which returns moveStateFrom(type name), so `moved`
+// blocks can move the states of the former resource.
+
+// movedResources are the former type names of the resources renamed between releases, with
+// the type name of the resource that replaced them.
+var movedResources = map[string]string{
+	"meraki_devices_appliance_vmx_authentication": "meraki_devices_appliance_vmx_authentication_token",
+	"meraki_devices_live_tools_cable_test":        "meraki_devices_live_tools_cable",
+	"meraki_networks_wireless_air_marshal_rules":  "meraki_networks_wireless_air_marshal_rules_create",
+}
+
+// stateUpgraders returns the upgraders of the states of every version before version. The
+// attributes of a prior state are converted to the types of the current schema.
+func stateUpgraders(version int64) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, version)
+	for prior := int64(0); prior < version; prior++ {
+		upgraders[prior] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				state, err := stateToSchema(req.RawState.JSON, resp.State.Schema.Type().TerraformType(ctx))
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to upgrade the resource state",
+						fmt.Sprintf("The state of schema version %d cannot be converted to schema version %d: %s", prior, version, err),
+					)
+					return
+				}
+				resp.State.Raw = state
+			},
+		}
+	}
+	return upgraders
+}
+
+// moveStateFrom returns the state mover of a resource from its former type names in
+// movedResources.
+func moveStateFrom(typeName string) []resource.StateMover {
+	return []resource.StateMover{{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if movedResources[req.SourceTypeName] != typeName || !strings.HasSuffix(strings.ToLower(req.SourceProviderAddress), "/meraki") || req.SourceRawState == nil {
+				return
+			}
+			state, err := stateToSchema(req.SourceRawState.JSON, resp.TargetState.Schema.Type().TerraformType(ctx))
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to move the resource state",
+					fmt.Sprintf("The state of %s cannot be converted to %s: %s", req.SourceTypeName, typeName, err),
+				)
+				return
+			}
+			resp.TargetState.Raw = state
+		},
+	}}
+}
+
+// stateToSchema decodes the JSON of a state written with another schema into typ. Attributes
+// that typ does not have are dropped, and values are converted to the type of their attribute
+// when possible, or dropped otherwise.
+func stateToSchema(stateJSON []byte, typ tftypes.Type) (tftypes.Value, error) {
+	var state interface{}
+	decoder := json.NewDecoder(bytes.NewReader(stateJSON))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		return tftypes.Value{}, err
+	}
+	converted, err := json.Marshal(convertStateValue(state, typ))
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	return tftypes.ValueFromJSONWithOpts(converted, typ, tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true})
+}
+
+// convertStateValue converts a JSON value of a state to typ. Strings and numbers are
+// converted to each other, a single object to a list of one object and back, and values that
+// cannot be converted become null.
+func convertStateValue(value interface{}, typ tftypes.Type) interface{} {
+	if value == nil {
+		return nil
+	}
+	switch {
+	case typ.Is(tftypes.String):
+		switch v := value.(type) {
+		case string:
+			return v
+		case json.Number:
+			return v.String()
+		case bool:
+			return strconv.FormatBool(v)
+		}
+	case typ.Is(tftypes.Number):
+		switch v := value.(type) {
+		case json.Number:
+			return v
+		case string:
+			if _, err := strconv.ParseFloat(v, 64); err == nil {
+				return json.Number(v)
+			}
+		}
+	case typ.Is(tftypes.Bool):
+		switch v := value.(type) {
+		case bool:
+			return v
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				return b
+			}
+		}
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+		var elementType tftypes.Type
+		if list, ok := typ.(tftypes.List); ok {
+			elementType = list.ElementType
+		} else {
+			elementType = typ.(tftypes.Set).ElementType
+		}
+		elements, ok := value.([]interface{})
+		if !ok {
+			elements = []interface{}{value}
+		}
+		converted := make([]interface{}, 0, len(elements))
+		for _, element := range elements {
+			if element = convertStateValue(element, elementType); element != nil {
+				converted = append(converted, element)
+			}
+		}
+		return converted
+	case typ.Is(tftypes.Map{}):
+		if values, ok := value.(map[string]interface{}); ok {
+			converted := make(map[string]interface{}, len(values))
+			for key, element := range values {
+				converted[key] = convertStateValue(element, typ.(tftypes.Map).ElementType)
+			}
+			return converted
+		}
+	case typ.Is(tftypes.Object{}):
+		if elements, ok := value.([]interface{}); ok {
+			if len(elements) != 1 {
+				return nil
+			}
+			value = elements[0]
+		}
+		if attributes, ok := value.(map[string]interface{}); ok {
+			converted := map[string]interface{}{}
+			for name, attributeType := range typ.(tftypes.Object).AttributeTypes {
+				converted[name] = convertStateValue(attributes[name], attributeType)
+			}
+			return converted
+		}
+	default:
+		return value
+	}
+	return nil
+}
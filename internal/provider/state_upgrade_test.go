@@ -0,0 +1,271 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var updateSchemaVersions = flag.Bool("update-schema-versions", false, "update testdata/schema_versions.json with the current resource schemas")
+
+func TestStateToSchema(t *testing.T) {
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"vlan_id": tftypes.Number,
+		"name":    tftypes.String,
+		"enabled": tftypes.Bool,
+		"tags":    tftypes.Set{ElementType: tftypes.String},
+		"radius": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"host": tftypes.String,
+		}},
+	}}
+	got, err := stateToSchema([]byte(`{"vlan_id":"10","name":12,"enabled":"true","tags":"a","radius":[{"host":"10.0.0.1","port":1812}],"removed":"x"}`), typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"vlan_id": tftypes.NewValue(tftypes.Number, 10),
+		"name":    tftypes.NewValue(tftypes.String, "12"),
+		"enabled": tftypes.NewValue(tftypes.Bool, true),
+		"tags":    tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "a")}),
+		"radius": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"host": tftypes.String}}, map[string]tftypes.Value{
+			"host": tftypes.NewValue(tftypes.String, "10.0.0.1"),
+		}),
+	})
+	if !got.Equal(want) {
+		t.Errorf("stateToSchema = %s, want %s", got, want)
+	}
+
+	got, err = stateToSchema([]byte(`{"vlan_id":"not a number","radius":[]}`), typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := got.As(&attributes); err != nil {
+		t.Fatal(err)
+	}
+	if !attributes["vlan_id"].IsNull() || !attributes["radius"].IsNull() {
+		t.Errorf("stateToSchema of values that cannot be converted = %s, want null values", got)
+	}
+}
+
+func TestStateUpgraders(t *testing.T) {
+	ctx := context.Background()
+	r := NewNetworksWirelessSSIDsVpnResource()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	upgrader, ok := r.(resource.ResourceWithUpgradeState).UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("no upgrader of the version 0 state")
+	}
+
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(`{"network_id":"N_1","number":"0","split_tunnel":null,"failover":null,"concentrator":{"network_id":"N_2","vlan_id":"44","name":"DC"}}`)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	var state NetworksWirelessSSIDsVpnRs
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if state.Concentrator == nil || state.Concentrator.VLANID.ValueInt64() != 44 || state.NetworkID.ValueString() != "N_1" {
+		t.Errorf("upgraded state = %+v, want the concentrator VLAN 44 of N_1", state)
+	}
+}
+
+func TestMoveStateFrom(t *testing.T) {
+	ctx := context.Background()
+	r := NewDevicesLiveToolsCableResource()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	mover := r.(resource.ResourceWithMoveState).MoveState(ctx)[0]
+	typ := schemaResp.Schema.Type().TerraformType(ctx)
+
+	for _, test := range []struct {
+		typeName, provider string
+		moved              bool
+	}{
+		{"meraki_devices_live_tools_cable_test", "registry.terraform.io/cisco-open/meraki", true},
+		{"meraki_devices_live_tools_cable_test", "registry.terraform.io/CiscoDevNet/meraki", true},
+		{"meraki_devices_live_tools_ping", "registry.terraform.io/CiscoDevNet/meraki", false},
+		{"meraki_devices_live_tools_cable_test", "registry.terraform.io/hashicorp/null", false},
+	} {
+		resp := &resource.MoveStateResponse{TargetState: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}}
+		mover.StateMover(ctx, resource.MoveStateRequest{
+			SourceTypeName:        test.typeName,
+			SourceProviderAddress: test.provider,
+			SourceRawState:        &tfprotov6.RawState{JSON: []byte(`{"serial":"Q2XX-AAAA-0001","removed":true}`)},
+		}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatal(resp.Diagnostics)
+		}
+		if moved := !resp.TargetState.Raw.IsNull(); moved != test.moved {
+			t.Errorf("state of %s from %s moved = %v, want %v", test.typeName, test.provider, moved, test.moved)
+		}
+	}
+}
+
+// schemaVersion is a resource in testdata/schema_versions.json.
+type schemaVersion struct {
+	Version    int64             `json:"version"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// TestSchemaVersions checks that the types of the attributes of a resource only change with a
+// new version of its schema, which has upgraders for the states of the previous versions, and
+// that renamed resources move the states of their former type. Run it with
+// -update-schema-versions after a change of the schemas.
+func TestSchemaVersions(t *testing.T) {
+	ctx := context.Background()
+	current := map[string]schemaVersion{}
+	resources := map[string]resource.Resource{}
+	p := &MerakiProvider{}
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		metadataResp := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "meraki"}, metadataResp)
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+		attributes := map[string]string{}
+		schemaAttributeTypes("", schemaResp.Schema.Type().TerraformType(ctx), attributes)
+		current[metadataResp.TypeName] = schemaVersion{Version: schemaResp.Schema.Version, Attributes: attributes}
+		resources[metadataResp.TypeName] = r
+	}
+
+	golden := filepath.Join("testdata", "schema_versions.json")
+	if *updateSchemaVersions {
+		content, err := json.MarshalIndent(current, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, append(content, '\n'), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	content, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var previous map[string]schemaVersion
+	if err := json.Unmarshal(content, &previous); err != nil {
+		t.Fatal(err)
+	}
+
+	for typeName, schema := range current {
+		if schema.Version > 0 {
+			upgrader, ok := resources[typeName].(resource.ResourceWithUpgradeState)
+			if !ok {
+				t.Errorf("%s has schema version %d and no UpgradeState", typeName, schema.Version)
+				continue
+			}
+			upgraders := upgrader.UpgradeState(ctx)
+			for version := int64(0); version < schema.Version; version++ {
+				if _, ok := upgraders[version]; !ok {
+					t.Errorf("%s has no upgrader of the version %d state", typeName, version)
+				}
+			}
+		}
+		prior, ok := previous[typeName]
+		if !ok {
+			t.Errorf("%s is not in %s, run the test with -update-schema-versions", typeName, golden)
+			continue
+		}
+		if schema.Version < prior.Version {
+			t.Errorf("%s schema version went down from %d to %d", typeName, prior.Version, schema.Version)
+		}
+		if schema.Version != prior.Version {
+			continue
+		}
+		var changed []string
+		for name, typ := range prior.Attributes {
+			if schema.Attributes[name] != typ {
+				changed = append(changed, fmt.Sprintf("%s (%s to %q)", name, typ, schema.Attributes[name]))
+			}
+		}
+		if len(changed) > 0 {
+			sort.Strings(changed)
+			t.Errorf("%s attributes changed without a new schema version, bump its Version and add an UpgradeState: %s",
+				typeName, strings.Join(changed, ", "))
+		}
+	}
+	for typeName := range previous {
+		if _, ok := current[typeName]; ok {
+			continue
+		}
+		target, ok := movedResources[typeName]
+		if !ok {
+			t.Errorf("%s was removed, add it to movedResources if it was renamed", typeName)
+			continue
+		}
+		if _, ok := resources[target].(resource.ResourceWithMoveState); !ok {
+			t.Errorf("%s was renamed to %s, which has no MoveState", typeName, target)
+		}
+	}
+	for typeName, target := range movedResources {
+		if _, ok := resources[target].(resource.ResourceWithMoveState); !ok {
+			t.Errorf("%s was renamed to %s, which has no MoveState", typeName, target)
+		}
+	}
+}
+
+// schemaAttributeTypes adds the type of every attribute of typ to attributes, by path.
+func schemaAttributeTypes(path string, typ tftypes.Type, attributes map[string]string) {
+	var elementType tftypes.Type
+	switch t := typ.(type) {
+	case tftypes.Object:
+		if path != "" {
+			attributes[path] = "object"
+		}
+		for name, attributeType := range t.AttributeTypes {
+			if path != "" {
+				name = path + "." + name
+			}
+			schemaAttributeTypes(name, attributeType, attributes)
+		}
+		return
+	case tftypes.List:
+		attributes[path], elementType = "list", t.ElementType
+	case tftypes.Set:
+		attributes[path], elementType = "set", t.ElementType
+	case tftypes.Map:
+		attributes[path], elementType = "map", t.ElementType
+	default:
+		attributes[path] = strings.ToLower(strings.TrimPrefix(typ.String(), "tftypes."))
+		return
+	}
+	if object, ok := elementType.(tftypes.Object); ok {
+		for name, attributeType := range object.AttributeTypes {
+			schemaAttributeTypes(path+"."+name, attributeType, attributes)
+		}
+		return
+	}
+	attributes[path] += " of " + strings.ToLower(strings.TrimPrefix(elementType.String(), "tftypes."))
+}
@@ -0,0 +1,94 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+)
+
+// transportOptions are the proxy and TLS options of the HTTP client of the SDK.
+type transportOptions struct {
+	// proxyURL is the proxy of every request. When it is empty, the proxy comes from the
+	// HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.
+	proxyURL *url.URL
+	// tlsConfig trusts the system certificates and the custom CA bundle.
+	tlsConfig *tls.Config
+}
+
+// newTransportOptions checks the options and loads the CA bundle. caCertFile and caCertPEM
+// are added to the system certificates, so the API is trusted with or without an inspecting
+// proxy.
+func newTransportOptions(proxyURL string, caCertFile string, caCertPEM string, insecureSkipVerify bool) (*transportOptions, error) {
+	options := &transportOptions{
+		tlsConfig: &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: insecureSkipVerify,
+		},
+	}
+	if proxyURL != "" {
+		parsed, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %q: %w", proxyURL, err)
+		}
+		switch parsed.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("invalid proxy URL %q: the scheme must be http, https or socks5", proxyURL)
+		}
+		if parsed.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: the host is missing", proxyURL)
+		}
+		options.proxyURL = parsed
+	}
+
+	if caCertFile == "" && caCertPEM == "" {
+		return options, nil
+	}
+	pemCerts := []byte(caCertPEM)
+	source := "the CA certificate PEM"
+	if caCertFile != "" {
+		var err error
+		pemCerts, err = os.ReadFile(caCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading the CA certificate file: %w", err)
+		}
+		source = caCertFile
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pemCerts) {
+		return nil, fmt.Errorf("no PEM certificate found in %s", source)
+	}
+	options.tlsConfig.RootCAs = pool
+	return options, nil
+}
+
+// install sets the proxy and the TLS configuration on the resty client of the SDK.
+func (o *transportOptions) install(client *merakigosdk.Client) {
+	client.RestyClient().SetTLSClientConfig(o.tlsConfig.Clone())
+	if o.proxyURL != nil {
+		client.RestyClient().SetProxy(o.proxyURL.String())
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	merakigosdk "github.com/meraki/dashboard-api-go/v5/sdk"
+)
+
+// testTransportClient returns an SDK client for baseURL with the transport options installed.
+func testTransportClient(t *testing.T, baseURL string, options *transportOptions) *merakigosdk.Client {
+	t.Helper()
+	client, err := merakigosdk.NewClientWithOptionsAndRequests(baseURL, testAccApiKey, "false", CUSTOM_USER_AGENT, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options.install(client)
+	return client
+}
+
+func TestTransportOptionsCACertificate(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeMerakiMockResponse(w, http.StatusOK, []interface{}{})
+	}))
+	// The request without the CA fails the handshake on purpose.
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+	caCertPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertFile, []byte(caCertPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name               string
+		caCertFile         string
+		caCertPEM          string
+		insecureSkipVerify bool
+		wantErr            string
+	}{
+		{name: "system certificates", wantErr: "certificate"},
+		{name: "CA file", caCertFile: caCertFile},
+		{name: "CA PEM", caCertPEM: caCertPEM},
+		{name: "insecure", insecureSkipVerify: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options, err := newTransportOptions("", test.caCertFile, test.caCertPEM, test.insecureSkipVerify)
+			if err != nil {
+				t.Fatal(err)
+			}
+			client := testTransportClient(t, server.URL, options)
+			_, err = client.RestyClient().R().Get("/api/v1/organizations")
+			if test.wantErr == "" && err != nil {
+				t.Errorf("request failed: %s", err)
+			}
+			if test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+				t.Errorf("error = %v, want %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestTransportOptionsProxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+		writeMerakiMockResponse(w, http.StatusOK, []interface{}{})
+	}))
+	defer proxy.Close()
+
+	options, err := newTransportOptions(proxy.URL, "", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := testTransportClient(t, "http://api.meraki.invalid", options)
+	if _, _, err := client.Organizations.GetOrganizations(nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(proxied) != 1 || !strings.HasPrefix(proxied[0], "http://api.meraki.invalid/api/v1/organizations") {
+		t.Errorf("proxied requests = %v", proxied)
+	}
+}
+
+func TestNewTransportOptionsErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		proxyURL   string
+		caCertFile string
+		caCertPEM  string
+		wantErr    string
+	}{
+		{name: "proxy scheme", proxyURL: "ftp://proxy.example.com", wantErr: "the scheme must be"},
+		{name: "proxy host", proxyURL: "http://", wantErr: "the host is missing"},
+		{name: "missing CA file", caCertFile: filepath.Join(t.TempDir(), "missing.pem"), wantErr: "reading the CA certificate file"},
+		{name: "invalid CA PEM", caCertPEM: "not a certificate", wantErr: "no PEM certificate found"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := newTransportOptions(test.proxyURL, test.caCertFile, test.caCertPEM, false)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("error = %v, want %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -11,6 +11,8 @@ import (
 
 	tfsdkr "github.com/cisco-open/terraform-provider-meraki/internal/provider/reflects"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -430,6 +432,23 @@ func int64ToFloat(i *int64) *float64 {
 	return &a
 }
 
+// resetOnDestroy reports whether a settings resource must push the Meraki defaults when it is destroyed.
+// The resource reset_on_destroy attribute takes precedence over the provider meraki_reset_on_destroy flag.
+func resetOnDestroy(value types.Bool, providerDefault bool) bool {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool()
+	}
+	return providerDefault
+}
+
+// writeOnlyString reads a write-only attribute from the configuration. Write-only values are
+// never part of the plan nor the state, so create and update must read them from the configuration.
+func writeOnlyString(ctx context.Context, config tfsdk.Config, attributePath path.Path) (types.String, diag.Diagnostics) {
+	var value types.String
+	diags := config.GetAttribute(ctx, attributePath, &value)
+	return value, diags
+}
+
 const (
 	// ExplicitSuppress strategy suppresses "(known after changes)" messages unless we're in the initial creation
 	ExplicitSuppress = iota
@@ -1168,6 +1187,11 @@ func mergeInterfaces(a, b interface{}, isFirstTime bool) interface{} {
 	resultStruct := reflect.New(valueA.Type()).Elem()
 
 	for i := 0; i < numFields; i++ {
+		// The timeouts block is not in the API, it is kept from the state.
+		if valueA.Field(i).Type() == reflect.TypeOf(timeouts.Value{}) {
+			resultStruct.Field(i).Set(valueA.Field(i))
+			continue
+		}
 		fieldA := valueA.Field(i)
 		fieldB := valueB.Field(i)
 		fieldA = dereferencePtr(fieldA)
@@ -1411,6 +1435,11 @@ func mergeInterfacesOnlyPath(a, b interface{}) interface{} {
 	resultStruct := reflect.New(valueA.Type()).Elem()
 
 	for i := 0; i < numFields; i++ {
+		// The timeouts block is not in the API, it is kept from the state.
+		if valueA.Field(i).Type() == reflect.TypeOf(timeouts.Value{}) {
+			resultStruct.Field(i).Set(valueA.Field(i))
+			continue
+		}
 		fieldA := valueA.Field(i)
 		fieldB := valueB.Field(i)
 		fieldA = dereferencePtr(fieldA)
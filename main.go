@@ -19,12 +19,16 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
+	"io"
 	"log"
+	"os"
 
 	"github.com/cisco-open/terraform-provider-meraki/internal/provider"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -47,19 +51,71 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/CiscoDevNet/meraki",
-		Debug:   debug,
+	var opts []tf6server.ServeOpt
+	if debug {
+		opts = append(opts, tf6server.WithManagedDebug())
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	err := tf6server.Serve("registry.terraform.io/CiscoDevNet/meraki", provider.NewServer(version), opts...)
 
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 }
+
+// export writes the networks, devices, SSIDs, VLANs, firewall rules and switch
+// ports of an organization as Terraform configuration with import blocks.
+//
+//	terraform-provider-meraki export --org <id> [--out <dir>]
+//
+// The client is configured from the environment variables of the provider:
+// MERAKI_DASHBOARD_API_KEY, MERAKI_BASE_URL or MERAKI_REGION, the proxy and TLS
+// variables, MERAKI_REQUEST_TIMEOUT and MERAKI_DEBUG.
+func export(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	organizationID := flags.String("org", "", "ID of the organization to export")
+	dir := flags.String("out", ".", "directory where the .tf files are written")
+	verbose := flags.Bool("verbose", false, "print the provider debug logs")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *organizationID == "" {
+		return errors.New("export: --org is required")
+	}
+	apiKey := os.Getenv("MERAKI_DASHBOARD_API_KEY")
+	if apiKey == "" {
+		return errors.New("export: the MERAKI_DASHBOARD_API_KEY environment variable is not set")
+	}
+	stderr := log.New(os.Stderr, "", 0)
+	if !*verbose {
+		log.SetOutput(io.Discard)
+	}
+	client, err := provider.NewExportClient(context.Background())
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	files, err := provider.Export(context.Background(), client, provider.ExportOptions{
+		OrganizationID: *organizationID,
+		Dir:            *dir,
+		Warnf: func(format string, args ...interface{}) {
+			stderr.Printf("Warning: "+format, args...)
+		},
+	})
+	for _, file := range files {
+		stderr.Printf("Wrote %s", file)
+	}
+	return err
+}